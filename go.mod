@@ -42,6 +42,7 @@ require (
 	github.com/spf13/viper v1.18.2
 	go.etcd.io/etcd/client/v3 v3.5.13
 	go.uber.org/automaxprocs v1.5.3
+	golang.org/x/crypto v0.27.0
 	golang.org/x/sync v0.8.0
 	k8s.io/api v0.31.2
 	k8s.io/apimachinery v0.31.2
@@ -216,6 +217,5 @@ require (
 	github.com/spf13/cobra v1.8.0
 	github.com/ugorji/go/codec v1.2.12 // indirect
 	go.uber.org/zap v1.24.0 // indirect
-	golang.org/x/crypto v0.27.0 // indirect
 	gopkg.in/ini.v1 v1.67.0 // indirect
 )
@@ -0,0 +1,109 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/apistruct"
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/authext"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/wrapperspb"
+	"github.com/openimsdk/tools/a2r"
+	"github.com/openimsdk/tools/apiresp"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+)
+
+// apiKeyIDKey marks a request authenticated with an API key in the gin context.
+const apiKeyIDKey = "apiKeyID"
+
+type ApiKeyApi struct {
+	Client        authext.AuthExtClient
+	imAdminUserID []string
+}
+
+func NewApiKeyApi(client authext.AuthExtClient, imAdminUserID []string) *ApiKeyApi {
+	return &ApiKeyApi{Client: client, imAdminUserID: imAdminUserID}
+}
+
+// CheckAdmin only lets admin tokens through, an API key can never manage API keys.
+func (a *ApiKeyApi) CheckAdmin(c *gin.Context) {
+	if c.GetString(apiKeyIDKey) != "" {
+		apiresp.GinError(c, errs.ErrNoPermission.WrapMsg("api keys can not manage api keys"))
+		c.Abort()
+		return
+	}
+	if err := authverify.CheckAdmin(c, a.imAdminUserID); err != nil {
+		apiresp.GinError(c, err)
+		c.Abort()
+	}
+}
+
+// Audit records the management of API keys.
+func (a *ApiKeyApi) Audit(c *gin.Context) {
+	auditRequest(c, a.Client, c.FullPath())
+}
+
+// Authenticate checks an API key sent in the token header and runs the request on behalf of
+// the admin, the request is recorded in the audit log.
+func (a *ApiKeyApi) Authenticate(c *gin.Context, token string) {
+	req := &authext.AuthenticateApiKeyReq{ApiKey: token, Ip: c.ClientIP(), Path: c.FullPath()}
+	resp, err := a.Client.AuthenticateApiKey(c, req)
+	if err != nil {
+		log.ZWarn(c, "api key rejected", err, "path", c.Request.URL.Path, "ip", c.ClientIP(), "remoteIP", c.RemoteIP())
+		apiresp.GinError(c, err)
+		c.Abort()
+		return
+	}
+	c.Set(constant.OpUserPlatform, constant.PlatformIDToName(constant.AdminPlatformID))
+	c.Set(constant.OpUserID, resp.UserID)
+	c.Set(apiKeyIDKey, resp.KeyID)
+	setGinTenantID(c, resp.TenantID)
+	auditRequest(c, a.Client, c.FullPath())
+}
+
+func (a *ApiKeyApi) CreateApiKey(c *gin.Context) {
+	a2r.Call(c, authext.AuthExtClient.CreateApiKey, a.Client)
+}
+
+// UpdateApiKey keeps the request of apistruct, whose omitted lists are left unchanged while empty ones clear them.
+func (a *ApiKeyApi) UpdateApiKey(c *gin.Context) {
+	req, err := a2r.ParseRequest[apistruct.UpdateApiKeyReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	updateReq := &authext.UpdateApiKeyReq{
+		KeyID:      req.KeyID,
+		Name:       wrapperspb.StringPtr(req.Name),
+		RateLimit:  wrapperspb.Int64Ptr(req.RateLimit),
+		ExpireTime: wrapperspb.Int64Ptr(req.ExpireTime),
+	}
+	if req.Scopes != nil {
+		updateReq.Scopes = &authext.ApiKeyScopes{Scopes: req.Scopes}
+	}
+	if req.AllowIPs != nil {
+		updateReq.AllowIPs = &authext.ApiKeyAllowIPs{AllowIPs: req.AllowIPs}
+	}
+	if err := updateReq.Check(); err != nil {
+		apiresp.GinError(c, errs.ErrArgs.WrapMsg(err.Error()))
+		return
+	}
+	resp, err := a.Client.UpdateApiKey(c, updateReq)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, resp)
+}
+
+func (a *ApiKeyApi) RotateApiKey(c *gin.Context) {
+	a2r.Call(c, authext.AuthExtClient.RotateApiKey, a.Client)
+}
+
+func (a *ApiKeyApi) DeleteApiKeys(c *gin.Context) {
+	a2r.Call(c, authext.AuthExtClient.DeleteApiKeys, a.Client)
+}
+
+func (a *ApiKeyApi) SearchApiKeys(c *gin.Context) {
+	a2r.Call(c, authext.AuthExtClient.SearchApiKeys, a.Client)
+}
@@ -0,0 +1,35 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestApiKeyClientIP(t *testing.T) {
+	gin.SetMode(gin.ReleaseMode)
+	for _, c := range []struct {
+		trustedProxies []string
+		want           string
+	}{
+		// X-Forwarded-For of an untrusted peer is ignored.
+		{nil, "192.0.2.1"},
+		{[]string{"192.0.2.0/24"}, "10.1.2.3"},
+	} {
+		r := gin.New()
+		if err := r.SetTrustedProxies(c.trustedProxies); err != nil {
+			t.Fatal(err)
+		}
+		var ip string
+		r.GET("/ip", func(c *gin.Context) { ip = c.ClientIP() })
+		req := httptest.NewRequest(http.MethodGet, "/ip", nil)
+		req.RemoteAddr = "192.0.2.1:1234"
+		req.Header.Set("X-Forwarded-For", "10.1.2.3")
+		r.ServeHTTP(httptest.NewRecorder(), req)
+		if ip != c.want {
+			t.Fatal("unexpected client ip", c.trustedProxies, ip)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+
+	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/audit"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/authext"
+	"github.com/openimsdk/tools/log"
+)
+
+// auditResponseMaxLen caps the buffered response, the error is at the start of apiresp bodies.
+const auditResponseMaxLen = 4096
+
+// auditWriter keeps the beginning of the response to read the result of the request.
+type auditWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *auditWriter) Write(b []byte) (int, error) {
+	if remain := auditResponseMaxLen - w.body.Len(); remain > 0 {
+		w.body.Write(b[:min(len(b), remain)])
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// auditRequest runs the rest of the chain and records the request and its result in the audit log
+// through the auth rpc, which takes the operator from the request.
+func auditRequest(c *gin.Context, client authext.AuthExtClient, action string) {
+	var request []byte
+	if c.Request.Body != nil {
+		// Only the head of the body is buffered, the handler still reads all of it.
+		head, err := io.ReadAll(io.LimitReader(c.Request.Body, audit.BodyMaxLen+1))
+		if err == nil {
+			c.Request.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(head), c.Request.Body), Closer: c.Request.Body}
+			request = head
+		}
+	}
+	target, summary := audit.Summary(request)
+	writer := &auditWriter{ResponseWriter: c.Writer}
+	c.Writer = writer
+	c.Next()
+
+	req := &authext.RecordAuditLogReq{
+		ApiKeyID: c.GetString(apiKeyIDKey),
+		Ip:       c.ClientIP(),
+		Action:   action,
+		Target:   target,
+		Request:  summary,
+	}
+	var resp struct {
+		ErrCode int32  `json:"errCode"`
+		ErrMsg  string `json:"errMsg"`
+	}
+	if err := json.Unmarshal(writer.body.Bytes(), &resp); err == nil {
+		req.ErrCode = resp.ErrCode
+		req.ErrMsg = resp.ErrMsg
+	}
+	if _, err := client.RecordAuditLog(c, req); err != nil {
+		log.ZWarn(c, "record audit log failed", err, "action", action)
+	}
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,41 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/authext"
+	"github.com/openimsdk/tools/a2r"
+	"github.com/openimsdk/tools/apiresp"
+	"github.com/openimsdk/tools/errs"
+)
+
+type AuditLogApi struct {
+	Client        authext.AuthExtClient
+	imAdminUserID []string
+}
+
+func NewAuditLogApi(client authext.AuthExtClient, imAdminUserID []string) *AuditLogApi {
+	return &AuditLogApi{Client: client, imAdminUserID: imAdminUserID}
+}
+
+// CheckAdmin only lets admin tokens through, the audit log is not readable with an API key.
+func (a *AuditLogApi) CheckAdmin(c *gin.Context) {
+	if c.GetString(apiKeyIDKey) != "" {
+		apiresp.GinError(c, errs.ErrNoPermission.WrapMsg("api keys can not read audit logs"))
+		c.Abort()
+		return
+	}
+	if err := authverify.CheckAdmin(c, a.imAdminUserID); err != nil {
+		apiresp.GinError(c, err)
+		c.Abort()
+	}
+}
+
+// Audit records admin operations handled by the api itself, such as config changes.
+func (a *AuditLogApi) Audit(c *gin.Context) {
+	auditRequest(c, a.Client, c.FullPath())
+}
+
+func (a *AuditLogApi) SearchAuditLogs(c *gin.Context) {
+	a2r.Call(c, authext.AuthExtClient.SearchAuditLogs, a.Client)
+}
@@ -0,0 +1,59 @@
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/audit"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/authext"
+	"github.com/openimsdk/tools/apiresp"
+	"google.golang.org/grpc"
+)
+
+type fakeAuditClient struct {
+	authext.AuthExtClient
+	logs []*authext.RecordAuditLogReq
+}
+
+func (f *fakeAuditClient) RecordAuditLog(ctx context.Context, in *authext.RecordAuditLogReq, opts ...grpc.CallOption) (*authext.RecordAuditLogResp, error) {
+	f.logs = append(f.logs, in)
+	return &authext.RecordAuditLogResp{}, nil
+}
+
+func TestAuditRequest(t *testing.T) {
+	gin.SetMode(gin.ReleaseMode)
+	client := &fakeAuditClient{}
+	r := gin.New()
+	var received int
+	r.POST("/config/set_config", func(c *gin.Context) { auditRequest(c, client, c.FullPath()) }, func(c *gin.Context) {
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		received = len(body)
+		apiresp.GinSuccess(c, nil)
+	})
+	body := `{"configName":"openim-api.yml","data":"` + strings.Repeat("a", audit.BodyMaxLen) + `"}`
+	req := httptest.NewRequest(http.MethodPost, "/config/set_config", strings.NewReader(body))
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	if received != len(body) {
+		t.Fatal("handler must read the whole body", received, len(body))
+	}
+	if len(client.logs) != 1 {
+		t.Fatal("request must be recorded")
+	}
+	auditLog := client.logs[0]
+	if auditLog.Action != "/config/set_config" || auditLog.ErrCode != 0 {
+		t.Fatal("unexpected audit log", auditLog.Action, auditLog.ErrCode)
+	}
+	if unquoted, err := strconv.Unquote(auditLog.Request); err != nil || len(unquoted) != audit.RequestMaxLen {
+		t.Fatal("oversized body must be truncated and escaped", err)
+	}
+}
@@ -16,20 +16,23 @@ package api
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/authext"
 	"github.com/openimsdk/protocol/auth"
 	"github.com/openimsdk/tools/a2r"
 )
 
 type AuthApi struct {
-	Client auth.AuthClient
+	Client    auth.AuthClient
+	ExtClient authext.AuthExtClient
 }
 
-func NewAuthApi(client auth.AuthClient) AuthApi {
-	return AuthApi{client}
+func NewAuthApi(client auth.AuthClient, extClient authext.AuthExtClient) AuthApi {
+	return AuthApi{Client: client, ExtClient: extClient}
 }
 
+// GetAdminToken issues the admin token of the tenant named in the request, the default tenant when it names none.
 func (o *AuthApi) GetAdminToken(c *gin.Context) {
-	a2r.Call(c, auth.AuthClient.GetAdminToken, o.Client)
+	a2r.Call(c, authext.AuthExtClient.GetTenantAdminToken, o.ExtClient)
 }
 
 func (o *AuthApi) GetUserToken(c *gin.Context) {
@@ -16,16 +16,18 @@ package api
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/conversationext"
 	"github.com/openimsdk/protocol/conversation"
 	"github.com/openimsdk/tools/a2r"
 )
 
 type ConversationApi struct {
-	Client conversation.ConversationClient
+	Client    conversation.ConversationClient
+	ExtClient conversationext.ConversationExtClient
 }
 
-func NewConversationApi(client conversation.ConversationClient) ConversationApi {
-	return ConversationApi{client}
+func NewConversationApi(client conversation.ConversationClient, extClient conversationext.ConversationExtClient) ConversationApi {
+	return ConversationApi{Client: client, ExtClient: extClient}
 }
 
 func (o *ConversationApi) GetAllConversations(c *gin.Context) {
@@ -40,10 +42,6 @@ func (o *ConversationApi) GetConversation(c *gin.Context) {
 	a2r.Call(c, conversation.ConversationClient.GetConversation, o.Client)
 }
 
-func (o *ConversationApi) GetConversations(c *gin.Context) {
-	a2r.Call(c, conversation.ConversationClient.GetConversations, o.Client)
-}
-
 func (o *ConversationApi) SetConversations(c *gin.Context) {
 	a2r.Call(c, conversation.ConversationClient.SetConversations, o.Client)
 }
@@ -56,14 +54,18 @@ func (o *ConversationApi) GetFullOwnerConversationIDs(c *gin.Context) {
 	a2r.Call(c, conversation.ConversationClient.GetFullOwnerConversationIDs, o.Client)
 }
 
-func (o *ConversationApi) GetIncrementalConversation(c *gin.Context) {
-	a2r.Call(c, conversation.ConversationClient.GetIncrementalConversation, o.Client)
-}
-
 func (o *ConversationApi) GetOwnerConversation(c *gin.Context) {
 	a2r.Call(c, conversation.ConversationClient.GetOwnerConversation, o.Client)
 }
 
+func (o *ConversationApi) GetConversations(c *gin.Context) {
+	a2r.Call(c, conversation.ConversationClient.GetConversations, o.Client)
+}
+
+func (o *ConversationApi) GetIncrementalConversation(c *gin.Context) {
+	a2r.Call(c, conversation.ConversationClient.GetIncrementalConversation, o.Client)
+}
+
 func (o *ConversationApi) GetNotNotifyConversationIDs(c *gin.Context) {
 	a2r.Call(c, conversation.ConversationClient.GetNotNotifyConversationIDs, o.Client)
 }
@@ -0,0 +1,28 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/tools/a2r"
+)
+
+// ConversationDraftApi serves the drafts from the msg rpc, clients usually set them over the websocket where they are debounced.
+type ConversationDraftApi struct {
+	Client msgext.MsgExtClient
+}
+
+func NewConversationDraftApi(client msgext.MsgExtClient) ConversationDraftApi {
+	return ConversationDraftApi{Client: client}
+}
+
+func (d *ConversationDraftApi) SetConversationDraft(c *gin.Context) {
+	a2r.Call(c, msgext.MsgExtClient.SetConversationDraft, d.Client)
+}
+
+func (d *ConversationDraftApi) GetConversationDrafts(c *gin.Context) {
+	a2r.Call(c, msgext.MsgExtClient.GetConversationDrafts, d.Client)
+}
+
+func (d *ConversationDraftApi) GetIncrementalConversationDrafts(c *gin.Context) {
+	a2r.Call(c, msgext.MsgExtClient.GetIncrementalConversationDrafts, d.Client)
+}
@@ -0,0 +1,35 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/conversationext"
+	"github.com/openimsdk/tools/a2r"
+)
+
+func (o *ConversationApi) CreateConversationFolder(c *gin.Context) {
+	a2r.Call(c, conversationext.ConversationExtClient.CreateConversationFolder, o.ExtClient)
+}
+
+func (o *ConversationApi) UpdateConversationFolder(c *gin.Context) {
+	a2r.Call(c, conversationext.ConversationExtClient.UpdateConversationFolder, o.ExtClient)
+}
+
+func (o *ConversationApi) AddFolderConversations(c *gin.Context) {
+	a2r.Call(c, conversationext.ConversationExtClient.AddFolderConversations, o.ExtClient)
+}
+
+func (o *ConversationApi) RemoveFolderConversations(c *gin.Context) {
+	a2r.Call(c, conversationext.ConversationExtClient.RemoveFolderConversations, o.ExtClient)
+}
+
+func (o *ConversationApi) DeleteConversationFolders(c *gin.Context) {
+	a2r.Call(c, conversationext.ConversationExtClient.DeleteConversationFolders, o.ExtClient)
+}
+
+func (o *ConversationApi) GetIncrementalConversationFolders(c *gin.Context) {
+	a2r.Call(c, conversationext.ConversationExtClient.GetIncrementalConversationFolders, o.ExtClient)
+}
+
+func (o *ConversationApi) GetFolderConversationIDs(c *gin.Context) {
+	a2r.Call(c, conversationext.ConversationExtClient.GetFolderConversationIDs, o.ExtClient)
+}
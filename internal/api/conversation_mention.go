@@ -0,0 +1,24 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/tools/a2r"
+)
+
+// ConversationMentionApi serves the unread mentions of the user from the msg rpc.
+type ConversationMentionApi struct {
+	Client msgext.MsgExtClient
+}
+
+func NewConversationMentionApi(client msgext.MsgExtClient) ConversationMentionApi {
+	return ConversationMentionApi{Client: client}
+}
+
+func (m *ConversationMentionApi) GetUnreadMentions(c *gin.Context) {
+	a2r.Call(c, msgext.MsgExtClient.GetUnreadMentions, m.Client)
+}
+
+func (m *ConversationMentionApi) GetNextMention(c *gin.Context) {
+	a2r.Call(c, msgext.MsgExtClient.GetNextMention, m.Client)
+}
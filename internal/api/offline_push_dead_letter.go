@@ -0,0 +1,38 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/pushext"
+	"github.com/openimsdk/tools/a2r"
+	"github.com/openimsdk/tools/apiresp"
+)
+
+// OfflinePushDeadLetterApi lets the admin inspect the offline pushes that failed all their attempts, and queue them again.
+type OfflinePushDeadLetterApi struct {
+	Client        pushext.PushExtClient
+	imAdminUserID []string
+}
+
+func NewOfflinePushDeadLetterApi(client pushext.PushExtClient, imAdminUserID []string) OfflinePushDeadLetterApi {
+	return OfflinePushDeadLetterApi{Client: client, imAdminUserID: imAdminUserID}
+}
+
+func (o *OfflinePushDeadLetterApi) CheckAdmin(c *gin.Context) {
+	if err := authverify.CheckAdmin(c, o.imAdminUserID); err != nil {
+		apiresp.GinError(c, err)
+		c.Abort()
+	}
+}
+
+func (o *OfflinePushDeadLetterApi) SearchOfflinePushDeadLetters(c *gin.Context) {
+	a2r.Call(c, pushext.PushExtClient.SearchOfflinePushDeadLetters, o.Client)
+}
+
+func (o *OfflinePushDeadLetterApi) ReplayOfflinePushDeadLetters(c *gin.Context) {
+	a2r.Call(c, pushext.PushExtClient.ReplayOfflinePushDeadLetters, o.Client)
+}
+
+func (o *OfflinePushDeadLetterApi) DeleteOfflinePushDeadLetters(c *gin.Context) {
+	a2r.Call(c, pushext.PushExtClient.DeleteOfflinePushDeadLetters, o.Client)
+}
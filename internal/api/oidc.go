@@ -0,0 +1,76 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/apistruct"
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/authext"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpcli"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/a2r"
+	"github.com/openimsdk/tools/apiresp"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+)
+
+type OIDCApi struct {
+	verifier      *authverify.OIDCVerifier
+	userClient    *rpcli.UserClient
+	tokens        authext.AuthExtClient
+	imAdminUserID []string
+}
+
+func NewOIDCApi(verifier *authverify.OIDCVerifier, userClient *rpcli.UserClient, tokens authext.AuthExtClient, imAdminUserID []string) *OIDCApi {
+	return &OIDCApi{verifier: verifier, userClient: userClient, tokens: tokens, imAdminUserID: imAdminUserID}
+}
+
+// ExchangeOIDCToken trades an ID token of a trusted issuer for an OpenIM token, so the app
+// server no longer has to call get_user_token with the admin token on every login.
+func (o *OIDCApi) ExchangeOIDCToken(c *gin.Context) {
+	req, err := a2r.ParseRequest[apistruct.ExchangeOIDCTokenReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	identity, err := o.verifier.Verify(c, req.IDToken)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if len(o.imAdminUserID) == 0 {
+		apiresp.GinError(c, errs.ErrInternalServer.WrapMsg("no admin user configured"))
+		return
+	}
+	// The user belongs to the tenant of the issuer, never to one the caller picks.
+	setGinTenantID(c, identity.TenantID)
+	// The caller has no token, registering and issuing the token is done on behalf of the admin.
+	ctx := mcontext.WithOpUserIDContext(c, o.imAdminUserID[0])
+	users, err := o.userClient.GetUsersInfo(ctx, []string{identity.UserID})
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if len(users) == 0 {
+		if !identity.AutoRegister {
+			apiresp.GinError(c, errs.ErrRecordNotFound.WrapMsg("user is not registered", "userID", identity.UserID))
+			return
+		}
+		nickname := identity.Nickname
+		if nickname == "" {
+			nickname = identity.UserID
+		}
+		user := &sdkws.UserInfo{UserID: identity.UserID, Nickname: nickname, FaceURL: identity.FaceURL}
+		if err := o.userClient.RegisterUsers(ctx, []*sdkws.UserInfo{user}); err != nil {
+			apiresp.GinError(c, err)
+			return
+		}
+		log.ZInfo(c, "oidc user registered", "userID", identity.UserID, "issuer", identity.Issuer)
+	}
+	resp, err := o.tokens.IssueUserToken(ctx, &authext.IssueUserTokenReq{UserID: identity.UserID, PlatformID: req.PlatformID})
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, resp)
+}
@@ -0,0 +1,27 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/thirdext"
+	"github.com/openimsdk/tools/a2r"
+)
+
+func (o *ThirdApi) RegisterPushDevice(c *gin.Context) {
+	a2r.Call(c, thirdext.ThirdExtClient.RegisterPushDevice, o.ExtClient)
+}
+
+func (o *ThirdApi) RegisterWebPush(c *gin.Context) {
+	a2r.Call(c, thirdext.ThirdExtClient.RegisterWebPush, o.ExtClient)
+}
+
+func (o *ThirdApi) UpdatePushDevice(c *gin.Context) {
+	a2r.Call(c, thirdext.ThirdExtClient.UpdatePushDevice, o.ExtClient)
+}
+
+func (o *ThirdApi) GetPushDevices(c *gin.Context) {
+	a2r.Call(c, thirdext.ThirdExtClient.GetPushDevices, o.ExtClient)
+}
+
+func (o *ThirdApi) UnregisterPushDevice(c *gin.Context) {
+	a2r.Call(c, thirdext.ThirdExtClient.UnregisterPushDevice, o.ExtClient)
+}
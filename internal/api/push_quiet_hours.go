@@ -0,0 +1,19 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/thirdext"
+	"github.com/openimsdk/tools/a2r"
+)
+
+func (o *ThirdApi) SetPushQuietHours(c *gin.Context) {
+	a2r.Call(c, thirdext.ThirdExtClient.SetPushQuietHours, o.ExtClient)
+}
+
+func (o *ThirdApi) GetPushQuietHours(c *gin.Context) {
+	a2r.Call(c, thirdext.ThirdExtClient.GetPushQuietHours, o.ExtClient)
+}
+
+func (o *ThirdApi) SnoozePush(c *gin.Context) {
+	a2r.Call(c, thirdext.ThirdExtClient.SnoozePush, o.ExtClient)
+}
@@ -0,0 +1,19 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/thirdext"
+	"github.com/openimsdk/tools/a2r"
+)
+
+func (o *ThirdApi) SetPushTemplate(c *gin.Context) {
+	a2r.Call(c, thirdext.ThirdExtClient.SetPushTemplate, o.ExtClient)
+}
+
+func (o *ThirdApi) GetPushTemplates(c *gin.Context) {
+	a2r.Call(c, thirdext.ThirdExtClient.GetPushTemplates, o.ExtClient)
+}
+
+func (o *ThirdApi) DeletePushTemplate(c *gin.Context) {
+	a2r.Call(c, thirdext.ThirdExtClient.DeletePushTemplate, o.ExtClient)
+}
@@ -0,0 +1,92 @@
+package api
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/tools/apiresp"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+)
+
+const (
+	rateLimitKeyUser   = "user"
+	rateLimitKeyIP     = "ip"
+	rateLimitKeyRoute  = "route"
+	rateLimitKeyApiKey = "apiKey"
+)
+
+type RateLimiter struct {
+	cache         cache.RateLimitCache
+	conf          *config.RateLimit
+	routes        map[string]*config.RateLimitRule
+	imAdminUserID []string
+}
+
+func NewRateLimiter(cache cache.RateLimitCache, conf *config.RateLimit, imAdminUserID []string) *RateLimiter {
+	routes := make(map[string]*config.RateLimitRule, len(conf.Routes))
+	for i := range conf.Routes {
+		routes[conf.Routes[i].Path] = &conf.Routes[i].RateLimitRule
+	}
+	return &RateLimiter{cache: cache, conf: conf, routes: routes, imAdminUserID: imAdminUserID}
+}
+
+// Limit rejects the request once its caller ran out of the allowance of the route.
+// The limiter fails open, requests pass when redis is unavailable.
+func (r *RateLimiter) Limit(c *gin.Context) {
+	route := c.FullPath()
+	rule, ok := r.routes[route]
+	if !ok {
+		rule = &r.conf.Default
+	}
+	if rule.Limit <= 0 || rule.Window <= 0 {
+		return
+	}
+	apiKeyID := c.GetString(apiKeyIDKey)
+	if r.conf.ExemptAdmin && apiKeyID == "" && authverify.IsManagerUserID(mcontext.GetOpUserID(c), r.imAdminUserID) {
+		return
+	}
+	allowed, retryAfter, err := r.cache.Allow(c, route, r.caller(c, rule.Key, apiKeyID), rule.Limit, time.Duration(rule.Window)*time.Second)
+	if err != nil {
+		log.ZWarn(c, "rate limit check failed", err, "route", route)
+		prommetrics.RateLimitCall(route, "error")
+		return
+	}
+	if allowed {
+		prommetrics.RateLimitCall(route, "allowed")
+		return
+	}
+	prommetrics.RateLimitCall(route, "rejected")
+	seconds := int64(math.Ceil(retryAfter.Seconds()))
+	c.Header("Retry-After", strconv.FormatInt(seconds, 10))
+	apiresp.GinError(c, servererrs.ErrTooManyRequests.WrapMsg("rate limit exceeded", "route", route, "retryAfter", seconds))
+	c.Abort()
+}
+
+// caller returns who the request is counted against, falling back to the ip for unauthenticated requests.
+// The ip is the peer address unless it is one of api.trustedProxies, so clients can not pick a new
+// X-Forwarded-For per request to escape the limit.
+func (r *RateLimiter) caller(c *gin.Context, key string, apiKeyID string) string {
+	switch key {
+	case rateLimitKeyRoute:
+		return ""
+	case rateLimitKeyIP:
+	case rateLimitKeyApiKey:
+		if apiKeyID != "" {
+			return "key_" + apiKeyID
+		}
+		fallthrough
+	default:
+		if userID := mcontext.GetOpUserID(c); userID != "" {
+			return "user_" + userID
+		}
+	}
+	return "ip_" + c.ClientIP()
+}
@@ -0,0 +1,64 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/protocol/constant"
+)
+
+type fakeRateLimitCache struct {
+	callers []string
+}
+
+func (f *fakeRateLimitCache) Allow(ctx context.Context, route string, caller string, limit int64, window time.Duration) (bool, time.Duration, error) {
+	f.callers = append(f.callers, caller)
+	return true, 0, nil
+}
+
+func TestRateLimitCaller(t *testing.T) {
+	gin.SetMode(gin.ReleaseMode)
+	for _, c := range []struct {
+		key      string
+		userID   string
+		apiKeyID string
+		want     string
+	}{
+		{rateLimitKeyUser, "u1", "", "user_u1"},
+		{rateLimitKeyUser, "", "", "ip_192.0.2.1"},
+		{rateLimitKeyIP, "u1", "", "ip_192.0.2.1"},
+		{rateLimitKeyRoute, "u1", "", ""},
+		{rateLimitKeyApiKey, "u1", "k1", "key_k1"},
+		{rateLimitKeyApiKey, "u1", "", "user_u1"},
+		{rateLimitKeyApiKey, "", "", "ip_192.0.2.1"},
+	} {
+		cache := &fakeRateLimitCache{}
+		limiter := NewRateLimiter(cache, &config.RateLimit{Default: config.RateLimitRule{Key: c.key, Limit: 10, Window: 1}}, nil)
+		r := gin.New()
+		// Like the api, no proxy is trusted unless configured.
+		if err := r.SetTrustedProxies(nil); err != nil {
+			t.Fatal(err)
+		}
+		r.POST("/msg/send_msg", func(ctx *gin.Context) {
+			if c.userID != "" {
+				ctx.Set(constant.OpUserID, c.userID)
+			}
+			if c.apiKeyID != "" {
+				ctx.Set(apiKeyIDKey, c.apiKeyID)
+			}
+		}, limiter.Limit)
+		req := httptest.NewRequest(http.MethodPost, "/msg/send_msg", nil)
+		req.RemoteAddr = "192.0.2.1:1234"
+		// A spoofed X-Forwarded-For must not change the caller.
+		req.Header.Set("X-Forwarded-For", "10.9.9.9")
+		r.ServeHTTP(httptest.NewRecorder(), req)
+		if len(cache.callers) != 1 || cache.callers[0] != c.want {
+			t.Fatal("unexpected caller", c.key, c.userID, c.apiKeyID, cache.callers)
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/authext"
+	"github.com/openimsdk/tools/a2r"
+)
+
+type RefreshTokenApi struct {
+	Client authext.AuthExtClient
+}
+
+func NewRefreshTokenApi(client authext.AuthExtClient) *RefreshTokenApi {
+	return &RefreshTokenApi{Client: client}
+}
+
+// GetUserToken issues the token through the auth rpc, paired with a refresh token.
+func (r *RefreshTokenApi) GetUserToken(c *gin.Context) {
+	a2r.Call(c, authext.AuthExtClient.IssueUserToken, r.Client)
+}
+
+func (r *RefreshTokenApi) RefreshToken(c *gin.Context) {
+	a2r.Call(c, authext.AuthExtClient.RefreshToken, r.Client)
+}
@@ -10,9 +10,18 @@ import (
 	"github.com/gin-gonic/gin/binding"
 	"github.com/go-playground/validator/v10"
 	"github.com/openimsdk/open-im-server/v3/internal/api/jssdk"
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/redis"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/authext"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/conversationext"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/pushext"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/thirdext"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/userext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcli"
 	pbAuth "github.com/openimsdk/protocol/auth"
 	"github.com/openimsdk/protocol/constant"
@@ -23,8 +32,10 @@ import (
 	"github.com/openimsdk/protocol/third"
 	"github.com/openimsdk/protocol/user"
 	"github.com/openimsdk/tools/apiresp"
+	"github.com/openimsdk/tools/db/redisutil"
 	"github.com/openimsdk/tools/discovery"
 	"github.com/openimsdk/tools/discovery/etcd"
+	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/mw"
 	clientv3 "go.etcd.io/etcd/client/v3"
@@ -81,8 +92,22 @@ func newGinRouter(ctx context.Context, client discovery.SvcDiscoveryRegistry, cf
 	if err != nil {
 		return nil, err
 	}
+	pushConn, err := client.GetConn(ctx, cfg.Discovery.RpcService.Push)
+	if err != nil {
+		return nil, err
+	}
+	rdb, err := redisutil.NewRedisClient(ctx, cfg.Redis.Build())
+	if err != nil {
+		return nil, err
+	}
+	ak := NewApiKeyApi(authext.NewAuthExtClient(authConn), cfg.Share.IMAdminUserID)
 	gin.SetMode(gin.ReleaseMode)
 	r := gin.New()
+	// gin trusts X-Forwarded-For from every peer by default, the client IP must not be spoofable
+	// since API keys and the rate limiter rely on it.
+	if err := r.SetTrustedProxies(cfg.API.Api.TrustedProxies); err != nil {
+		return nil, errs.WrapMsg(err, "invalid api.trustedProxies")
+	}
 	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
 		_ = v.RegisterValidation("required_if", RequiredIf)
 	}
@@ -96,9 +121,12 @@ func newGinRouter(ctx context.Context, client discovery.SvcDiscoveryRegistry, cf
 		r.Use(gzip.Gzip(gzip.BestSpeed))
 	}
 	r.Use(prommetricsGin(), gin.RecoveryWithWriter(gin.DefaultErrorWriter, mw.GinPanicErr), mw.CorsHandler(),
-		mw.GinParseOperationID(), GinParseToken(rpcli.NewAuthClient(authConn)))
+		mw.GinParseOperationID(), GinParseToken(rpcli.NewAuthClient(authConn), ak))
+	if cfg.API.RateLimit.Enable {
+		r.Use(NewRateLimiter(redis.NewRateLimitCacheRedis(rdb), &cfg.API.RateLimit, cfg.Share.IMAdminUserID).Limit)
+	}
 
-	u := NewUserApi(user.NewUserClient(userConn), client, cfg.Discovery.RpcService)
+	u := NewUserApi(user.NewUserClient(userConn), userext.NewUserExtClient(userConn), client, cfg.Discovery.RpcService, cfg.Share.IMAdminUserID)
 	{
 		userRouterGroup := r.Group("/user")
 		userRouterGroup.POST("/user_register", u.UserRegister)
@@ -124,6 +152,20 @@ func newGinRouter(ctx context.Context, client discovery.SvcDiscoveryRegistry, cf
 		userRouterGroup.POST("/add_notification_account", u.AddNotificationAccount)
 		userRouterGroup.POST("/update_notification_account", u.UpdateNotificationAccountInfo)
 		userRouterGroup.POST("/search_notification_account", u.SearchNotificationAccount)
+
+		userRouterGroup.POST("/suspend_user", u.SuspendUser)
+		userRouterGroup.POST("/unsuspend_user", u.UnsuspendUser)
+		userRouterGroup.POST("/get_suspended_users", u.GetSuspendedUsers)
+
+		userRouterGroup.POST("/set_user_field_schema", u.SetUserFieldSchema)
+		userRouterGroup.POST("/delete_user_field_schema", u.DeleteUserFieldSchema)
+		userRouterGroup.POST("/get_user_field_schema", u.GetUserFieldSchema)
+		userRouterGroup.POST("/set_user_fields", u.SetUserFields)
+		userRouterGroup.POST("/search_users_by_fields", u.SearchUsersByFields)
+
+		userRouterGroup.POST("/set_last_seen_privacy", u.SetLastSeenPrivacy)
+		userRouterGroup.POST("/get_last_seen_privacy", u.GetLastSeenPrivacy)
+		userRouterGroup.POST("/get_users_last_seen", u.GetUsersLastSeen)
 	}
 	// friend routing group
 	{
@@ -189,21 +231,51 @@ func newGinRouter(ctx context.Context, client discovery.SvcDiscoveryRegistry, cf
 	}
 	// certificate
 	{
-		a := NewAuthApi(pbAuth.NewAuthClient(authConn))
+		a := NewAuthApi(pbAuth.NewAuthClient(authConn), authext.NewAuthExtClient(authConn))
 		authRouterGroup := r.Group("/auth")
 		authRouterGroup.POST("/get_admin_token", a.GetAdminToken)
-		authRouterGroup.POST("/get_user_token", a.GetUserToken)
 		authRouterGroup.POST("/parse_token", a.ParseToken)
 		authRouterGroup.POST("/force_logout", a.ForceLogout)
 
+		s := NewSessionApi(authext.NewAuthExtClient(authConn))
+		authRouterGroup.POST("/get_sessions", s.GetSessions)
+		authRouterGroup.POST("/revoke_session", s.RevokeSession)
+		authRouterGroup.POST("/revoke_other_sessions", s.RevokeOtherSessions)
+
+		rt := NewRefreshTokenApi(authext.NewAuthExtClient(authConn))
+		authRouterGroup.POST("/get_user_token", rt.GetUserToken)
+		authRouterGroup.POST("/refresh_token", rt.RefreshToken)
+
+		for _, issuer := range cfg.API.OIDC.Issuers {
+			if issuer.TenantID != "" && cfg.Share.GetTenant(issuer.TenantID) == nil {
+				return nil, errs.ErrArgs.WrapMsg("oidc issuer tenant is not configured", "issuer", issuer.Issuer, "tenantID", issuer.TenantID)
+			}
+		}
+		oidc := NewOIDCApi(authverify.NewOIDCVerifier(&cfg.API.OIDC), rpcli.NewUserClient(userConn), authext.NewAuthExtClient(authConn), cfg.Share.IMAdminUserID)
+		authRouterGroup.POST("/exchange_oidc_token", oidc.ExchangeOIDCToken)
+
+		tk := NewTokenKeyApi(authext.NewAuthExtClient(authConn))
+		authRouterGroup.GET("/jwks", tk.JWKS)
+		authRouterGroup.POST("/rotate_signing_key", tk.RotateSigningKey)
+
 	}
 	// Third service
 	{
-		t := NewThirdApi(third.NewThirdClient(thirdConn), cfg.API.Prometheus.GrafanaURL)
+		t := NewThirdApi(third.NewThirdClient(thirdConn), thirdext.NewThirdExtClient(thirdConn), cfg.API.Prometheus.GrafanaURL)
 		thirdGroup := r.Group("/third")
 		thirdGroup.GET("/prometheus", t.GetPrometheus)
 		thirdGroup.POST("/fcm_update_token", t.FcmUpdateToken)
 		thirdGroup.POST("/set_app_badge", t.SetAppBadge)
+		thirdGroup.POST("/register_push_device", t.RegisterPushDevice)
+		thirdGroup.POST("/unregister_push_device", t.UnregisterPushDevice)
+		thirdGroup.POST("/update_push_device", t.UpdatePushDevice)
+		thirdGroup.POST("/get_push_devices", t.GetPushDevices)
+		thirdGroup.POST("/register_web_push", t.RegisterWebPush)
+		thirdGroup.POST("/unregister_web_push", t.UnregisterPushDevice)
+
+		thirdGroup.POST("/set_push_quiet_hours", t.SetPushQuietHours)
+		thirdGroup.POST("/get_push_quiet_hours", t.GetPushQuietHours)
+		thirdGroup.POST("/snooze_push", t.SnoozePush)
 
 		logs := thirdGroup.Group("/logs")
 		logs.POST("/upload", t.UploadLogs)
@@ -251,19 +323,37 @@ func newGinRouter(ctx context.Context, client discovery.SvcDiscoveryRegistry, cf
 	}
 	// Conversation
 	{
-		c := NewConversationApi(conversation.NewConversationClient(conversationConn))
+		c := NewConversationApi(conversation.NewConversationClient(conversationConn), conversationext.NewConversationExtClient(conversationConn))
 		conversationGroup := r.Group("/conversation")
 		conversationGroup.POST("/get_sorted_conversation_list", c.GetSortedConversationList)
 		conversationGroup.POST("/get_all_conversations", c.GetAllConversations)
 		conversationGroup.POST("/get_conversation", c.GetConversation)
-		conversationGroup.POST("/get_conversations", c.GetConversations)
 		conversationGroup.POST("/set_conversations", c.SetConversations)
 		conversationGroup.POST("/get_conversation_offline_push_user_ids", c.GetConversationOfflinePushUserIDs)
 		conversationGroup.POST("/get_full_conversation_ids", c.GetFullOwnerConversationIDs)
-		conversationGroup.POST("/get_incremental_conversations", c.GetIncrementalConversation)
 		conversationGroup.POST("/get_owner_conversation", c.GetOwnerConversation)
 		conversationGroup.POST("/get_not_notify_conversation_ids", c.GetNotNotifyConversationIDs)
 		conversationGroup.POST("/get_pinned_conversation_ids", c.GetPinnedConversationIDs)
+
+		conversationGroup.POST("/create_folder", c.CreateConversationFolder)
+		conversationGroup.POST("/update_folder", c.UpdateConversationFolder)
+		conversationGroup.POST("/add_folder_conversations", c.AddFolderConversations)
+		conversationGroup.POST("/remove_folder_conversations", c.RemoveFolderConversations)
+		conversationGroup.POST("/delete_folders", c.DeleteConversationFolders)
+		conversationGroup.POST("/get_incremental_folders", c.GetIncrementalConversationFolders)
+		conversationGroup.POST("/get_folder_conversation_ids", c.GetFolderConversationIDs)
+
+		conversationGroup.POST("/get_conversations", c.GetConversations)
+		conversationGroup.POST("/get_incremental_conversations", c.GetIncrementalConversation)
+
+		cd := NewConversationDraftApi(msgext.NewMsgExtClient(msgConn))
+		conversationGroup.POST("/set_draft", cd.SetConversationDraft)
+		conversationGroup.POST("/get_drafts", cd.GetConversationDrafts)
+		conversationGroup.POST("/get_incremental_drafts", cd.GetIncrementalConversationDrafts)
+
+		cm := NewConversationMentionApi(msgext.NewMsgExtClient(msgConn))
+		conversationGroup.POST("/get_unread_mentions", cm.GetUnreadMentions)
+		conversationGroup.POST("/get_next_mention", cm.GetNextMention)
 	}
 
 	{
@@ -301,29 +391,64 @@ func newGinRouter(ctx context.Context, client discovery.SvcDiscoveryRegistry, cf
 	if cfg.Discovery.Enable == config.ETCD {
 		etcdClient = client.(*etcd.SvcDiscoveryRegistryImpl).GetClient()
 	}
+	{
+		apiKeyGroup := r.Group("/api_key", ak.CheckAdmin, ak.Audit)
+		apiKeyGroup.POST("/create", ak.CreateApiKey)
+		apiKeyGroup.POST("/update", ak.UpdateApiKey)
+		apiKeyGroup.POST("/rotate", ak.RotateApiKey)
+		apiKeyGroup.POST("/delete", ak.DeleteApiKeys)
+		apiKeyGroup.POST("/search", ak.SearchApiKeys)
+	}
+	al := NewAuditLogApi(authext.NewAuthExtClient(authConn), cfg.Share.IMAdminUserID)
+	{
+		auditGroup := r.Group("/audit", al.CheckAdmin)
+		auditGroup.POST("/search_logs", al.SearchAuditLogs)
+	}
+	pd := NewOfflinePushDeadLetterApi(pushext.NewPushExtClient(pushConn), cfg.Share.IMAdminUserID)
+	{
+		deadLetterGroup := r.Group("/push_dead_letter", pd.CheckAdmin)
+		deadLetterGroup.POST("/search", pd.SearchOfflinePushDeadLetters)
+		deadLetterGroup.POST("/replay", al.Audit, pd.ReplayOfflinePushDeadLetters)
+		deadLetterGroup.POST("/delete", al.Audit, pd.DeleteOfflinePushDeadLetters)
+	}
+	pt := NewThirdApi(third.NewThirdClient(thirdConn), thirdext.NewThirdExtClient(thirdConn), cfg.API.Prometheus.GrafanaURL)
+	{
+		// The admin is checked before the audit, so only the requests of the admin are recorded.
+		pushTemplateGroup := r.Group("/push_template", al.CheckAdmin)
+		pushTemplateGroup.POST("/set", al.Audit, pt.SetPushTemplate)
+		pushTemplateGroup.POST("/get", pt.GetPushTemplates)
+		pushTemplateGroup.POST("/delete", al.Audit, pt.DeletePushTemplate)
+	}
+
 	cm := NewConfigManager(cfg.Share.IMAdminUserID, cfg.AllConfig, etcdClient, cfg.ConfigPath, cfg.RuntimeEnv)
 	{
 
 		configGroup := r.Group("/config", cm.CheckAdmin)
 		configGroup.POST("/get_config_list", cm.GetConfigList)
 		configGroup.POST("/get_config", cm.GetConfig)
-		configGroup.POST("/set_config", cm.SetConfig)
-		configGroup.POST("/reset_config", cm.ResetConfig)
-		configGroup.POST("/set_enable_config_manager", cm.SetEnableConfigManager)
+		configGroup.POST("/set_config", al.Audit, cm.SetConfig)
+		configGroup.POST("/reset_config", al.Audit, cm.ResetConfig)
+		configGroup.POST("/set_enable_config_manager", al.Audit, cm.SetEnableConfigManager)
 		configGroup.POST("/get_enable_config_manager", cm.GetEnableConfigManager)
 	}
 	{
-		r.POST("/restart", cm.CheckAdmin, cm.Restart)
+		r.POST("/restart", cm.CheckAdmin, al.Audit, cm.Restart)
 	}
 	return r, nil
 }
 
-func GinParseToken(authClient *rpcli.AuthClient) gin.HandlerFunc {
+func GinParseToken(authClient *rpcli.AuthClient, apiKey *ApiKeyApi) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		switch c.Request.Method {
 		case http.MethodPost:
 			for _, wApi := range Whitelist {
 				if strings.HasPrefix(c.Request.URL.Path, wApi) {
+					// The tenant is never taken from the headers here, get_admin_token names it in its request
+					// and the auth rpc checks it against the secret.
+					if token := c.Request.Header.Get(constant.Token); authverify.IsApiKey(token) {
+						apiKey.Authenticate(c, token)
+						return
+					}
 					c.Next()
 					return
 				}
@@ -336,6 +461,19 @@ func GinParseToken(authClient *rpcli.AuthClient) gin.HandlerFunc {
 				c.Abort()
 				return
 			}
+			if authverify.IsApiKey(token) {
+				apiKey.Authenticate(c, token)
+				return
+			}
+			claims, err := authverify.ParseClaimsUnverified(token)
+			if err != nil {
+				apiresp.GinError(c, err)
+				c.Abort()
+				return
+			}
+			// The auth rpc verifies the signature of the claimed tenant and rejects the token of
+			// another tenant.
+			setGinTenantID(c, claims.TenantID)
 			resp, err := authClient.ParseToken(c, token)
 			if err != nil {
 				apiresp.GinError(c, err)
@@ -349,8 +487,19 @@ func GinParseToken(authClient *rpcli.AuthClient) gin.HandlerFunc {
 	}
 }
 
+// setGinTenantID stores the tenant the same way tenant.WithTenantID does, so it is forwarded to the rpc services.
+func setGinTenantID(c *gin.Context, tenantID string) {
+	if tenantID == "" {
+		return
+	}
+	c.Set(constant.RpcCustomHeader, []string{tenant.TenantID})
+	c.Set(tenant.TenantID, []string{tenantID})
+}
+
 // Whitelist api not parse token
 var Whitelist = []string{
 	"/auth/get_admin_token",
 	"/auth/parse_token",
+	"/auth/refresh_token",
+	"/auth/exchange_oidc_token",
 }
@@ -0,0 +1,40 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/authext"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/a2r"
+)
+
+type SessionApi struct {
+	Client authext.AuthExtClient
+}
+
+func NewSessionApi(client authext.AuthExtClient) *SessionApi {
+	return &SessionApi{Client: client}
+}
+
+// GetSessions marks the session of the token of the request as the current one.
+func (s *SessionApi) GetSessions(c *gin.Context) {
+	a2r.Call(c, authext.AuthExtClient.GetSessions, s.Client, &a2r.Option[authext.GetSessionsReq, authext.GetSessionsResp]{
+		BindAfter: func(req *authext.GetSessionsReq) error {
+			req.Token = c.GetHeader(constant.Token)
+			return nil
+		},
+	})
+}
+
+func (s *SessionApi) RevokeSession(c *gin.Context) {
+	a2r.Call(c, authext.AuthExtClient.RevokeSession, s.Client)
+}
+
+// RevokeOtherSessions keeps the session of the token of the request.
+func (s *SessionApi) RevokeOtherSessions(c *gin.Context) {
+	a2r.Call(c, authext.AuthExtClient.RevokeOtherSessions, s.Client, &a2r.Option[authext.RevokeOtherSessionsReq, authext.RevokeOtherSessionsResp]{
+		BindAfter: func(req *authext.RevokeOtherSessionsReq) error {
+			req.PreservedToken = c.GetHeader(constant.Token)
+			return nil
+		},
+	})
+}
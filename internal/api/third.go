@@ -24,6 +24,8 @@ import (
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/thirdext"
 	"github.com/openimsdk/protocol/third"
 	"github.com/openimsdk/tools/a2r"
 	"github.com/openimsdk/tools/errs"
@@ -33,10 +35,11 @@ import (
 type ThirdApi struct {
 	GrafanaUrl string
 	Client     third.ThirdClient
+	ExtClient  thirdext.ThirdExtClient
 }
 
-func NewThirdApi(client third.ThirdClient, grafanaUrl string) ThirdApi {
-	return ThirdApi{Client: client, GrafanaUrl: grafanaUrl}
+func NewThirdApi(client third.ThirdClient, extClient thirdext.ThirdExtClient, grafanaUrl string) ThirdApi {
+	return ThirdApi{Client: client, ExtClient: extClient, GrafanaUrl: grafanaUrl}
 }
 
 func (o *ThirdApi) FcmUpdateToken(c *gin.Context) {
@@ -132,6 +135,8 @@ func (o *ThirdApi) ObjectRedirect(c *gin.Context) {
 	if operationID == "" {
 		operationID = strconv.Itoa(rand.Int())
 	}
+	tenantID, name := tenant.SplitObjectKey(name)
+	setGinTenantID(c, tenantID)
 	ctx := mcontext.SetOperationID(c, operationID)
 	query := make(map[string]string)
 	for key, values := range c.Request.URL.Query() {
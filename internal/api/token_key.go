@@ -0,0 +1,48 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/authext"
+	"github.com/openimsdk/tools/a2r"
+	"github.com/openimsdk/tools/apiresp"
+)
+
+type TokenKeyApi struct {
+	Client authext.AuthExtClient
+}
+
+func NewTokenKeyApi(client authext.AuthExtClient) *TokenKeyApi {
+	return &TokenKeyApi{Client: client}
+}
+
+// JWKS serves the public token keys as a plain RFC 7517 document, so standard JWT libraries can consume it.
+func (t *TokenKeyApi) JWKS(c *gin.Context) {
+	resp, err := t.Client.GetJWKS(c, &authext.GetJWKSReq{})
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	jwks := &authverify.JWKS{Keys: make([]*authverify.JWK, 0, len(resp.Keys))}
+	for _, key := range resp.Keys {
+		jwks.Keys = append(jwks.Keys, &authverify.JWK{
+			Kty: key.Kty,
+			Use: key.Use,
+			Alg: key.Alg,
+			Kid: key.Kid,
+			N:   key.N,
+			E:   key.E,
+			Crv: key.Crv,
+			X:   key.X,
+			Y:   key.Y,
+		})
+	}
+	c.Header("Cache-Control", "public, max-age=60")
+	c.JSON(http.StatusOK, jwks)
+}
+
+func (t *TokenKeyApi) RotateSigningKey(c *gin.Context) {
+	a2r.Call(c, authext.AuthExtClient.RotateSigningKey, t.Client)
+}
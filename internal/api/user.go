@@ -16,25 +16,32 @@ package api
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/apistruct"
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/userext"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/msggateway"
+	"github.com/openimsdk/protocol/sdkws"
 	"github.com/openimsdk/protocol/user"
 	"github.com/openimsdk/tools/a2r"
 	"github.com/openimsdk/tools/apiresp"
 	"github.com/openimsdk/tools/discovery"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
 )
 
 type UserApi struct {
-	Client user.UserClient
-	discov discovery.SvcDiscoveryRegistry
-	config config.RpcService
+	Client        user.UserClient
+	ExtClient     userext.UserExtClient
+	discov        discovery.SvcDiscoveryRegistry
+	config        config.RpcService
+	imAdminUserID []string
 }
 
-func NewUserApi(client user.UserClient, discov discovery.SvcDiscoveryRegistry, config config.RpcService) UserApi {
-	return UserApi{Client: client, discov: discov, config: config}
+func NewUserApi(client user.UserClient, extClient userext.UserExtClient, discov discovery.SvcDiscoveryRegistry, config config.RpcService, imAdminUserID []string) UserApi {
+	return UserApi{Client: client, ExtClient: extClient, discov: discov, config: config, imAdminUserID: imAdminUserID}
 }
 
 func (u *UserApi) UserRegister(c *gin.Context) {
@@ -54,7 +61,55 @@ func (u *UserApi) SetGlobalRecvMessageOpt(c *gin.Context) {
 }
 
 func (u *UserApi) GetUsersPublicInfo(c *gin.Context) {
-	a2r.Call(c, user.UserClient.GetDesignateUsers, u.Client)
+	req, err := a2r.ParseRequest[user.GetDesignateUsersReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	resp, err := u.Client.GetDesignateUsers(c, req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if err := u.hideSuspendedUsers(c, resp); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	usersInfo, err := u.fillVisibleFields(c, resp.UsersInfo)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, &apistruct.GetUsersPublicInfoResp{UsersInfo: usersInfo})
+}
+
+// hideSuspendedUsers removes suspended users that asked to hide their profile, unless the caller is an admin.
+func (u *UserApi) hideSuspendedUsers(c *gin.Context, resp *user.GetDesignateUsersResp) error {
+	if len(resp.UsersInfo) == 0 || authverify.IsAppManagerUid(c, u.imAdminUserID) {
+		return nil
+	}
+	hidden, err := u.ExtClient.GetHiddenUserIDs(c, &userext.GetHiddenUserIDsReq{
+		UserIDs: datautil.Slice(resp.UsersInfo, func(e *sdkws.UserInfo) string { return e.UserID }),
+	})
+	if err != nil {
+		return err
+	}
+	resp.UsersInfo = datautil.Filter(resp.UsersInfo, func(e *sdkws.UserInfo) (*sdkws.UserInfo, bool) {
+		return e, !datautil.Contain(e.UserID, hidden.UserIDs...)
+	})
+	return nil
+}
+
+func (u *UserApi) SuspendUser(c *gin.Context) {
+	a2r.Call(c, userext.UserExtClient.SuspendUser, u.ExtClient)
+}
+
+func (u *UserApi) UnsuspendUser(c *gin.Context) {
+	a2r.Call(c, userext.UserExtClient.UnsuspendUser, u.ExtClient)
+}
+
+func (u *UserApi) GetSuspendedUsers(c *gin.Context) {
+	a2r.Call(c, userext.UserExtClient.GetSuspendedUsers, u.ExtClient)
 }
 
 func (u *UserApi) GetAllUsersID(c *gin.Context) {
@@ -0,0 +1,135 @@
+package api
+
+import (
+	"encoding/json"
+
+	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/apistruct"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/userext"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/a2r"
+	"github.com/openimsdk/tools/apiresp"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+func (u *UserApi) SetUserFieldSchema(c *gin.Context) {
+	a2r.Call(c, userext.UserExtClient.SetUserFieldSchema, u.ExtClient)
+}
+
+func (u *UserApi) DeleteUserFieldSchema(c *gin.Context) {
+	a2r.Call(c, userext.UserExtClient.DeleteUserFieldSchema, u.ExtClient)
+}
+
+func (u *UserApi) GetUserFieldSchema(c *gin.Context) {
+	a2r.Call(c, userext.UserExtClient.GetUserFieldSchema, u.ExtClient)
+}
+
+func (u *UserApi) SetUserFields(c *gin.Context) {
+	req, err := a2r.ParseRequest[apistruct.SetUserFieldsReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	fields, err := encodeUserFields(req.Fields)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if _, err := u.ExtClient.SetUserFields(c, &userext.SetUserFieldsReq{UserID: req.UserID, Fields: fields}); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, &apistruct.SetUserFieldsResp{})
+}
+
+func (u *UserApi) SearchUsersByFields(c *gin.Context) {
+	req, err := a2r.ParseRequest[apistruct.SearchUsersByFieldsReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	fields, err := encodeUserFields(req.Fields)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	resp, err := u.ExtClient.SearchUsersByFields(c, &userext.SearchUsersByFieldsReq{
+		UserID:     req.UserID,
+		Nickname:   req.Nickname,
+		Fields:     fields,
+		Pagination: req.Pagination,
+	})
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	res := &apistruct.SearchUsersByFieldsResp{Total: resp.Total}
+	for _, user := range resp.Users {
+		values, err := decodeUserFields(user.Fields)
+		if err != nil {
+			apiresp.GinError(c, err)
+			return
+		}
+		res.Users = append(res.Users, &apistruct.UserInfoWithFields{UserInfo: user.UserInfo, Fields: values})
+	}
+	apiresp.GinSuccess(c, res)
+}
+
+// fillVisibleFields attaches the custom fields the caller is allowed to see to each user.
+func (u *UserApi) fillVisibleFields(c *gin.Context, usersInfo []*sdkws.UserInfo) ([]*apistruct.UserInfoWithFields, error) {
+	res := datautil.Slice(usersInfo, func(e *sdkws.UserInfo) *apistruct.UserInfoWithFields {
+		return &apistruct.UserInfoWithFields{UserInfo: e}
+	})
+	if len(res) == 0 {
+		return res, nil
+	}
+	resp, err := u.ExtClient.GetVisibleUserFields(c, &userext.GetVisibleUserFieldsReq{
+		UserIDs: datautil.Slice(usersInfo, func(e *sdkws.UserInfo) string { return e.UserID }),
+	})
+	if err != nil {
+		return nil, err
+	}
+	userFields := datautil.SliceToMapAny(resp.Users, func(e *userext.UserFields) (string, map[string]string) {
+		return e.UserID, e.Fields
+	})
+	for _, info := range res {
+		fields, err := decodeUserFields(userFields[info.UserID])
+		if err != nil {
+			return nil, err
+		}
+		info.Fields = fields
+	}
+	return res, nil
+}
+
+// encodeUserFields JSON encodes the values of the custom fields for the user rpc.
+func encodeUserFields(fields map[string]any) (map[string]string, error) {
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	res := make(map[string]string, len(fields))
+	for key, value := range fields {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, errs.ErrArgs.WrapMsg("invalid user field value", "key", key)
+		}
+		res[key] = string(data)
+	}
+	return res, nil
+}
+
+func decodeUserFields(fields map[string]string) (map[string]any, error) {
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	res := make(map[string]any, len(fields))
+	for key, data := range fields {
+		if !json.Valid([]byte(data)) {
+			return nil, servererrs.ErrData.WrapMsg("invalid user field value", "key", key)
+		}
+		res[key] = json.RawMessage(data)
+	}
+	return res, nil
+}
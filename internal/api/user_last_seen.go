@@ -0,0 +1,19 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/open-im-server/v3/pkg/protocol/userext"
+	"github.com/openimsdk/tools/a2r"
+)
+
+func (u *UserApi) SetLastSeenPrivacy(c *gin.Context) {
+	a2r.Call(c, userext.UserExtClient.SetLastSeenPrivacy, u.ExtClient)
+}
+
+func (u *UserApi) GetLastSeenPrivacy(c *gin.Context) {
+	a2r.Call(c, userext.UserExtClient.GetLastSeenPrivacy, u.ExtClient)
+}
+
+func (u *UserApi) GetUsersLastSeen(c *gin.Context) {
+	a2r.Call(c, userext.UserExtClient.GetUsersLastSeen, u.ExtClient)
+}
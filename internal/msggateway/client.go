@@ -24,6 +24,7 @@ import (
 
 	"google.golang.org/protobuf/proto"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/sdkws"
@@ -83,6 +84,11 @@ type Client struct {
 }
 
 // ResetClient updates the client's state with new connection and context information.
+// userKey identifies the user among the users of all tenants connected to the gateway.
+func (c *Client) userKey() string {
+	return tenant.KeyOf(c.ctx.TenantID, c.UserID)
+}
+
 func (c *Client) ResetClient(ctx *UserConnContext, conn LongConn, longConnServer LongConnServer) {
 	c.w = new(sync.Mutex)
 	c.conn = conn
@@ -212,9 +218,9 @@ func (c *Client) handleMessage(message []byte) error {
 		return errs.New("exception conn userID not same to req userID", "binaryReq", binaryReq.String())
 	}
 
-	ctx := mcontext.WithMustInfoCtx(
+	ctx := tenant.WithTenantID(mcontext.WithMustInfoCtx(
 		[]string{binaryReq.OperationID, binaryReq.SendID, constant.PlatformIDToName(c.PlatformID), c.ctx.GetConnID()},
-	)
+	), c.ctx.TenantID)
 
 	log.ZDebug(ctx, "gateway req message", "req", binaryReq.String())
 
@@ -238,6 +244,8 @@ func (c *Client) handleMessage(message []byte) error {
 		resp, messageErr = c.longConnServer.GetConversationsHasReadAndMaxSeq(ctx, binaryReq)
 	case WsPullConvLastMessage:
 		resp, messageErr = c.longConnServer.GetLastMessage(ctx, binaryReq)
+	case WsSetConversationDraft:
+		resp, messageErr = c.longConnServer.SetConversationDraft(ctx, binaryReq)
 	case WsLogoutMsg:
 		resp, messageErr = c.longConnServer.UserLogout(ctx, binaryReq)
 	case WsSetBackgroundStatus:
@@ -28,6 +28,7 @@ const (
 	BackgroundStatus        = "isBackground"
 	SendResponse            = "isMsgResp"
 	SDKType                 = "sdkType"
+	DeviceName              = "deviceName"
 )
 
 const (
@@ -41,19 +42,20 @@ const (
 
 const (
 	// Websocket Protocol.
-	WSGetNewestSeq        = 1001
-	WSPullMsgBySeqList    = 1002
-	WSSendMsg             = 1003
-	WSSendSignalMsg       = 1004
-	WSPullMsg             = 1005
-	WSGetConvMaxReadSeq   = 1006
-	WsPullConvLastMessage = 1007
-	WSPushMsg             = 2001
-	WSKickOnlineMsg       = 2002
-	WsLogoutMsg           = 2003
-	WsSetBackgroundStatus = 2004
-	WsSubUserOnlineStatus = 2005
-	WSDataError           = 3001
+	WSGetNewestSeq         = 1001
+	WSPullMsgBySeqList     = 1002
+	WSSendMsg              = 1003
+	WSSendSignalMsg        = 1004
+	WSPullMsg              = 1005
+	WSGetConvMaxReadSeq    = 1006
+	WsPullConvLastMessage  = 1007
+	WsSetConversationDraft = 1008
+	WSPushMsg              = 2001
+	WSKickOnlineMsg        = 2002
+	WsLogoutMsg            = 2003
+	WsSetBackgroundStatus  = 2004
+	WsSubUserOnlineStatus  = 2005
+	WSDataError            = 3001
 )
 
 const (
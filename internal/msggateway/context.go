@@ -16,12 +16,16 @@ package msggateway
 
 import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"net"
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/utils/encrypt"
 	"github.com/openimsdk/tools/utils/stringutil"
 	"github.com/openimsdk/tools/utils/timeutil"
@@ -34,6 +38,8 @@ type UserConnContext struct {
 	Method     string
 	RemoteAddr string
 	ConnID     string
+	// TenantID is taken from the claims of the token once the auth rpc verified it.
+	TenantID string
 }
 
 func (c *UserConnContext) Deadline() (deadline time.Time, ok bool) {
@@ -60,6 +66,16 @@ func (c *UserConnContext) Value(key any) any {
 		return constant.PlatformIDToName(stringutil.StringToInt(c.GetPlatformID()))
 	case constant.RemoteAddr:
 		return c.RemoteAddr
+	case constant.RpcCustomHeader:
+		if c.TenantID == "" {
+			return nil
+		}
+		return []string{tenant.TenantID}
+	case tenant.TenantID:
+		if c.TenantID == "" {
+			return nil
+		}
+		return []string{c.TenantID}
 	default:
 		return ""
 	}
@@ -90,6 +106,68 @@ func (c *UserConnContext) GetRemoteAddr() string {
 	return c.RemoteAddr
 }
 
+// GetClientIP returns the peer address of the connection. X-Forwarded-For is only read when the peer
+// is a trusted proxy, the address is the last one of the header that was not added by a trusted proxy.
+func (c *UserConnContext) GetClientIP(trustedProxies []*net.IPNet) string {
+	ip := c.Req.RemoteAddr
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		ip = host
+	}
+	if !ipTrusted(ip, trustedProxies) {
+		return ip
+	}
+	forwarded := strings.Split(c.Req.Header.Get("X-Forwarded-For"), ",")
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		addr := strings.TrimSpace(forwarded[i])
+		if net.ParseIP(addr) == nil {
+			break
+		}
+		ip = addr
+		if !ipTrusted(ip, trustedProxies) {
+			break
+		}
+	}
+	return ip
+}
+
+func ipTrusted(ip string, trustedProxies []*net.IPNet) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, proxy := range trustedProxies {
+		if proxy.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseTrustedProxies parses the configured proxies, a single IP is trusted as a /32 or /128.
+func parseTrustedProxies(proxies []string) ([]*net.IPNet, error) {
+	nets := make([]*net.IPNet, 0, len(proxies))
+	for _, proxy := range proxies {
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return nil, errs.ErrArgs.WrapMsg("invalid trusted proxy", "proxy", proxy)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, errs.WrapMsg(err, "invalid trusted proxy", "proxy", proxy)
+		}
+		nets = append(nets, ipNet)
+	}
+	return nets, nil
+}
+
 func (c *UserConnContext) Query(key string) (string, bool) {
 	var value string
 	if value = c.Req.URL.Query().Get(key); value == "" {
@@ -178,6 +256,11 @@ func (c *UserConnContext) SetToken(token string) {
 	c.Req.URL.RawQuery = Token + "=" + token
 }
 
+func (c *UserConnContext) GetDeviceName() string {
+	deviceName, _ := c.Query(DeviceName)
+	return deviceName
+}
+
 func (c *UserConnContext) GetBackground() bool {
 	b, err := strconv.ParseBool(c.Req.URL.Query().Get(BackgroundStatus))
 	if err != nil {
@@ -0,0 +1,33 @@
+package msggateway
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetClientIP(t *testing.T) {
+	proxies, err := parseTrustedProxies([]string{"10.0.0.0/8", "192.168.1.1"})
+	require.NoError(t, err)
+	newCtx := func(remoteAddr, forwarded string) *UserConnContext {
+		req := &http.Request{RemoteAddr: remoteAddr, Header: http.Header{}}
+		if forwarded != "" {
+			req.Header.Set("X-Forwarded-For", forwarded)
+		}
+		return &UserConnContext{Req: req}
+	}
+	// An untrusted peer can not choose its address.
+	assert.Equal(t, "1.2.3.4", newCtx("1.2.3.4:5000", "8.8.8.8").GetClientIP(proxies))
+	assert.Equal(t, "1.2.3.4", newCtx("1.2.3.4:5000", "8.8.8.8").GetClientIP(nil))
+	// A trusted proxy forwards the address of the client.
+	assert.Equal(t, "8.8.8.8", newCtx("10.1.2.3:5000", "8.8.8.8").GetClientIP(proxies))
+	assert.Equal(t, "10.1.2.3", newCtx("10.1.2.3:5000", "").GetClientIP(proxies))
+	// Addresses the client put in front of the header are ignored.
+	assert.Equal(t, "8.8.8.8", newCtx("192.168.1.1:5000", "6.6.6.6, 8.8.8.8, 10.0.0.2").GetClientIP(proxies))
+	assert.Equal(t, "10.0.0.2", newCtx("192.168.1.1:5000", "junk, 10.0.0.2").GetClientIP(proxies))
+
+	_, err = parseTrustedProxies([]string{"not-an-ip"})
+	assert.Error(t, err)
+}
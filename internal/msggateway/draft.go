@@ -0,0 +1,162 @@
+package msggateway
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/open-im-server/v3/pkg/notification"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpcli"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+)
+
+// draftSaveDelay is how long the drafts typed in a conversation are coalesced before the latest one is saved.
+const draftSaveDelay = 2 * time.Second
+
+type setConversationDraftReq struct {
+	ConversationID   string `json:"conversationID"`
+	Text             string `json:"text"`
+	ReplyClientMsgID string `json:"replyClientMsgID"`
+}
+
+func (ws *WsServer) SetConversationDraft(ctx context.Context, data *Req) ([]byte, error) {
+	var req setConversationDraftReq
+	if err := json.Unmarshal(data.Data, &req); err != nil {
+		return nil, errs.WrapMsg(err, "SetConversationDraft: error unmarshaling request", "action", "unmarshal", "dataType", "setConversationDraftReq")
+	}
+	if req.ConversationID == "" {
+		return nil, errs.ErrArgs.WrapMsg("conversationID is empty")
+	}
+	ws.drafts.set(ctx, &model.ConversationDraft{
+		OwnerUserID:      data.SendID,
+		ConversationID:   req.ConversationID,
+		Text:             req.Text,
+		ReplyClientMsgID: req.ReplyClientMsgID,
+		UpdateTime:       time.Now(),
+	})
+	return nil, nil
+}
+
+type draftKey struct {
+	tenantID       string
+	userID         string
+	conversationID string
+}
+
+type pendingDraft struct {
+	ctx   context.Context
+	draft *model.ConversationDraft
+	timer *time.Timer
+}
+
+// draftSaver saves the drafts sent by the clients, at most once per delay for each conversation,
+// and tells the other devices of the user about them.
+type draftSaver struct {
+	db        controller.ConversationDraftDatabase
+	msgClient *rpcli.MsgClient
+	delay     time.Duration
+
+	lock    sync.Mutex
+	pending map[draftKey]*pendingDraft
+	// saving holds the drafts being saved, the channel is closed once saved.
+	saving map[draftKey]chan struct{}
+}
+
+func newDraftSaver(db controller.ConversationDraftDatabase, msgClient *rpcli.MsgClient, delay time.Duration) *draftSaver {
+	return &draftSaver{
+		db:        db,
+		msgClient: msgClient,
+		delay:     delay,
+		pending:   make(map[draftKey]*pendingDraft),
+		saving:    make(map[draftKey]chan struct{}),
+	}
+}
+
+func (d *draftSaver) set(ctx context.Context, draft *model.ConversationDraft) {
+	key := draftKey{tenantID: tenant.GetTenantID(ctx), userID: draft.OwnerUserID, conversationID: draft.ConversationID}
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if p, ok := d.pending[key]; ok {
+		p.ctx, p.draft = ctx, draft
+		return
+	}
+	p := &pendingDraft{ctx: ctx, draft: draft}
+	p.timer = time.AfterFunc(d.delay, func() { d.flush(key) })
+	d.pending[key] = p
+}
+
+// discard drops the pending draft of the conversation the message is sent in, the message replaces
+// it. It is called before the message is sent, a draft being saved is waited for so that the msg rpc
+// clears it once stored.
+func (d *draftSaver) discard(ctx context.Context, msg *sdkws.MsgData) {
+	if msg.MsgFrom != constant.UserMsgType {
+		return
+	}
+	key := draftKey{tenantID: tenant.GetTenantID(ctx), userID: msg.SendID, conversationID: msgprocessor.GetConversationIDByMsg(msg)}
+	d.lock.Lock()
+	if p, ok := d.pending[key]; ok {
+		p.timer.Stop()
+		delete(d.pending, key)
+	}
+	saving := d.saving[key]
+	d.lock.Unlock()
+	if saving != nil {
+		<-saving
+	}
+}
+
+// flushUser saves the pending drafts of the user right away, it is called when a connection of the user closes.
+func (d *draftSaver) flushUser(tenantID string, userID string) {
+	var keys []draftKey
+	d.lock.Lock()
+	for key := range d.pending {
+		if key.tenantID == tenantID && key.userID == userID {
+			keys = append(keys, key)
+		}
+	}
+	d.lock.Unlock()
+	for _, key := range keys {
+		d.flush(key)
+	}
+}
+
+func (d *draftSaver) flush(key draftKey) {
+	d.lock.Lock()
+	p, ok := d.pending[key]
+	if !ok {
+		d.lock.Unlock()
+		return
+	}
+	delete(d.pending, key)
+	saving := make(chan struct{})
+	d.saving[key] = saving
+	d.lock.Unlock()
+	p.timer.Stop()
+	stored, err := d.db.SetDraft(p.ctx, p.draft)
+	d.lock.Lock()
+	if d.saving[key] == saving {
+		delete(d.saving, key)
+	}
+	d.lock.Unlock()
+	close(saving)
+	if err != nil {
+		log.ZWarn(p.ctx, "save conversation draft failed", err, "userID", key.userID, "conversationID", key.conversationID)
+		return
+	}
+	if !stored {
+		return
+	}
+	req := rpcclient.NewSelfBusinessNotification(key.userID, rpcclient.ConversationDraftChangedKey, convert.ConversationDraftDB2Api(p.draft))
+	if _, err := d.msgClient.MsgClient.SendMsg(p.ctx, req); err != nil {
+		log.ZWarn(p.ctx, "send conversation draft changed notification failed", err, "userID", key.userID, "conversationID", key.conversationID)
+	}
+}
@@ -0,0 +1,102 @@
+package msggateway
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpcli"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/protocol/sdkws"
+	"google.golang.org/grpc"
+)
+
+type fakeDraftDatabase struct {
+	controller.ConversationDraftDatabase
+	block chan struct{}
+
+	lock   sync.Mutex
+	stored []string
+}
+
+func (f *fakeDraftDatabase) SetDraft(_ context.Context, draft *model.ConversationDraft) (bool, error) {
+	if f.block != nil {
+		<-f.block
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.stored = append(f.stored, draft.ConversationID)
+	return true, nil
+}
+
+func (f *fakeDraftDatabase) storedDrafts() []string {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return append([]string(nil), f.stored...)
+}
+
+type fakeDraftMsgClient struct {
+	msg.MsgClient
+}
+
+func (fakeDraftMsgClient) SendMsg(context.Context, *msg.SendMsgReq, ...grpc.CallOption) (*msg.SendMsgResp, error) {
+	return &msg.SendMsgResp{}, nil
+}
+
+func singleChatMsg(sendID string, recvID string) *sdkws.MsgData {
+	return &sdkws.MsgData{SendID: sendID, RecvID: recvID, SessionType: constant.SingleChatType, MsgFrom: constant.UserMsgType}
+}
+
+func TestDraftSaverDiscard(t *testing.T) {
+	db := &fakeDraftDatabase{}
+	d := newDraftSaver(db, &rpcli.MsgClient{MsgClient: fakeDraftMsgClient{}}, 20*time.Millisecond)
+	ctx := context.Background()
+	d.set(ctx, &model.ConversationDraft{OwnerUserID: "u1", ConversationID: "si_u1_u2", Text: "a"})
+	d.set(ctx, &model.ConversationDraft{OwnerUserID: "u1", ConversationID: "si_u1_u3", Text: "b"})
+	// The message sent in the conversation replaces its draft, which must not be saved after the send.
+	d.discard(ctx, singleChatMsg("u1", "u2"))
+	time.Sleep(100 * time.Millisecond)
+	if stored := db.storedDrafts(); len(stored) != 1 || stored[0] != "si_u1_u3" {
+		t.Fatal("unexpected stored drafts", stored)
+	}
+}
+
+func TestDraftSaverDiscardWaitsForSave(t *testing.T) {
+	db := &fakeDraftDatabase{block: make(chan struct{})}
+	d := newDraftSaver(db, &rpcli.MsgClient{MsgClient: fakeDraftMsgClient{}}, time.Hour)
+	ctx := context.Background()
+	d.set(ctx, &model.ConversationDraft{OwnerUserID: "u1", ConversationID: "si_u1_u2", Text: "a"})
+	go d.flushUser("", "u1")
+	for {
+		d.lock.Lock()
+		saving := len(d.saving)
+		d.lock.Unlock()
+		if saving == 1 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	discarded := make(chan struct{})
+	go func() {
+		d.discard(ctx, singleChatMsg("u1", "u2"))
+		close(discarded)
+	}()
+	select {
+	case <-discarded:
+		t.Fatal("the message was sent while its draft was being saved")
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(db.block)
+	select {
+	case <-discarded:
+	case <-time.After(time.Second):
+		t.Fatal("the message was not sent once its draft was saved")
+	}
+	if stored := db.storedDrafts(); len(stored) != 1 {
+		t.Fatal("unexpected stored drafts", stored)
+	}
+}
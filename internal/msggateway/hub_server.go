@@ -23,6 +23,7 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/startrpc"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/msggateway"
 	"github.com/openimsdk/protocol/sdkws"
@@ -106,7 +107,7 @@ func (s *Server) GetUsersOnlineStatus(ctx context.Context, req *msggateway.GetUs
 	}
 	var resp msggateway.GetUsersOnlineStatusResp
 	for _, userID := range req.UserIDs {
-		clients, ok := s.LongConnServer.GetUserAllCons(userID)
+		clients, ok := s.LongConnServer.GetUserAllCons(ctx, userID)
 		if !ok {
 			continue
 		}
@@ -134,7 +135,7 @@ func (s *Server) GetUsersOnlineStatus(ctx context.Context, req *msggateway.GetUs
 }
 
 func (s *Server) pushToUser(ctx context.Context, userID string, msgData *sdkws.MsgData) *msggateway.SingleMsgToUserResults {
-	clients, ok := s.LongConnServer.GetUserAllCons(userID)
+	clients, ok := s.LongConnServer.GetUserAllCons(ctx, userID)
 	if !ok {
 		log.ZDebug(ctx, "push user not online", "userID", userID)
 		return &msggateway.SingleMsgToUserResults{
@@ -223,15 +224,28 @@ func (s *Server) SuperGroupOnlineBatchPushOneMsg(ctx context.Context, req *msgga
 	}
 }
 
+// KickUserOffline closes the connections of the users on the platform, on all their platforms when the platformID is zero.
 func (s *Server) KickUserOffline(ctx context.Context, req *msggateway.KickUserOfflineReq) (*msggateway.KickUserOfflineResp, error) {
+	tokens := authverify.GetKickTokens(ctx)
 	for _, v := range req.KickUserIDList {
-		clients, _, ok := s.LongConnServer.GetUserPlatformCons(v, int(req.PlatformID))
+		var (
+			clients []*Client
+			ok      bool
+		)
+		if req.PlatformID == 0 {
+			clients, ok = s.LongConnServer.GetUserAllCons(ctx, v)
+		} else {
+			clients, _, ok = s.LongConnServer.GetUserPlatformCons(ctx, v, int(req.PlatformID))
+		}
 		if !ok {
 			log.ZDebug(ctx, "conn not exist", "userID", v, "platformID", req.PlatformID)
 			continue
 		}
 
 		for _, client := range clients {
+			if len(tokens) > 0 && !datautil.Contain(client.token, tokens...) {
+				continue
+			}
 			log.ZDebug(ctx, "kick user offline", "userID", v, "platformID", req.PlatformID, "client", client)
 			if err := client.longConnServer.KickUserConn(client); err != nil {
 				log.ZWarn(ctx, "kick user offline failed", err, "userID", v, "platformID", req.PlatformID)
@@ -244,8 +258,9 @@ func (s *Server) KickUserOffline(ctx context.Context, req *msggateway.KickUserOf
 }
 
 func (s *Server) MultiTerminalLoginCheck(ctx context.Context, req *msggateway.MultiTerminalLoginCheckReq) (*msggateway.MultiTerminalLoginCheckResp, error) {
-	if oldClients, userOK, clientOK := s.LongConnServer.GetUserPlatformCons(req.UserID, int(req.PlatformID)); userOK {
+	if oldClients, userOK, clientOK := s.LongConnServer.GetUserPlatformCons(ctx, req.UserID, int(req.PlatformID)); userOK {
 		tempUserCtx := newTempContext()
+		tempUserCtx.TenantID = tenant.GetTenantID(ctx)
 		tempUserCtx.SetToken(req.Token)
 		tempUserCtx.SetOperationID(mcontext.GetOperationID(ctx))
 		client := &Client{}
@@ -19,7 +19,11 @@ import (
 	"time"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/redis"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/mgo"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpccache"
+	"github.com/openimsdk/tools/db/mongoutil"
 	"github.com/openimsdk/tools/db/redisutil"
 	"github.com/openimsdk/tools/utils/datautil"
 	"github.com/openimsdk/tools/utils/runtimeenv"
@@ -31,6 +35,7 @@ type Config struct {
 	MsgGateway     config.MsgGateway
 	Share          config.Share
 	RedisConfig    config.Redis
+	MongodbConfig  config.Mongo
 	WebhooksConfig config.Webhooks
 	Discovery      config.Discovery
 
@@ -53,17 +58,37 @@ func Start(ctx context.Context, index int, conf *Config) error {
 	if err != nil {
 		return err
 	}
+	mgocli, err := mongoutil.NewMongoDB(ctx, conf.MongodbConfig.Build())
+	if err != nil {
+		return err
+	}
+	userPrivacyDB, err := mgo.NewUserPrivacyMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	conversationDraftDB, err := mgo.NewConversationDraftMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	trustedProxies, err := parseTrustedProxies(conf.MsgGateway.LongConnSvr.TrustedProxies)
+	if err != nil {
+		return err
+	}
 	longServer := NewWsServer(
 		conf,
 		WithPort(wsPort),
 		WithMaxConnNum(int64(conf.MsgGateway.LongConnSvr.WebsocketMaxConnNum)),
 		WithHandshakeTimeout(time.Duration(conf.MsgGateway.LongConnSvr.WebsocketTimeout)*time.Second),
 		WithMessageMaxMsgLength(conf.MsgGateway.LongConnSvr.WebsocketMaxMsgLen),
+		WithTrustedProxies(trustedProxies),
 	)
+	longServer.tokenSession = redis.NewTokenSessionCache(rdb)
+	longServer.lastSeen = controller.NewUserLastSeenDatabase(userPrivacyDB, redis.NewUserLastSeenCacheRedis(rdb, userPrivacyDB, redis.GetRocksCacheOptions()))
+	longServer.draftDatabase = controller.NewConversationDraftDatabase(conversationDraftDB, redis.NewConversationDraftCacheRedis(rdb, conversationDraftDB, redis.GetRocksCacheOptions()))
 
 	hubServer := NewServer(longServer, conf, func(srv *Server) error {
 		var err error
-		longServer.online, err = rpccache.NewOnlineCache(srv.userClient, nil, rdb, false, longServer.subscriberUserOnlineStatusChanges)
+		longServer.online, err = rpccache.NewOnlineCache(srv.userClient, nil, rdb, false, conf.Share.TenantIDs(), longServer.subscriberUserOnlineStatusChanges)
 		return err
 	})
 
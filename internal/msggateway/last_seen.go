@@ -0,0 +1,41 @@
+package msggateway
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+// lastSeenVisible reports whether viewerUserID may see the last-seen time of userID.
+func (ws *WsServer) lastSeenVisible(ctx context.Context, userID string, viewerUserID string, privacy string) (bool, error) {
+	if userID == viewerUserID {
+		return true, nil
+	}
+	switch privacy {
+	case model.LastSeenPrivacyNobody:
+		return false, nil
+	case model.LastSeenPrivacyFriends:
+		return ws.relationClient.IsFriend(ctx, userID, viewerUserID)
+	default:
+		return true, nil
+	}
+}
+
+// getLastSeen returns the last-seen time and privacy of an offline user, or 0 if there is none.
+func (ws *WsServer) getLastSeen(ctx context.Context, userID string) (int64, string, error) {
+	if ws.lastSeen == nil {
+		return 0, "", nil
+	}
+	lastSeen, err := ws.lastSeen.GetLastSeen(ctx, []string{userID})
+	if err != nil {
+		return 0, "", err
+	}
+	if lastSeen[userID] == 0 {
+		return 0, "", nil
+	}
+	privacy, err := ws.lastSeen.GetLastSeenPrivacy(ctx, []string{userID})
+	if err != nil {
+		return 0, "", err
+	}
+	return lastSeen[userID], privacy[userID], nil
+}
@@ -117,6 +117,8 @@ type GrpcHandler struct {
 	validate   *validator.Validate
 	msgClient  *rpcli.MsgClient
 	pushClient *rpcli.PushMsgServiceClient
+	// beforeSend is called with each message before it is sent.
+	beforeSend func(ctx context.Context, msg *sdkws.MsgData)
 }
 
 func NewGrpcHandler(validate *validator.Validate, msgClient *rpcli.MsgClient, pushClient *rpcli.PushMsgServiceClient) *GrpcHandler {
@@ -158,6 +160,9 @@ func (g *GrpcHandler) SendMessage(ctx context.Context, data *Req) ([]byte, error
 		return nil, errs.WrapMsg(err, "SendMessage: message data validation failed", "action", "validate", "dataType", "MsgData")
 	}
 
+	if g.beforeSend != nil {
+		g.beforeSend(ctx, &msgData)
+	}
 	req := msg.SendMsgReq{MsgData: &msgData}
 	resp, err := g.msgClient.MsgClient.SendMsg(ctx, &req)
 	if err != nil {
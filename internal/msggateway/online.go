@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	pbuser "github.com/openimsdk/protocol/user"
 	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/mcontext"
@@ -84,16 +85,14 @@ func (ws *WsServer) ChangeOnlineStatus(concurrent int) {
 
 	var count atomic.Int64
 	operationIDPrefix := fmt.Sprintf("p_%d_", os.Getpid())
-	doRequest := func(req *pbuser.SetUserOnlineStatusReq) {
-		opIdCtx := mcontext.SetOperationID(context.Background(), operationIDPrefix+strconv.FormatInt(count.Add(1), 10))
-		ctx, cancel := context.WithTimeout(opIdCtx, time.Second*5)
-		defer cancel()
+	// doTenantRequest updates the users of one tenant, their user IDs are no longer scoped.
+	doTenantRequest := func(ctx context.Context, req *pbuser.SetUserOnlineStatusReq) {
 		if err := ws.userClient.SetUserOnlineStatus(ctx, req); err != nil {
 			log.ZError(ctx, "update user online status", err)
 		}
 		for _, ss := range req.Status {
 			for _, online := range ss.Online {
-				client, _, _ := ws.clients.Get(ss.UserID, int(online))
+				client, _, _ := ws.clients.Get(tenant.Key(ctx, ss.UserID), int(online))
 				back := false
 				if len(client) > 0 {
 					back = client[0].IsBackground
@@ -106,6 +105,27 @@ func (ws *WsServer) ChangeOnlineStatus(concurrent int) {
 		}
 	}
 
+	// doRequest splits the users by tenant, the clients are keyed by the user IDs scoped by tenant.
+	doRequest := func(req *pbuser.SetUserOnlineStatusReq) {
+		opIdCtx := mcontext.SetOperationID(context.Background(), operationIDPrefix+strconv.FormatInt(count.Add(1), 10))
+		ctx, cancel := context.WithTimeout(opIdCtx, time.Second*5)
+		defer cancel()
+		tenantReqs := make(map[string]*pbuser.SetUserOnlineStatusReq)
+		for _, ss := range req.Status {
+			tenantID, userID := tenant.SplitKey(ss.UserID)
+			tenantReq, ok := tenantReqs[tenantID]
+			if !ok {
+				tenantReq = &pbuser.SetUserOnlineStatusReq{}
+				tenantReqs[tenantID] = tenantReq
+			}
+			ss.UserID = userID
+			tenantReq.Status = append(tenantReq.Status, ss)
+		}
+		for tenantID, tenantReq := range tenantReqs {
+			doTenantRequest(tenant.WithTenantID(ctx, tenantID), tenantReq)
+		}
+	}
+
 	for i := 0; i < concurrent; i++ {
 		go func(ch <-chan *pbuser.SetUserOnlineStatusReq) {
 			for req := range ch {
@@ -14,7 +14,10 @@
 
 package msggateway
 
-import "time"
+import (
+	"net"
+	"time"
+)
 
 type (
 	Option  func(opt *configs)
@@ -29,6 +32,8 @@ type (
 		messageMaxMsgLength int
 		// Websocket write buffer, default: 4096, 4kb.
 		writeBufferSize int
+		// Proxies whose X-Forwarded-For header is believed
+		trustedProxies []*net.IPNet
 	}
 )
 
@@ -61,3 +66,9 @@ func WithWriteBufferSize(size int) Option {
 		opt.writeBufferSize = size
 	}
 }
+
+func WithTrustedProxies(proxies []*net.IPNet) Option {
+	return func(opt *configs) {
+		opt.trustedProxies = proxies
+	}
+}
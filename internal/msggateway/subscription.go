@@ -2,6 +2,9 @@ package msggateway
 
 import (
 	"context"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	pbgatewayext "github.com/openimsdk/open-im-server/v3/pkg/protocol/gatewayext"
 	"github.com/openimsdk/protocol/sdkws"
 	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/utils/datautil"
@@ -10,7 +13,7 @@ import (
 )
 
 func (ws *WsServer) subscriberUserOnlineStatusChanges(ctx context.Context, userID string, platformIDs []int32) {
-	if ws.clients.RecvSubChange(userID, platformIDs) {
+	if ws.clients.RecvSubChange(tenant.Key(ctx, userID), platformIDs) {
 		log.ZDebug(ctx, "gateway receive subscription message and go back online", "userID", userID, "platformIDs", platformIDs)
 	} else {
 		log.ZDebug(ctx, "gateway ignore user online status changes", "userID", userID, "platformIDs", platformIDs)
@@ -23,19 +26,27 @@ func (ws *WsServer) SubUserOnlineStatus(ctx context.Context, client *Client, dat
 	if err := proto.Unmarshal(data.Data, &sub); err != nil {
 		return nil, err
 	}
-	ws.subscription.Sub(client, sub.SubscribeUserID, sub.UnsubscribeUserID)
-	var resp sdkws.SubUserOnlineStatusTips
+	// A client only subscribes to the users of its own tenant.
+	scope := func(userID string) string { return tenant.KeyOf(client.ctx.TenantID, userID) }
+	ws.subscription.Sub(client, datautil.Slice(sub.SubscribeUserID, scope), datautil.Slice(sub.UnsubscribeUserID, scope))
+	var resp pbgatewayext.SubUserOnlineStatusTips
 	if len(sub.SubscribeUserID) > 0 {
-		resp.Subscribers = make([]*sdkws.SubUserOnlineStatusElem, 0, len(sub.SubscribeUserID))
+		resp.Subscribers = make([]*pbgatewayext.SubUserOnlineStatusElem, 0, len(sub.SubscribeUserID))
 		for _, userID := range sub.SubscribeUserID {
 			platformIDs, err := ws.online.GetUserOnlinePlatform(ctx, userID)
 			if err != nil {
 				return nil, err
 			}
-			resp.Subscribers = append(resp.Subscribers, &sdkws.SubUserOnlineStatusElem{
+			elem := &pbgatewayext.SubUserOnlineStatusElem{
 				UserID:            userID,
 				OnlinePlatformIDs: platformIDs,
-			})
+			}
+			if len(platformIDs) == 0 {
+				if err := ws.fillLastSeen(ctx, elem, client.UserID); err != nil {
+					log.ZWarn(ctx, "fill last seen failed", err, "userID", userID)
+				}
+			}
+			resp.Subscribers = append(resp.Subscribers, elem)
 		}
 	}
 	return proto.Marshal(&resp)
@@ -147,20 +158,61 @@ func (s *Subscription) Sub(client *Client, addUserIDs, delUserIDs []string) {
 }
 
 func (ws *WsServer) pushUserIDOnlineStatus(ctx context.Context, userID string, platformIDs []int32) {
-	clients := ws.subscription.GetClient(userID)
+	clients := ws.subscription.GetClient(tenant.Key(ctx, userID))
 	if len(clients) == 0 {
 		return
 	}
-	onlineStatus, err := proto.Marshal(&sdkws.SubUserOnlineStatusTips{
-		Subscribers: []*sdkws.SubUserOnlineStatusElem{{UserID: userID, OnlinePlatformIDs: platformIDs}},
+	onlineStatus, err := proto.Marshal(&pbgatewayext.SubUserOnlineStatusTips{
+		Subscribers: []*pbgatewayext.SubUserOnlineStatusElem{{UserID: userID, OnlinePlatformIDs: platformIDs}},
 	})
 	if err != nil {
 		log.ZError(ctx, "pushUserIDOnlineStatus json.Marshal", err)
 		return
 	}
+	var (
+		lastSeenStatus []byte
+		privacy        string
+	)
+	if len(platformIDs) == 0 {
+		var lastSeen int64
+		lastSeen, privacy, err = ws.getLastSeen(ctx, userID)
+		if err != nil {
+			log.ZWarn(ctx, "get user last seen failed", err, "userID", userID)
+		} else if lastSeen > 0 && privacy != model.LastSeenPrivacyNobody {
+			elem := &pbgatewayext.SubUserOnlineStatusElem{UserID: userID, OnlinePlatformIDs: platformIDs, LastSeen: lastSeen}
+			lastSeenStatus, err = proto.Marshal(&pbgatewayext.SubUserOnlineStatusTips{Subscribers: []*pbgatewayext.SubUserOnlineStatusElem{elem}})
+			if err != nil {
+				log.ZError(ctx, "pushUserIDOnlineStatus json.Marshal", err)
+				return
+			}
+		}
+	}
 	for _, client := range clients {
-		if err := client.PushUserOnlineStatus(onlineStatus); err != nil {
+		data := onlineStatus
+		if lastSeenStatus != nil {
+			visible, err := ws.lastSeenVisible(ctx, userID, client.UserID, privacy)
+			if err != nil {
+				log.ZWarn(ctx, "check last seen visible failed", err, "userID", userID, "viewerUserID", client.UserID)
+			} else if visible {
+				data = lastSeenStatus
+			}
+		}
+		if err := client.PushUserOnlineStatus(data); err != nil {
 			log.ZError(ctx, "UserSubscribeOnlineStatusNotification push failed", err, "userID", client.UserID, "platformID", client.PlatformID, "changeUserID", userID, "changePlatformID", platformIDs)
 		}
 	}
 }
+
+// fillLastSeen attaches the last-seen time of an offline user if viewerUserID may see it.
+func (ws *WsServer) fillLastSeen(ctx context.Context, elem *pbgatewayext.SubUserOnlineStatusElem, viewerUserID string) error {
+	lastSeen, privacy, err := ws.getLastSeen(ctx, elem.UserID)
+	if err != nil || lastSeen == 0 {
+		return err
+	}
+	visible, err := ws.lastSeenVisible(ctx, elem.UserID, viewerUserID, privacy)
+	if err != nil || !visible {
+		return err
+	}
+	elem.LastSeen = lastSeen
+	return nil
+}
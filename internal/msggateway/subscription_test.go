@@ -0,0 +1,24 @@
+package msggateway
+
+import (
+	"testing"
+
+	pbgatewayext "github.com/openimsdk/open-im-server/v3/pkg/protocol/gatewayext"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestOnlineStatusTipsCompatible(t *testing.T) {
+	data, err := proto.Marshal(&pbgatewayext.SubUserOnlineStatusTips{
+		Subscribers: []*pbgatewayext.SubUserOnlineStatusElem{{UserID: "user1", OnlinePlatformIDs: []int32{1}, LastSeen: 1700000000000}},
+	})
+	require.NoError(t, err)
+	// Clients still decoding sdkws read the online platforms and skip the last-seen time.
+	var tips sdkws.SubUserOnlineStatusTips
+	require.NoError(t, proto.Unmarshal(data, &tips))
+	require.Len(t, tips.Subscribers, 1)
+	assert.Equal(t, "user1", tips.Subscribers[0].UserID)
+	assert.Equal(t, []int32{1}, tips.Subscribers[0].OnlinePlatformIDs)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"sync"
 	"sync/atomic"
@@ -12,14 +13,19 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcli"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/discovery/etcd"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/webhook"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpccache"
 	pbAuth "github.com/openimsdk/protocol/auth"
 	"github.com/openimsdk/tools/mcontext"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/msggateway"
 	"github.com/openimsdk/tools/discovery"
@@ -32,14 +38,15 @@ import (
 type LongConnServer interface {
 	Run(done chan error) error
 	wsHandler(w http.ResponseWriter, r *http.Request)
-	GetUserAllCons(userID string) ([]*Client, bool)
-	GetUserPlatformCons(userID string, platform int) ([]*Client, bool, bool)
+	GetUserAllCons(ctx context.Context, userID string) ([]*Client, bool)
+	GetUserPlatformCons(ctx context.Context, userID string, platform int) ([]*Client, bool, bool)
 	Validate(s any) error
 	SetDiscoveryRegistry(ctx context.Context, client discovery.SvcDiscoveryRegistry, config *Config) error
 	KickUserConn(client *Client) error
 	UnRegister(c *Client)
 	SetKickHandlerInfo(i *kickHandler)
 	SubUserOnlineStatus(ctx context.Context, client *Client, data *Req) ([]byte, error)
+	SetConversationDraft(ctx context.Context, data *Req) ([]byte, error)
 	Compressor
 	MessageHandler
 }
@@ -59,14 +66,20 @@ type WsServer struct {
 	onlineUserConnNum atomic.Int64
 	handshakeTimeout  time.Duration
 	writeBufferSize   int
+	trustedProxies    []*net.IPNet
 	validate          *validator.Validate
 	disCov            discovery.SvcDiscoveryRegistry
 	Compressor
 	//Encoder
 	MessageHandler
-	webhookClient *webhook.Client
-	userClient    *rpcli.UserClient
-	authClient    *rpcli.AuthClient
+	webhookClient  *webhook.Client
+	userClient     *rpcli.UserClient
+	authClient     *rpcli.AuthClient
+	relationClient *rpcli.RelationClient
+	tokenSession   cache.TokenSessionCache
+	lastSeen       controller.UserLastSeenDatabase
+	draftDatabase  controller.ConversationDraftDatabase
+	drafts         *draftSaver
 }
 
 type kickHandler struct {
@@ -92,9 +105,18 @@ func (ws *WsServer) SetDiscoveryRegistry(ctx context.Context, disCov discovery.S
 	if err != nil {
 		return err
 	}
+	friendConn, err := disCov.GetConn(ctx, config.Discovery.RpcService.Friend)
+	if err != nil {
+		return err
+	}
 	ws.userClient = rpcli.NewUserClient(userConn)
 	ws.authClient = rpcli.NewAuthClient(authConn)
-	ws.MessageHandler = NewGrpcHandler(ws.validate, rpcli.NewMsgClient(msgConn), rpcli.NewPushMsgServiceClient(pushConn))
+	ws.relationClient = rpcli.NewRelationClient(friendConn)
+	msgClient := rpcli.NewMsgClient(msgConn)
+	ws.drafts = newDraftSaver(ws.draftDatabase, msgClient, draftSaveDelay)
+	handler := NewGrpcHandler(ws.validate, msgClient, rpcli.NewPushMsgServiceClient(pushConn))
+	handler.beforeSend = ws.drafts.discard
+	ws.MessageHandler = handler
 	ws.disCov = disCov
 	return nil
 }
@@ -120,12 +142,13 @@ func (ws *WsServer) Validate(_ any) error {
 	return nil
 }
 
-func (ws *WsServer) GetUserAllCons(userID string) ([]*Client, bool) {
-	return ws.clients.GetAll(userID)
+// GetUserAllCons returns the connections of the user of the tenant of ctx.
+func (ws *WsServer) GetUserAllCons(ctx context.Context, userID string) ([]*Client, bool) {
+	return ws.clients.GetAll(tenant.Key(ctx, userID))
 }
 
-func (ws *WsServer) GetUserPlatformCons(userID string, platform int) ([]*Client, bool, bool) {
-	return ws.clients.Get(userID, platform)
+func (ws *WsServer) GetUserPlatformCons(ctx context.Context, userID string, platform int) ([]*Client, bool, bool) {
+	return ws.clients.Get(tenant.Key(ctx, userID), platform)
 }
 
 func NewWsServer(msgGatewayConfig *Config, opts ...Option) *WsServer {
@@ -142,6 +165,7 @@ func NewWsServer(msgGatewayConfig *Config, opts ...Option) *WsServer {
 		wsMaxConnNum:     config.maxConnNum,
 		writeBufferSize:  config.writeBufferSize,
 		handshakeTimeout: config.handshakeTimeout,
+		trustedProxies:   config.trustedProxies,
 		clientPool: sync.Pool{
 			New: func() any {
 				return new(Client)
@@ -154,7 +178,7 @@ func NewWsServer(msgGatewayConfig *Config, opts ...Option) *WsServer {
 		clients:         newUserMap(),
 		subscription:    newSubscription(),
 		Compressor:      NewGzipCompressor(),
-		webhookClient:   webhook.NewWebhookClient(msgGatewayConfig.WebhooksConfig.URL),
+		webhookClient:   webhook.NewWebhookClient(&msgGatewayConfig.WebhooksConfig),
 	}
 }
 
@@ -262,9 +286,9 @@ func (ws *WsServer) registerClient(client *Client) {
 		clientOK   bool
 		oldClients []*Client
 	)
-	oldClients, userOK, clientOK = ws.clients.Get(client.UserID, client.PlatformID)
+	oldClients, userOK, clientOK = ws.clients.Get(client.userKey(), client.PlatformID)
 	if !userOK {
-		ws.clients.Set(client.UserID, client)
+		ws.clients.Set(client.userKey(), client)
 		log.ZDebug(client.ctx, "user not exist", "userID", client.UserID, "platformID", client.PlatformID)
 		prommetrics.OnlineUserGauge.Add(1)
 		ws.onlineUserNum.Add(1)
@@ -273,13 +297,13 @@ func (ws *WsServer) registerClient(client *Client) {
 		ws.multiTerminalLoginChecker(clientOK, oldClients, client)
 		log.ZDebug(client.ctx, "user exist", "userID", client.UserID, "platformID", client.PlatformID)
 		if clientOK {
-			ws.clients.Set(client.UserID, client)
+			ws.clients.Set(client.userKey(), client)
 			// There is already a connection to the platform
 			log.ZDebug(client.ctx, "repeat login", "userID", client.UserID, "platformID",
 				client.PlatformID, "old remote addr", getRemoteAdders(oldClients))
 			ws.onlineUserConnNum.Add(1)
 		} else {
-			ws.clients.Set(client.UserID, client)
+			ws.clients.Set(client.userKey(), client)
 			ws.onlineUserConnNum.Add(1)
 		}
 	}
@@ -319,14 +343,14 @@ func getRemoteAdders(client []*Client) string {
 }
 
 func (ws *WsServer) KickUserConn(client *Client) error {
-	ws.clients.DeleteClients(client.UserID, []*Client{client})
+	ws.clients.DeleteClients(client.userKey(), []*Client{client})
 	return client.KickOnlineMessage()
 }
 
 func (ws *WsServer) multiTerminalLoginChecker(clientOK bool, oldClients []*Client, newClient *Client) {
 	kickTokenFunc := func(kickClients []*Client) {
 		var kickTokens []string
-		ws.clients.DeleteClients(newClient.UserID, kickClients)
+		ws.clients.DeleteClients(newClient.userKey(), kickClients)
 		for _, c := range kickClients {
 			kickTokens = append(kickTokens, c.token)
 			err := c.KickOnlineMessage()
@@ -334,16 +358,16 @@ func (ws *WsServer) multiTerminalLoginChecker(clientOK bool, oldClients []*Clien
 				log.ZWarn(c.ctx, "KickOnlineMessage", err)
 			}
 		}
-		ctx := mcontext.WithMustInfoCtx(
+		ctx := tenant.WithTenantID(mcontext.WithMustInfoCtx(
 			[]string{newClient.ctx.GetOperationID(), newClient.ctx.GetUserID(),
 				constant.PlatformIDToName(newClient.PlatformID), newClient.ctx.GetConnID()},
-		)
+		), newClient.ctx.TenantID)
 		if err := ws.authClient.KickTokens(ctx, kickTokens); err != nil {
 			log.ZWarn(newClient.ctx, "kickTokens err", err)
 		}
 	}
 
-	switch ws.msgGatewayConfig.Share.MultiLogin.Policy {
+	switch ws.msgGatewayConfig.Share.GetMultiLogin(newClient.ctx.TenantID).Policy {
 	case constant.DefalutNotKick:
 	case constant.PCAndOther:
 		if constant.PlatformIDToClass(newClient.PlatformID) == constant.TerminalPC {
@@ -354,17 +378,17 @@ func (ws *WsServer) multiTerminalLoginChecker(clientOK bool, oldClients []*Clien
 		if !clientOK {
 			return
 		}
-		ws.clients.DeleteClients(newClient.UserID, oldClients)
+		ws.clients.DeleteClients(newClient.userKey(), oldClients)
 		for _, c := range oldClients {
 			err := c.KickOnlineMessage()
 			if err != nil {
 				log.ZWarn(c.ctx, "KickOnlineMessage", err)
 			}
 		}
-		ctx := mcontext.WithMustInfoCtx(
+		ctx := tenant.WithTenantID(mcontext.WithMustInfoCtx(
 			[]string{newClient.ctx.GetOperationID(), newClient.ctx.GetUserID(),
 				constant.PlatformIDToName(newClient.PlatformID), newClient.ctx.GetConnID()},
-		)
+		), newClient.ctx.TenantID)
 		req := &pbAuth.InvalidateTokenReq{
 			PreservedToken: newClient.token,
 			UserID:         newClient.UserID,
@@ -375,7 +399,7 @@ func (ws *WsServer) multiTerminalLoginChecker(clientOK bool, oldClients []*Clien
 				"platformID", newClient.PlatformID)
 		}
 	case constant.AllLoginButSameClassKick:
-		clients, ok := ws.clients.GetAll(newClient.UserID)
+		clients, ok := ws.clients.GetAll(newClient.userKey())
 		if !ok {
 			return
 		}
@@ -393,13 +417,14 @@ func (ws *WsServer) multiTerminalLoginChecker(clientOK bool, oldClients []*Clien
 
 func (ws *WsServer) unregisterClient(client *Client) {
 	defer ws.clientPool.Put(client)
-	isDeleteUser := ws.clients.DeleteClients(client.UserID, []*Client{client})
+	isDeleteUser := ws.clients.DeleteClients(client.userKey(), []*Client{client})
 	if isDeleteUser {
 		ws.onlineUserNum.Add(-1)
 		prommetrics.OnlineUserGauge.Dec()
 	}
 	ws.onlineUserConnNum.Add(-1)
 	ws.subscription.DelClient(client)
+	go ws.drafts.flushUser(client.ctx.TenantID, client.UserID)
 	//ws.SetUserOnlineStatus(client.ctx, client, constant.Offline)
 	log.ZDebug(client.ctx, "user offline", "close reason", client.closedErr, "online user Num",
 		ws.onlineUserNum.Load(), "online user conn Num",
@@ -420,6 +445,31 @@ func (ws *WsServer) validateRespWithRequest(ctx *UserConnContext, resp *pbAuth.P
 	return nil
 }
 
+// touchTokenSession records where the session of the token was last seen.
+// A failure here must not reject the connection, so it is only logged.
+func (ws *WsServer) touchTokenSession(ctx *UserConnContext) {
+	if ws.tokenSession == nil {
+		return
+	}
+	userID, sessionID := ctx.GetUserID(), cachekey.GetTokenSessionID(ctx.GetToken())
+	session, err := ws.tokenSession.GetTokenSession(ctx, userID, sessionID)
+	if err != nil {
+		log.ZWarn(ctx, "get token session failed", err, "userID", userID)
+		return
+	}
+	if session == nil {
+		return
+	}
+	session.LastSeenIP = ctx.GetClientIP(ws.trustedProxies)
+	session.LastSeenTime = time.Now().UnixMilli()
+	if deviceName := ctx.GetDeviceName(); deviceName != "" {
+		session.DeviceName = deviceName
+	}
+	if err := ws.tokenSession.UpdateTokenSession(ctx, userID, sessionID, session); err != nil {
+		log.ZWarn(ctx, "update token session failed", err, "userID", userID)
+	}
+}
+
 func (ws *WsServer) wsHandler(w http.ResponseWriter, r *http.Request) {
 	// Create a new connection context
 	connContext := newContext(w, r)
@@ -440,6 +490,14 @@ func (ws *WsServer) wsHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// The auth rpc verifies the signature of the claimed tenant and rejects the token of another tenant.
+	claims, err := authverify.ParseClaimsUnverified(connContext.GetToken())
+	if err != nil {
+		httpError(connContext, err)
+		return
+	}
+	connContext.TenantID = claims.TenantID
+
 	// Call the authentication client to parse the Token obtained from the context
 	resp, err := ws.authClient.ParseToken(connContext, connContext.GetToken())
 	if err != nil {
@@ -466,6 +524,8 @@ func (ws *WsServer) wsHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	ws.touchTokenSession(connContext)
+
 	log.ZDebug(connContext, "new conn", "token", connContext.GetToken())
 	// Create a WebSocket long connection object
 	wsLongConn := newGWebSocket(WebSocket, ws.handshakeTimeout, ws.writeBufferSize)
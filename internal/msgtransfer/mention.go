@@ -0,0 +1,43 @@
+package msgtransfer
+
+import (
+	"context"
+
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// recordMentions indexes the seqs of the group messages mentioning users, once the seqs are allocated,
+// so that the users can count their unread mentions and jump to them.
+func (och *OnlineHistoryRedisConsumerHandler) recordMentions(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) {
+	var memberUserIDs []string
+	for _, msg := range msgs {
+		if msg.SessionType != constant.ReadGroupChatType || msg.ContentType != constant.AtText || len(msg.AtUserIDList) == 0 {
+			continue
+		}
+		// A mention of all the members is stored once, the sender has read it already.
+		if datautil.Contain(constant.AtAllString, msg.AtUserIDList...) {
+			if err := och.mentionDatabase.AddMentionAll(ctx, conversationID, msg.Seq); err != nil {
+				log.ZWarn(ctx, "add mention all error", err, "conversationID", conversationID, "seq", msg.Seq)
+			}
+			continue
+		}
+		if memberUserIDs == nil {
+			var err error
+			memberUserIDs, err = och.groupClient.GetGroupMemberUserIDs(ctx, msg.GroupID)
+			if err != nil {
+				log.ZWarn(ctx, "get group member ids error", err, "conversationID", conversationID)
+				return
+			}
+		}
+		mentioned := datautil.SliceIntersectFuncs(msg.AtUserIDList, memberUserIDs, func(a string) string { return a }, func(b string) string { return b })
+		userIDs := datautil.Filter(mentioned, func(userID string) (string, bool) {
+			return userID, userID != msg.SendID
+		})
+		if err := och.mentionDatabase.AddMention(ctx, conversationID, userIDs, msg.Seq); err != nil {
+			log.ZWarn(ctx, "add mention error", err, "conversationID", conversationID, "seq", msg.Seq)
+		}
+	}
+}
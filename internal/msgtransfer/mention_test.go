@@ -0,0 +1,64 @@
+package msgtransfer
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpcli"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/group"
+	"github.com/openimsdk/protocol/sdkws"
+	"google.golang.org/grpc"
+)
+
+type fakeMentionDatabase struct {
+	controller.ConversationMentionDatabase
+	mentions map[int64][]string
+	all      []int64
+}
+
+func (f *fakeMentionDatabase) AddMention(_ context.Context, _ string, userIDs []string, seq int64) error {
+	f.mentions[seq] = userIDs
+	return nil
+}
+
+func (f *fakeMentionDatabase) AddMentionAll(_ context.Context, _ string, seq int64) error {
+	f.all = append(f.all, seq)
+	return nil
+}
+
+type fakeMentionGroupClient struct {
+	group.GroupClient
+	calls int
+}
+
+func (f *fakeMentionGroupClient) GetGroupMemberUserIDs(context.Context, *group.GetGroupMemberUserIDsReq, ...grpc.CallOption) (*group.GetGroupMemberUserIDsResp, error) {
+	f.calls++
+	return &group.GetGroupMemberUserIDsResp{UserIDs: []string{"u1", "u2", "u3"}}, nil
+}
+
+func TestRecordMentions(t *testing.T) {
+	db := &fakeMentionDatabase{mentions: make(map[int64][]string)}
+	groupClient := &fakeMentionGroupClient{}
+	och := &OnlineHistoryRedisConsumerHandler{mentionDatabase: db, groupClient: &rpcli.GroupClient{GroupClient: groupClient}}
+	mention := func(seq int64, atUserIDs ...string) *sdkws.MsgData {
+		return &sdkws.MsgData{SendID: "u1", GroupID: "g1", Seq: seq, SessionType: constant.ReadGroupChatType, ContentType: constant.AtText, AtUserIDList: atUserIDs}
+	}
+	och.recordMentions(context.Background(), "sg_g1", []*sdkws.MsgData{
+		mention(1, constant.AtAllString, "u2"),
+		mention(2, "u1", "u2", "u9"),
+		{SendID: "u1", GroupID: "g1", Seq: 3, SessionType: constant.ReadGroupChatType, ContentType: constant.Text},
+	})
+	if !reflect.DeepEqual(db.all, []int64{1}) {
+		t.Fatal("unexpected mentions of all", db.all)
+	}
+	// The sender and the users who are not members are left out.
+	if len(db.mentions) != 1 || !reflect.DeepEqual(db.mentions[2], []string{"u2"}) {
+		t.Fatal("unexpected mentions", db.mentions)
+	}
+	if groupClient.calls != 1 {
+		t.Fatal("unexpected member lookups", groupClient.calls)
+	}
+}
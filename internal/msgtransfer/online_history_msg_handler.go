@@ -29,6 +29,7 @@ import (
 	"github.com/go-redis/redis"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
 	"github.com/openimsdk/open-im-server/v3/pkg/tools/batcher"
 	"github.com/openimsdk/protocol/constant"
@@ -59,6 +60,7 @@ type ContextMsg struct {
 // This structure is used for asynchronously writing the sender’s read sequence (seq) regarding a message into MongoDB.
 // For example, if the sender sends a message with a seq of 10, then their own read seq for this conversation should be set to 10.
 type userHasReadSeq struct {
+	tenantID       string
 	conversationID string
 	userHasReadMap map[string]int64
 }
@@ -74,9 +76,10 @@ type OnlineHistoryRedisConsumerHandler struct {
 
 	groupClient        *rpcli.GroupClient
 	conversationClient *rpcli.ConversationClient
+	mentionDatabase    controller.ConversationMentionDatabase
 }
 
-func NewOnlineHistoryRedisConsumerHandler(ctx context.Context, client discovery.SvcDiscoveryRegistry, config *Config, database controller.MsgTransferDatabase) (*OnlineHistoryRedisConsumerHandler, error) {
+func NewOnlineHistoryRedisConsumerHandler(ctx context.Context, client discovery.SvcDiscoveryRegistry, config *Config, database controller.MsgTransferDatabase, mentionDatabase controller.ConversationMentionDatabase) (*OnlineHistoryRedisConsumerHandler, error) {
 	kafkaConf := config.KafkaConfig
 	historyConsumerGroup, err := kafka.NewMConsumerGroup(kafkaConf.Build(), kafkaConf.ToRedisGroupID, []string{kafkaConf.ToRedisTopic}, false)
 	if err != nil {
@@ -92,6 +95,7 @@ func NewOnlineHistoryRedisConsumerHandler(ctx context.Context, client discovery.
 	}
 	var och OnlineHistoryRedisConsumerHandler
 	och.msgTransferDatabase = database
+	och.mentionDatabase = mentionDatabase
 	och.conversationUserHasReadChan = make(chan *userHasReadSeq, hasReadChanBuffer)
 	och.groupClient = rpcli.NewGroupClient(groupConn)
 	och.conversationClient = rpcli.NewConversationClient(conversationConn)
@@ -122,7 +126,9 @@ func (och *OnlineHistoryRedisConsumerHandler) do(ctx context.Context, channelID
 	ctx = mcontext.WithTriggerIDContext(ctx, val.TriggerID())
 	ctxMessages := och.parseConsumerMessages(ctx, val.Val())
 	ctx = withAggregationCtx(ctx, ctxMessages)
-	log.ZInfo(ctx, "msg arrived channel", "channel id", channelID, "msgList length", len(ctxMessages), "key", val.Key())
+	// The messages of a batch share the key, so they share the tenant too.
+	ctx, key := tenant.FromKey(ctx, val.Key())
+	log.ZInfo(ctx, "msg arrived channel", "channel id", channelID, "msgList length", len(ctxMessages), "key", key)
 	och.doSetReadSeq(ctx, ctxMessages)
 
 	storageMsgList, notStorageMsgList, storageNotificationList, notStorageNotificationList :=
@@ -132,8 +138,8 @@ func (och *OnlineHistoryRedisConsumerHandler) do(ctx context.Context, channelID
 
 	conversationIDMsg := msgprocessor.GetChatConversationIDByMsg(ctxMessages[0].message)
 	conversationIDNotification := msgprocessor.GetNotificationConversationIDByMsg(ctxMessages[0].message)
-	och.handleMsg(ctx, val.Key(), conversationIDMsg, storageMsgList, notStorageMsgList)
-	och.handleNotification(ctx, val.Key(), conversationIDNotification, storageNotificationList, notStorageNotificationList)
+	och.handleMsg(ctx, key, conversationIDMsg, storageMsgList, notStorageMsgList)
+	och.handleNotification(ctx, key, conversationIDNotification, storageNotificationList, notStorageNotificationList)
 }
 
 func (och *OnlineHistoryRedisConsumerHandler) doSetReadSeq(ctx context.Context, msgs []*ContextMsg) {
@@ -205,7 +211,7 @@ func (och *OnlineHistoryRedisConsumerHandler) parseConsumerMessages(ctx context.
 		}
 		log.ZDebug(ctx, "consumer.kafka.GetContextWithMQHeader", "len", len(consumerMessages[i].Headers),
 			"header", strings.Join(arr, ", "))
-		ctxMsg.ctx = kafka.GetContextWithMQHeader(consumerMessages[i].Headers)
+		ctxMsg.ctx, _ = tenant.FromKey(kafka.GetContextWithMQHeader(consumerMessages[i].Headers), string(consumerMessages[i].Key))
 		ctxMsg.message = msgFromMQ
 		log.ZDebug(ctx, "message parse finish", "message", msgFromMQ, "key",
 			string(consumerMessages[i].Key))
@@ -283,6 +289,7 @@ func (och *OnlineHistoryRedisConsumerHandler) handleMsg(ctx context.Context, key
 			prommetrics.SeqSetFailedCounter.Inc()
 		}
 		och.conversationUserHasReadChan <- &userHasReadSeq{
+			tenantID:       tenant.GetTenantID(ctx),
 			conversationID: conversationID,
 			userHasReadMap: userSeqMap,
 		}
@@ -332,6 +339,7 @@ func (och *OnlineHistoryRedisConsumerHandler) handleMsg(ctx context.Context, key
 
 		och.toPushTopic(ctx, key, conversationID, storageList)
 		log.ZInfo(ctx, "toPushTopic end")
+		och.recordMentions(ctx, conversationID, storageMessageList)
 	}
 }
 
@@ -368,7 +376,7 @@ func (och *OnlineHistoryRedisConsumerHandler) HandleUserHasReadSeqMessages(ctx c
 	defer och.wg.Done()
 
 	for msg := range och.conversationUserHasReadChan {
-		if err := och.msgTransferDatabase.SetHasReadSeqToDB(ctx, msg.conversationID, msg.userHasReadMap); err != nil {
+		if err := och.msgTransferDatabase.SetHasReadSeqToDB(tenant.WithTenantID(ctx, msg.tenantID), msg.conversationID, msg.userHasReadMap); err != nil {
 			log.ZWarn(ctx, "set read seq to db error", err, "conversationID", msg.conversationID, "userSeqMap", msg.userHasReadMap)
 		}
 	}
@@ -0,0 +1,143 @@
+package push
+
+import (
+	"context"
+	"sync"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/protocol/constant"
+	pbconversation "github.com/openimsdk/protocol/conversation"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+	"golang.org/x/sync/errgroup"
+)
+
+const badgeWorkerCount = 20
+
+// badges computes the unread count of the users pushed as their app badge: the messages of their conversations
+// after their read seq, the conversations which do not notify being left out. The counts are cached, incremented
+// here as messages are sent and decremented by the msg service as they are read. A nil badges computes no counts.
+// The seqs are read from their caches in one batch per user, the conversations from the local cache.
+type badges struct {
+	cache           cache.BadgeCache
+	conversations   badgeConversations
+	seqConversation cache.SeqConversationCache
+	seqUser         cache.SeqUser
+}
+
+// badgeConversations reads the conversations the counts are computed from, implemented by rpccache.ConversationLocalCache.
+type badgeConversations interface {
+	GetConversationIDs(ctx context.Context, ownerUserID string) ([]string, error)
+	GetConversations(ctx context.Context, ownerUserID string, conversationIDs []string) ([]*pbconversation.Conversation, error)
+	GetConversationNotReceiveMessageUserIDMap(ctx context.Context, conversationID string) (map[string]struct{}, error)
+}
+
+func newBadges(cache cache.BadgeCache, conversations badgeConversations, seqConversation cache.SeqConversationCache, seqUser cache.SeqUser) *badges {
+	return &badges{cache: cache, conversations: conversations, seqConversation: seqConversation, seqUser: seqUser}
+}
+
+// sent counts msg in the cached counts of its receivers. When the conversations muted by the receivers can not be read,
+// their counts are dropped to be computed again.
+func (b *badges) sent(ctx context.Context, msg *sdkws.MsgData, userIDs []string) {
+	if b == nil || msg.Seq == 0 {
+		return
+	}
+	muted, err := b.conversations.GetConversationNotReceiveMessageUserIDMap(ctx, msgprocessor.GetConversationIDByMsg(msg))
+	if err != nil {
+		log.ZWarn(ctx, "get muted users of badge failed", err, "userIDs", userIDs)
+		if err := b.cache.DelBadges(ctx, userIDs...); err != nil {
+			log.ZWarn(ctx, "delete badges failed", err, "userIDs", userIDs)
+		}
+		return
+	}
+	receivers := datautil.Filter(userIDs, func(userID string) (string, bool) {
+		_, ok := muted[userID]
+		return userID, !ok && userID != msg.SendID
+	})
+	if err := b.cache.IncrBadges(ctx, receivers, 1); err != nil {
+		log.ZWarn(ctx, "incr badges failed", err, "userIDs", receivers)
+	}
+}
+
+// get returns the unread count of the users, computed and cached for the users without a cached one.
+// The users whose count can not be computed are left out.
+func (b *badges) get(ctx context.Context, userIDs []string) map[string]int {
+	if b == nil {
+		return nil
+	}
+	cached, err := b.cache.GetBadges(ctx, userIDs)
+	if err != nil {
+		log.ZWarn(ctx, "get badges failed", err, "userIDs", userIDs)
+	}
+	var (
+		lock   sync.Mutex
+		counts = make(map[string]int, len(userIDs))
+	)
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(badgeWorkerCount)
+	for _, userID := range userIDs {
+		if count, ok := cached[userID]; ok {
+			counts[userID] = int(count)
+			continue
+		}
+		g.Go(func() error {
+			count, err := b.compute(gctx, userID)
+			if err != nil {
+				log.ZWarn(ctx, "compute badge failed", err, "userID", userID)
+				return nil
+			}
+			if err := b.cache.SetBadge(gctx, userID, count); err != nil {
+				log.ZWarn(ctx, "set badge failed", err, "userID", userID)
+			}
+			lock.Lock()
+			counts[userID] = int(count)
+			lock.Unlock()
+			return nil
+		})
+	}
+	_ = g.Wait()
+	return counts
+}
+
+func (b *badges) compute(ctx context.Context, userID string) (int64, error) {
+	conversationIDs, err := b.conversations.GetConversationIDs(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	conversations, err := b.conversations.GetConversations(ctx, userID, conversationIDs)
+	if err != nil {
+		return 0, err
+	}
+	conversations = datautil.Filter(conversations, func(e *pbconversation.Conversation) (*pbconversation.Conversation, bool) {
+		return e, e.RecvMsgOpt == constant.ReceiveMessage
+	})
+	ids := datautil.Slice(conversations, func(e *pbconversation.Conversation) string { return e.ConversationID })
+	maxSeqs, err := b.seqConversation.GetMaxSeqs(ctx, ids)
+	if err != nil {
+		return 0, err
+	}
+	hasReadSeqs, err := b.seqUser.GetUserReadSeqs(ctx, userID, ids)
+	if err != nil {
+		return 0, err
+	}
+	return unreadCount(conversations, maxSeqs, hasReadSeqs), nil
+}
+
+// unreadCount sums the messages after the read seq of the conversations. The max seq of a conversation
+// is the one it was left at, and the messages before its min seq were cleared.
+func unreadCount(conversations []*pbconversation.Conversation, maxSeqs map[string]int64, hasReadSeqs map[string]int64) int64 {
+	var count int64
+	for _, conversation := range conversations {
+		maxSeq := maxSeqs[conversation.ConversationID]
+		if conversation.MaxSeq != 0 {
+			maxSeq = conversation.MaxSeq
+		}
+		readSeq := max(hasReadSeqs[conversation.ConversationID], conversation.MinSeq-1)
+		if maxSeq > readSeq {
+			count += maxSeq - readSeq
+		}
+	}
+	return count
+}
@@ -0,0 +1,154 @@
+package push
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/protocol/constant"
+	pbconversation "github.com/openimsdk/protocol/conversation"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+func TestUnreadCount(t *testing.T) {
+	conversations := []*pbconversation.Conversation{
+		{ConversationID: "si_alice_bob"},
+		// Left at seq 20.
+		{ConversationID: "sg_left", MaxSeq: 20},
+		// Cleared up to seq 30.
+		{ConversationID: "sg_cleared", MinSeq: 31},
+		{ConversationID: "sg_read"},
+	}
+	maxSeqs := map[string]int64{"si_alice_bob": 10, "sg_left": 50, "sg_cleared": 35, "sg_read": 8}
+	hasReadSeqs := map[string]int64{"si_alice_bob": 7, "sg_left": 18, "sg_cleared": 2, "sg_read": 8}
+	if count := unreadCount(conversations, maxSeqs, hasReadSeqs); count != 3+2+5 {
+		t.Errorf("expected 10 unread messages, got %d", count)
+	}
+}
+
+type fakeBadgeCache struct {
+	cache.BadgeCache
+	counts  map[string]int64
+	deleted []string
+}
+
+func (f *fakeBadgeCache) GetBadges(_ context.Context, userIDs []string) (map[string]int64, error) {
+	counts := make(map[string]int64)
+	for _, userID := range userIDs {
+		if count, ok := f.counts[userID]; ok {
+			counts[userID] = count
+		}
+	}
+	return counts, nil
+}
+
+func (f *fakeBadgeCache) SetBadge(_ context.Context, userID string, count int64) error {
+	f.counts[userID] = count
+	return nil
+}
+
+func (f *fakeBadgeCache) IncrBadges(_ context.Context, userIDs []string, delta int64) error {
+	for _, userID := range userIDs {
+		if count, ok := f.counts[userID]; ok {
+			f.counts[userID] = max(count+delta, 0)
+		}
+	}
+	return nil
+}
+
+func (f *fakeBadgeCache) DelBadges(_ context.Context, userIDs ...string) error {
+	for _, userID := range userIDs {
+		delete(f.counts, userID)
+	}
+	f.deleted = append(f.deleted, userIDs...)
+	return nil
+}
+
+type fakeBadgeConversations struct {
+	conversations map[string][]*pbconversation.Conversation
+	muted         map[string]struct{}
+	err           error
+}
+
+func (f *fakeBadgeConversations) GetConversationIDs(_ context.Context, ownerUserID string) ([]string, error) {
+	return datautil.Slice(f.conversations[ownerUserID], func(e *pbconversation.Conversation) string { return e.ConversationID }), nil
+}
+
+func (f *fakeBadgeConversations) GetConversations(_ context.Context, ownerUserID string, _ []string) ([]*pbconversation.Conversation, error) {
+	return f.conversations[ownerUserID], nil
+}
+
+func (f *fakeBadgeConversations) GetConversationNotReceiveMessageUserIDMap(_ context.Context, _ string) (map[string]struct{}, error) {
+	return f.muted, f.err
+}
+
+type fakeSeqConversation struct {
+	cache.SeqConversationCache
+	maxSeqs map[string]int64
+	calls   int
+}
+
+func (f *fakeSeqConversation) GetMaxSeqs(_ context.Context, conversationIDs []string) (map[string]int64, error) {
+	f.calls++
+	return datautil.SliceToMapAny(conversationIDs, func(e string) (string, int64) { return e, f.maxSeqs[e] }), nil
+}
+
+type fakeSeqUser struct {
+	cache.SeqUser
+	readSeqs map[string]int64
+	calls    int
+}
+
+func (f *fakeSeqUser) GetUserReadSeqs(_ context.Context, _ string, conversationIDs []string) (map[string]int64, error) {
+	f.calls++
+	return datautil.SliceToMapAny(conversationIDs, func(e string) (string, int64) { return e, f.readSeqs[e] }), nil
+}
+
+func TestBadgesSent(t *testing.T) {
+	badgeCache := &fakeBadgeCache{counts: map[string]int64{"alice": 1, "bob": 2, "carol": 3}}
+	conversations := &fakeBadgeConversations{muted: map[string]struct{}{"carol": {}}}
+	b := newBadges(badgeCache, conversations, nil, nil)
+	msg := &sdkws.MsgData{SendID: "alice", GroupID: "team", SessionType: constant.ReadGroupChatType, Seq: 5}
+
+	// The sender and the users who muted the conversation are not counted, nor the users without a cached count.
+	b.sent(context.Background(), msg, []string{"alice", "bob", "carol", "dave"})
+	if expected := map[string]int64{"alice": 1, "bob": 3, "carol": 3}; !maps.Equal(badgeCache.counts, expected) {
+		t.Errorf("expected counts %v, got %v", expected, badgeCache.counts)
+	}
+
+	// The counts are dropped when the muted users can not be read.
+	conversations.err = errors.New("conversation unavailable")
+	b.sent(context.Background(), msg, []string{"bob"})
+	if _, ok := badgeCache.counts["bob"]; ok || !slices.Equal(badgeCache.deleted, []string{"bob"}) {
+		t.Errorf("expected the count of bob to be dropped, got %v", badgeCache.counts)
+	}
+}
+
+func TestBadgesGet(t *testing.T) {
+	badgeCache := &fakeBadgeCache{counts: map[string]int64{"alice": 4}}
+	conversations := &fakeBadgeConversations{conversations: map[string][]*pbconversation.Conversation{
+		"bob": {
+			{ConversationID: "si_alice_bob", RecvMsgOpt: constant.ReceiveMessage},
+			{ConversationID: "sg_muted", RecvMsgOpt: constant.ReceiveNotNotifyMessage},
+		},
+	}}
+	seqConversation := &fakeSeqConversation{maxSeqs: map[string]int64{"si_alice_bob": 10, "sg_muted": 50}}
+	seqUser := &fakeSeqUser{readSeqs: map[string]int64{"si_alice_bob": 7}}
+	b := newBadges(badgeCache, conversations, seqConversation, seqUser)
+
+	counts := b.get(context.Background(), []string{"alice", "bob"})
+	if expected := map[string]int{"alice": 4, "bob": 3}; !maps.Equal(counts, expected) {
+		t.Errorf("expected counts %v, got %v", expected, counts)
+	}
+	// The seqs of a cold count are read in one batch, and the count is cached.
+	if seqConversation.calls != 1 || seqUser.calls != 1 {
+		t.Errorf("expected the seqs to be read once, got %d max seqs and %d read seqs", seqConversation.calls, seqUser.calls)
+	}
+	if badgeCache.counts["bob"] != 3 {
+		t.Errorf("expected the count of bob to be cached, got %v", badgeCache.counts)
+	}
+}
@@ -0,0 +1,93 @@
+package push
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	pbpushext "github.com/openimsdk/open-im-server/v3/pkg/protocol/pushext"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+	"google.golang.org/protobuf/proto"
+)
+
+func deadLetterDB2Pb(letter *model.OfflinePushDeadLetter) *pbpushext.OfflinePushDeadLetterInfo {
+	info := &pbpushext.OfflinePushDeadLetterInfo{
+		Id:             letter.ID.Hex(),
+		ConversationID: letter.ConversationID,
+		ClientMsgID:    letter.ClientMsgID,
+		UserIDs:        letter.UserIDs,
+		Attempts:       int32(letter.Attempts),
+		Providers:      letter.Providers,
+		ErrorClass:     letter.ErrorClass,
+		Error:          letter.Error,
+		CreateTime:     letter.CreateTime.UnixMilli(),
+	}
+	var msg sdkws.MsgData
+	if err := proto.Unmarshal(letter.Msg, &msg); err == nil {
+		info.Msg = &msg
+	}
+	return info
+}
+
+func (p *pushServer) SearchOfflinePushDeadLetters(ctx context.Context, req *pbpushext.SearchOfflinePushDeadLettersReq) (*pbpushext.SearchOfflinePushDeadLettersResp, error) {
+	if err := authverify.CheckAdmin(ctx, p.imAdminUserID); err != nil {
+		return nil, err
+	}
+	filter := &database.OfflinePushDeadLetterFilter{
+		ConversationID: req.ConversationID,
+		UserID:         req.UserID,
+		Provider:       req.Provider,
+		ErrorClass:     req.ErrorClass,
+	}
+	if req.StartTime > 0 {
+		filter.StartTime = time.UnixMilli(req.StartTime)
+	}
+	if req.EndTime > 0 {
+		filter.EndTime = time.UnixMilli(req.EndTime)
+	}
+	total, letters, err := p.deadLetterDB.SearchDeadLetters(ctx, filter, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &pbpushext.SearchOfflinePushDeadLettersResp{Total: total, DeadLetters: datautil.Slice(letters, deadLetterDB2Pb)}, nil
+}
+
+func (p *pushServer) ReplayOfflinePushDeadLetters(ctx context.Context, req *pbpushext.ReplayOfflinePushDeadLettersReq) (*pbpushext.ReplayOfflinePushDeadLettersResp, error) {
+	if err := authverify.CheckAdmin(ctx, p.imAdminUserID); err != nil {
+		return nil, err
+	}
+	letters, err := p.deadLetterDB.GetDeadLetters(ctx, req.Ids)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pbpushext.ReplayOfflinePushDeadLettersResp{}
+	for _, letter := range letters {
+		var msg sdkws.MsgData
+		if err := proto.Unmarshal(letter.Msg, &msg); err != nil {
+			log.ZWarn(ctx, "unmarshal offline push dead letter failed", err, "id", letter.ID.Hex())
+			continue
+		}
+		if err := p.database.MsgToOfflinePushMQ(ctx, letter.ConversationID, letter.UserIDs, &msg); err != nil {
+			return nil, err
+		}
+		if err := p.deadLetterDB.DeleteDeadLetters(ctx, []string{letter.ID.Hex()}); err != nil {
+			return nil, err
+		}
+		resp.ReplayedIDs = append(resp.ReplayedIDs, letter.ID.Hex())
+	}
+	return resp, nil
+}
+
+func (p *pushServer) DeleteOfflinePushDeadLetters(ctx context.Context, req *pbpushext.DeleteOfflinePushDeadLettersReq) (*pbpushext.DeleteOfflinePushDeadLettersResp, error) {
+	if err := authverify.CheckAdmin(ctx, p.imAdminUserID); err != nil {
+		return nil, err
+	}
+	if err := p.deadLetterDB.DeleteDeadLetters(ctx, req.Ids); err != nil {
+		return nil, err
+	}
+	return &pbpushext.DeleteOfflinePushDeadLettersResp{}, nil
+}
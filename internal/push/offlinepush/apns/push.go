@@ -0,0 +1,310 @@
+package apns
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/options"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	productionEndpoint  = "https://api.push.apple.com"
+	developmentEndpoint = "https://api.sandbox.push.apple.com"
+
+	// Apple rejects provider tokens older than an hour and throttles refreshing them more than every 20 minutes.
+	tokenRefreshInterval = 50 * time.Minute
+
+	concurrentRequests = 16
+	requestTimeout     = 10 * time.Second
+)
+
+// Terminal are the platforms whose device token is an APNs token, registered through the fcm token api.
+// The apns devices of the device registry are pushed to as well, whatever their platform.
+var Terminal = []int{constant.IOSPlatformID, constant.IPadPlatformID}
+
+type APNs struct {
+	pushConf   *config.Push
+	cache      cache.ThirdCache
+	deviceDB   controller.PushDeviceDatabase
+	endpoint   string
+	httpClient *http.Client
+	key        *ecdsa.PrivateKey
+
+	lock      sync.Mutex
+	token     string
+	tokenTime time.Time
+}
+
+// NewClient creates an APNs client authenticating with the .p8 key located in the configuration directory.
+func NewClient(pushConf *config.Push, cache cache.ThirdCache, deviceDB controller.PushDeviceDatabase, configPath string) (*APNs, error) {
+	conf := &pushConf.APNs
+	if conf.KeyFilePath == "" || conf.KeyID == "" || conf.TeamID == "" || conf.BundleID == "" {
+		return nil, errs.New("apns keyFilePath, keyID, teamID and bundleID are required").Wrap()
+	}
+	data, err := os.ReadFile(filepath.Join(configPath, conf.KeyFilePath))
+	if err != nil {
+		return nil, errs.WrapMsg(err, "read apns key failed", "keyFilePath", conf.KeyFilePath)
+	}
+	key, err := jwt.ParseECPrivateKeyFromPEM(data)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "parse apns key failed", "keyFilePath", conf.KeyFilePath)
+	}
+	endpoint := developmentEndpoint
+	if pushConf.IOSPush.Production {
+		endpoint = productionEndpoint
+	}
+	return newClient(pushConf, cache, deviceDB, key, endpoint, &http.Client{Timeout: requestTimeout}), nil
+}
+
+func newClient(pushConf *config.Push, cache cache.ThirdCache, deviceDB controller.PushDeviceDatabase, key *ecdsa.PrivateKey, endpoint string, httpClient *http.Client) *APNs {
+	return &APNs{pushConf: pushConf, cache: cache, deviceDB: deviceDB, endpoint: endpoint, httpClient: httpClient, key: key}
+}
+
+type alert struct {
+	Title string `json:"title,omitempty"`
+	Body  string `json:"body,omitempty"`
+}
+
+type aps struct {
+	Alert          alert  `json:"alert"`
+	Badge          *int   `json:"badge,omitempty"`
+	Sound          string `json:"sound,omitempty"`
+	MutableContent int    `json:"mutable-content,omitempty"`
+	ThreadID       string `json:"thread-id,omitempty"`
+}
+
+type payload struct {
+	Aps         aps    `json:"aps"`
+	Ex          string `json:"ex,omitempty"`
+	ClientMsgID string `json:"clientMsgID,omitempty"`
+}
+
+type errorResponse struct {
+	Reason string `json:"reason"`
+}
+
+func (a *APNs) Push(ctx context.Context, userIDs []string, title, content string, opts *options.Opts) error {
+	sound := opts.IOSPushSound
+	if sound == "" {
+		sound = a.pushConf.IOSPush.PushSound
+	}
+	var (
+		failLock sync.Mutex
+		fail     int
+		failed   []string
+		lastErr  error
+	)
+	registered := a.registeredTokens(ctx, userIDs, opts)
+	// The tokens of the devices whose notifications are turned off are not pushed to, even when kept by the cache.
+	disabledTokens, err := a.deviceDB.GetDisabledTokens(ctx, model.PushProviderAPNs, userIDs)
+	if err != nil {
+		log.ZWarn(ctx, "get disabled apns tokens failed", err, "userIDs", userIDs)
+	}
+	disabled := datautil.SliceSet(disabledTokens)
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(concurrentRequests)
+	for _, userID := range userIDs {
+		var tokens []platformToken
+		for _, platformID := range Terminal {
+			if !opts.HasPlatform(platformID) {
+				continue
+			}
+			token, err := a.cache.GetFcmToken(ctx, userID, platformID)
+			if _, ok := disabled[token]; err == nil && token != "" && !ok {
+				tokens = append(tokens, platformToken{platformID: platformID, token: token})
+			}
+		}
+		for _, token := range registered[userID] {
+			if !datautil.Contain(token.token, datautil.Slice(tokens, func(t platformToken) string { return t.token })...) {
+				tokens = append(tokens, token)
+			}
+		}
+		if len(tokens) == 0 {
+			continue
+		}
+		p := payload{
+			Aps: aps{
+				Alert:          alert{Title: title, Body: content},
+				Sound:          sound,
+				MutableContent: 1,
+				ThreadID:       opts.ConversationID,
+			},
+			Ex: opts.Ex,
+		}
+		if opts.Signal != nil {
+			p.ClientMsgID = opts.Signal.ClientMsgID
+		}
+		badge, ok, err := a.badge(ctx, userID, opts)
+		if err != nil {
+			log.ZWarn(ctx, "get apns badge failed", err, "userID", userID)
+		} else if ok {
+			// A badge of 0 is sent too, it clears the badge shown on the app icon.
+			p.Aps.Badge = &badge
+		}
+		body, err := json.Marshal(&p)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		for _, token := range tokens {
+			g.Go(func() error {
+				if err := a.send(ctx, userID, token, body, opts); err != nil {
+					failLock.Lock()
+					fail++
+					failed = append(failed, userID)
+					lastErr = err
+					failLock.Unlock()
+				}
+				return nil
+			})
+		}
+	}
+	_ = g.Wait()
+	if fail != 0 {
+		return &options.UsersError{UserIDs: datautil.Distinct(failed), Err: errs.WrapMsg(lastErr, fmt.Sprintf("%d apns message send failed", fail))}
+	}
+	return nil
+}
+
+type platformToken struct {
+	platformID int
+	token      string
+}
+
+// registeredTokens returns the tokens of the apns devices of the device registry, by user.
+func (a *APNs) registeredTokens(ctx context.Context, userIDs []string, opts *options.Opts) map[string][]platformToken {
+	devices, err := a.deviceDB.GetDevices(ctx, userIDs)
+	if err != nil {
+		log.ZWarn(ctx, "get apns devices failed", err, "userIDs", userIDs)
+		return nil
+	}
+	tokens := make(map[string][]platformToken)
+	for _, device := range devices {
+		if device.Provider == model.PushProviderAPNs && opts.HasPlatform(int(device.PlatformID)) {
+			tokens[device.UserID] = append(tokens[device.UserID], platformToken{platformID: int(device.PlatformID), token: device.Token})
+		}
+	}
+	return tokens
+}
+
+// badge returns the unread count shown on the app icon, the one computed by the push service if any,
+// else the one reported by the client, incremented when the message counts as unread. It returns
+// false when the client has not reported a count, the badge on the app icon is then left as is.
+func (a *APNs) badge(ctx context.Context, userID string, opts *options.Opts) (int, bool, error) {
+	if count, ok := opts.Badges[userID]; ok {
+		return count, true, nil
+	}
+	if opts.IOSBadgeCount {
+		count, err := a.cache.IncrUserBadgeUnreadCountSum(ctx, userID)
+		if err != nil {
+			return 0, false, err
+		}
+		return count, true, nil
+	}
+	count, err := a.cache.GetUserBadgeUnreadCountSum(ctx, userID)
+	if err != nil {
+		if errors.Is(errs.Unwrap(err), redis.Nil) {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+	return count, true, nil
+}
+
+func (a *APNs) send(ctx context.Context, userID string, token platformToken, body []byte, opts *options.Opts) error {
+	providerToken, err := a.providerToken()
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.endpoint+"/3/device/"+token.token, bytes.NewReader(body))
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	req.Header.Set("authorization", "bearer "+providerToken)
+	req.Header.Set("apns-topic", a.pushConf.APNs.BundleID)
+	req.Header.Set("apns-push-type", "alert")
+	req.Header.Set("apns-priority", "10")
+	if opts.Signal != nil && opts.Signal.ClientMsgID != "" {
+		req.Header.Set("apns-collapse-id", opts.Signal.ClientMsgID)
+	}
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return errs.WrapMsg(err, "apns request failed")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+	var res errorResponse
+	data, _ := io.ReadAll(resp.Body)
+	_ = json.Unmarshal(data, &res)
+	switch {
+	case resp.StatusCode == http.StatusGone, resp.StatusCode == http.StatusBadRequest && res.Reason == "BadDeviceToken":
+		// The device will not get a push through this token again, the push did not fail for a retry to succeed.
+		a.pruneToken(ctx, userID, token)
+		return nil
+	case resp.StatusCode == http.StatusForbidden && res.Reason == "ExpiredProviderToken":
+		a.lock.Lock()
+		a.token = ""
+		a.lock.Unlock()
+	}
+	return errs.New("apns push failed", "status", resp.StatusCode, "reason", res.Reason).Wrap()
+}
+
+// pruneToken removes a device token APNs no longer accepts, from the device registry,
+// and from the fcm token api unless the device registered a new one meanwhile.
+func (a *APNs) pruneToken(ctx context.Context, userID string, token platformToken) {
+	if err := a.deviceDB.DeleteInvalidTokens(ctx, model.PushProviderAPNs, []string{token.token}); err != nil {
+		log.ZWarn(ctx, "delete invalid apns device token failed", err, "userID", userID)
+	}
+	current, err := a.cache.GetFcmToken(ctx, userID, token.platformID)
+	if err != nil || current != token.token {
+		return
+	}
+	if err := a.cache.DelFcmToken(ctx, userID, token.platformID); err != nil {
+		log.ZWarn(ctx, "delete invalid apns token failed", err, "userID", userID, "platformID", token.platformID)
+		return
+	}
+	log.ZInfo(ctx, "deleted invalid apns token", "userID", userID, "platformID", token.platformID)
+}
+
+// providerToken returns the JWT authenticating the requests, signed again once it gets old.
+func (a *APNs) providerToken() (string, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	now := time.Now()
+	if a.token != "" && now.Sub(a.tokenTime) < tokenRefreshInterval {
+		return a.token, nil
+	}
+	t := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"iss": a.pushConf.APNs.TeamID,
+		"iat": now.Unix(),
+	})
+	t.Header["kid"] = a.pushConf.APNs.KeyID
+	token, err := t.SignedString(a.key)
+	if err != nil {
+		return "", errs.WrapMsg(err, "sign apns provider token failed")
+	}
+	a.token, a.tokenTime = token, now
+	return token, nil
+}
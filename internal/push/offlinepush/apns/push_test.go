@@ -0,0 +1,264 @@
+package apns
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/options"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/redis/go-redis/v9"
+)
+
+type memoryThirdCache struct {
+	cache.ThirdCache
+	lock   sync.Mutex
+	tokens map[string]string
+	badges map[string]int
+}
+
+func (c *memoryThirdCache) key(userID string, platformID int) string {
+	return fmt.Sprintf("%s:%d", userID, platformID)
+}
+
+func (c *memoryThirdCache) GetFcmToken(_ context.Context, userID string, platformID int) (string, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	token, ok := c.tokens[c.key(userID, platformID)]
+	if !ok {
+		return "", errs.Wrap(redis.Nil)
+	}
+	return token, nil
+}
+
+func (c *memoryThirdCache) DelFcmToken(_ context.Context, userID string, platformID int) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.tokens, c.key(userID, platformID))
+	return nil
+}
+
+func (c *memoryThirdCache) IncrUserBadgeUnreadCountSum(_ context.Context, userID string) (int, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.badges[userID]++
+	return c.badges[userID], nil
+}
+
+func (c *memoryThirdCache) GetUserBadgeUnreadCountSum(_ context.Context, userID string) (int, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	badge, ok := c.badges[userID]
+	if !ok {
+		return 0, errs.Wrap(redis.Nil)
+	}
+	return badge, nil
+}
+
+type memoryDeviceDB struct {
+	controller.PushDeviceDatabase
+	lock    sync.Mutex
+	devices []*model.PushDevice
+	// disabled are the devices whose notifications are turned off.
+	disabled []*model.PushDevice
+}
+
+func (d *memoryDeviceDB) GetDisabledTokens(_ context.Context, provider string, userIDs []string) ([]string, error) {
+	var tokens []string
+	for _, device := range d.disabled {
+		if device.Provider == provider && datautil.Contain(device.UserID, userIDs...) {
+			tokens = append(tokens, device.Token)
+		}
+	}
+	return tokens, nil
+}
+
+func (d *memoryDeviceDB) GetDevices(_ context.Context, userIDs []string) ([]*model.PushDevice, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	var devices []*model.PushDevice
+	for _, device := range d.devices {
+		for _, userID := range userIDs {
+			if device.UserID == userID {
+				devices = append(devices, device)
+			}
+		}
+	}
+	return devices, nil
+}
+
+func (d *memoryDeviceDB) DeleteInvalidTokens(_ context.Context, provider string, tokens []string) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	var devices []*model.PushDevice
+	for _, device := range d.devices {
+		invalid := false
+		for _, token := range tokens {
+			invalid = invalid || device.Provider == provider && device.Token == token
+		}
+		if !invalid {
+			devices = append(devices, device)
+		}
+	}
+	d.devices = devices
+	return nil
+}
+
+type receivedPush struct {
+	header  http.Header
+	payload payload
+}
+
+func TestPush(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var (
+		lock     sync.Mutex
+		received = make(map[string]receivedPush)
+	)
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ProtoMajor != 2 {
+			t.Errorf("expected an HTTP/2 request, got %s", r.Proto)
+		}
+		_, err := jwt.Parse(strings.TrimPrefix(r.Header.Get("authorization"), "bearer "), func(*jwt.Token) (any, error) {
+			return &key.PublicKey, nil
+		})
+		if err != nil {
+			t.Errorf("invalid provider token: %v", err)
+		}
+		token := strings.TrimPrefix(r.URL.Path, "/3/device/")
+		if token == "unregistered" {
+			w.WriteHeader(http.StatusGone)
+			_, _ = io.WriteString(w, `{"reason":"Unregistered"}`)
+			return
+		}
+		if token == "unavailable" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = io.WriteString(w, `{"reason":"ServiceUnavailable"}`)
+			return
+		}
+		var p payload
+		if err := json.NewDecoder(r.Body).Decode(&p); err != nil {
+			t.Error(err)
+		}
+		lock.Lock()
+		received[token] = receivedPush{header: r.Header, payload: p}
+		lock.Unlock()
+	}))
+	srv.EnableHTTP2 = true
+	srv.StartTLS()
+	defer srv.Close()
+
+	thirdCache := &memoryThirdCache{
+		tokens: map[string]string{
+			fmt.Sprintf("alice:%d", constant.IOSPlatformID):  "alice-phone",
+			fmt.Sprintf("alice:%d", constant.IPadPlatformID): "alice-pad",
+			fmt.Sprintf("bob:%d", constant.IOSPlatformID):    "unregistered",
+			fmt.Sprintf("dave:%d", constant.IOSPlatformID):   "unavailable",
+			fmt.Sprintf("erin:%d", constant.IOSPlatformID):   "erin-phone",
+		},
+		badges: map[string]int{"alice": 2},
+	}
+	pushConf := &config.Push{}
+	pushConf.APNs.KeyID = "key"
+	pushConf.APNs.TeamID = "team"
+	pushConf.APNs.BundleID = "io.openim.app"
+	pushConf.IOSPush.PushSound = "default"
+	deviceDB := &memoryDeviceDB{devices: []*model.PushDevice{
+		{UserID: "alice", DeviceID: "phone2", PlatformID: constant.IOSPlatformID, Provider: model.PushProviderAPNs, Token: "alice-phone2"},
+		{UserID: "alice", DeviceID: "phone", PlatformID: constant.IOSPlatformID, Provider: model.PushProviderAPNs, Token: "alice-phone"},
+		{UserID: "alice", DeviceID: "android", PlatformID: constant.AndroidPlatformID, Provider: model.PushProviderHuawei, Token: "alice-android"},
+		{UserID: "carol", DeviceID: "phone", PlatformID: constant.IOSPlatformID, Provider: model.PushProviderAPNs, Token: "unregistered"},
+	}, disabled: []*model.PushDevice{
+		{UserID: "erin", DeviceID: "phone", PlatformID: constant.IOSPlatformID, Provider: model.PushProviderAPNs, Token: "erin-phone"},
+	}}
+	client := newClient(pushConf, thirdCache, deviceDB, key, srv.URL, srv.Client())
+
+	opts := &options.Opts{
+		Signal:         &options.Signal{ClientMsgID: "msg1"},
+		IOSBadgeCount:  true,
+		ConversationID: "sg_group1",
+	}
+	// The unregistered tokens of bob and carol are pruned, only the push to dave failed.
+	err = client.Push(context.Background(), []string{"alice", "bob", "carol", "dave", "erin"}, "title", "content", opts)
+	if failed := options.FailedUserIDs(err, nil); err == nil || len(failed) != 1 || failed[0] != "dave" {
+		t.Fatalf("expected the push to dave to fail, got %v", err)
+	}
+
+	if _, ok := received["alice-android"]; ok {
+		t.Error("expected no apns push to a huawei token")
+	}
+	if _, ok := received["erin-phone"]; ok {
+		t.Error("expected no push to the token of a device whose notifications are turned off")
+	}
+	for _, token := range []string{"alice-phone", "alice-pad", "alice-phone2"} {
+		push, ok := received[token]
+		if !ok {
+			t.Fatalf("no push received for %s", token)
+		}
+		if push.header.Get("apns-topic") != "io.openim.app" || push.header.Get("apns-collapse-id") != "msg1" {
+			t.Errorf("unexpected headers %v", push.header)
+		}
+		aps := push.payload.Aps
+		if aps.Alert.Title != "title" || aps.Sound != "default" || aps.MutableContent != 1 || aps.ThreadID != "sg_group1" {
+			t.Errorf("unexpected aps %+v", aps)
+		}
+		if aps.Badge == nil || *aps.Badge != 3 {
+			t.Errorf("expected badge 3, got %v", aps.Badge)
+		}
+	}
+	if _, err := thirdCache.GetFcmToken(context.Background(), "bob", constant.IOSPlatformID); err == nil {
+		t.Error("expected the unregistered token to be pruned")
+	}
+	for _, device := range deviceDB.devices {
+		if device.Token == "unregistered" {
+			t.Error("expected the unregistered device token to be pruned")
+		}
+	}
+
+	// The counts computed by the push service are used as they are.
+	opts.Badges = map[string]int{"alice": 7}
+	if err := client.Push(context.Background(), []string{"alice"}, "title", "content", opts); err != nil {
+		t.Fatal(err)
+	}
+	if badge := received["alice-phone"].payload.Aps.Badge; badge == nil || *badge != 7 {
+		t.Errorf("expected badge 7, got %v", badge)
+	}
+
+	// A count of 0 is sent to clear the badge.
+	opts.Badges = map[string]int{"alice": 0}
+	if err := client.Push(context.Background(), []string{"alice"}, "title", "content", opts); err != nil {
+		t.Fatal(err)
+	}
+	if badge := received["alice-phone"].payload.Aps.Badge; badge == nil || *badge != 0 {
+		t.Errorf("expected badge 0, got %v", badge)
+	}
+
+	// Without a count reported by the client the badge is left as is.
+	opts.Badges, opts.IOSBadgeCount = nil, false
+	thirdCache.badges = map[string]int{}
+	if err := client.Push(context.Background(), []string{"alice"}, "title", "content", opts); err != nil {
+		t.Fatal(err)
+	}
+	if badge := received["alice-phone"].payload.Aps.Badge; badge != nil {
+		t.Errorf("expected no badge, got %v", *badge)
+	}
+}
@@ -0,0 +1,90 @@
+package offlinepush
+
+import (
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/options"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// ProviderError is the failure of a push through a provider, the router returns one for each failed route.
+type ProviderError struct {
+	Route    string
+	Provider string
+	// UserIDs are the users the push failed for.
+	UserIDs []string
+	Err     error
+}
+
+func (e *ProviderError) Error() string {
+	return e.Provider + ": " + e.Err.Error()
+}
+
+func (e *ProviderError) Unwrap() error {
+	return e.Err
+}
+
+// ProviderErrors returns the ProviderErrors err is made of, the result of each provider a push failed through.
+func ProviderErrors(err error) []*ProviderError {
+	var res []*ProviderError
+	var walk func(err error)
+	walk = func(err error) {
+		switch e := err.(type) {
+		case *ProviderError:
+			res = append(res, e)
+		case interface{ Unwrap() []error }:
+			for _, err := range e.Unwrap() {
+				walk(err)
+			}
+		case interface{ Unwrap() error }:
+			walk(e.Unwrap())
+		}
+	}
+	walk(err)
+	return res
+}
+
+// FailedProviders returns the providers of the ProviderErrors err is made of.
+func FailedProviders(err error) []string {
+	var providers []string
+	for _, e := range ProviderErrors(err) {
+		providers = append(providers, e.Provider)
+	}
+	return providers
+}
+
+// FailedUserIDs returns the users a push to userIDs failed for with err, made of the errors of the pushes to
+// groups of them. All the users failed when a part of err does not tell which users it failed for.
+func FailedUserIDs(err error, userIDs []string) []string {
+	failed := make(map[string]struct{})
+	var walk func(err error) bool
+	walk = func(err error) bool {
+		switch e := err.(type) {
+		case *ProviderError:
+			for _, userID := range e.UserIDs {
+				failed[userID] = struct{}{}
+			}
+			return true
+		case *options.UsersError:
+			for _, userID := range e.UserIDs {
+				failed[userID] = struct{}{}
+			}
+			return true
+		case interface{ Unwrap() []error }:
+			for _, err := range e.Unwrap() {
+				if !walk(err) {
+					return false
+				}
+			}
+			return true
+		case interface{ Unwrap() error }:
+			return walk(e.Unwrap())
+		}
+		return false
+	}
+	if !walk(err) {
+		return userIDs
+	}
+	return datautil.Filter(userIDs, func(userID string) (string, bool) {
+		_, ok := failed[userID]
+		return userID, ok
+	})
+}
@@ -26,8 +26,12 @@ import (
 	"firebase.google.com/go/v4/messaging"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
 	"github.com/redis/go-redis/v9"
 	"google.golang.org/api/option"
 )
@@ -39,11 +43,13 @@ var Terminal = []int{constant.IOSPlatformID, constant.AndroidPlatformID, constan
 type Fcm struct {
 	fcmMsgCli *messaging.Client
 	cache     cache.ThirdCache
+	deviceDB  controller.PushDeviceDatabase
 }
 
 // NewClient initializes a new FCM client using the Firebase Admin SDK.
 // It requires the FCM service account credentials file located within the project's configuration directory.
-func NewClient(pushConf *config.Push, cache cache.ThirdCache, fcmConfigPath string) (*Fcm, error) {
+// The tokens are those of the fcm token api, one per platform, and those of the fcm devices of the device registry.
+func NewClient(pushConf *config.Push, cache cache.ThirdCache, deviceDB controller.PushDeviceDatabase, fcmConfigPath string) (*Fcm, error) {
 	var opt option.ClientOption
 	switch {
 	case len(pushConf.FCM.FilePath) != 0:
@@ -71,62 +77,97 @@ func NewClient(pushConf *config.Push, cache cache.ThirdCache, fcmConfigPath stri
 	if err != nil {
 		return nil, errs.Wrap(err)
 	}
-	return &Fcm{fcmMsgCli: fcmMsgClient, cache: cache}, nil
+	return &Fcm{fcmMsgCli: fcmMsgClient, cache: cache, deviceDB: deviceDB}, nil
 }
 
 func (f *Fcm) Push(ctx context.Context, userIDs []string, title, content string, opts *options.Opts) error {
+	// The tokens of the devices whose notifications are turned off are not pushed to, even when kept by the cache.
+	disabledTokens, err := f.deviceDB.GetDisabledTokens(ctx, model.PushProviderFCM, userIDs)
+	if err != nil {
+		log.ZWarn(ctx, "get disabled fcm tokens failed", err, "userIDs", userIDs)
+	}
+	disabled := datautil.SliceSet(disabledTokens)
 	// accounts->registrationToken
 	allTokens := make(map[string][]string, 0)
 	for _, account := range userIDs {
 		var personTokens []string
 		for _, v := range Terminal {
+			if !opts.HasPlatform(v) {
+				continue
+			}
 			Token, err := f.cache.GetFcmToken(ctx, account, v)
-			if err == nil {
+			if _, ok := disabled[Token]; err == nil && !ok {
 				personTokens = append(personTokens, Token)
 			}
 		}
 		allTokens[account] = personTokens
 	}
+	devices, err := f.deviceDB.GetDevices(ctx, userIDs)
+	if err != nil {
+		log.ZWarn(ctx, "get fcm devices failed", err, "userIDs", userIDs)
+	}
+	for _, device := range devices {
+		if device.Provider != model.PushProviderFCM || !opts.HasPlatform(int(device.PlatformID)) {
+			continue
+		}
+		if !datautil.Contain(device.Token, allTokens[device.UserID]...) {
+			allTokens[device.UserID] = append(allTokens[device.UserID], device.Token)
+		}
+	}
 	Success := 0
 	Fail := 0
 	notification := &messaging.Notification{}
 	notification.Body = content
 	notification.Title = title
-	var messages []*messaging.Message
+	var (
+		messages []*messaging.Message
+		// messageUserIDs are the users of the messages, failed the users whose push failed.
+		messageUserIDs []string
+		failed         []string
+	)
 	var sendErrBuilder strings.Builder
 	var msgErrBuilder strings.Builder
-	for userID, personTokens := range allTokens {
-		apns := &messaging.APNSConfig{Payload: &messaging.APNSPayload{Aps: &messaging.Aps{Sound: opts.IOSPushSound}}}
-		messageCount := len(messages)
-		if messageCount >= SinglePushCountLimit {
-			response, err := f.fcmMsgCli.SendEach(ctx, messages)
-			if err != nil {
-				Fail = Fail + messageCount
-				// Record push error
-				sendErrBuilder.WriteString(err.Error())
-				sendErrBuilder.WriteByte('.')
-			} else {
-				Success = Success + response.SuccessCount
-				Fail = Fail + response.FailureCount
-				if response.FailureCount != 0 {
+	sendEach := func() {
+		response, err := f.fcmMsgCli.SendEach(ctx, messages)
+		if err != nil {
+			Fail = Fail + len(messages)
+			failed = append(failed, messageUserIDs...)
+			// Record push error
+			sendErrBuilder.WriteString(err.Error())
+			sendErrBuilder.WriteByte('.')
+		} else {
+			Success = Success + response.SuccessCount
+			Fail = Fail + response.FailureCount
+			f.pruneTokens(ctx, messages, response)
+			for i := range response.Responses {
+				// The unregistered tokens are pruned, pushing to them again would fail the same.
+				if !response.Responses[i].Success && !messaging.IsUnregistered(response.Responses[i].Error) {
+					failed = append(failed, messageUserIDs[i])
 					// Record message error
-					for i := range response.Responses {
-						if !response.Responses[i].Success {
-							msgErrBuilder.WriteString(response.Responses[i].Error.Error())
-							msgErrBuilder.WriteByte('.')
-						}
-					}
+					msgErrBuilder.WriteString(response.Responses[i].Error.Error())
+					msgErrBuilder.WriteByte('.')
 				}
 			}
-			messages = messages[0:0]
 		}
-		if opts.IOSBadgeCount {
+		messages, messageUserIDs = messages[0:0], messageUserIDs[0:0]
+	}
+	for userID, personTokens := range allTokens {
+		apns := &messaging.APNSConfig{Payload: &messaging.APNSPayload{Aps: &messaging.Aps{Sound: opts.IOSPushSound}}}
+		if len(messages) >= SinglePushCountLimit {
+			sendEach()
+		}
+		var android *messaging.AndroidConfig
+		if count, ok := opts.Badges[userID]; ok {
+			apns.Payload.Aps.Badge = &count
+			android = &messaging.AndroidConfig{Notification: &messaging.AndroidNotification{NotificationCount: &count}}
+		} else if opts.IOSBadgeCount {
 			unreadCountSum, err := f.cache.IncrUserBadgeUnreadCountSum(ctx, userID)
 			if err == nil {
 				apns.Payload.Aps.Badge = &unreadCountSum
 			} else {
 				// log.Error(operationID, "IncrUserBadgeUnreadCountSum redis err", err.Error(), uid)
 				Fail++
+				failed = append(failed, userID)
 				continue
 			}
 		} else {
@@ -139,6 +180,7 @@ func (f *Fcm) Push(ctx context.Context, userIDs []string, title, content string,
 			} else {
 				// log.Error(operationID, "GetUserBadgeUnreadCountSum redis err", err.Error(), uid)
 				Fail++
+				failed = append(failed, userID)
 				continue
 			}
 		}
@@ -148,23 +190,37 @@ func (f *Fcm) Push(ctx context.Context, userIDs []string, title, content string,
 				Token:        token,
 				Notification: notification,
 				APNS:         apns,
+				Android:      android,
 			}
 			messages = append(messages, temp)
+			messageUserIDs = append(messageUserIDs, userID)
 		}
 	}
-	messageCount := len(messages)
-	if messageCount > 0 {
-		response, err := f.fcmMsgCli.SendEach(ctx, messages)
-		if err != nil {
-			Fail = Fail + messageCount
-		} else {
-			Success = Success + response.SuccessCount
-			Fail = Fail + response.FailureCount
-		}
+	if len(messages) > 0 {
+		sendEach()
 	}
 	if Fail != 0 {
-		return errs.New(fmt.Sprintf("%d message send failed;send err:%s;message err:%s",
+		err := errs.New(fmt.Sprintf("%d message send failed;send err:%s;message err:%s",
 			Fail, sendErrBuilder.String(), msgErrBuilder.String())).Wrap()
+		if len(failed) == 0 {
+			// Only unregistered tokens failed, they are pruned.
+			log.ZWarn(ctx, "fcm push to unregistered tokens", err)
+			return nil
+		}
+		return &options.UsersError{UserIDs: datautil.Distinct(failed), Err: err}
 	}
 	return nil
 }
+
+// pruneTokens removes from the device registry the tokens FCM reported as no longer registered.
+func (f *Fcm) pruneTokens(ctx context.Context, messages []*messaging.Message, response *messaging.BatchResponse) {
+	var invalid []string
+	for i, res := range response.Responses {
+		if !res.Success && messaging.IsUnregistered(res.Error) {
+			invalid = append(invalid, messages[i].Token)
+		}
+	}
+	if err := f.deviceDB.DeleteInvalidTokens(ctx, model.PushProviderFCM, invalid); err != nil {
+		log.ZWarn(ctx, "delete invalid fcm tokens failed", err, "tokens", invalid)
+	}
+}
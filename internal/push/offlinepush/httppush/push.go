@@ -0,0 +1,216 @@
+// Package httppush delegates the offline pushes to an in-house push service,
+// posting them to its URL as a signed JSON envelope.
+package httppush
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/options"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+)
+
+const (
+	// EnvelopeVersion is increased when the envelope changes incompatibly.
+	EnvelopeVersion = 1
+
+	// HeaderTimestamp and HeaderSignature authenticate the requests, the signature is
+	// the hex HMAC-SHA256 of the timestamp, a dot and the body, keyed with the secret.
+	HeaderTimestamp = "X-OpenIM-Timestamp"
+	HeaderSignature = "X-OpenIM-Signature"
+
+	provider = "http"
+
+	defaultBatchSize = 500
+	defaultMaxRetry  = 3
+	defaultTimeout   = 5
+	retryInterval    = 200 * time.Millisecond
+)
+
+// Envelope is the body posted to the push service.
+type Envelope struct {
+	Version int `json:"version"`
+	// RequestID is the same for the retries of a request, for the push service to drop duplicates.
+	RequestID      string          `json:"requestID"`
+	UserIDs        []string        `json:"userIDs"`
+	Title          string          `json:"title"`
+	Content        string          `json:"content"`
+	ConversationID string          `json:"conversationID"`
+	Options        EnvelopeOptions `json:"options"`
+	Message        *EnvelopeMsg    `json:"message,omitempty"`
+}
+
+type EnvelopeOptions struct {
+	IOSPushSound  string `json:"iosPushSound"`
+	IOSBadgeCount bool   `json:"iosBadgeCount"`
+	Ex            string `json:"ex"`
+	// PlatformIDs restricts the push to the devices of these platforms, all platforms when empty.
+	PlatformIDs []int `json:"platformIDs,omitempty"`
+}
+
+type EnvelopeMsg struct {
+	ClientMsgID string `json:"clientMsgID"`
+	SendID      string `json:"sendID"`
+	GroupID     string `json:"groupID,omitempty"`
+	SessionType int32  `json:"sessionType"`
+	ContentType int32  `json:"contentType"`
+	Seq         int64  `json:"seq"`
+	SendTime    int64  `json:"sendTime"`
+}
+
+type HTTPPush struct {
+	pushConf   *config.Push
+	batchSize  int
+	maxRetry   int
+	httpClient *http.Client
+}
+
+func NewClient(pushConf *config.Push) (*HTTPPush, error) {
+	conf := &pushConf.HTTP
+	if conf.URL == "" {
+		return nil, errs.New("http push url is required").Wrap()
+	}
+	h := &HTTPPush{
+		pushConf:  pushConf,
+		batchSize: conf.BatchSize,
+		maxRetry:  defaultMaxRetry,
+	}
+	if h.batchSize <= 0 {
+		h.batchSize = defaultBatchSize
+	}
+	if conf.MaxRetry != nil {
+		if *conf.MaxRetry < 0 {
+			return nil, errs.New("http push maxRetry can not be negative", "maxRetry", *conf.MaxRetry).Wrap()
+		}
+		h.maxRetry = *conf.MaxRetry
+	}
+	timeout := conf.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	h.httpClient = &http.Client{Timeout: time.Duration(timeout) * time.Second}
+	return h, nil
+}
+
+func (h *HTTPPush) Push(ctx context.Context, userIDs []string, title, content string, opts *options.Opts) error {
+	var (
+		failed  []string
+		lastErr error
+	)
+	for i := 0; i < len(userIDs); i += h.batchSize {
+		batch := userIDs[i:min(i+h.batchSize, len(userIDs))]
+		if err := h.post(ctx, newEnvelope(batch, title, content, opts)); err != nil {
+			prommetrics.OfflinePushProviderCall(provider, "failed", len(batch))
+			log.ZWarn(ctx, "http push failed", err, "userIDs", len(batch))
+			failed = append(failed, batch...)
+			lastErr = err
+			continue
+		}
+		prommetrics.OfflinePushProviderCall(provider, "success", len(batch))
+	}
+	if lastErr != nil {
+		return &options.UsersError{UserIDs: failed, Err: lastErr}
+	}
+	return nil
+}
+
+func newEnvelope(userIDs []string, title, content string, opts *options.Opts) *Envelope {
+	e := &Envelope{
+		Version:        EnvelopeVersion,
+		RequestID:      uuid.New().String(),
+		UserIDs:        userIDs,
+		Title:          title,
+		Content:        content,
+		ConversationID: opts.ConversationID,
+		Options: EnvelopeOptions{
+			IOSPushSound:  opts.IOSPushSound,
+			IOSBadgeCount: opts.IOSBadgeCount,
+			Ex:            opts.Ex,
+			PlatformIDs:   opts.PlatformIDs,
+		},
+	}
+	if opts.Msg != nil {
+		e.Message = &EnvelopeMsg{
+			SendID:      opts.Msg.SendID,
+			GroupID:     opts.Msg.GroupID,
+			SessionType: opts.Msg.SessionType,
+			ContentType: opts.Msg.ContentType,
+			Seq:         opts.Msg.Seq,
+			SendTime:    opts.Msg.SendTime,
+		}
+	}
+	if opts.Signal != nil {
+		if e.Message == nil {
+			e.Message = &EnvelopeMsg{}
+		}
+		e.Message.ClientMsgID = opts.Signal.ClientMsgID
+	}
+	return e
+}
+
+// post sends the envelope, retrying with a growing interval while the failure may be temporary.
+func (h *HTTPPush) post(ctx context.Context, e *Envelope) error {
+	body, err := json.Marshal(e)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	interval := retryInterval
+	for i := 0; ; i++ {
+		retry, err := h.do(ctx, body)
+		if err == nil || !retry || i >= h.maxRetry {
+			return err
+		}
+		log.ZDebug(ctx, "http push retry", "requestID", e.RequestID, "retry", i+1, "error", err)
+		select {
+		case <-ctx.Done():
+			return errs.Wrap(ctx.Err())
+		case <-time.After(interval):
+		}
+		interval *= 2
+	}
+}
+
+func (h *HTTPPush) do(ctx context.Context, body []byte) (retry bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.pushConf.HTTP.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if secret := h.pushConf.HTTP.Secret; secret != "" {
+		timestamp := strconv.FormatInt(time.Now().UnixMilli(), 10)
+		req.Header.Set(HeaderTimestamp, timestamp)
+		req.Header.Set(HeaderSignature, Sign(secret, timestamp, body))
+	}
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		return true, errs.WrapMsg(err, "http push request failed")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	retry = resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+	return retry, errs.New("http push failed", "status", resp.StatusCode, "body", string(msg)).Wrap()
+}
+
+// Sign returns the signature of a request, for the push service to verify it.
+func Sign(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
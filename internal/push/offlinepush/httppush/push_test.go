@@ -0,0 +1,111 @@
+package httppush
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/options"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+)
+
+func TestPush(t *testing.T) {
+	var (
+		lock      sync.Mutex
+		attempts  = make(map[string]int)
+		envelopes []*Envelope
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Header.Get(HeaderSignature) != Sign("secret", r.Header.Get(HeaderTimestamp), body) {
+			t.Errorf("invalid signature")
+		}
+		var e Envelope
+		if err := json.Unmarshal(body, &e); err != nil {
+			t.Error(err)
+		}
+		lock.Lock()
+		defer lock.Unlock()
+		attempts[e.RequestID]++
+		// The first attempt of every request fails temporarily.
+		if attempts[e.RequestID] == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		envelopes = append(envelopes, &e)
+	}))
+	defer srv.Close()
+
+	pushConf := &config.Push{}
+	pushConf.HTTP.URL = srv.URL
+	pushConf.HTTP.Secret = "secret"
+	pushConf.HTTP.BatchSize = 2
+	client, err := NewClient(pushConf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := &options.Opts{
+		Signal:         &options.Signal{ClientMsgID: "msg1"},
+		Ex:             "ex",
+		ConversationID: "sg_group1",
+		Msg:            &options.Msg{SendID: "alice", GroupID: "group1", Seq: 7},
+	}
+	if err := client.Push(context.Background(), []string{"bob", "carol", "dave"}, "title", "content", opts); err != nil {
+		t.Fatal(err)
+	}
+	if len(envelopes) != 2 || len(envelopes[0].UserIDs) != 2 || len(envelopes[1].UserIDs) != 1 {
+		t.Fatalf("expected the users in 2 batches, got %d envelopes", len(envelopes))
+	}
+	e := envelopes[0]
+	if e.Version != EnvelopeVersion || e.Title != "title" || e.ConversationID != "sg_group1" || e.Options.Ex != "ex" {
+		t.Errorf("unexpected envelope %+v", e)
+	}
+	if e.Message == nil || e.Message.ClientMsgID != "msg1" || e.Message.SendID != "alice" || e.Message.Seq != 7 {
+		t.Errorf("unexpected message %+v", e.Message)
+	}
+}
+
+func TestPushRetries(t *testing.T) {
+	for _, c := range []struct {
+		name     string
+		status   int
+		maxRetry *int
+		attempts int
+	}{
+		{name: "client error", status: http.StatusBadRequest, attempts: 1},
+		{name: "default", status: http.StatusServiceUnavailable, attempts: defaultMaxRetry + 1},
+		{name: "no retry", status: http.StatusServiceUnavailable, maxRetry: new(int), attempts: 1},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			var (
+				lock     sync.Mutex
+				attempts int
+			)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				lock.Lock()
+				attempts++
+				lock.Unlock()
+				w.WriteHeader(c.status)
+			}))
+			defer srv.Close()
+
+			pushConf := &config.Push{}
+			pushConf.HTTP.URL = srv.URL
+			pushConf.HTTP.MaxRetry = c.maxRetry
+			client, err := NewClient(pushConf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := client.Push(context.Background(), []string{"bob"}, "title", "content", &options.Opts{}); err == nil {
+				t.Fatal("expected the push to fail")
+			}
+			if attempts != c.attempts {
+				t.Errorf("expected %d attempts, got %d", c.attempts, attempts)
+			}
+		})
+	}
+}
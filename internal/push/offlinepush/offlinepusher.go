@@ -16,13 +16,19 @@ package offlinepush
 
 import (
 	"context"
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/apns"
 	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/dummy"
 	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/fcm"
 	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/getui"
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/httppush"
 	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/jpush"
 	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/options"
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/vendorpush"
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/webpush"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/tools/errs"
 	"strings"
 )
 
@@ -30,6 +36,11 @@ const (
 	geTUI    = "getui"
 	firebase = "fcm"
 	jPush    = "jpush"
+	aPNs     = "apns"
+	vendor   = "vendor"
+	webPush  = "webpush"
+	httpPush = "http"
+	routing  = "router"
 )
 
 // OfflinePusher Offline Pusher.
@@ -37,16 +48,53 @@ type OfflinePusher interface {
 	Push(ctx context.Context, userIDs []string, title, content string, opts *options.Opts) error
 }
 
-func NewOfflinePusher(pushConf *config.Push, cache cache.ThirdCache, fcmConfigPath string) (OfflinePusher, error) {
-	var offlinePusher OfflinePusher
+func NewOfflinePusher(pushConf *config.Push, cache cache.ThirdCache, deviceDB controller.PushDeviceDatabase, fcmConfigPath string) (OfflinePusher, error) {
 	pushConf.Enable = strings.ToLower(pushConf.Enable)
-	switch pushConf.Enable {
+	pusher, err := newProvidersPusher(pushConf, cache, deviceDB, fcmConfigPath)
+	if err != nil || len(pushConf.Tenants) == 0 {
+		return pusher, err
+	}
+	tenants := make(map[string]OfflinePusher, len(pushConf.Tenants))
+	for i := range pushConf.Tenants {
+		pushConf.Tenants[i].Enable = strings.ToLower(pushConf.Tenants[i].Enable)
+		tenantConf := *pushConf
+		tenantConf.PushProviders = pushConf.Tenants[i].PushProviders
+		tenants[pushConf.Tenants[i].ID], err = newProvidersPusher(&tenantConf, cache, deviceDB, fcmConfigPath)
+		if err != nil {
+			return nil, errs.WrapMsg(err, "new tenant offline pusher failed", "tenantID", pushConf.Tenants[i].ID)
+		}
+	}
+	return &tenantPusher{pusher: pusher, tenants: tenants}, nil
+}
+
+// newProvidersPusher returns the pusher of the providers enabled in pushConf.
+func newProvidersPusher(pushConf *config.Push, cache cache.ThirdCache, deviceDB controller.PushDeviceDatabase, fcmConfigPath string) (OfflinePusher, error) {
+	newPusher := func(name string) (OfflinePusher, error) {
+		return newOfflinePusher(name, pushConf, cache, deviceDB, fcmConfigPath)
+	}
+	if pushConf.Enable == routing {
+		return newRouter(&pushConf.Router, deviceDB, cache, newPusher)
+	}
+	return newPusher(pushConf.Enable)
+}
+
+func newOfflinePusher(name string, pushConf *config.Push, cache cache.ThirdCache, deviceDB controller.PushDeviceDatabase, fcmConfigPath string) (OfflinePusher, error) {
+	var offlinePusher OfflinePusher
+	switch name {
 	case geTUI:
 		offlinePusher = getui.NewClient(pushConf, cache)
 	case firebase:
-		return fcm.NewClient(pushConf, cache, fcmConfigPath)
+		return fcm.NewClient(pushConf, cache, deviceDB, fcmConfigPath)
 	case jPush:
 		offlinePusher = jpush.NewClient(pushConf)
+	case aPNs:
+		return apns.NewClient(pushConf, cache, deviceDB, fcmConfigPath)
+	case vendor:
+		return vendorpush.NewClient(pushConf, deviceDB)
+	case webPush:
+		return webpush.NewClient(pushConf, deviceDB)
+	case httpPush:
+		return httppush.NewClient(pushConf)
 	default:
 		offlinePusher = dummy.NewClient()
 	}
@@ -0,0 +1,29 @@
+package options
+
+import (
+	"errors"
+	"fmt"
+)
+
+// UsersError is returned by the pushers that can tell which users a push failed for, the others were pushed to.
+type UsersError struct {
+	UserIDs []string
+	Err     error
+}
+
+func (e *UsersError) Error() string {
+	return fmt.Sprintf("push to %d users failed: %s", len(e.UserIDs), e.Err)
+}
+
+func (e *UsersError) Unwrap() error {
+	return e.Err
+}
+
+// FailedUserIDs returns the users a push to userIDs failed for with err, all of them unless err is a UsersError.
+func FailedUserIDs(err error, userIDs []string) []string {
+	var usersErr *UsersError
+	if errors.As(err, &usersErr) {
+		return usersErr.UserIDs
+	}
+	return userIDs
+}
@@ -6,6 +6,39 @@ type Opts struct {
 	IOSPushSound  string
 	IOSBadgeCount bool
 	Ex            string
+	// ConversationID groups the notifications of a conversation, as the thread of APNs.
+	ConversationID string
+	// Msg describes the pushed message.
+	Msg *Msg
+	// PlatformIDs restricts the push to the devices of these platforms, all platforms when empty.
+	// Pushers addressing users rather than their devices ignore it.
+	PlatformIDs []int
+	// Badges is the unread count of each user computed by the push service, shown on the app icon.
+	// It is nil when the counts are not computed, the pushers then use the counts reported by the clients.
+	Badges map[string]int
+}
+
+// HasPlatform reports whether the devices of the platform are pushed to.
+func (o *Opts) HasPlatform(platformID int) bool {
+	if len(o.PlatformIDs) == 0 {
+		return true
+	}
+	for _, id := range o.PlatformIDs {
+		if id == platformID {
+			return true
+		}
+	}
+	return false
+}
+
+// Msg is the metadata of the pushed message.
+type Msg struct {
+	SendID      string
+	GroupID     string
+	SessionType int32
+	ContentType int32
+	Seq         int64
+	SendTime    int64
 }
 
 // Signal message id.
@@ -0,0 +1,224 @@
+package offlinepush
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/options"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	routeIOS           = "ios"
+	routeAndroid       = "android"
+	routeAndroidVendor = "androidVendor"
+	routeWeb           = "web"
+)
+
+// routePlatforms are the platforms of the devices of each route, browser subscriptions can come from desktop apps.
+var routePlatforms = map[string][]int{
+	routeIOS:           {constant.IOSPlatformID, constant.IPadPlatformID},
+	routeAndroid:       {constant.AndroidPlatformID, constant.AndroidPadPlatformID},
+	routeAndroidVendor: {constant.AndroidPlatformID, constant.AndroidPadPlatformID},
+	routeWeb:           {constant.WebPlatformID, constant.WindowsPlatformID, constant.OSXPlatformID, constant.LinuxPlatformID},
+}
+
+// tokenPlatformIDs are the platforms the tokens of FcmUpdateToken are looked up on.
+var tokenPlatformIDs = slices.Concat(routePlatforms[routeIOS], routePlatforms[routeAndroid], routePlatforms[routeWeb])
+
+// userAddressedProviders push to the users by their alias, on all of their devices, the platforms of the route are not told apart.
+var userAddressedProviders = []string{geTUI, jPush, httpPush}
+
+type route struct {
+	name     string
+	provider string
+}
+
+// router pushes each class of devices through its own provider, all providers are called concurrently.
+type router struct {
+	deviceDB controller.PushDeviceDatabase
+	// tokenCache holds the tokens registered through FcmUpdateToken, apart from the device registry.
+	tokenCache cache.ThirdCache
+	routes     []route
+	fallback   map[string]string
+	pushers    map[string]OfflinePusher
+}
+
+func newRouter(conf *config.PushRouter, deviceDB controller.PushDeviceDatabase, tokenCache cache.ThirdCache,
+	newPusher func(name string) (OfflinePusher, error)) (*router, error) {
+	r := &router{deviceDB: deviceDB, tokenCache: tokenCache, fallback: conf.Fallback, pushers: make(map[string]OfflinePusher)}
+	for _, rt := range []route{
+		{name: routeIOS, provider: conf.IOS},
+		{name: routeAndroid, provider: conf.Android},
+		{name: routeAndroidVendor, provider: conf.AndroidVendor},
+		{name: routeWeb, provider: conf.Web},
+	} {
+		if rt.provider != "" {
+			r.routes = append(r.routes, rt)
+		}
+	}
+	if len(r.routes) == 0 {
+		return nil, errs.New("push router has no route").Wrap()
+	}
+	names := datautil.Slice(r.routes, func(rt route) string { return rt.provider })
+	for provider, fallback := range conf.Fallback {
+		names = append(names, provider, fallback)
+	}
+	for _, name := range datautil.Distinct(names) {
+		if !datautil.Contain(name, geTUI, firebase, jPush, aPNs, vendor, webPush, httpPush) {
+			return nil, errs.New("unsupported push router provider", "provider", name).Wrap()
+		}
+		pusher, err := newPusher(name)
+		if err != nil {
+			return nil, err
+		}
+		r.pushers[name] = pusher
+	}
+	return r, nil
+}
+
+func (r *router) Push(ctx context.Context, userIDs []string, title, content string, opts *options.Opts) error {
+	routeUserIDs, err := r.routeUserIDs(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+	var (
+		lock   sync.Mutex
+		failed []error
+	)
+	g, ctx := errgroup.WithContext(ctx)
+	for _, rt := range r.routes {
+		if len(routeUserIDs[rt.name]) == 0 {
+			continue
+		}
+		g.Go(func() error {
+			if err := r.push(ctx, rt, routeUserIDs[rt.name], title, content, opts); err != nil {
+				lock.Lock()
+				failed = append(failed, err)
+				lock.Unlock()
+			}
+			return nil
+		})
+	}
+	_ = g.Wait()
+	if len(failed) != 0 {
+		return errs.Wrap(errors.Join(failed...))
+	}
+	return nil
+}
+
+// push pushes through the provider of the route, and through its fallback to the users it failed for.
+// It returns a ProviderError with the users the push failed for.
+func (r *router) push(ctx context.Context, rt route, userIDs []string, title, content string, opts *options.Opts) error {
+	routeOpts := *opts
+	routeOpts.PlatformIDs = routePlatforms[rt.name]
+	err := r.pushers[rt.provider].Push(ctx, userIDs, title, content, &routeOpts)
+	if err == nil {
+		prommetrics.OfflinePushRouteCall(rt.name, rt.provider, "success")
+		return nil
+	}
+	prommetrics.OfflinePushRouteCall(rt.name, rt.provider, "failed")
+	failedUserIDs := options.FailedUserIDs(err, userIDs)
+	fallback, ok := r.fallback[rt.provider]
+	if !ok {
+		return errs.WrapMsg(&ProviderError{Route: rt.name, Provider: rt.provider, UserIDs: failedUserIDs, Err: err},
+			"offline push failed", "route", rt.name, "provider", rt.provider)
+	}
+	log.ZWarn(ctx, "offline push failed, pushing through the fallback", err, "route", rt.name, "provider", rt.provider, "fallback", fallback,
+		"failedUserIDs", len(failedUserIDs))
+	if err := r.pushers[fallback].Push(ctx, failedUserIDs, title, content, &routeOpts); err != nil {
+		prommetrics.OfflinePushRouteCall(rt.name, fallback, "failed")
+		return errs.WrapMsg(&ProviderError{Route: rt.name, Provider: fallback, UserIDs: options.FailedUserIDs(err, failedUserIDs), Err: err},
+			"offline push fallback failed", "route", rt.name, "provider", fallback)
+	}
+	prommetrics.OfflinePushRouteCall(rt.name, fallback, "success")
+	return nil
+}
+
+// routeUserIDs splits the users between the routes by the platforms of the devices they registered, and of the tokens
+// they still hold from FcmUpdateToken. The users without a registered device, pushed through a token or an alias
+// registered elsewhere, may be on any platform and go through every route but the vendor one, whose pushers find the
+// users' devices. A provider addressing the users rather than their devices pushes to each user once, whatever the
+// routes it serves.
+func (r *router) routeUserIDs(ctx context.Context, userIDs []string) (map[string][]string, error) {
+	devices, err := r.deviceDB.GetDevices(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	routeNames := datautil.SliceSet(datautil.Slice(r.routes, func(rt route) string { return rt.name }))
+	_, vendorRoute := routeNames[routeAndroidVendor]
+	userRoutes := make(map[string]map[string]struct{})
+	addRoute := func(userID string, name string) {
+		if userRoutes[userID] == nil {
+			userRoutes[userID] = make(map[string]struct{})
+		}
+		userRoutes[userID][name] = struct{}{}
+	}
+	for _, device := range devices {
+		name := devicePlatformRoute(device.PlatformID)
+		if name == routeAndroid && vendorRoute && datautil.Contain(device.Provider, model.VendorPushProviders...) {
+			name = routeAndroidVendor
+		}
+		if name == "" {
+			continue
+		}
+		addRoute(device.UserID, name)
+	}
+	// The tokens only matter for the users with registered devices, the others go through every route.
+	registered := datautil.Keys(userRoutes)
+	tokenPlatforms, err := r.tokenCache.GetFcmTokenPlatforms(ctx, registered, tokenPlatformIDs)
+	if err != nil {
+		log.ZWarn(ctx, "get fcm token platforms failed, pushing to the registered devices only", err, "userIDs", registered)
+	}
+	for userID, platformIDs := range tokenPlatforms {
+		for _, platformID := range platformIDs {
+			addRoute(userID, devicePlatformRoute(int32(platformID)))
+		}
+	}
+	res := make(map[string][]string)
+	providerUserIDs := make(map[string]map[string]struct{})
+	for _, rt := range r.routes {
+		userAddressed := datautil.Contain(rt.provider, userAddressedProviders...)
+		if userAddressed && providerUserIDs[rt.provider] == nil {
+			providerUserIDs[rt.provider] = make(map[string]struct{})
+		}
+		for _, userID := range userIDs {
+			if routes, ok := userRoutes[userID]; ok {
+				if _, ok := routes[rt.name]; !ok {
+					continue
+				}
+			} else if rt.name == routeAndroidVendor {
+				continue
+			}
+			if userAddressed {
+				if _, ok := providerUserIDs[rt.provider][userID]; ok {
+					continue
+				}
+				providerUserIDs[rt.provider][userID] = struct{}{}
+			}
+			res[rt.name] = append(res[rt.name], userID)
+		}
+	}
+	return res, nil
+}
+
+// devicePlatformRoute returns the route of the devices of the platform, the vendor devices are told apart by their provider.
+func devicePlatformRoute(platformID int32) string {
+	for _, name := range []string{routeIOS, routeAndroid, routeWeb} {
+		if datautil.Contain(int(platformID), routePlatforms[name]...) {
+			return name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,229 @@
+package offlinepush
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/options"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+type memoryDeviceDB struct {
+	controller.PushDeviceDatabase
+	devices []*model.PushDevice
+}
+
+func (m *memoryDeviceDB) GetDevices(_ context.Context, _ []string) ([]*model.PushDevice, error) {
+	return m.devices, nil
+}
+
+type memoryTokenCache struct {
+	cache.ThirdCache
+	// platforms are the platforms of the tokens of each user.
+	platforms map[string][]int
+}
+
+func (m *memoryTokenCache) GetFcmTokenPlatforms(_ context.Context, accounts []string, platformIDs []int) (map[string][]int, error) {
+	res := make(map[string][]int)
+	for _, account := range accounts {
+		for _, platformID := range m.platforms[account] {
+			if datautil.Contain(platformID, platformIDs...) {
+				res[account] = append(res[account], platformID)
+			}
+		}
+	}
+	return res, nil
+}
+
+type pushCall struct {
+	userIDs     []string
+	platformIDs []int
+}
+
+type fakePusher struct {
+	lock  sync.Mutex
+	calls []pushCall
+	err   error
+}
+
+func (f *fakePusher) Push(_ context.Context, userIDs []string, _, _ string, opts *options.Opts) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	userIDs = append([]string(nil), userIDs...)
+	sort.Strings(userIDs)
+	f.calls = append(f.calls, pushCall{userIDs: userIDs, platformIDs: opts.PlatformIDs})
+	return f.err
+}
+
+func TestRouter(t *testing.T) {
+	pushers := map[string]*fakePusher{
+		aPNs:     {},
+		firebase: {},
+		vendor:   {err: errs.New("vendor unavailable")},
+		webPush:  {},
+	}
+	conf := &config.PushRouter{
+		IOS:           aPNs,
+		Android:       firebase,
+		AndroidVendor: vendor,
+		Fallback:      map[string]string{vendor: firebase},
+	}
+	db := &memoryDeviceDB{devices: []*model.PushDevice{
+		{UserID: "bob", Provider: model.PushProviderHuawei, PlatformID: constant.AndroidPlatformID},
+		{UserID: "carol", Provider: model.PushProviderWebPush, PlatformID: constant.WebPlatformID},
+	}}
+	r, err := newRouter(conf, db, &memoryTokenCache{}, func(name string) (OfflinePusher, error) { return pushers[name], nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Push(context.Background(), []string{"alice", "bob", "carol"}, "title", "content", &options.Opts{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// alice registered no device, she may be on any platform. bob and carol are on Android and the web only.
+	if calls := pushers[aPNs].calls; len(calls) != 1 || strings.Join(calls[0].userIDs, ",") != "alice" || calls[0].platformIDs[0] != constant.IOSPlatformID {
+		t.Errorf("unexpected apns calls %+v", calls)
+	}
+	if calls := pushers[vendor].calls; len(calls) != 1 || strings.Join(calls[0].userIDs, ",") != "bob" {
+		t.Errorf("unexpected vendor calls %+v", calls)
+	}
+	// fcm pushes to the Android users without a vendor device, and to bob once the vendor failed.
+	calls := pushers[firebase].calls
+	sort.Slice(calls, func(i, j int) bool { return calls[i].userIDs[0] < calls[j].userIDs[0] })
+	if len(calls) != 2 || strings.Join(calls[0].userIDs, ",") != "alice" || strings.Join(calls[1].userIDs, ",") != "bob" {
+		t.Errorf("unexpected fcm calls %+v", calls)
+	}
+	if len(pushers[webPush].calls) != 0 {
+		t.Error("expected no web push without a web route")
+	}
+}
+
+func TestRouterMergesErrors(t *testing.T) {
+	pushers := map[string]*fakePusher{
+		aPNs:     {err: errs.New("apns unavailable")},
+		firebase: {err: errs.New("fcm unavailable")},
+	}
+	conf := &config.PushRouter{IOS: aPNs, Android: firebase}
+	r, err := newRouter(conf, &memoryDeviceDB{}, &memoryTokenCache{}, func(name string) (OfflinePusher, error) { return pushers[name], nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = r.Push(context.Background(), []string{"alice"}, "title", "content", &options.Opts{})
+	if err == nil {
+		t.Fatal("expected the push to fail")
+	}
+	for _, msg := range []string{"provider=apns", "provider=fcm"} {
+		if !strings.Contains(err.Error(), msg) {
+			t.Errorf("expected the error to contain %q, got %v", msg, err)
+		}
+	}
+	providers := FailedProviders(err)
+	sort.Strings(providers)
+	if strings.Join(providers, ",") != "apns,fcm" {
+		t.Errorf("expected the failed providers apns and fcm, got %v", providers)
+	}
+}
+
+func TestRouterPushesUserAddressedProvidersOnce(t *testing.T) {
+	pushers := map[string]*fakePusher{geTUI: {}, webPush: {}}
+	conf := &config.PushRouter{IOS: geTUI, Android: geTUI, Web: webPush}
+	db := &memoryDeviceDB{devices: []*model.PushDevice{
+		{UserID: "bob", Provider: model.PushProviderAPNs, PlatformID: constant.IOSPlatformID},
+		{UserID: "bob", Provider: model.PushProviderFCM, PlatformID: constant.AndroidPlatformID},
+		{UserID: "carol", Provider: model.PushProviderWebPush, PlatformID: constant.WebPlatformID},
+	}}
+	r, err := newRouter(conf, db, &memoryTokenCache{}, func(name string) (OfflinePusher, error) { return pushers[name], nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Push(context.Background(), []string{"alice", "bob", "carol"}, "title", "content", &options.Opts{}); err != nil {
+		t.Fatal(err)
+	}
+	// getui pushes to all the devices of a user, it is called once for alice and bob, and not for carol.
+	var userIDs []string
+	for _, call := range pushers[geTUI].calls {
+		userIDs = append(userIDs, call.userIDs...)
+	}
+	sort.Strings(userIDs)
+	if strings.Join(userIDs, ",") != "alice,bob" {
+		t.Errorf("unexpected getui users %v", userIDs)
+	}
+	if calls := pushers[webPush].calls; len(calls) != 1 || strings.Join(calls[0].userIDs, ",") != "alice,carol" {
+		t.Errorf("unexpected web push calls %+v", calls)
+	}
+}
+
+func TestRouterFallsBackForFailedUsers(t *testing.T) {
+	pushers := map[string]*fakePusher{
+		vendor:   {err: &options.UsersError{UserIDs: []string{"bob", "dave"}, Err: errs.New("vendor unavailable")}},
+		firebase: {err: &options.UsersError{UserIDs: []string{"dave"}, Err: errs.New("fcm unavailable")}},
+	}
+	conf := &config.PushRouter{AndroidVendor: vendor, Fallback: map[string]string{vendor: firebase}}
+	db := &memoryDeviceDB{}
+	for _, userID := range []string{"bob", "carol", "dave"} {
+		db.devices = append(db.devices, &model.PushDevice{UserID: userID, Provider: model.PushProviderXiaomi, PlatformID: constant.AndroidPlatformID})
+	}
+	r, err := newRouter(conf, db, &memoryTokenCache{}, func(name string) (OfflinePusher, error) { return pushers[name], nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = r.Push(context.Background(), []string{"bob", "carol", "dave"}, "title", "content", &options.Opts{})
+	if calls := pushers[firebase].calls; len(calls) != 1 || strings.Join(calls[0].userIDs, ",") != "bob,dave" {
+		t.Errorf("expected the fallback for bob and dave only, got %+v", calls)
+	}
+	providerErrs := ProviderErrors(err)
+	if len(providerErrs) != 1 || providerErrs[0].Provider != firebase || providerErrs[0].Route != routeAndroidVendor ||
+		strings.Join(providerErrs[0].UserIDs, ",") != "dave" {
+		t.Errorf("expected the push to dave to fail through fcm, got %v", err)
+	}
+}
+
+func TestRouterKeepsLegacyTokens(t *testing.T) {
+	pushers := map[string]*fakePusher{aPNs: {}, firebase: {}}
+	conf := &config.PushRouter{IOS: aPNs, Android: firebase}
+	// bob registered an iPad but still holds the Android token of FcmUpdateToken, carol only the iPad.
+	db := &memoryDeviceDB{devices: []*model.PushDevice{
+		{UserID: "bob", Provider: model.PushProviderAPNs, PlatformID: constant.IPadPlatformID},
+		{UserID: "carol", Provider: model.PushProviderAPNs, PlatformID: constant.IPadPlatformID},
+	}}
+	tokens := &memoryTokenCache{platforms: map[string][]int{"bob": {constant.AndroidPlatformID}}}
+	r, err := newRouter(conf, db, tokens, func(name string) (OfflinePusher, error) { return pushers[name], nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Push(context.Background(), []string{"bob", "carol"}, "title", "content", &options.Opts{}); err != nil {
+		t.Fatal(err)
+	}
+	if calls := pushers[aPNs].calls; len(calls) != 1 || strings.Join(calls[0].userIDs, ",") != "bob,carol" {
+		t.Errorf("unexpected apns calls %+v", calls)
+	}
+	if calls := pushers[firebase].calls; len(calls) != 1 || strings.Join(calls[0].userIDs, ",") != "bob" {
+		t.Errorf("expected the legacy Android token of bob to be pushed, got %+v", calls)
+	}
+}
+
+func TestTenantPusher(t *testing.T) {
+	shared, app1 := &fakePusher{}, &fakePusher{}
+	pusher := &tenantPusher{pusher: shared, tenants: map[string]OfflinePusher{"app1": app1}}
+	for _, tenantID := range []string{"", "app1", "app2"} {
+		if err := pusher.Push(tenant.WithTenantID(context.Background(), tenantID), []string{"u-" + tenantID}, "", "", &options.Opts{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(app1.calls) != 1 || app1.calls[0].userIDs[0] != "u-app1" {
+		t.Errorf("expected app1 to push through its own providers, got %+v", app1.calls)
+	}
+	if len(shared.calls) != 2 {
+		t.Errorf("expected the other tenants to push through the shared providers, got %+v", shared.calls)
+	}
+}
@@ -0,0 +1,22 @@
+package offlinepush
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/options"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+)
+
+// tenantPusher pushes with the providers of the tenant of the push,
+// the tenants without providers of their own use the shared ones.
+type tenantPusher struct {
+	pusher  OfflinePusher
+	tenants map[string]OfflinePusher
+}
+
+func (t *tenantPusher) Push(ctx context.Context, userIDs []string, title, content string, opts *options.Opts) error {
+	if pusher, ok := t.tenants[tenant.GetTenantID(ctx)]; ok {
+		return pusher.Push(ctx, userIDs, title, content, opts)
+	}
+	return t.pusher.Push(ctx, userIDs, title, content, opts)
+}
@@ -0,0 +1,121 @@
+package vendorpush
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/tools/errs"
+)
+
+const (
+	honorAuthURL = "https://iam.developer.honor.com/auth/token"
+	honorPushURL = "https://push-api.cloud.honor.com/api/v1/%s/sendMessage"
+
+	honorSuccess       = 200
+	honorTokenExpired  = 80200003
+	honorInvalidTokens = 80300007
+)
+
+type honor struct {
+	pushConf   *config.Push
+	authURL    string
+	pushURL    string
+	httpClient *http.Client
+	token      *accessToken
+}
+
+func newHonor(pushConf *config.Push) *honor {
+	h := &honor{
+		pushConf:   pushConf,
+		authURL:    honorAuthURL,
+		pushURL:    honorPushURL,
+		httpClient: &http.Client{Timeout: requestTimeout},
+	}
+	h.token = &accessToken{fetch: h.auth}
+	return h
+}
+
+func (h *honor) auth(ctx context.Context) (string, time.Duration, error) {
+	form := url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {h.pushConf.Vendor.Honor.ClientID},
+		"client_secret": {h.pushConf.Vendor.Honor.ClientSecret},
+	}
+	var resp oauthResp
+	if err := postForm(ctx, h.httpClient, h.authURL, nil, form, &resp); err != nil {
+		return "", 0, err
+	}
+	if resp.AccessToken == "" {
+		return "", 0, errs.New("honor auth failed", "error", resp.Error, "description", resp.ErrorDescription).Wrap()
+	}
+	return resp.AccessToken, time.Duration(resp.ExpiresIn) * time.Second, nil
+}
+
+type honorClickAction struct {
+	// Type 3 starts the app.
+	Type int `json:"type"`
+}
+
+type honorAndroidNotification struct {
+	Title       string           `json:"title"`
+	Body        string           `json:"body"`
+	ClickAction honorClickAction `json:"clickAction"`
+	Tag         string           `json:"tag,omitempty"`
+}
+
+type honorAndroid struct {
+	Notification honorAndroidNotification `json:"notification"`
+}
+
+type honorPushReq struct {
+	Data    string       `json:"data,omitempty"`
+	Android honorAndroid `json:"android"`
+	Token   []string     `json:"token"`
+}
+
+type honorPushResp struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    struct {
+		FailTokens   []string `json:"failTokens"`
+		ExpireTokens []string `json:"expireTokens"`
+	} `json:"data"`
+}
+
+func (h *honor) send(ctx context.Context, tokens []string, n *notification) ([]string, error) {
+	token, err := h.token.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req := honorPushReq{
+		Data: n.Payload,
+		Android: honorAndroid{Notification: honorAndroidNotification{
+			Title:       n.Title,
+			Body:        n.Content,
+			ClickAction: honorClickAction{Type: 3},
+			Tag:         n.ClientMsgID,
+		}},
+		Token: tokens,
+	}
+	header := map[string]string{
+		"Authorization": "Bearer " + token,
+		"timestamp":     strconv.FormatInt(time.Now().UnixMilli(), 10),
+	}
+	var resp honorPushResp
+	if err := postJSON(ctx, h.httpClient, fmtURL(h.pushURL, h.pushConf.Vendor.Honor.AppID), header, &req, &resp); err != nil {
+		return nil, err
+	}
+	switch resp.Code {
+	case honorSuccess:
+		return resp.Data.ExpireTokens, nil
+	case honorInvalidTokens:
+		return tokens, nil
+	case honorTokenExpired:
+		h.token.reset()
+	}
+	return nil, errs.New("honor push failed", "code", resp.Code, "message", resp.Message).Wrap()
+}
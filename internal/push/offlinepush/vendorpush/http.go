@@ -0,0 +1,118 @@
+package vendorpush
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	requestTimeout = 10 * time.Second
+
+	// tokenRefreshMargin renews the access tokens a bit before the vendors expire them.
+	tokenRefreshMargin = 5 * time.Minute
+)
+
+func postJSON(ctx context.Context, client *http.Client, rawURL string, header map[string]string, req any, resp any) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	return post(ctx, client, rawURL, "application/json", header, body, resp)
+}
+
+func postForm(ctx context.Context, client *http.Client, rawURL string, header map[string]string, form url.Values, resp any) error {
+	return post(ctx, client, rawURL, "application/x-www-form-urlencoded", header, []byte(form.Encode()), resp)
+}
+
+func post(ctx context.Context, client *http.Client, rawURL string, contentType string, header map[string]string, body []byte, resp any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, rawURL, bytes.NewReader(body))
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return errs.WrapMsg(err, "vendor push request failed", "url", rawURL)
+	}
+	defer res.Body.Close()
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if res.StatusCode != http.StatusOK {
+		return errs.New("vendor push request failed", "url", rawURL, "status", res.StatusCode, "body", strings.TrimSpace(string(data))).Wrap()
+	}
+	if err := json.Unmarshal(data, resp); err != nil {
+		return errs.WrapMsg(err, "decode vendor push response failed", "url", rawURL)
+	}
+	return nil
+}
+
+// accessToken caches the access token of a vendor until it is about to expire.
+type accessToken struct {
+	fetch func(ctx context.Context) (token string, expiresIn time.Duration, err error)
+	// fetching lets a single request renew the token, the lock is not held while it waits for the vendor.
+	fetching singleflight.Group
+
+	lock   sync.Mutex
+	token  string
+	expire time.Time
+}
+
+func (a *accessToken) get(ctx context.Context) (string, error) {
+	a.lock.Lock()
+	token, expire := a.token, a.expire
+	a.lock.Unlock()
+	if token != "" && time.Now().Before(expire) {
+		return token, nil
+	}
+	v, err, _ := a.fetching.Do("", func() (any, error) {
+		// The token is shared by the pushes waiting for it, the renewal must not fail when the first of them is canceled.
+		token, expiresIn, err := a.fetch(context.WithoutCancel(ctx))
+		if err != nil {
+			return "", err
+		}
+		a.lock.Lock()
+		a.token, a.expire = token, time.Now().Add(tokenLifetime(expiresIn))
+		a.lock.Unlock()
+		return token, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return v.(string), nil
+}
+
+// tokenLifetime is how long a token valid for expiresIn is used, tokens too short-lived for the margin are renewed halfway.
+func tokenLifetime(expiresIn time.Duration) time.Duration {
+	if expiresIn <= 2*tokenRefreshMargin {
+		return max(expiresIn/2, 0)
+	}
+	return expiresIn - tokenRefreshMargin
+}
+
+// reset drops the cached token, it is called when the vendor rejected it.
+func (a *accessToken) reset() {
+	a.lock.Lock()
+	a.token = ""
+	a.lock.Unlock()
+}
+
+// fmtURL fills the app id into the path of a vendor endpoint.
+func fmtURL(format string, appID string) string {
+	return fmt.Sprintf(format, url.PathEscape(appID))
+}
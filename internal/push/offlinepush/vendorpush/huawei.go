@@ -0,0 +1,137 @@
+package vendorpush
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/tools/errs"
+)
+
+const (
+	huaweiAuthURL = "https://oauth-login.cloud.huawei.com/oauth2/v3/token"
+	huaweiPushURL = "https://push-api.cloud.huawei.com/v1/%s/messages:send"
+
+	huaweiSuccess        = "80000000"
+	huaweiPartialSuccess = "80100000"
+	huaweiTokenExpired   = "80200003"
+	huaweiInvalidTokens  = "80300007"
+)
+
+type huawei struct {
+	pushConf   *config.Push
+	authURL    string
+	pushURL    string
+	httpClient *http.Client
+	token      *accessToken
+}
+
+func newHuawei(pushConf *config.Push) *huawei {
+	h := &huawei{
+		pushConf:   pushConf,
+		authURL:    huaweiAuthURL,
+		pushURL:    huaweiPushURL,
+		httpClient: &http.Client{Timeout: requestTimeout},
+	}
+	h.token = &accessToken{fetch: h.auth}
+	return h
+}
+
+type oauthResp struct {
+	AccessToken      string `json:"access_token"`
+	ExpiresIn        int64  `json:"expires_in"`
+	Error            any    `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+func (h *huawei) auth(ctx context.Context) (string, time.Duration, error) {
+	form := url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {h.pushConf.Vendor.Huawei.AppID},
+		"client_secret": {h.pushConf.Vendor.Huawei.ClientSecret},
+	}
+	var resp oauthResp
+	if err := postForm(ctx, h.httpClient, h.authURL, nil, form, &resp); err != nil {
+		return "", 0, err
+	}
+	if resp.AccessToken == "" {
+		return "", 0, errs.New("huawei auth failed", "error", resp.Error, "description", resp.ErrorDescription).Wrap()
+	}
+	return resp.AccessToken, time.Duration(resp.ExpiresIn) * time.Second, nil
+}
+
+type huaweiClickAction struct {
+	// Type 3 starts the app.
+	Type int `json:"type"`
+}
+
+type huaweiAndroidNotification struct {
+	Title       string            `json:"title"`
+	Body        string            `json:"body"`
+	ClickAction huaweiClickAction `json:"click_action"`
+	Tag         string            `json:"tag,omitempty"`
+}
+
+type huaweiAndroid struct {
+	Data         string                    `json:"data,omitempty"`
+	Notification huaweiAndroidNotification `json:"notification"`
+}
+
+type huaweiMessage struct {
+	Android huaweiAndroid `json:"android"`
+	Token   []string      `json:"token"`
+}
+
+type huaweiPushReq struct {
+	Message huaweiMessage `json:"message"`
+}
+
+type huaweiPushResp struct {
+	Code string `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+// huaweiPartialResult is the msg of a partially successful push.
+type huaweiPartialResult struct {
+	IllegalTokens []string `json:"illegal_tokens"`
+}
+
+func (h *huawei) send(ctx context.Context, tokens []string, n *notification) ([]string, error) {
+	token, err := h.token.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req := huaweiPushReq{Message: huaweiMessage{
+		Android: huaweiAndroid{
+			Data: n.Payload,
+			Notification: huaweiAndroidNotification{
+				Title:       n.Title,
+				Body:        n.Content,
+				ClickAction: huaweiClickAction{Type: 3},
+				Tag:         n.ClientMsgID,
+			},
+		},
+		Token: tokens,
+	}}
+	var resp huaweiPushResp
+	header := map[string]string{"Authorization": "Bearer " + token}
+	if err := postJSON(ctx, h.httpClient, fmtURL(h.pushURL, h.pushConf.Vendor.Huawei.AppID), header, &req, &resp); err != nil {
+		return nil, err
+	}
+	switch resp.Code {
+	case huaweiSuccess:
+		return nil, nil
+	case huaweiPartialSuccess:
+		var result huaweiPartialResult
+		_ = json.Unmarshal([]byte(resp.Msg), &result)
+		return result.IllegalTokens, nil
+	case huaweiInvalidTokens:
+		return tokens, nil
+	case huaweiTokenExpired:
+		h.token.reset()
+	}
+	return nil, errs.New("huawei push failed", "code", resp.Code, "msg", resp.Msg).Wrap()
+}
@@ -0,0 +1,142 @@
+package vendorpush
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/tools/errs"
+)
+
+const (
+	oppoAuthURL = "https://api.push.oppomobile.com/server/v1/auth"
+	oppoPushURL = "https://api.push.oppomobile.com/server/v1/message/notification/unicast_batch"
+
+	// oppoTokenTTL is how long an auth token is valid, the response does not tell.
+	oppoTokenTTL = 24 * time.Hour
+
+	oppoInvalidAuthToken = 11
+	oppoInvalidRegID     = 10000
+)
+
+type oppo struct {
+	pushConf   *config.Push
+	authURL    string
+	pushURL    string
+	httpClient *http.Client
+	token      *accessToken
+}
+
+func newOPPO(pushConf *config.Push) *oppo {
+	o := &oppo{
+		pushConf:   pushConf,
+		authURL:    oppoAuthURL,
+		pushURL:    oppoPushURL,
+		httpClient: &http.Client{Timeout: requestTimeout},
+	}
+	o.token = &accessToken{fetch: o.auth}
+	return o
+}
+
+type oppoAuthResp struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    struct {
+		AuthToken string `json:"auth_token"`
+	} `json:"data"`
+}
+
+func (o *oppo) auth(ctx context.Context) (string, time.Duration, error) {
+	conf := &o.pushConf.Vendor.OPPO
+	timestamp := strconv.FormatInt(time.Now().UnixMilli(), 10)
+	sign := sha256.Sum256([]byte(conf.AppKey + timestamp + conf.MasterSecret))
+	form := url.Values{
+		"app_key":   {conf.AppKey},
+		"timestamp": {timestamp},
+		"sign":      {hex.EncodeToString(sign[:])},
+	}
+	var resp oppoAuthResp
+	if err := postForm(ctx, o.httpClient, o.authURL, nil, form, &resp); err != nil {
+		return "", 0, err
+	}
+	if resp.Code != 0 || resp.Data.AuthToken == "" {
+		return "", 0, errs.New("oppo auth failed", "code", resp.Code, "message", resp.Message).Wrap()
+	}
+	return resp.Data.AuthToken, oppoTokenTTL, nil
+}
+
+type oppoNotification struct {
+	Title   string `json:"title"`
+	Content string `json:"content"`
+	// ClickActionType 0 starts the app.
+	ClickActionType  int    `json:"click_action_type"`
+	ActionParameters string `json:"action_parameters,omitempty"`
+	ChannelID        string `json:"channel_id,omitempty"`
+}
+
+type oppoMessage struct {
+	// TargetType 2 targets a registration id.
+	TargetType   int              `json:"target_type"`
+	TargetValue  string           `json:"target_value"`
+	Notification oppoNotification `json:"notification"`
+}
+
+type oppoPushResp struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    []struct {
+		RegistrationID string `json:"registrationId"`
+		ErrorCode      int    `json:"errorCode"`
+	} `json:"data"`
+}
+
+func (o *oppo) send(ctx context.Context, tokens []string, n *notification) ([]string, error) {
+	token, err := o.token.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	messages := make([]oppoMessage, 0, len(tokens))
+	for _, t := range tokens {
+		messages = append(messages, oppoMessage{
+			TargetType:  2,
+			TargetValue: t,
+			Notification: oppoNotification{
+				Title:            n.Title,
+				Content:          n.Content,
+				ActionParameters: n.Payload,
+				ChannelID:        o.pushConf.Vendor.OPPO.ChannelID,
+			},
+		})
+	}
+	data, err := json.Marshal(messages)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	form := url.Values{
+		"auth_token": {token},
+		"messages":   {string(data)},
+	}
+	var resp oppoPushResp
+	if err := postForm(ctx, o.httpClient, o.pushURL, nil, form, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Code != 0 {
+		if resp.Code == oppoInvalidAuthToken {
+			o.token.reset()
+		}
+		return nil, errs.New("oppo push failed", "code", resp.Code, "message", resp.Message).Wrap()
+	}
+	var invalid []string
+	for _, result := range resp.Data {
+		if result.ErrorCode == oppoInvalidRegID {
+			invalid = append(invalid, result.RegistrationID)
+		}
+	}
+	return invalid, nil
+}
@@ -0,0 +1,169 @@
+// Package vendorpush pushes to Android devices through the push channels of their vendor,
+// using the tokens the devices registered with their provider.
+package vendorpush
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/options"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// maxBatchSize is the most tokens the vendors accept in one request.
+	maxBatchSize = 1000
+
+	concurrentRequests = 8
+)
+
+// notification is the content pushed to the devices.
+type notification struct {
+	Title   string
+	Content string
+	// Payload is handed to the app when the notification is tapped.
+	Payload string
+	// ClientMsgID identifies the message, vendors supporting it collapse notifications with the same id.
+	ClientMsgID string
+}
+
+// sender pushes through the channel of a vendor.
+type sender interface {
+	// send pushes the notification to at most maxBatchSize tokens, it returns the tokens the vendor reported as invalid.
+	send(ctx context.Context, tokens []string, n *notification) (invalid []string, err error)
+}
+
+type VendorPush struct {
+	db      controller.PushDeviceDatabase
+	senders map[string]sender
+}
+
+// NewClient creates a pusher for the vendors configured in pushConf.Vendor.
+func NewClient(pushConf *config.Push, db controller.PushDeviceDatabase) (*VendorPush, error) {
+	conf := &pushConf.Vendor
+	senders := make(map[string]sender)
+	if conf.Huawei.AppID != "" {
+		senders[model.PushProviderHuawei] = newHuawei(pushConf)
+	}
+	if conf.Honor.AppID != "" {
+		senders[model.PushProviderHonor] = newHonor(pushConf)
+	}
+	if conf.Xiaomi.AppSecret != "" {
+		senders[model.PushProviderXiaomi] = newXiaomi(pushConf)
+	}
+	if conf.OPPO.AppKey != "" {
+		senders[model.PushProviderOPPO] = newOPPO(pushConf)
+	}
+	if conf.Vivo.AppID != "" {
+		senders[model.PushProviderVivo] = newVivo(pushConf)
+	}
+	if len(senders) == 0 {
+		return nil, errs.New("no vendor push channel is configured").Wrap()
+	}
+	return newClient(db, senders), nil
+}
+
+func newClient(db controller.PushDeviceDatabase, senders map[string]sender) *VendorPush {
+	return &VendorPush{db: db, senders: senders}
+}
+
+func (v *VendorPush) Push(ctx context.Context, userIDs []string, title, content string, opts *options.Opts) error {
+	devices, err := v.db.GetDevices(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+	tokens := make(map[string][]string)
+	// tokenUserIDs are the users of the tokens of each provider, those a failed batch was for.
+	tokenUserIDs := make(map[string]map[string][]string)
+	for _, device := range devices {
+		if _, ok := v.senders[device.Provider]; ok && opts.HasPlatform(int(device.PlatformID)) {
+			tokens[device.Provider] = append(tokens[device.Provider], device.Token)
+			if tokenUserIDs[device.Provider] == nil {
+				tokenUserIDs[device.Provider] = make(map[string][]string)
+			}
+			tokenUserIDs[device.Provider][device.Token] = append(tokenUserIDs[device.Provider][device.Token], device.UserID)
+		}
+	}
+	if len(tokens) == 0 {
+		return nil
+	}
+	n, err := newNotification(title, content, opts)
+	if err != nil {
+		return err
+	}
+	var (
+		failLock sync.Mutex
+		fail     int
+		failed   []string
+		lastErr  error
+	)
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(concurrentRequests)
+	for provider, providerTokens := range tokens {
+		providerTokens = datautil.Distinct(providerTokens)
+		for i := 0; i < len(providerTokens); i += maxBatchSize {
+			batch := providerTokens[i:min(i+maxBatchSize, len(providerTokens))]
+			g.Go(func() error {
+				if err := v.send(ctx, provider, batch, n); err != nil {
+					failLock.Lock()
+					fail += len(batch)
+					for _, token := range batch {
+						failed = append(failed, tokenUserIDs[provider][token]...)
+					}
+					lastErr = err
+					failLock.Unlock()
+				}
+				return nil
+			})
+		}
+	}
+	_ = g.Wait()
+	if fail != 0 {
+		return &options.UsersError{UserIDs: datautil.Distinct(failed), Err: errs.WrapMsg(lastErr, fmt.Sprintf("%d vendor push tokens failed", fail))}
+	}
+	return nil
+}
+
+func (v *VendorPush) send(ctx context.Context, provider string, tokens []string, n *notification) error {
+	invalid, err := v.senders[provider].send(ctx, tokens, n)
+	if err != nil {
+		prommetrics.OfflinePushProviderCall(provider, "failed", len(tokens))
+		log.ZWarn(ctx, "vendor push failed", err, "provider", provider, "tokens", len(tokens))
+		return err
+	}
+	prommetrics.OfflinePushProviderCall(provider, "success", len(tokens)-len(invalid))
+	if len(invalid) == 0 {
+		return nil
+	}
+	prommetrics.OfflinePushProviderCall(provider, "invalid", len(invalid))
+	if err := v.db.DeleteInvalidTokens(ctx, provider, invalid); err != nil {
+		log.ZWarn(ctx, "delete invalid vendor push tokens failed", err, "provider", provider, "tokens", invalid)
+	} else {
+		log.ZInfo(ctx, "deleted invalid vendor push tokens", "provider", provider, "tokens", invalid)
+	}
+	return nil
+}
+
+func newNotification(title, content string, opts *options.Opts) (*notification, error) {
+	n := &notification{Title: title, Content: content}
+	payload := map[string]string{"ex": opts.Ex}
+	if opts.Signal != nil && opts.Signal.ClientMsgID != "" {
+		n.ClientMsgID = opts.Signal.ClientMsgID
+		payload["clientMsgID"] = opts.Signal.ClientMsgID
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	n.Payload = string(data)
+	return n, nil
+}
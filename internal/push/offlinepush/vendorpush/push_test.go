@@ -0,0 +1,369 @@
+package vendorpush
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/options"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+type memoryDeviceDB struct {
+	controller.PushDeviceDatabase
+	lock    sync.Mutex
+	devices []*model.PushDevice
+}
+
+func (m *memoryDeviceDB) GetDevices(_ context.Context, userIDs []string) ([]*model.PushDevice, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	var devices []*model.PushDevice
+	for _, device := range m.devices {
+		if datautil.Contain(device.UserID, userIDs...) {
+			devices = append(devices, device)
+		}
+	}
+	return devices, nil
+}
+
+func (m *memoryDeviceDB) DeleteInvalidTokens(_ context.Context, provider string, tokens []string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.devices = datautil.Filter(m.devices, func(device *model.PushDevice) (*model.PushDevice, bool) {
+		return device, !(device.Provider == provider && datautil.Contain(device.Token, tokens...))
+	})
+	return nil
+}
+
+type fakeSender struct {
+	lock    sync.Mutex
+	batches [][]string
+	invalid map[string]bool
+}
+
+func (f *fakeSender) send(_ context.Context, tokens []string, _ *notification) ([]string, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.batches = append(f.batches, tokens)
+	var invalid []string
+	for _, token := range tokens {
+		if f.invalid[token] {
+			invalid = append(invalid, token)
+		}
+	}
+	return invalid, nil
+}
+
+func TestPushRoutesAndBatches(t *testing.T) {
+	db := &memoryDeviceDB{}
+	for i := 0; i < maxBatchSize+1; i++ {
+		db.devices = append(db.devices, &model.PushDevice{UserID: fmt.Sprintf("user%d", i), Provider: model.PushProviderXiaomi, Token: fmt.Sprintf("xiaomi%d", i)})
+	}
+	db.devices = append(db.devices,
+		&model.PushDevice{UserID: "user0", DeviceID: "phone", Provider: model.PushProviderHuawei, Token: "huawei-ok"},
+		&model.PushDevice{UserID: "user1", DeviceID: "tablet", Provider: model.PushProviderHuawei, Token: "huawei-gone"},
+		&model.PushDevice{UserID: "user2", DeviceID: "phone", Provider: model.PushProviderVivo, Token: "vivo-unconfigured"},
+	)
+	huawei := &fakeSender{invalid: map[string]bool{"huawei-gone": true}}
+	xiaomi := &fakeSender{}
+	pusher := newClient(db, map[string]sender{model.PushProviderHuawei: huawei, model.PushProviderXiaomi: xiaomi})
+
+	userIDs := datautil.Slice(db.devices, func(device *model.PushDevice) string { return device.UserID })
+	if err := pusher.Push(context.Background(), datautil.Distinct(userIDs), "title", "content", &options.Opts{}); err != nil {
+		t.Fatal(err)
+	}
+	if len(xiaomi.batches) != 2 || len(xiaomi.batches[0])+len(xiaomi.batches[1]) != maxBatchSize+1 {
+		t.Errorf("expected the xiaomi tokens in 2 batches, got %d", len(xiaomi.batches))
+	}
+	if len(huawei.batches) != 1 || len(huawei.batches[0]) != 2 {
+		t.Errorf("expected the huawei tokens in 1 batch, got %v", huawei.batches)
+	}
+	for _, device := range db.devices {
+		if device.Token == "huawei-gone" {
+			t.Error("expected the invalid huawei token to be deleted")
+		}
+	}
+}
+
+func TestHuaweiPartialSuccess(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/token":
+			_ = json.NewEncoder(w).Encode(map[string]any{"access_token": "token", "expires_in": 3600})
+		case "/v1/app/messages:send":
+			if r.Header.Get("Authorization") != "Bearer token" {
+				t.Errorf("unexpected authorization %q", r.Header.Get("Authorization"))
+			}
+			var req huaweiPushReq
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Error(err)
+			}
+			if req.Message.Android.Notification.Title != "title" || req.Message.Android.Data == "" {
+				t.Errorf("unexpected message %+v", req.Message)
+			}
+			_ = json.NewEncoder(w).Encode(map[string]string{
+				"code": huaweiPartialSuccess,
+				"msg":  `{"success":1,"failure":1,"illegal_tokens":["b"]}`,
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	pushConf := &config.Push{}
+	pushConf.Vendor.Huawei.AppID = "app"
+	h := newHuawei(pushConf)
+	h.authURL = srv.URL + "/token"
+	h.pushURL = srv.URL + "/v1/%s/messages:send"
+	n, err := newNotification("title", "content", &options.Opts{Ex: "ex", Signal: &options.Signal{ClientMsgID: "msg1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	invalid, err := h.send(context.Background(), []string{"a", "b"}, n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(invalid) != 1 || invalid[0] != "b" {
+		t.Errorf("expected token b to be invalid, got %v", invalid)
+	}
+}
+
+func newTestNotification(t *testing.T) *notification {
+	n, err := newNotification("title", "content", &options.Opts{Ex: "ex", Signal: &options.Signal{ClientMsgID: "msg1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+func TestHonorExpiredTokens(t *testing.T) {
+	var auths int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/token":
+			auths++
+			if r.FormValue("client_id") != "client" {
+				t.Errorf("unexpected client id %q", r.FormValue("client_id"))
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"access_token": fmt.Sprintf("token%d", auths), "expires_in": 3600})
+		case "/api/v1/app/sendMessage":
+			if r.Header.Get("timestamp") == "" {
+				t.Error("expected a timestamp header")
+			}
+			var req honorPushReq
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Error(err)
+			}
+			if r.Header.Get("Authorization") == "Bearer token1" {
+				_ = json.NewEncoder(w).Encode(map[string]any{"code": honorTokenExpired, "message": "expired"})
+				return
+			}
+			resp := map[string]any{"code": honorSuccess}
+			resp["data"] = map[string]any{"expireTokens": []string{req.Token[1]}}
+			_ = json.NewEncoder(w).Encode(resp)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	pushConf := &config.Push{}
+	pushConf.Vendor.Honor.AppID = "app"
+	pushConf.Vendor.Honor.ClientID = "client"
+	h := newHonor(pushConf)
+	h.authURL = srv.URL + "/token"
+	h.pushURL = srv.URL + "/api/v1/%s/sendMessage"
+	n := newTestNotification(t)
+	// The rejected access token is dropped, the next push gets a new one.
+	if _, err := h.send(context.Background(), []string{"a", "b"}, n); err == nil {
+		t.Fatal("expected the expired access token to fail the push")
+	}
+	invalid, err := h.send(context.Background(), []string{"a", "b"}, n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if auths != 2 || len(invalid) != 1 || invalid[0] != "b" {
+		t.Errorf("expected token b to be invalid after 2 auths, got %v after %d", invalid, auths)
+	}
+}
+
+func TestOPPOInvalidRegIDs(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/auth":
+			if r.FormValue("app_key") != "key" || r.FormValue("sign") == "" {
+				t.Errorf("unexpected auth form %v", r.Form)
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"code": 0, "data": map[string]string{"auth_token": "token"}})
+		case "/unicast_batch":
+			if r.FormValue("auth_token") != "token" {
+				t.Errorf("unexpected auth token %q", r.FormValue("auth_token"))
+			}
+			var messages []oppoMessage
+			if err := json.Unmarshal([]byte(r.FormValue("messages")), &messages); err != nil {
+				t.Error(err)
+			}
+			if len(messages) != 2 || messages[0].Notification.Title != "title" || messages[0].Notification.ChannelID != "channel" {
+				t.Errorf("unexpected messages %+v", messages)
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"code": 0, "data": []map[string]any{
+				{"registrationId": "a"},
+				{"registrationId": "b", "errorCode": oppoInvalidRegID},
+			}})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	pushConf := &config.Push{}
+	pushConf.Vendor.OPPO.AppKey = "key"
+	pushConf.Vendor.OPPO.ChannelID = "channel"
+	o := newOPPO(pushConf)
+	o.authURL = srv.URL + "/auth"
+	o.pushURL = srv.URL + "/unicast_batch"
+	invalid, err := o.send(context.Background(), []string{"a", "b"}, newTestNotification(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(invalid) != 1 || invalid[0] != "b" {
+		t.Errorf("expected token b to be invalid, got %v", invalid)
+	}
+}
+
+func TestVivoPushToList(t *testing.T) {
+	var paths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		if r.URL.Path != "/auth" && r.Header.Get("authToken") != "token" {
+			t.Errorf("unexpected auth token %q", r.Header.Get("authToken"))
+		}
+		switch r.URL.Path {
+		case "/auth":
+			_ = json.NewEncoder(w).Encode(map[string]any{"result": 0, "authToken": "token"})
+		case "/send":
+			var msg vivoMessage
+			if err := json.NewDecoder(r.Body).Decode(&msg); err != nil {
+				t.Error(err)
+			}
+			if msg.RegID != "a" || msg.PushMode != 1 {
+				t.Errorf("unexpected message %+v", msg)
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"result": 0})
+		case "/saveListPayload":
+			_ = json.NewEncoder(w).Encode(map[string]any{"result": 0, "taskId": "task"})
+		case "/pushToList":
+			var req vivoPushToListReq
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Error(err)
+			}
+			if req.TaskID != "task" || len(req.RegIDs) != 3 {
+				t.Errorf("unexpected list push %+v", req)
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"result": 0, "invalidUsers": []map[string]any{
+				{"status": 3, "userid": "b"},
+				// A token over its daily quota is not removed.
+				{"status": 4, "userid": "c"},
+			}})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	pushConf := &config.Push{}
+	pushConf.Vendor.Vivo.AppID = "app"
+	v := newVivo(pushConf)
+	v.authURL = srv.URL + "/auth"
+	v.sendURL = srv.URL + "/send"
+	v.savePayloadURL = srv.URL + "/saveListPayload"
+	v.pushToListURL = srv.URL + "/pushToList"
+	n := newTestNotification(t)
+	if _, err := v.send(context.Background(), []string{"a"}, n); err != nil {
+		t.Fatal(err)
+	}
+	invalid, err := v.send(context.Background(), []string{"a", "b", "c"}, n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(invalid) != 1 || invalid[0] != "b" {
+		t.Errorf("expected token b to be invalid, got %v", invalid)
+	}
+	if len(paths) != 4 || paths[0] != "/auth" {
+		t.Errorf("expected a single auth, got %v", paths)
+	}
+}
+
+func TestXiaomiBadRegIDs(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "key=secret" {
+			t.Errorf("unexpected authorization %q", r.Header.Get("Authorization"))
+		}
+		if r.FormValue("registration_id") != "a,b" || r.FormValue("restricted_package_name") != "im.app" || r.FormValue("extra.channel_id") != "channel" {
+			t.Errorf("unexpected form %v", r.Form)
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"result": "ok", "code": 0, "data": map[string]string{"bad_regids": "b"}})
+	}))
+	defer srv.Close()
+
+	pushConf := &config.Push{}
+	pushConf.Vendor.Xiaomi.AppSecret = "secret"
+	pushConf.Vendor.Xiaomi.PackageName = "im.app"
+	pushConf.Vendor.Xiaomi.ChannelID = "channel"
+	x := newXiaomi(pushConf)
+	x.pushURL = srv.URL
+	invalid, err := x.send(context.Background(), []string{"a", "b"}, newTestNotification(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(invalid) != 1 || invalid[0] != "b" {
+		t.Errorf("expected token b to be invalid, got %v", invalid)
+	}
+}
+
+func TestAccessTokenRenewedOnce(t *testing.T) {
+	var (
+		fetches int
+		release = make(chan struct{})
+	)
+	a := &accessToken{fetch: func(ctx context.Context) (string, time.Duration, error) {
+		fetches++
+		<-release
+		// A lifetime shorter than the refresh margin must still cache the token.
+		return "token", time.Minute, nil
+	}}
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if token, err := a.get(context.Background()); err != nil || token != "token" {
+				t.Errorf("unexpected token %q, %v", token, err)
+			}
+		}()
+	}
+	time.Sleep(20 * time.Millisecond)
+	// The lock is not held while the token is fetched.
+	a.reset()
+	close(release)
+	wg.Wait()
+	if _, err := a.get(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if fetches != 1 {
+		t.Errorf("expected a single fetch, got %d", fetches)
+	}
+	if lifetime := tokenLifetime(time.Minute); lifetime != 30*time.Second {
+		t.Errorf("unexpected lifetime %v", lifetime)
+	}
+}
@@ -0,0 +1,179 @@
+package vendorpush
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/tools/errs"
+)
+
+const (
+	vivoAuthURL        = "https://api-push.vivo.com.cn/message/auth"
+	vivoSendURL        = "https://api-push.vivo.com.cn/message/send"
+	vivoSavePayloadURL = "https://api-push.vivo.com.cn/message/saveListPayload"
+	vivoPushToListURL  = "https://api-push.vivo.com.cn/message/pushToList"
+
+	// vivoTokenTTL is how long an auth token is valid, the response does not tell.
+	vivoTokenTTL = 24 * time.Hour
+
+	vivoInvalidAuthToken = 10000
+)
+
+// vivoInvalidStatus are the statuses of the rejected tokens that will never be valid again.
+var vivoInvalidStatus = map[int]bool{
+	1: true, // not registered
+	2: true, // unsubscribed
+	3: true, // app uninstalled
+}
+
+type vivo struct {
+	pushConf       *config.Push
+	authURL        string
+	sendURL        string
+	savePayloadURL string
+	pushToListURL  string
+	httpClient     *http.Client
+	token          *accessToken
+}
+
+func newVivo(pushConf *config.Push) *vivo {
+	v := &vivo{
+		pushConf:       pushConf,
+		authURL:        vivoAuthURL,
+		sendURL:        vivoSendURL,
+		savePayloadURL: vivoSavePayloadURL,
+		pushToListURL:  vivoPushToListURL,
+		httpClient:     &http.Client{Timeout: requestTimeout},
+	}
+	v.token = &accessToken{fetch: v.auth}
+	return v
+}
+
+type vivoAuthReq struct {
+	AppID     string `json:"appId"`
+	AppKey    string `json:"appKey"`
+	Timestamp int64  `json:"timestamp"`
+	Sign      string `json:"sign"`
+}
+
+type vivoResp struct {
+	Result    int    `json:"result"`
+	Desc      string `json:"desc"`
+	AuthToken string `json:"authToken"`
+	TaskID    string `json:"taskId"`
+	// InvalidUsers are the tokens of a list push that were rejected.
+	InvalidUsers []struct {
+		Status int    `json:"status"`
+		UserID string `json:"userid"`
+	} `json:"invalidUsers"`
+}
+
+func (v *vivo) auth(ctx context.Context) (string, time.Duration, error) {
+	conf := &v.pushConf.Vendor.Vivo
+	timestamp := time.Now().UnixMilli()
+	sign := md5.Sum([]byte(conf.AppID + conf.AppKey + strconv.FormatInt(timestamp, 10) + conf.AppSecret))
+	req := vivoAuthReq{AppID: conf.AppID, AppKey: conf.AppKey, Timestamp: timestamp, Sign: hex.EncodeToString(sign[:])}
+	var resp vivoResp
+	if err := postJSON(ctx, v.httpClient, v.authURL, nil, &req, &resp); err != nil {
+		return "", 0, err
+	}
+	if resp.Result != 0 || resp.AuthToken == "" {
+		return "", 0, errs.New("vivo auth failed", "result", resp.Result, "desc", resp.Desc).Wrap()
+	}
+	return resp.AuthToken, vivoTokenTTL, nil
+}
+
+type vivoMessage struct {
+	RegID   string `json:"regId,omitempty"`
+	Title   string `json:"title"`
+	Content string `json:"content"`
+	// NotifyType 4 rings and vibrates.
+	NotifyType int `json:"notifyType"`
+	// SkipType 1 starts the app.
+	SkipType        int               `json:"skipType"`
+	ClientCustomMap map[string]string `json:"clientCustomMap,omitempty"`
+	// Classification 1 marks the message as a system message, e.g. an instant message.
+	Classification int    `json:"classification"`
+	PushMode       int    `json:"pushMode"`
+	RequestID      string `json:"requestId"`
+}
+
+type vivoPushToListReq struct {
+	RegIDs    []string `json:"regIds"`
+	TaskID    string   `json:"taskId"`
+	RequestID string   `json:"requestId"`
+	PushMode  int      `json:"pushMode"`
+}
+
+// send pushes to a single token with /message/send, a list push needs at least two tokens.
+func (v *vivo) send(ctx context.Context, tokens []string, n *notification) ([]string, error) {
+	token, err := v.token.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	header := map[string]string{"authToken": token}
+	msg := vivoMessage{
+		Title:           n.Title,
+		Content:         n.Content,
+		NotifyType:      4,
+		SkipType:        1,
+		ClientCustomMap: map[string]string{"payload": n.Payload},
+		Classification:  1,
+		PushMode:        v.pushMode(),
+		RequestID:       uuid.New().String(),
+	}
+	if len(tokens) == 1 {
+		msg.RegID = tokens[0]
+		var resp vivoResp
+		if err := postJSON(ctx, v.httpClient, v.sendURL, header, &msg, &resp); err != nil {
+			return nil, err
+		}
+		return nil, v.check(&resp)
+	}
+	var saved vivoResp
+	if err := postJSON(ctx, v.httpClient, v.savePayloadURL, header, &msg, &saved); err != nil {
+		return nil, err
+	}
+	if err := v.check(&saved); err != nil {
+		return nil, err
+	}
+	req := vivoPushToListReq{RegIDs: tokens, TaskID: saved.TaskID, RequestID: uuid.New().String(), PushMode: msg.PushMode}
+	var resp vivoResp
+	if err := postJSON(ctx, v.httpClient, v.pushToListURL, header, &req, &resp); err != nil {
+		return nil, err
+	}
+	if err := v.check(&resp); err != nil {
+		return nil, err
+	}
+	var invalid []string
+	for _, user := range resp.InvalidUsers {
+		if vivoInvalidStatus[user.Status] {
+			invalid = append(invalid, user.UserID)
+		}
+	}
+	return invalid, nil
+}
+
+// pushMode 1 delivers to the test devices only.
+func (v *vivo) pushMode() int {
+	if v.pushConf.Vendor.Vivo.Production {
+		return 0
+	}
+	return 1
+}
+
+func (v *vivo) check(resp *vivoResp) error {
+	if resp.Result == 0 {
+		return nil
+	}
+	if resp.Result == vivoInvalidAuthToken {
+		v.token.reset()
+	}
+	return errs.New("vivo push failed", "result", resp.Result, "desc", resp.Desc).Wrap()
+}
@@ -0,0 +1,67 @@
+package vendorpush
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/tools/errs"
+)
+
+const xiaomiPushURL = "https://api.xmpush.xiaomi.com/v3/message/regid"
+
+// xiaomi authenticates every request with the app secret, there is no access token to refresh.
+type xiaomi struct {
+	pushConf   *config.Push
+	pushURL    string
+	httpClient *http.Client
+}
+
+func newXiaomi(pushConf *config.Push) *xiaomi {
+	return &xiaomi{
+		pushConf:   pushConf,
+		pushURL:    xiaomiPushURL,
+		httpClient: &http.Client{Timeout: requestTimeout},
+	}
+}
+
+type xiaomiPushResp struct {
+	Result      string `json:"result"`
+	Code        int    `json:"code"`
+	Description string `json:"description"`
+	Reason      string `json:"reason"`
+	Data        struct {
+		// BadRegIDs are the comma separated tokens that are not valid.
+		BadRegIDs string `json:"bad_regids"`
+	} `json:"data"`
+}
+
+func (x *xiaomi) send(ctx context.Context, tokens []string, n *notification) ([]string, error) {
+	conf := &x.pushConf.Vendor.Xiaomi
+	form := url.Values{
+		"registration_id":         {strings.Join(tokens, ",")},
+		"restricted_package_name": {conf.PackageName},
+		"title":                   {n.Title},
+		"description":             {n.Content},
+		"payload":                 {n.Payload},
+		"pass_through":            {"0"},
+		"notify_type":             {"-1"},
+	}
+	if conf.ChannelID != "" {
+		form.Set("extra.channel_id", conf.ChannelID)
+	}
+	var resp xiaomiPushResp
+	header := map[string]string{"Authorization": "key=" + conf.AppSecret}
+	if err := postForm(ctx, x.httpClient, x.pushURL, header, form, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Code != 0 {
+		return nil, errs.New("xiaomi push failed", "code", resp.Code, "description", resp.Description, "reason", resp.Reason).Wrap()
+	}
+	if resp.Data.BadRegIDs == "" {
+		return nil, nil
+	}
+	return strings.Split(resp.Data.BadRegIDs, ","), nil
+}
@@ -0,0 +1,108 @@
+package webpush
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"strings"
+
+	"github.com/openimsdk/tools/errs"
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// recordSize is the size of the single aes128gcm record the payload is sent in.
+	recordSize = 4096
+
+	saltSize = 16
+	tagSize  = 16
+)
+
+// encrypt encrypts the payload for the subscription keys with the aes128gcm content coding of RFC 8291.
+func encrypt(payload []byte, p256dh, auth string) ([]byte, error) {
+	uaPublicBytes, err := decodeKey(p256dh)
+	if err != nil {
+		return nil, err
+	}
+	authSecret, err := decodeKey(auth)
+	if err != nil {
+		return nil, err
+	}
+	curve := ecdh.P256()
+	uaPublic, err := curve.NewPublicKey(uaPublicBytes)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "invalid subscription p256dh key")
+	}
+	asPrivate, err := curve.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	asPublicBytes := asPrivate.PublicKey().Bytes()
+	ecdhSecret, err := asPrivate.ECDH(uaPublic)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	keyInfo := append([]byte("WebPush: info\x00"), uaPublicBytes...)
+	keyInfo = append(keyInfo, asPublicBytes...)
+	ikm, err := derive(ecdhSecret, authSecret, keyInfo, 32)
+	if err != nil {
+		return nil, err
+	}
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	cek, err := derive(ikm, salt, []byte("Content-Encoding: aes128gcm\x00"), 16)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := derive(ikm, salt, []byte("Content-Encoding: nonce\x00"), 12)
+	if err != nil {
+		return nil, err
+	}
+
+	// The delimiter 0x02 marks the last record, the record is not padded.
+	plaintext := append(payload[:len(payload):len(payload)], 0x02)
+	if len(plaintext)+tagSize > recordSize {
+		return nil, errs.New("web push payload is too large", "size", len(payload)).Wrap()
+	}
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	// header: salt | record size | key id length | key id (the application server public key)
+	body := make([]byte, 0, saltSize+4+1+len(asPublicBytes)+len(plaintext)+tagSize)
+	body = append(body, salt...)
+	body = binary.BigEndian.AppendUint32(body, recordSize)
+	body = append(body, byte(len(asPublicBytes)))
+	body = append(body, asPublicBytes...)
+	return gcm.Seal(body, nonce, plaintext, nil), nil
+}
+
+func derive(secret, salt, info []byte, size int) ([]byte, error) {
+	key := make([]byte, size)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, secret, salt, info), key); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return key, nil
+}
+
+// decodeKey decodes a base64url key, browsers leave out the padding but some libraries add it.
+func decodeKey(key string) ([]byte, error) {
+	data, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(key, "="))
+	if err != nil {
+		return nil, errs.WrapMsg(err, "invalid base64url key")
+	}
+	return data, nil
+}
@@ -0,0 +1,238 @@
+// Package webpush pushes to the browsers through their Web Push subscriptions (RFC 8030),
+// with the payload encrypted per RFC 8291 and the application server identified by VAPID (RFC 8292).
+package webpush
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/options"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/util/webpushutil"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	defaultTTL = 24 * 60 * 60
+
+	// vapidExpire is the lifetime of the VAPID tokens, push services reject them beyond 24 hours.
+	vapidExpire = 12 * time.Hour
+
+	concurrentRequests = 16
+	requestTimeout     = 10 * time.Second
+)
+
+type WebPush struct {
+	pushConf   *config.Push
+	db         controller.PushDeviceDatabase
+	key        *ecdsa.PrivateKey
+	publicKey  string
+	httpClient *http.Client
+
+	lock   sync.Mutex
+	tokens map[string]*vapidToken
+}
+
+type vapidToken struct {
+	token  string
+	expire time.Time
+}
+
+// NewClient creates a web push client signing with the VAPID private key of the configuration.
+func NewClient(pushConf *config.Push, db controller.PushDeviceDatabase) (*WebPush, error) {
+	conf := &pushConf.WebPush
+	if conf.PrivateKey == "" || conf.Subject == "" {
+		return nil, errs.New("webPush privateKey and subject are required").Wrap()
+	}
+	d, err := decodeKey(conf.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	key, err := parsePrivateKey(d)
+	if err != nil {
+		return nil, err
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = webpushutil.Dialer(requestTimeout).DialContext
+	return newClient(pushConf, db, key, &http.Client{Timeout: requestTimeout, Transport: transport}), nil
+}
+
+func newClient(pushConf *config.Push, db controller.PushDeviceDatabase, key *ecdsa.PrivateKey, httpClient *http.Client) *WebPush {
+	x, y := make([]byte, 32), make([]byte, 32)
+	key.X.FillBytes(x)
+	key.Y.FillBytes(y)
+	publicKey := append(append([]byte{4}, x...), y...)
+	return &WebPush{
+		pushConf:   pushConf,
+		db:         db,
+		key:        key,
+		publicKey:  base64.RawURLEncoding.EncodeToString(publicKey),
+		httpClient: httpClient,
+		tokens:     make(map[string]*vapidToken),
+	}
+}
+
+// parsePrivateKey creates the signing key of the raw P-256 private key, as generated by the web-push tools.
+func parsePrivateKey(d []byte) (*ecdsa.PrivateKey, error) {
+	priv, err := ecdh.P256().NewPrivateKey(d)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "invalid webPush privateKey")
+	}
+	pub := priv.PublicKey().Bytes()
+	return &ecdsa.PrivateKey{
+		PublicKey: ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(pub[1:33]),
+			Y:     new(big.Int).SetBytes(pub[33:]),
+		},
+		D: new(big.Int).SetBytes(d),
+	}, nil
+}
+
+type payload struct {
+	Title       string `json:"title,omitempty"`
+	Body        string `json:"body,omitempty"`
+	Ex          string `json:"ex,omitempty"`
+	ClientMsgID string `json:"clientMsgID,omitempty"`
+	// Tag lets the service worker replace the notification of the same conversation.
+	Tag string `json:"tag,omitempty"`
+}
+
+func (w *WebPush) Push(ctx context.Context, userIDs []string, title, content string, opts *options.Opts) error {
+	devices, err := w.db.GetDevices(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+	p := payload{Title: title, Body: content, Ex: opts.Ex, Tag: opts.ConversationID}
+	if opts.Signal != nil {
+		p.ClientMsgID = opts.Signal.ClientMsgID
+	}
+	data, err := json.Marshal(&p)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	var (
+		failLock sync.Mutex
+		fail     int
+		failed   []string
+		lastErr  error
+	)
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(concurrentRequests)
+	for _, device := range devices {
+		if device.Provider != model.PushProviderWebPush || !opts.HasPlatform(int(device.PlatformID)) {
+			continue
+		}
+		g.Go(func() error {
+			if err := w.send(ctx, device, data); err != nil {
+				failLock.Lock()
+				fail++
+				failed = append(failed, device.UserID)
+				lastErr = err
+				failLock.Unlock()
+			}
+			return nil
+		})
+	}
+	_ = g.Wait()
+	if fail != 0 {
+		return &options.UsersError{UserIDs: datautil.Distinct(failed), Err: errs.WrapMsg(lastErr, fmt.Sprintf("%d web push message send failed", fail))}
+	}
+	return nil
+}
+
+func (w *WebPush) send(ctx context.Context, device *model.PushDevice, data []byte) error {
+	body, err := encrypt(data, device.P256dh, device.Auth)
+	if err != nil {
+		prommetrics.OfflinePushProviderCall(model.PushProviderWebPush, "failed", 1)
+		return err
+	}
+	authorization, err := w.authorization(device.Token)
+	if err != nil {
+		prommetrics.OfflinePushProviderCall(model.PushProviderWebPush, "failed", 1)
+		return err
+	}
+	ttl := w.pushConf.WebPush.TTL
+	if ttl <= 0 {
+		ttl = defaultTTL
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, device.Token, bytes.NewReader(body))
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	req.Header.Set("Authorization", authorization)
+	req.Header.Set("Content-Encoding", "aes128gcm")
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("TTL", strconv.Itoa(ttl))
+	req.Header.Set("Urgency", "high")
+	resp, err := w.httpClient.Do(req)
+	if err != nil {
+		prommetrics.OfflinePushProviderCall(model.PushProviderWebPush, "failed", 1)
+		return errs.WrapMsg(err, "web push request failed")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		prommetrics.OfflinePushProviderCall(model.PushProviderWebPush, "success", 1)
+		return nil
+	}
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone {
+		// The subscription expired or the user revoked it.
+		prommetrics.OfflinePushProviderCall(model.PushProviderWebPush, "invalid", 1)
+		if err := w.db.DeleteInvalidTokens(ctx, model.PushProviderWebPush, []string{device.Token}); err != nil {
+			log.ZWarn(ctx, "delete gone web push subscription failed", err, "userID", device.UserID, "deviceID", device.DeviceID)
+		} else {
+			log.ZInfo(ctx, "deleted gone web push subscription", "userID", device.UserID, "deviceID", device.DeviceID)
+		}
+		return nil
+	}
+	prommetrics.OfflinePushProviderCall(model.PushProviderWebPush, "failed", 1)
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	return errs.New("web push failed", "status", resp.StatusCode, "body", string(msg)).Wrap()
+}
+
+// authorization returns the VAPID authorization of the push service hosting the endpoint.
+func (w *WebPush) authorization(endpoint string) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", errs.WrapMsg(err, "invalid web push endpoint", "endpoint", endpoint)
+	}
+	audience := u.Scheme + "://" + u.Host
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	now := time.Now()
+	t, ok := w.tokens[audience]
+	if !ok || now.After(t.expire.Add(-time.Hour)) {
+		expire := now.Add(vapidExpire)
+		token, err := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+			"aud": audience,
+			"exp": expire.Unix(),
+			"sub": w.pushConf.WebPush.Subject,
+		}).SignedString(w.key)
+		if err != nil {
+			return "", errs.WrapMsg(err, "sign vapid token failed")
+		}
+		t = &vapidToken{token: token, expire: expire}
+		w.tokens[audience] = t
+	}
+	return fmt.Sprintf("vapid t=%s, k=%s", t.token, w.publicKey), nil
+}
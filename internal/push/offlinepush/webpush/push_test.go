@@ -0,0 +1,178 @@
+package webpush
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/options"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+type memoryDeviceDB struct {
+	controller.PushDeviceDatabase
+	lock    sync.Mutex
+	devices []*model.PushDevice
+}
+
+func (m *memoryDeviceDB) GetDevices(_ context.Context, userIDs []string) ([]*model.PushDevice, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return datautil.Filter(m.devices, func(device *model.PushDevice) (*model.PushDevice, bool) {
+		return device, datautil.Contain(device.UserID, userIDs...)
+	}), nil
+}
+
+func (m *memoryDeviceDB) DeleteInvalidTokens(_ context.Context, provider string, tokens []string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.devices = datautil.Filter(m.devices, func(device *model.PushDevice) (*model.PushDevice, bool) {
+		return device, !(device.Provider == provider && datautil.Contain(device.Token, tokens...))
+	})
+	return nil
+}
+
+// browser is the user agent side of a subscription.
+type browser struct {
+	key  *ecdh.PrivateKey
+	auth []byte
+}
+
+func newBrowser(t *testing.T) *browser {
+	key, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	auth := make([]byte, 16)
+	_, _ = rand.Read(auth)
+	return &browser{key: key, auth: auth}
+}
+
+func (b *browser) device(userID, endpoint string) *model.PushDevice {
+	return &model.PushDevice{
+		UserID:   userID,
+		DeviceID: "browser",
+		Provider: model.PushProviderWebPush,
+		Token:    endpoint,
+		P256dh:   base64.RawURLEncoding.EncodeToString(b.key.PublicKey().Bytes()),
+		Auth:     base64.RawURLEncoding.EncodeToString(b.auth),
+	}
+}
+
+// decrypt decrypts an aes128gcm body as the browser does.
+func (b *browser) decrypt(t *testing.T, body []byte) []byte {
+	salt, rs, idLen := body[:16], binary.BigEndian.Uint32(body[16:20]), int(body[20])
+	if rs != recordSize {
+		t.Errorf("unexpected record size %d", rs)
+	}
+	asPublic, err := ecdh.P256().NewPublicKey(body[21 : 21+idLen])
+	if err != nil {
+		t.Fatal(err)
+	}
+	secret, err := b.key.ECDH(asPublic)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyInfo := append([]byte("WebPush: info\x00"), b.key.PublicKey().Bytes()...)
+	keyInfo = append(keyInfo, asPublic.Bytes()...)
+	ikm, _ := derive(secret, b.auth, keyInfo, 32)
+	cek, _ := derive(ikm, salt, []byte("Content-Encoding: aes128gcm\x00"), 16)
+	nonce, _ := derive(ikm, salt, []byte("Content-Encoding: nonce\x00"), 12)
+	block, _ := aes.NewCipher(cek)
+	gcm, _ := cipher.NewGCM(block)
+	plaintext, err := gcm.Open(nil, nonce, body[21+idLen:], nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plaintext[len(plaintext)-1] != 0x02 {
+		t.Errorf("missing last record delimiter")
+	}
+	return plaintext[:len(plaintext)-1]
+}
+
+func TestPush(t *testing.T) {
+	vapidKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pushConf := &config.Push{}
+	pushConf.WebPush.Subject = "mailto:admin@example.com"
+	pushConf.WebPush.TTL = 60
+	var client *WebPush
+	var (
+		lock     sync.Mutex
+		received = make(map[string][]byte)
+	)
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, publicKey, _ := strings.Cut(strings.TrimPrefix(r.Header.Get("Authorization"), "vapid t="), ", k=")
+		if publicKey != client.publicKey {
+			t.Errorf("unexpected vapid public key %q", publicKey)
+		}
+		claims := jwt.MapClaims{}
+		if _, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (any, error) { return &vapidKey.PublicKey, nil }); err != nil {
+			t.Errorf("invalid vapid token: %v", err)
+		}
+		if claims["aud"] != "https://"+r.Host || claims["sub"] != "mailto:admin@example.com" {
+			t.Errorf("unexpected vapid claims %v", claims)
+		}
+		if r.Header.Get("Content-Encoding") != "aes128gcm" || r.Header.Get("TTL") != "60" {
+			t.Errorf("unexpected headers %v", r.Header)
+		}
+		if r.URL.Path == "/gone" {
+			w.WriteHeader(http.StatusGone)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		lock.Lock()
+		received[r.URL.Path] = body
+		lock.Unlock()
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer srv.Close()
+
+	alice, bob := newBrowser(t), newBrowser(t)
+	db := &memoryDeviceDB{devices: []*model.PushDevice{
+		alice.device("alice", srv.URL+"/alice"),
+		bob.device("bob", srv.URL+"/gone"),
+		{UserID: "alice", DeviceID: "phone", Provider: model.PushProviderHuawei, Token: "huawei"},
+	}}
+	client = newClient(pushConf, db, vapidKey, srv.Client())
+
+	opts := &options.Opts{Ex: "ex", Signal: &options.Signal{ClientMsgID: "msg1"}, ConversationID: "si_alice_bob"}
+	if err := client.Push(context.Background(), []string{"alice", "bob"}, "title", "content", opts); err != nil {
+		t.Fatal(err)
+	}
+	body, ok := received["/alice"]
+	if !ok {
+		t.Fatal("no push received for alice")
+	}
+	var p payload
+	if err := json.Unmarshal(alice.decrypt(t, body), &p); err != nil {
+		t.Fatal(err)
+	}
+	if p.Title != "title" || p.Body != "content" || p.ClientMsgID != "msg1" || p.Tag != "si_alice_bob" {
+		t.Errorf("unexpected payload %+v", p)
+	}
+	for _, device := range db.devices {
+		if device.Token == srv.URL+"/gone" {
+			t.Error("expected the gone subscription to be deleted")
+		}
+	}
+}
@@ -7,6 +7,9 @@ import (
 	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush"
 	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/options"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
 	"github.com/openimsdk/protocol/constant"
 	pbpush "github.com/openimsdk/protocol/push"
 	"github.com/openimsdk/protocol/sdkws"
@@ -20,12 +23,21 @@ import (
 type OfflinePushConsumerHandler struct {
 	OfflinePushConsumerGroup *kafka.MConsumerGroup
 	offlinePusher            offlinepush.OfflinePusher
+	retry                    *offlinePushRetry
+	quietHours               *quietHours
+	templates                *pushTemplates
+	badges                   *badges
 }
 
-func NewOfflinePushConsumerHandler(config *Config, offlinePusher offlinepush.OfflinePusher) (*OfflinePushConsumerHandler, error) {
+func NewOfflinePushConsumerHandler(config *Config, offlinePusher offlinepush.OfflinePusher, retry *offlinePushRetry, quietHours *quietHours,
+	templates *pushTemplates, badges *badges) (*OfflinePushConsumerHandler, error) {
 	var offlinePushConsumerHandler OfflinePushConsumerHandler
 	var err error
 	offlinePushConsumerHandler.offlinePusher = offlinePusher
+	offlinePushConsumerHandler.retry = retry
+	offlinePushConsumerHandler.quietHours = quietHours
+	offlinePushConsumerHandler.templates = templates
+	offlinePushConsumerHandler.badges = badges
 	offlinePushConsumerHandler.OfflinePushConsumerGroup, err = kafka.NewMConsumerGroup(config.KafkaConfig.Build(), config.KafkaConfig.ToOfflineGroupID,
 		[]string{config.KafkaConfig.ToOfflinePushTopic}, true)
 	if err != nil {
@@ -38,7 +50,7 @@ func (*OfflinePushConsumerHandler) Setup(sarama.ConsumerGroupSession) error   {
 func (*OfflinePushConsumerHandler) Cleanup(sarama.ConsumerGroupSession) error { return nil }
 func (o *OfflinePushConsumerHandler) ConsumeClaim(sess sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
 	for msg := range claim.Messages() {
-		ctx := o.OfflinePushConsumerGroup.GetContextFromMsg(msg)
+		ctx, _ := tenant.FromKey(o.OfflinePushConsumerGroup.GetContextFromMsg(msg), string(msg.Key))
 		o.handleMsg2OfflinePush(ctx, msg.Value)
 		sess.MarkMessage(msg, "")
 	}
@@ -60,9 +72,15 @@ func (o *OfflinePushConsumerHandler) handleMsg2OfflinePush(ctx context.Context,
 	}
 	log.ZInfo(ctx, "receive to OfflinePush MQ", "userIDs", offlinePushMsg.UserIDs, "msg", offlinePushMsg.MsgData)
 
-	err := o.offlinePushMsg(ctx, offlinePushMsg.MsgData, offlinePushMsg.UserIDs)
+	// The group pushes and the replayed dead letters are checked here, when they are pushed.
+	userIDs := o.quietHours.filter(ctx, offlinePushMsg.UserIDs, offlinePushMsg.MsgData)
+	if len(userIDs) == 0 {
+		return
+	}
+	err := o.offlinePushMsg(ctx, offlinePushMsg.MsgData, userIDs)
 	if err != nil {
 		log.ZWarn(ctx, "offline push failed", err, "msg", offlinePushMsg.String())
+		o.retry.failed(ctx, userIDs, offlinePushMsg.MsgData, 1, err)
 	}
 }
 
@@ -73,7 +91,18 @@ func (o *OfflinePushConsumerHandler) getOfflinePushInfos(msg *sdkws.MsgData) (ti
 		IsAtSelf   bool     `json:"isAtSelf"`
 	}
 
-	opts = &options.Opts{Signal: &options.Signal{ClientMsgID: msg.ClientMsgID}}
+	opts = &options.Opts{
+		Signal:         &options.Signal{ClientMsgID: msg.ClientMsgID},
+		ConversationID: msgprocessor.GetConversationIDByMsg(msg),
+		Msg: &options.Msg{
+			SendID:      msg.SendID,
+			GroupID:     msg.GroupID,
+			SessionType: msg.SessionType,
+			ContentType: msg.ContentType,
+			Seq:         msg.Seq,
+			SendTime:    msg.SendTime,
+		},
+	}
 	if msg.OfflinePushInfo != nil {
 		opts.IOSBadgeCount = msg.OfflinePushInfo.IOSBadgeCount
 		opts.IOSPushSound = msg.OfflinePushInfo.IOSPushSound
@@ -86,6 +115,8 @@ func (o *OfflinePushConsumerHandler) getOfflinePushInfos(msg *sdkws.MsgData) (ti
 	}
 	if title == "" {
 		switch msg.ContentType {
+		case model.PushTemplateQuietDigest:
+			title, content = digestText(msg)
 		case constant.Text:
 			fallthrough
 		case constant.Picture:
@@ -116,7 +147,8 @@ func (o *OfflinePushConsumerHandler) offlinePushMsg(ctx context.Context, msg *sd
 	if err != nil {
 		return err
 	}
-	err = o.offlinePusher.Push(ctx, offlinePushUserIDs, title, content, opts)
+	opts.Badges = o.badges.get(ctx, offlinePushUserIDs)
+	err = o.templates.push(ctx, o.offlinePusher, offlinePushUserIDs, msg, title, content, opts)
 	if err != nil {
 		prommetrics.MsgOfflinePushFailedCounter.Inc()
 		return err
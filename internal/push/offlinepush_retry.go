@@ -0,0 +1,217 @@
+package push
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/protocol/constant"
+	pbpush "github.com/openimsdk/protocol/push"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mq/kafka"
+	"github.com/openimsdk/tools/utils/datautil"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	errorClassTimeout  = "timeout"
+	errorClassNetwork  = "network"
+	errorClassProvider = "provider"
+)
+
+// offlinePushRetry schedules the failed offline pushes on the retry topics with an exponential backoff,
+// one topic per delay, and keeps them as dead letters once they failed too many times.
+type offlinePushRetry struct {
+	pushConf     *config.Push
+	database     controller.PushDatabase
+	deadLetterDB controller.OfflinePushDeadLetterDatabase
+}
+
+func newOfflinePushRetry(pushConf *config.Push, database controller.PushDatabase, deadLetterDB controller.OfflinePushDeadLetterDatabase) *offlinePushRetry {
+	return &offlinePushRetry{pushConf: pushConf, database: database, deadLetterDB: deadLetterDB}
+}
+
+// backoff returns the delay before the push is tried again, after attempts tries.
+func (r *offlinePushRetry) backoff(attempts int) time.Duration {
+	delay := time.Duration(r.pushConf.Retry.Interval) * time.Second
+	maxDelay := time.Duration(r.pushConf.Retry.MaxInterval) * time.Second
+	for i := 1; i < attempts && (maxDelay <= 0 || delay < maxDelay); i++ {
+		delay *= 2
+	}
+	if maxDelay > 0 && delay > maxDelay {
+		delay = maxDelay
+	}
+	return delay
+}
+
+// delays returns the delays of the retries, each delay is a tier with its own topic.
+func (r *offlinePushRetry) delays() []time.Duration {
+	var delays []time.Duration
+	for attempts := 1; attempts < r.pushConf.Retry.MaxAttempts; attempts++ {
+		if delay := r.backoff(attempts); !datautil.Contain(delay, delays...) {
+			delays = append(delays, delay)
+		}
+	}
+	return delays
+}
+
+// failed handles a push that failed after attempts tries, zero when it could not even be queued.
+// Only the users the push failed for are tried again.
+func (r *offlinePushRetry) failed(ctx context.Context, userIDs []string, msg *sdkws.MsgData, attempts int, err error) {
+	userIDs = offlinepush.FailedUserIDs(err, userIDs)
+	if len(userIDs) == 0 {
+		return
+	}
+	providers := offlinepush.FailedProviders(err)
+	if len(providers) == 0 {
+		providers = []string{r.pushConf.GetProviders(tenant.GetTenantID(ctx)).Enable}
+	}
+	class := errorClass(err)
+	if attempts < r.pushConf.Retry.MaxAttempts {
+		key := msgprocessor.GetConversationIDByMsg(msg)
+		retryErr := r.database.MsgToOfflinePushRetryMQ(ctx, key, userIDs, msg, attempts+1, r.backoff(attempts))
+		if retryErr == nil {
+			for _, provider := range providers {
+				prommetrics.OfflinePushRetryCall(provider, class, "retry")
+			}
+			return
+		}
+		log.ZWarn(ctx, "schedule offline push retry failed", retryErr, "attempts", attempts, "userIDs", userIDs)
+	}
+	for _, provider := range providers {
+		prommetrics.OfflinePushRetryCall(provider, class, "dead")
+	}
+	data, marshalErr := proto.Marshal(msg)
+	if marshalErr != nil {
+		log.ZError(ctx, "marshal offline push dead letter failed", marshalErr, "msg", msg)
+		return
+	}
+	letter := &model.OfflinePushDeadLetter{
+		ID:             primitive.NewObjectID(),
+		ConversationID: msgprocessor.GetConversationIDByMsg(msg),
+		ClientMsgID:    msg.ClientMsgID,
+		UserIDs:        userIDs,
+		Msg:            data,
+		Attempts:       attempts,
+		Providers:      providers,
+		ErrorClass:     class,
+		Error:          err.Error(),
+		CreateTime:     time.Now(),
+	}
+	if err := r.deadLetterDB.AddDeadLetter(ctx, letter); err != nil {
+		log.ZError(ctx, "add offline push dead letter failed", err, "userIDs", userIDs, "msg", msg)
+		return
+	}
+	log.ZWarn(ctx, "offline push kept as dead letter", err, "attempts", attempts, "userIDs", userIDs, "clientMsgID", msg.ClientMsgID)
+}
+
+func errorClass(err error) string {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return errorClassTimeout
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		if netErr.Timeout() {
+			return errorClassTimeout
+		}
+		return errorClassNetwork
+	}
+	return errorClassProvider
+}
+
+// OfflinePushRetryConsumerHandler pushes again the failed offline pushes of the retry topic once they are due.
+type OfflinePushRetryConsumerHandler struct {
+	OfflinePushRetryConsumerGroup *kafka.MConsumerGroup
+	offlinePush                   *OfflinePushConsumerHandler
+	retry                         *offlinePushRetry
+}
+
+// NewOfflinePushRetryConsumerHandler consumes the topics of all the retry delays, nil when the retries are disabled.
+func NewOfflinePushRetryConsumerHandler(config *Config, offlinePush *OfflinePushConsumerHandler, retry *offlinePushRetry) (*OfflinePushRetryConsumerHandler, error) {
+	delays := retry.delays()
+	if len(delays) == 0 {
+		return nil, nil
+	}
+	topics := datautil.Slice(delays, func(delay time.Duration) string {
+		return controller.OfflinePushRetryTopic(config.KafkaConfig.ToOfflinePushRetryTopic, delay)
+	})
+	consumerGroup, err := kafka.NewMConsumerGroup(config.KafkaConfig.Build(), config.KafkaConfig.ToOfflinePushRetryGroupID, topics, true)
+	if err != nil {
+		return nil, err
+	}
+	return &OfflinePushRetryConsumerHandler{OfflinePushRetryConsumerGroup: consumerGroup, offlinePush: offlinePush, retry: retry}, nil
+}
+
+func (*OfflinePushRetryConsumerHandler) Setup(sarama.ConsumerGroupSession) error   { return nil }
+func (*OfflinePushRetryConsumerHandler) Cleanup(sarama.ConsumerGroupSession) error { return nil }
+
+// ConsumeClaim pushes the messages of a delay tier once due. All the messages of a tier are delayed
+// alike, a message is due no later than those after it, so holding the claim until it is due delays no other retry.
+func (o *OfflinePushRetryConsumerHandler) ConsumeClaim(sess sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for msg := range claim.Messages() {
+		ctx, attempt, due := retryHeaders(msg.Headers)
+		ctx, _ = tenant.FromKey(ctx, string(msg.Key))
+		if wait := time.Until(due); wait > 0 {
+			timer := time.NewTimer(wait)
+			select {
+			case <-timer.C:
+			case <-sess.Context().Done():
+				timer.Stop()
+				return nil
+			}
+		}
+		o.handleRetry(ctx, msg.Value, attempt)
+		sess.MarkMessage(msg, "")
+	}
+	return nil
+}
+
+func (o *OfflinePushRetryConsumerHandler) handleRetry(ctx context.Context, value []byte, attempt int) {
+	var req pbpush.PushMsgReq
+	if err := proto.Unmarshal(value, &req); err != nil {
+		log.ZError(ctx, "offline push retry Unmarshal msg err", err, "msg", string(value))
+		return
+	}
+	if req.MsgData == nil || len(req.UserIDs) == 0 {
+		return
+	}
+	log.ZInfo(ctx, "receive to OfflinePushRetry MQ", "userIDs", req.UserIDs, "attempt", attempt, "clientMsgID", req.MsgData.ClientMsgID)
+	// The quiet hours may have begun since the first attempt.
+	userIDs := o.offlinePush.quietHours.filter(ctx, req.UserIDs, req.MsgData)
+	if len(userIDs) == 0 {
+		return
+	}
+	if err := o.offlinePush.offlinePushMsg(ctx, req.MsgData, userIDs); err != nil {
+		log.ZWarn(ctx, "offline push retry failed", err, "attempt", attempt, "userIDs", userIDs)
+		o.retry.failed(ctx, userIDs, req.MsgData, attempt, err)
+	}
+}
+
+// retryHeaders reads the retry headers, the context is made only of the context headers it expects.
+func retryHeaders(headers []*sarama.RecordHeader) (ctx context.Context, attempt int, due time.Time) {
+	values := make(map[string]string, len(headers))
+	for _, header := range headers {
+		values[string(header.Key)] = string(header.Value)
+	}
+	var ctxHeaders []*sarama.RecordHeader
+	for _, key := range []string{constant.OperationID, constant.OpUserID, constant.OpUserPlatform, constant.ConnID} {
+		ctxHeaders = append(ctxHeaders, &sarama.RecordHeader{Key: []byte(key), Value: []byte(values[key])})
+	}
+	attempt, _ = strconv.Atoi(values[controller.OfflinePushAttemptHeader])
+	if dueMilli, err := strconv.ParseInt(values[controller.OfflinePushDueHeader], 10, 64); err == nil {
+		due = time.UnixMilli(dueMilli)
+	}
+	return kafka.GetContextWithMQHeader(ctxHeaders), attempt, due
+}
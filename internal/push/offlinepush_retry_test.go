@@ -0,0 +1,147 @@
+package push
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush"
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/options"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+)
+
+type retryCall struct {
+	userIDs []string
+	attempt int
+	delay   time.Duration
+}
+
+type memoryPushDatabase struct {
+	controller.PushDatabase
+	retries []retryCall
+}
+
+func (m *memoryPushDatabase) MsgToOfflinePushRetryMQ(_ context.Context, _ string, userIDs []string, _ *sdkws.MsgData, attempt int, delay time.Duration) error {
+	m.retries = append(m.retries, retryCall{userIDs: userIDs, attempt: attempt, delay: delay})
+	return nil
+}
+
+type memoryDeadLetterDB struct {
+	controller.OfflinePushDeadLetterDatabase
+	letters []*model.OfflinePushDeadLetter
+}
+
+func (m *memoryDeadLetterDB) AddDeadLetter(_ context.Context, letter *model.OfflinePushDeadLetter) error {
+	m.letters = append(m.letters, letter)
+	return nil
+}
+
+func TestOfflinePushRetryBackoff(t *testing.T) {
+	pushConf := &config.Push{}
+	pushConf.Retry.Interval = 5
+	pushConf.Retry.MaxInterval = 30
+	r := newOfflinePushRetry(pushConf, nil, nil)
+	for attempts, expected := range map[int]time.Duration{
+		0: 5 * time.Second,
+		1: 5 * time.Second,
+		2: 10 * time.Second,
+		3: 20 * time.Second,
+		4: 30 * time.Second,
+		9: 30 * time.Second,
+	} {
+		if delay := r.backoff(attempts); delay != expected {
+			t.Errorf("backoff after %d attempts: expected %s, got %s", attempts, expected, delay)
+		}
+	}
+}
+
+func TestRetryHeaders(t *testing.T) {
+	due := time.UnixMilli(time.Now().Add(time.Minute).UnixMilli())
+	headers := []*sarama.RecordHeader{
+		{Key: []byte(constant.OperationID), Value: []byte("op1")},
+		{Key: []byte(constant.OpUserID), Value: []byte("admin")},
+		{Key: []byte(constant.OpUserPlatform), Value: []byte("Admin")},
+		{Key: []byte(constant.ConnID), Value: []byte("")},
+		{Key: []byte(controller.OfflinePushAttemptHeader), Value: []byte("3")},
+		{Key: []byte(controller.OfflinePushDueHeader), Value: []byte(strconv.FormatInt(due.UnixMilli(), 10))},
+	}
+	ctx, attempt, gotDue := retryHeaders(headers)
+	if attempt != 3 || !gotDue.Equal(due) {
+		t.Errorf("expected attempt 3 due %s, got %d due %s", due, attempt, gotDue)
+	}
+	if mcontext.GetOperationID(ctx) != "op1" || mcontext.GetOpUserID(ctx) != "admin" {
+		t.Errorf("unexpected context operationID %q opUserID %q", mcontext.GetOperationID(ctx), mcontext.GetOpUserID(ctx))
+	}
+}
+
+func TestOfflinePushRetryDelays(t *testing.T) {
+	pushConf := &config.Push{}
+	pushConf.Retry.MaxAttempts = 6
+	pushConf.Retry.Interval = 5
+	pushConf.Retry.MaxInterval = 12
+	delays := newOfflinePushRetry(pushConf, nil, nil).delays()
+	if len(delays) != 3 || delays[0] != 5*time.Second || delays[1] != 10*time.Second || delays[2] != 12*time.Second {
+		t.Errorf("unexpected delays %v", delays)
+	}
+	if topic := controller.OfflinePushRetryTopic("toOfflinePushRetry", delays[1]); topic != "toOfflinePushRetry.10s" {
+		t.Errorf("unexpected topic %s", topic)
+	}
+	pushConf.Retry.MaxAttempts = 1
+	if delays := newOfflinePushRetry(pushConf, nil, nil).delays(); len(delays) != 0 {
+		t.Errorf("expected no delay without retries, got %v", delays)
+	}
+}
+
+func TestOfflinePushRetryRequeuesFailedUsers(t *testing.T) {
+	pushConf := &config.Push{PushProviders: config.PushProviders{Enable: "router"}}
+	pushConf.Retry.MaxAttempts = 3
+	pushConf.Retry.Interval = 5
+	database := &memoryPushDatabase{}
+	deadLetterDB := &memoryDeadLetterDB{}
+	r := newOfflinePushRetry(pushConf, database, deadLetterDB)
+	msg := &sdkws.MsgData{SendID: "alice", RecvID: "bob", SessionType: constant.SingleChatType, ClientMsgID: "msg1"}
+	userIDs := []string{"bob", "carol", "dave"}
+	// The pushes of two groups of users failed, for carol through apns and for dave through fcm.
+	err := errors.Join(
+		errs.WrapMsg(&offlinepush.ProviderError{Provider: "apns", UserIDs: []string{"carol"}, Err: errs.New("apns unavailable")}, "offline push failed"),
+		&offlinepush.ProviderError{Provider: "fcm", UserIDs: []string{"dave"}, Err: errs.New("fcm unavailable")},
+	)
+
+	r.failed(context.Background(), userIDs, msg, 2, err)
+	if len(database.retries) != 1 || strings.Join(database.retries[0].userIDs, ",") != "carol,dave" ||
+		database.retries[0].attempt != 3 || database.retries[0].delay != 10*time.Second {
+		t.Fatalf("expected carol and dave to be retried in 10s, got %+v", database.retries)
+	}
+	if len(deadLetterDB.letters) != 0 {
+		t.Fatalf("unexpected dead letters %+v", deadLetterDB.letters)
+	}
+
+	r.failed(context.Background(), userIDs, msg, 3, &options.UsersError{UserIDs: []string{"dave"}, Err: errs.New("unavailable")})
+	if len(database.retries) != 1 {
+		t.Fatalf("expected no retry after the last attempt, got %+v", database.retries)
+	}
+	if len(deadLetterDB.letters) != 1 {
+		t.Fatalf("expected a dead letter, got %+v", deadLetterDB.letters)
+	}
+	letter := deadLetterDB.letters[0]
+	if strings.Join(letter.UserIDs, ",") != "dave" || letter.Attempts != 3 || letter.ClientMsgID != "msg1" ||
+		strings.Join(letter.Providers, ",") != "router" {
+		t.Errorf("unexpected dead letter %+v", letter)
+	}
+
+	// A push failing for a reason that names no user is retried for all of them.
+	r.failed(context.Background(), userIDs, msg, 1, errs.New("unavailable"))
+	if len(database.retries) != 2 || strings.Join(database.retries[1].userIDs, ",") != "bob,carol,dave" {
+		t.Errorf("expected all the users to be retried, got %+v", database.retries)
+	}
+}
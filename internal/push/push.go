@@ -2,21 +2,34 @@ package push
 
 import (
 	"context"
+	"errors"
 
 	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
-	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/redis"
+	redisCache "github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/redis"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/mgo"
+	pbpushext "github.com/openimsdk/open-im-server/v3/pkg/protocol/pushext"
+	pbuserext "github.com/openimsdk/open-im-server/v3/pkg/protocol/userext"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpccache"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpcli"
 	pbpush "github.com/openimsdk/protocol/push"
+	"github.com/openimsdk/tools/db/mongoutil"
 	"github.com/openimsdk/tools/db/redisutil"
 	"github.com/openimsdk/tools/discovery"
+	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/utils/runtimeenv"
+	"github.com/redis/go-redis/v9"
 	"google.golang.org/grpc"
 )
 
 type pushServer struct {
 	pbpush.UnimplementedPushMsgServiceServer
+	pbpushext.UnimplementedPushExtServer
 	database      controller.PushDatabase
+	deviceDB      controller.PushDeviceDatabase
+	deadLetterDB  controller.OfflinePushDeadLetterDatabase
+	imAdminUserID []string
 	disCov        discovery.SvcDiscoveryRegistry
 	offlinePusher offlinepush.OfflinePusher
 	pushCh        *ConsumerHandler
@@ -26,6 +39,7 @@ type pushServer struct {
 type Config struct {
 	RpcConfig          config.Push
 	RedisConfig        config.Redis
+	MongodbConfig      config.Mongo
 	KafkaConfig        config.Kafka
 	NotificationConfig config.Notification
 	Share              config.Share
@@ -39,9 +53,20 @@ type Config struct {
 
 func (p pushServer) DelUserPushToken(ctx context.Context,
 	req *pbpush.DelUserPushTokenReq) (resp *pbpush.DelUserPushTokenResp, err error) {
+	token, err := p.database.GetFcmToken(ctx, req.UserID, int(req.PlatformID))
+	if err != nil && !errors.Is(errs.Unwrap(err), redis.Nil) {
+		return nil, err
+	}
 	if err = p.database.DelFcmToken(ctx, req.UserID, int(req.PlatformID)); err != nil {
 		return nil, err
 	}
+	// The logout does not name the device, it is the one whose token the platform holds. The devices registered
+	// apart from it unregister with their device ID.
+	if token != "" {
+		if err = p.deviceDB.UnregisterTokenDevices(ctx, req.UserID, token); err != nil {
+			return nil, err
+		}
+	}
 	return &pbpush.DelUserPushTokenResp{}, nil
 }
 
@@ -52,35 +77,109 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	if err != nil {
 		return err
 	}
-	cacheModel := redis.NewThirdCache(rdb)
-	offlinePusher, err := offlinepush.NewOfflinePusher(&config.RpcConfig, cacheModel, config.FcmConfigPath)
+	mgocli, err := mongoutil.NewMongoDB(ctx, config.MongodbConfig.Build())
+	if err != nil {
+		return err
+	}
+	pushDeviceDB, err := mgo.NewPushDeviceMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	deadLetterDB, err := mgo.NewOfflinePushDeadLetterMongo(mgocli.GetDB(), config.RpcConfig.DeadLetterRetention())
+	if err != nil {
+		return err
+	}
+	quietHoursDB, err := mgo.NewPushQuietHoursMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	quietDigestDB, err := mgo.NewPushQuietDigestMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	templateDB, err := mgo.NewPushTemplateMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	groupConn, err := client.GetConn(ctx, config.Discovery.RpcService.Group)
+	if err != nil {
+		return err
+	}
+	var badge *badges
+	if config.RpcConfig.IOSPush.BadgeCount {
+		conversationConn, err := client.GetConn(ctx, config.Discovery.RpcService.Conversation)
+		if err != nil {
+			return err
+		}
+		seqConversation, err := mgo.NewSeqConversationMongo(mgocli.GetDB())
+		if err != nil {
+			return err
+		}
+		seqUser, err := mgo.NewSeqUserMongo(mgocli.GetDB())
+		if err != nil {
+			return err
+		}
+		conversationLocalCache := rpccache.NewConversationLocalCache(rpcli.NewConversationClient(conversationConn), &config.LocalCacheConfig, rdb)
+		badge = newBadges(redisCache.NewBadgeCacheRedis(rdb), conversationLocalCache,
+			redisCache.NewSeqConversationCacheRedis(rdb, seqConversation), redisCache.NewSeqUserCacheRedis(rdb, seqUser))
+	}
+	cacheModel := redisCache.NewThirdCache(rdb)
+	deviceDatabase := controller.NewPushDeviceDatabase(pushDeviceDB)
+	offlinePusher, err := offlinepush.NewOfflinePusher(&config.RpcConfig, cacheModel, deviceDatabase, config.FcmConfigPath)
 	if err != nil {
 		return err
 	}
 
 	database := controller.NewPushDatabase(cacheModel, &config.KafkaConfig)
+	deadLetterDatabase := controller.NewOfflinePushDeadLetterDatabase(deadLetterDB)
+	retry := newOfflinePushRetry(&config.RpcConfig, database, deadLetterDatabase)
+
+	userConn, err := client.GetConn(ctx, config.Discovery.RpcService.User)
+	if err != nil {
+		return err
+	}
+	templates := newPushTemplates(&config.RpcConfig, controller.NewPushTemplateDatabase(templateDB), deviceDatabase, pbuserext.NewUserExtClient(userConn),
+		rpcli.NewGroupClient(groupConn), config.Share.IMAdminUserID[0])
+	quiet := newQuietHours(controller.NewPushQuietHoursDatabase(quietHoursDB, quietDigestDB,
+		redisCache.NewPushQuietHoursCacheRedis(rdb, quietHoursDB, redisCache.GetRocksCacheOptions())))
+
+	consumer, err := NewConsumerHandler(ctx, config, database, offlinePusher, retry, quiet, templates, badge, rdb, client)
+	if err != nil {
+		return err
+	}
 
-	consumer, err := NewConsumerHandler(ctx, config, database, offlinePusher, rdb, client)
+	offlinePushConsumer, err := NewOfflinePushConsumerHandler(config, offlinePusher, retry, quiet, templates, badge)
 	if err != nil {
 		return err
 	}
 
-	offlinePushConsumer, err := NewOfflinePushConsumerHandler(config, offlinePusher)
+	offlinePushRetryConsumer, err := NewOfflinePushRetryConsumerHandler(config, offlinePushConsumer, retry)
 	if err != nil {
 		return err
 	}
 
-	pbpush.RegisterPushMsgServiceServer(server, &pushServer{
+	s := &pushServer{
 		database:      database,
+		deviceDB:      deviceDatabase,
+		deadLetterDB:  deadLetterDatabase,
+		imAdminUserID: config.Share.IMAdminUserID,
 		disCov:        client,
 		offlinePusher: offlinePusher,
 		pushCh:        consumer,
 		offlinePushCh: offlinePushConsumer,
-	})
+	}
+	pbpush.RegisterPushMsgServiceServer(server, s)
+	pbpushext.RegisterPushExtServer(server, s)
 
 	go consumer.pushConsumerGroup.RegisterHandleAndConsumer(ctx, consumer)
 
 	go offlinePushConsumer.OfflinePushConsumerGroup.RegisterHandleAndConsumer(ctx, offlinePushConsumer)
 
+	if offlinePushRetryConsumer != nil {
+		go offlinePushRetryConsumer.OfflinePushRetryConsumerGroup.RegisterHandleAndConsumer(ctx, offlinePushRetryConsumer)
+	}
+
+	go quiet.runDigest(ctx, offlinePushConsumer, config.Share.Tenants)
+
 	return nil
 }
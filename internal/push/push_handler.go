@@ -14,6 +14,7 @@ import (
 	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/options"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/webhook"
 	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpccache"
@@ -38,6 +39,10 @@ type ConsumerHandler struct {
 	offlinePusher          offlinepush.OfflinePusher
 	onlinePusher           OnlinePusher
 	pushDatabase           controller.PushDatabase
+	offlinePushRetry       *offlinePushRetry
+	quietHours             *quietHours
+	templates              *pushTemplates
+	badges                 *badges
 	onlineCache            *rpccache.OnlineCache
 	groupLocalCache        *rpccache.GroupLocalCache
 	conversationLocalCache *rpccache.ConversationLocalCache
@@ -49,8 +54,8 @@ type ConsumerHandler struct {
 	conversationClient     *rpcli.ConversationClient
 }
 
-func NewConsumerHandler(ctx context.Context, config *Config, database controller.PushDatabase, offlinePusher offlinepush.OfflinePusher, rdb redis.UniversalClient,
-	client discovery.SvcDiscoveryRegistry) (*ConsumerHandler, error) {
+func NewConsumerHandler(ctx context.Context, config *Config, database controller.PushDatabase, offlinePusher offlinepush.OfflinePusher, retry *offlinePushRetry,
+	quietHours *quietHours, templates *pushTemplates, badges *badges, rdb redis.UniversalClient, client discovery.SvcDiscoveryRegistry) (*ConsumerHandler, error) {
 	var consumerHandler ConsumerHandler
 	var err error
 	consumerHandler.pushConsumerGroup, err = kafka.NewMConsumerGroup(config.KafkaConfig.Build(), config.KafkaConfig.ToPushGroupID,
@@ -83,10 +88,14 @@ func NewConsumerHandler(ctx context.Context, config *Config, database controller
 	consumerHandler.onlinePusher = NewOnlinePusher(client, config)
 	consumerHandler.groupLocalCache = rpccache.NewGroupLocalCache(consumerHandler.groupClient, &config.LocalCacheConfig, rdb)
 	consumerHandler.conversationLocalCache = rpccache.NewConversationLocalCache(consumerHandler.conversationClient, &config.LocalCacheConfig, rdb)
-	consumerHandler.webhookClient = webhook.NewWebhookClient(config.WebhooksConfig.URL)
+	consumerHandler.webhookClient = webhook.NewWebhookClient(&config.WebhooksConfig)
 	consumerHandler.config = config
 	consumerHandler.pushDatabase = database
-	consumerHandler.onlineCache, err = rpccache.NewOnlineCache(consumerHandler.userClient, consumerHandler.groupLocalCache, rdb, config.RpcConfig.FullUserCache, nil)
+	consumerHandler.offlinePushRetry = retry
+	consumerHandler.quietHours = quietHours
+	consumerHandler.templates = templates
+	consumerHandler.badges = badges
+	consumerHandler.onlineCache, err = rpccache.NewOnlineCache(consumerHandler.userClient, consumerHandler.groupLocalCache, rdb, config.RpcConfig.FullUserCache, config.Share.TenantIDs(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -141,7 +150,7 @@ func (c *ConsumerHandler) ConsumeClaim(sess sarama.ConsumerGroupSession, claim s
 	log.ZInfo(ctx, "begin consume messages")
 
 	for msg := range claim.Messages() {
-		ctx := c.pushConsumerGroup.GetContextFromMsg(msg)
+		ctx, _ := tenant.FromKey(c.pushConsumerGroup.GetContextFromMsg(msg), string(msg.Key))
 		c.handleMs2PsChat(ctx, msg.Value)
 		sess.MarkMessage(msg, "")
 	}
@@ -155,6 +164,7 @@ func (c *ConsumerHandler) Push2User(ctx context.Context, userIDs []string, msg *
 		t := time.Since(duration)
 		log.ZInfo(ctx, "Get msg from msg_transfer And push msg end", "msg", msg.String(), "time cost", t)
 	}(time.Now())
+	c.badges.sent(ctx, msg, userIDs)
 	if err := c.webhookBeforeOnlinePush(ctx, &c.config.WebhooksConfig.BeforeOnlinePush, userIDs, msg); err != nil {
 		return err
 	}
@@ -193,10 +203,15 @@ func (c *ConsumerHandler) Push2User(ctx context.Context, userIDs []string, msg *
 	if len(offlinePushUserID) > 0 {
 		needOfflinePushUserID = offlinePushUserID
 	}
+	needOfflinePushUserID = c.quietHours.filter(ctx, needOfflinePushUserID, msg)
+	if len(needOfflinePushUserID) == 0 {
+		return nil
+	}
 	err = c.offlinePushMsg(ctx, msg, needOfflinePushUserID)
 	if err != nil {
 		log.ZDebug(ctx, "offlinePushMsg failed", err, "needOfflinePushUserID", needOfflinePushUserID, "msg", msg)
 		log.ZWarn(ctx, "offlinePushMsg failed", err, "needOfflinePushUserID length", len(needOfflinePushUserID), "msg", msg)
+		c.offlinePushRetry.failed(ctx, needOfflinePushUserID, msg, 1, err)
 		return nil
 	}
 
@@ -256,6 +271,7 @@ func (c *ConsumerHandler) Push2Group(ctx context.Context, groupID string, msg *s
 	if err != nil {
 		return err
 	}
+	c.badges.sent(ctx, msg, pushToUserIDs)
 
 	wsResults, err := c.GetConnsAndOnlinePush(ctx, msg, pushToUserIDs)
 	if err != nil {
@@ -301,6 +317,7 @@ func (c *ConsumerHandler) asyncOfflinePush(ctx context.Context, needOfflinePushU
 		log.ZWarn(ctx, "Msg To OfflinePush MQ error", err, "needOfflinePushUserIDs length",
 			len(needOfflinePushUserIDs), "msg", msg)
 		prommetrics.GroupChatMsgProcessFailedCounter.Inc()
+		c.offlinePushRetry.failed(ctx, needOfflinePushUserIDs, msg, 0, err)
 		return
 	}
 }
@@ -359,7 +376,8 @@ func (c *ConsumerHandler) offlinePushMsg(ctx context.Context, msg *sdkws.MsgData
 		log.ZError(ctx, "getOfflinePushInfos failed", err, "msg", msg)
 		return err
 	}
-	err = c.offlinePusher.Push(ctx, offlinePushUserIDs, title, content, opts)
+	opts.Badges = c.badges.get(ctx, offlinePushUserIDs)
+	err = c.templates.push(ctx, c.offlinePusher, offlinePushUserIDs, msg, title, content, opts)
 	if err != nil {
 		prommetrics.MsgOfflinePushFailedCounter.Inc()
 		return err
@@ -383,7 +401,18 @@ func (c *ConsumerHandler) getOfflinePushInfos(msg *sdkws.MsgData) (title, conten
 		IsAtSelf   bool     `json:"isAtSelf"`
 	}
 
-	opts = &options.Opts{Signal: &options.Signal{ClientMsgID: msg.ClientMsgID}}
+	opts = &options.Opts{
+		Signal:         &options.Signal{ClientMsgID: msg.ClientMsgID},
+		ConversationID: msgprocessor.GetConversationIDByMsg(msg),
+		Msg: &options.Msg{
+			SendID:      msg.SendID,
+			GroupID:     msg.GroupID,
+			SessionType: msg.SessionType,
+			ContentType: msg.ContentType,
+			Seq:         msg.Seq,
+			SendTime:    msg.SendTime,
+		},
+	}
 	if msg.OfflinePushInfo != nil {
 		opts.IOSBadgeCount = msg.OfflinePushInfo.IOSBadgeCount
 		opts.IOSPushSound = msg.OfflinePushInfo.IOSPushSound
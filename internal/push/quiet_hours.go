@@ -0,0 +1,269 @@
+package push
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/openimsdk/tools/utils/idutil"
+	"github.com/openimsdk/tools/utils/jsonutil"
+)
+
+const (
+	digestInterval  = time.Minute
+	digestBatchSize = 100
+
+	// maxChainedWindows bounds the windows followed when one ends inside another, e.g. 22:00-24:00 then 00:00-07:00.
+	maxChainedWindows = 16
+)
+
+// quietHours suppresses the offline pushes of the users whose do-not-disturb schedule is active,
+// and pushes a digest of them when the schedule ends if the user asked for one.
+type quietHours struct {
+	db controller.PushQuietHoursDatabase
+}
+
+func newQuietHours(db controller.PushQuietHoursDatabase) *quietHours {
+	return &quietHours{db: db}
+}
+
+// filter returns the users to push the message to now, the others are dropped or counted in their digest,
+// a digest suppressed again being added back whole. The users are pushed to when their settings can not be read.
+func (q *quietHours) filter(ctx context.Context, userIDs []string, msg *sdkws.MsgData) []string {
+	if len(userIDs) == 0 {
+		return userIDs
+	}
+	settings, err := q.db.FindQuietHours(ctx, userIDs)
+	if err != nil {
+		log.ZWarn(ctx, "find push quiet hours failed", err, "userIDs", userIDs)
+		return userIDs
+	}
+	if len(settings) == 0 {
+		return userIDs
+	}
+	conversationID := msgprocessor.GetConversationIDByMsg(msg)
+	count, conversationIDs := int64(1), []string{conversationID}
+	if digest, ok := parseDigest(msg); ok {
+		count, conversationIDs = digest.Count, digest.ConversationIDs
+	} else if conversationID == "" {
+		conversationIDs = nil
+	}
+	now := time.Now()
+	suppressed := make(map[string]struct{})
+	var digests []*model.PushQuietDigest
+	for _, setting := range settings {
+		until := quietUntil(setting, now)
+		if until.IsZero() || notifyThrough(setting, conversationID, msg) {
+			continue
+		}
+		suppressed[setting.UserID] = struct{}{}
+		if setting.Digest {
+			digests = append(digests, &model.PushQuietDigest{UserID: setting.UserID, Count: count, ConversationIDs: conversationIDs, SendTime: until})
+		}
+	}
+	if len(suppressed) == 0 {
+		return userIDs
+	}
+	if err := q.db.AddToDigests(ctx, digests); err != nil {
+		log.ZWarn(ctx, "add push to quiet hours digests failed", err, "count", len(digests))
+	}
+	log.ZDebug(ctx, "offline push suppressed by quiet hours", "count", len(suppressed), "conversationID", conversationID)
+	return datautil.Filter(userIDs, func(userID string) (string, bool) {
+		_, ok := suppressed[userID]
+		return userID, !ok
+	})
+}
+
+// notifyThrough reports whether an override of the user lets the message through the quiet hours.
+func notifyThrough(setting *model.PushQuietHours, conversationID string, msg *sdkws.MsgData) bool {
+	for _, override := range setting.Overrides {
+		if override.ConversationID != "" && override.ConversationID != conversationID {
+			continue
+		}
+		switch override.Notify {
+		case model.QuietNotifyAlways:
+			return true
+		case model.QuietNotifyMentions:
+			if isMentioned(setting.UserID, msg) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isMentioned(userID string, msg *sdkws.MsgData) bool {
+	if msg.ContentType != constant.AtText {
+		return false
+	}
+	return datautil.Contain(userID, msg.AtUserIDList...) || datautil.Contain(constant.AtAllString, msg.AtUserIDList...)
+}
+
+// quietUntil returns when the quiet hours active at now end, zero when they are not active.
+func quietUntil(setting *model.PushQuietHours, now time.Time) time.Time {
+	loc, err := time.LoadLocation(setting.TimeZone)
+	if err != nil {
+		loc = time.UTC
+	}
+	var until time.Time
+	at := now
+	if setting.SnoozeUntil.After(now) {
+		until, at = setting.SnoozeUntil, setting.SnoozeUntil
+	}
+	for i := 0; i < maxChainedWindows; i++ {
+		end, ok := windowEnd(setting.Windows, at.In(loc))
+		if !ok {
+			break
+		}
+		until, at = end, end
+	}
+	return until
+}
+
+// windowEnd returns the latest end of the windows t is in, the windows which started the day before are checked too.
+func windowEnd(windows []model.PushQuietWindow, t time.Time) (time.Time, bool) {
+	var (
+		end   time.Time
+		found bool
+	)
+	for _, window := range windows {
+		for _, offset := range []int{0, -1} {
+			day := time.Date(t.Year(), t.Month(), t.Day()+offset, 0, 0, 0, 0, t.Location())
+			if len(window.Weekdays) != 0 && !datautil.Contain(int(day.Weekday()), window.Weekdays...) {
+				continue
+			}
+			start := day.Add(time.Duration(window.Start) * time.Minute)
+			stop := day.Add(time.Duration(window.End) * time.Minute)
+			if window.End <= window.Start {
+				stop = stop.Add(24 * time.Hour)
+			}
+			if !t.Before(start) && t.Before(stop) && stop.After(end) {
+				end, found = stop, true
+			}
+		}
+	}
+	return end, found
+}
+
+// runDigest pushes the digests whose quiet hours ended, until ctx is done. The digests of every
+// tenant are stored apart, so each tenant is swept in turn.
+func (q *quietHours) runDigest(ctx context.Context, push *OfflinePushConsumerHandler, tenants []config.Tenant) {
+	ticker := time.NewTicker(digestInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			ctx := mcontext.SetOperationID(ctx, "quiet_hours_digest_"+idutil.OperationIDGenerator())
+			q.pushDigests(ctx, push)
+			for _, t := range tenants {
+				q.pushDigests(tenant.WithTenantID(ctx, t.ID), push)
+			}
+		}
+	}
+}
+
+// pushDigests pushes the due digests like the other offline pushes, so they are rendered with the templates
+// of the users and retried when they fail.
+func (q *quietHours) pushDigests(ctx context.Context, push *OfflinePushConsumerHandler) {
+	now := time.Now()
+	digests, err := q.db.FindDueDigests(ctx, now, digestBatchSize)
+	if err != nil {
+		log.ZWarn(ctx, "find due quiet hours digests failed", err)
+		return
+	}
+	for _, due := range digests {
+		// Another instance may push the same digest, the one removing it pushes it.
+		digest, err := q.db.TakeDueDigest(ctx, due.UserID, now)
+		if err != nil {
+			log.ZWarn(ctx, "take quiet hours digest failed", err, "userID", due.UserID)
+			continue
+		}
+		if digest == nil {
+			continue
+		}
+		msg := digestMsg(digest, now)
+		// The user may have snoozed the pushes since the digest was counted.
+		userIDs := q.filter(ctx, []string{digest.UserID}, msg)
+		if len(userIDs) == 0 {
+			continue
+		}
+		if err := push.offlinePushMsg(ctx, msg, userIDs); err != nil {
+			log.ZWarn(ctx, "push quiet hours digest failed", err, "userID", digest.UserID)
+			push.retry.failed(ctx, userIDs, msg, 1, err)
+		}
+	}
+}
+
+// digestElem is the content of the digest messages.
+type digestElem struct {
+	Count           int64    `json:"count"`
+	ConversationIDs []string `json:"conversationIDs"`
+}
+
+// digestMsg returns the message pushing the digest. The digest of a single conversation is in that conversation,
+// so opening the push opens it.
+func digestMsg(digest *model.PushQuietDigest, now time.Time) *sdkws.MsgData {
+	msg := &sdkws.MsgData{
+		RecvID:      digest.UserID,
+		ClientMsgID: fmt.Sprintf("quiet_digest_%s_%d", digest.UserID, digest.SendTime.UnixMilli()),
+		ContentType: model.PushTemplateQuietDigest,
+		Content:     []byte(jsonutil.StructToJsonString(digestElem{Count: digest.Count, ConversationIDs: digest.ConversationIDs})),
+		SendTime:    now.UnixMilli(),
+		Status:      constant.MsgStatusSendSuccess,
+	}
+	if len(digest.ConversationIDs) != 1 {
+		return msg
+	}
+	conversationID := digest.ConversationIDs[0]
+	switch {
+	case strings.HasPrefix(conversationID, "sg_"):
+		msg.SessionType, msg.GroupID = constant.ReadGroupChatType, strings.TrimPrefix(conversationID, "sg_")
+	case strings.HasPrefix(conversationID, "g_"):
+		msg.SessionType, msg.GroupID = constant.WriteGroupChatType, strings.TrimPrefix(conversationID, "g_")
+	case strings.HasPrefix(conversationID, "si_"):
+		ids := strings.TrimPrefix(conversationID, "si_")
+		if sendID, ok := strings.CutPrefix(ids, digest.UserID+"_"); ok {
+			msg.SessionType, msg.SendID = constant.SingleChatType, sendID
+		} else if sendID, ok := strings.CutSuffix(ids, "_"+digest.UserID); ok {
+			msg.SessionType, msg.SendID = constant.SingleChatType, sendID
+		}
+	}
+	return msg
+}
+
+func parseDigest(msg *sdkws.MsgData) (*digestElem, bool) {
+	if msg.ContentType != model.PushTemplateQuietDigest {
+		return nil, false
+	}
+	var elem digestElem
+	if err := jsonutil.JsonUnmarshal(msg.Content, &elem); err != nil {
+		return nil, false
+	}
+	return &elem, true
+}
+
+// digestText returns the title and content of the digests pushed without template.
+func digestText(msg *sdkws.MsgData) (title, content string) {
+	digest, ok := parseDigest(msg)
+	if !ok {
+		return constant.ContentType2PushContent[constant.Common], ""
+	}
+	title = fmt.Sprintf("%d new messages", digest.Count)
+	if len(digest.ConversationIDs) == 1 {
+		return title, title
+	}
+	return title, fmt.Sprintf("%d new messages in %d conversations", digest.Count, len(digest.ConversationIDs))
+}
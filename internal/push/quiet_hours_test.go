@@ -0,0 +1,136 @@
+package push
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+func TestQuietUntil(t *testing.T) {
+	loc, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Skip(err)
+	}
+	setting := &model.PushQuietHours{
+		TimeZone: "Asia/Shanghai",
+		Windows: []model.PushQuietWindow{
+			// Weeknights from 22:00 to 07:00, Friday night until midnight only.
+			{Weekdays: []int{0, 1, 2, 3, 4}, Start: 22 * 60, End: 7 * 60},
+			{Weekdays: []int{5}, Start: 22 * 60, End: 24 * 60},
+			// Saturday from midnight to noon, following the Friday window.
+			{Weekdays: []int{6}, Start: 0, End: 12 * 60},
+		},
+	}
+	for _, c := range []struct {
+		name  string
+		now   time.Time
+		until time.Time
+	}{
+		{"monday evening", time.Date(2026, 10, 19, 20, 0, 0, 0, loc), time.Time{}},
+		{"monday night", time.Date(2026, 10, 19, 23, 0, 0, 0, loc), time.Date(2026, 10, 20, 7, 0, 0, 0, loc)},
+		{"tuesday early morning", time.Date(2026, 10, 20, 6, 59, 0, 0, loc), time.Date(2026, 10, 20, 7, 0, 0, 0, loc)},
+		{"tuesday morning", time.Date(2026, 10, 20, 7, 0, 0, 0, loc), time.Time{}},
+		{"friday night chained", time.Date(2026, 10, 23, 23, 0, 0, 0, loc), time.Date(2026, 10, 24, 12, 0, 0, 0, loc)},
+	} {
+		if until := quietUntil(setting, c.now); !until.Equal(c.until) {
+			t.Errorf("%s: expected %s, got %s", c.name, c.until, until)
+		}
+	}
+
+	// A snooze ending inside a window lasts until the window ends.
+	setting.SnoozeUntil = time.Date(2026, 10, 19, 22, 30, 0, 0, loc)
+	if until := quietUntil(setting, time.Date(2026, 10, 19, 15, 0, 0, 0, loc)); !until.Equal(time.Date(2026, 10, 20, 7, 0, 0, 0, loc)) {
+		t.Errorf("expected the snooze to last until the window ends, got %s", until)
+	}
+}
+
+func TestNotifyThrough(t *testing.T) {
+	setting := &model.PushQuietHours{
+		UserID: "alice",
+		Overrides: []model.PushQuietOverride{
+			{ConversationID: "sg_team", Notify: model.QuietNotifyMentions},
+			{ConversationID: "si_alice_boss", Notify: model.QuietNotifyAlways},
+		},
+	}
+	mention := &sdkws.MsgData{ContentType: constant.AtText, AtUserIDList: []string{"alice"}}
+	text := &sdkws.MsgData{ContentType: constant.Text}
+	if !notifyThrough(setting, "sg_team", mention) {
+		t.Error("expected the mention to be notified")
+	}
+	if notifyThrough(setting, "sg_team", text) {
+		t.Error("expected the text message to be suppressed")
+	}
+	if notifyThrough(setting, "sg_other", mention) {
+		t.Error("expected the mention of another group to be suppressed")
+	}
+	if !notifyThrough(setting, "si_alice_boss", text) {
+		t.Error("expected the message of the always notified conversation to be notified")
+	}
+}
+
+type memoryQuietHoursDB struct {
+	controller.PushQuietHoursDatabase
+	settings []*model.PushQuietHours
+	digests  []*model.PushQuietDigest
+}
+
+func (m *memoryQuietHoursDB) FindQuietHours(_ context.Context, userIDs []string) ([]*model.PushQuietHours, error) {
+	return datautil.Filter(m.settings, func(e *model.PushQuietHours) (*model.PushQuietHours, bool) {
+		return e, datautil.Contain(e.UserID, userIDs...)
+	}), nil
+}
+
+func (m *memoryQuietHoursDB) AddToDigests(_ context.Context, digests []*model.PushQuietDigest) error {
+	m.digests = append(m.digests, digests...)
+	return nil
+}
+
+func TestQuietHoursFilter(t *testing.T) {
+	allDay := []model.PushQuietWindow{{Start: 0, End: 24 * 60}}
+	db := &memoryQuietHoursDB{settings: []*model.PushQuietHours{
+		{UserID: "alice", Windows: allDay, Digest: true},
+		{UserID: "bob", Windows: allDay},
+	}}
+	q := newQuietHours(db)
+	msg := &sdkws.MsgData{SessionType: constant.ReadGroupChatType, GroupID: "team", ContentType: constant.Text}
+	userIDs := q.filter(context.Background(), []string{"alice", "bob", "carol"}, msg)
+	if len(userIDs) != 1 || userIDs[0] != "carol" {
+		t.Fatalf("expected only carol to be pushed to, got %v", userIDs)
+	}
+	if len(db.digests) != 1 || db.digests[0].UserID != "alice" || db.digests[0].Count != 1 || db.digests[0].ConversationIDs[0] != "sg_team" {
+		t.Fatalf("expected the message in the digest of alice, got %+v", db.digests)
+	}
+
+	// A digest pushed while the quiet hours are active again goes back whole into the next digest.
+	db.digests = nil
+	digest := digestMsg(&model.PushQuietDigest{UserID: "alice", Count: 3, ConversationIDs: []string{"sg_team", "sg_other"}}, time.Now())
+	if userIDs := q.filter(context.Background(), []string{"alice"}, digest); len(userIDs) != 0 {
+		t.Fatalf("expected the digest to be suppressed, got %v", userIDs)
+	}
+	if len(db.digests) != 1 || db.digests[0].Count != 3 || len(db.digests[0].ConversationIDs) != 2 {
+		t.Fatalf("expected the digest to be added back, got %+v", db.digests)
+	}
+}
+
+func TestDigestMsg(t *testing.T) {
+	for _, conversationID := range []string{"sg_team", "g_team", "si_alice_bob", "si_adam_alice"} {
+		msg := digestMsg(&model.PushQuietDigest{UserID: "alice", Count: 2, ConversationIDs: []string{conversationID}}, time.Now())
+		if got := msgprocessor.GetConversationIDByMsg(msg); got != conversationID {
+			t.Errorf("expected the digest in %s, got %q", conversationID, got)
+		}
+	}
+	msg := digestMsg(&model.PushQuietDigest{UserID: "alice", Count: 2, ConversationIDs: []string{"sg_team", "sg_other"}}, time.Now())
+	if got := msgprocessor.GetConversationIDByMsg(msg); got != "" {
+		t.Errorf("expected the digest of several conversations in none, got %q", got)
+	}
+	if title, content := digestText(msg); title != "2 new messages" || content != "2 new messages in 2 conversations" {
+		t.Errorf("unexpected digest text %q %q", title, content)
+	}
+}
@@ -0,0 +1,335 @@
+package push
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush"
+	"github.com/openimsdk/open-im-server/v3/internal/push/offlinepush/options"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/openimsdk/open-im-server/v3/pkg/localcache"
+	"github.com/openimsdk/open-im-server/v3/pkg/localcache/lru"
+	pbuserext "github.com/openimsdk/open-im-server/v3/pkg/protocol/userext"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpcli"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/openimsdk/tools/utils/jsonutil"
+)
+
+const (
+	// templateReloadInterval bounds how long a template changed by the admin takes to be used.
+	templateReloadInterval = time.Minute
+	maxPreviewLength       = 100
+
+	localeExpire    = time.Minute * 10
+	localeCacheSize = 1024 * 64
+
+	varSenderNickname = "{senderNickname}"
+	varGroupName      = "{groupName}"
+	varPreview        = "{preview}"
+	// The variables of the quiet hours digests.
+	varCount             = "{count}"
+	varConversationCount = "{conversationCount}"
+)
+
+type templateKey struct {
+	contentType int32
+	locale      string
+}
+
+// pushTemplates renders the offline pushes with the templates of the admin, in the locale of each user.
+type pushTemplates struct {
+	conf          *config.Push
+	db            controller.PushTemplateDatabase
+	deviceDB      controller.PushDeviceDatabase
+	userExtClient pbuserext.UserExtClient
+	groupClient   *rpcli.GroupClient
+	imAdminUserID string
+	// locales caches the locales of the users, a locale changed by the user is used within localeExpire.
+	locales lru.LRU[string, string]
+
+	lock sync.Mutex
+	// templates holds the templates of each tenant, stored apart.
+	templates map[string]*loadedTemplates
+}
+
+// loadedTemplates are the templates of a tenant and when they were read.
+type loadedTemplates struct {
+	templates map[templateKey]*model.PushTemplate
+	loadTime  time.Time
+}
+
+func newPushTemplates(conf *config.Push, db controller.PushTemplateDatabase, deviceDB controller.PushDeviceDatabase, userExtClient pbuserext.UserExtClient,
+	groupClient *rpcli.GroupClient, imAdminUserID string) *pushTemplates {
+	return &pushTemplates{
+		conf:          conf,
+		db:            db,
+		deviceDB:      deviceDB,
+		userExtClient: userExtClient,
+		groupClient:   groupClient,
+		imAdminUserID: imAdminUserID,
+		templates:     make(map[string]*loadedTemplates),
+		// One LRU, not slots, keeps the users missing from it read in one batch.
+		locales: lru.NewLayLRU[string, string](localeCacheSize, localeExpire, time.Second*3, localcache.EmptyTarget{}, func(string, string) {}),
+	}
+}
+
+// load returns the templates of the tenant of ctx, read again from the database once they are older than templateReloadInterval.
+// The templates read before are kept when they can not be read.
+func (t *pushTemplates) load(ctx context.Context) map[templateKey]*model.PushTemplate {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	tenantID := tenant.GetTenantID(ctx)
+	loaded, ok := t.templates[tenantID]
+	if !ok {
+		loaded = &loadedTemplates{}
+		t.templates[tenantID] = loaded
+	}
+	if loaded.templates != nil && time.Since(loaded.loadTime) < templateReloadInterval {
+		return loaded.templates
+	}
+	loaded.loadTime = time.Now()
+	templates, err := t.db.GetTemplates(ctx)
+	if err != nil {
+		log.ZWarn(ctx, "load push templates failed", err)
+		return loaded.templates
+	}
+	loaded.templates = make(map[templateKey]*model.PushTemplate, len(templates))
+	for _, template := range templates {
+		loaded.templates[templateKey{contentType: template.ContentType, locale: template.Locale}] = template
+	}
+	return loaded.templates
+}
+
+// push pushes msg to the users, rendered with the template of their locale. Title and content are pushed to the users
+// without template, and to all of them when the sender set the title of the push.
+func (t *pushTemplates) push(ctx context.Context, pusher offlinepush.OfflinePusher, userIDs []string, msg *sdkws.MsgData,
+	title, content string, opts *options.Opts) error {
+	if msg.OfflinePushInfo != nil && msg.OfflinePushInfo.Title != "" {
+		return pusher.Push(ctx, userIDs, title, content, opts)
+	}
+	return t.pushRendered(ctx, pusher, userIDs, msg, title, content, opts)
+}
+
+// pushRendered pushes each group of users sharing a template apart.
+func (t *pushTemplates) pushRendered(ctx context.Context, pusher offlinepush.OfflinePusher, userIDs []string, msg *sdkws.MsgData,
+	title, content string, opts *options.Opts) error {
+	templates := t.load(ctx)
+	if len(templates) == 0 {
+		return pusher.Push(ctx, userIDs, title, content, opts)
+	}
+	locales := t.userLocales(ctx, userIDs)
+	var (
+		order  []*model.PushTemplate
+		groups = make(map[*model.PushTemplate][]string)
+	)
+	for _, userID := range userIDs {
+		template := findTemplate(templates, msg.ContentType, locales[userID], t.conf.Template.DefaultLocale)
+		if _, ok := groups[template]; !ok {
+			order = append(order, template)
+		}
+		groups[template] = append(groups[template], userID)
+	}
+	replacer := t.replacer(ctx, order, msg)
+	var errList []error
+	for _, template := range order {
+		pushTitle, pushContent := title, content
+		if template != nil {
+			pushTitle, pushContent = renderTemplate(template, replacer)
+		}
+		if err := pusher.Push(ctx, groups[template], pushTitle, pushContent, opts); err != nil {
+			errList = append(errList, err)
+		}
+	}
+	return errors.Join(errList...)
+}
+
+// findTemplate returns the template of the content type in the locale, its language, or the default locale,
+// the locale of the user being preferred to the content type.
+func findTemplate(templates map[templateKey]*model.PushTemplate, contentType int32, locale, defaultLocale string) *model.PushTemplate {
+	contentTypes := []int32{contentType, model.PushTemplateDefault}
+	if contentType == model.PushTemplateQuietDigest {
+		contentTypes = contentTypes[:1]
+	}
+	for _, l := range localeCandidates(locale, defaultLocale) {
+		for _, c := range contentTypes {
+			if template, ok := templates[templateKey{contentType: c, locale: l}]; ok {
+				return template
+			}
+		}
+	}
+	return nil
+}
+
+// localeCandidates returns the locales to look a template up in, e.g. zh-tw, zh, then en for the default locale en.
+func localeCandidates(locale, defaultLocale string) []string {
+	var candidates []string
+	for _, l := range []string{controller.NormalizeLocale(locale), controller.NormalizeLocale(defaultLocale)} {
+		if l == "" {
+			continue
+		}
+		for _, c := range []string{l, strings.SplitN(l, "-", 2)[0]} {
+			if !datautil.Contain(c, candidates...) {
+				candidates = append(candidates, c)
+			}
+		}
+	}
+	return candidates
+}
+
+func renderTemplate(template *model.PushTemplate, replacer *strings.Replacer) (title, content string) {
+	title = replacer.Replace(template.Title)
+	content = replacer.Replace(template.Content)
+	if content == "" {
+		content = title
+	}
+	return title, content
+}
+
+// replacer returns the replacer of the template variables, the group is only read when a template uses its name.
+func (t *pushTemplates) replacer(ctx context.Context, templates []*model.PushTemplate, msg *sdkws.MsgData) *strings.Replacer {
+	var groupName string
+	if msg.GroupID != "" && usesVariable(templates, varGroupName) {
+		group, err := t.groupClient.GetGroupInfoCache(ctx, msg.GroupID)
+		if err != nil {
+			log.ZWarn(ctx, "get group of push template failed", err, "groupID", msg.GroupID)
+		} else {
+			groupName = group.GroupName
+		}
+	}
+	var count, conversationCount int
+	if digest, ok := parseDigest(msg); ok {
+		count, conversationCount = int(digest.Count), len(digest.ConversationIDs)
+	}
+	return strings.NewReplacer(
+		varSenderNickname, msg.SenderNickname,
+		varGroupName, groupName,
+		varPreview, msgPreview(msg),
+		varCount, strconv.Itoa(count),
+		varConversationCount, strconv.Itoa(conversationCount),
+	)
+}
+
+func usesVariable(templates []*model.PushTemplate, variable string) bool {
+	for _, template := range templates {
+		if template != nil && (strings.Contains(template.Title, variable) || strings.Contains(template.Content, variable)) {
+			return true
+		}
+	}
+	return false
+}
+
+// msgPreview returns the text of the text messages, cut to maxPreviewLength characters, and the kind of the others.
+func msgPreview(msg *sdkws.MsgData) string {
+	var text string
+	switch msg.ContentType {
+	case constant.Text:
+		var elem struct {
+			Content string `json:"content"`
+		}
+		_ = jsonutil.JsonStringToStruct(string(msg.Content), &elem)
+		text = elem.Content
+	case constant.AtText:
+		var elem struct {
+			Text string `json:"text"`
+		}
+		_ = jsonutil.JsonStringToStruct(string(msg.Content), &elem)
+		text = elem.Text
+	}
+	if text == "" {
+		if kind, ok := constant.ContentType2PushContent[int64(msg.ContentType)]; ok {
+			return kind
+		}
+		return constant.ContentType2PushContent[constant.Common]
+	}
+	if runes := []rune(text); len(runes) > maxPreviewLength {
+		text = string(runes[:maxPreviewLength]) + "..."
+	}
+	return text
+}
+
+// userLocales returns the locale of the users, the one of their last seen device with a locale,
+// else the one of their profile. The users without locale are left out.
+func (t *pushTemplates) userLocales(ctx context.Context, userIDs []string) map[string]string {
+	keys := datautil.Slice(userIDs, func(userID string) string { return tenant.Key(ctx, userID) })
+	values, err := t.locales.GetBatch(keys, func(keys []string) (map[string]string, error) {
+		userIDs := datautil.Slice(keys, func(key string) string {
+			_, userID := tenant.SplitKey(key)
+			return userID
+		})
+		locales, err := t.findUserLocales(ctx, userIDs)
+		// The users without locale are cached too, so they are not looked up on each push.
+		values := make(map[string]string, len(userIDs))
+		for _, userID := range userIDs {
+			values[tenant.Key(ctx, userID)] = locales[userID]
+		}
+		return values, err
+	})
+	if err != nil {
+		log.ZWarn(ctx, "get locales of push template failed", err, "userIDs", userIDs)
+	}
+	locales := make(map[string]string, len(values))
+	for key, locale := range values {
+		if locale == "" {
+			continue
+		}
+		_, userID := tenant.SplitKey(key)
+		locales[userID] = locale
+	}
+	return locales
+}
+
+// findUserLocales reads the locales of the users, those read before an error are returned with it.
+func (t *pushTemplates) findUserLocales(ctx context.Context, userIDs []string) (map[string]string, error) {
+	locales := make(map[string]string, len(userIDs))
+	if len(userIDs) == 0 {
+		return locales, nil
+	}
+	devices, err := t.deviceDB.GetDevices(ctx, userIDs)
+	if err != nil {
+		return locales, err
+	}
+	sort.SliceStable(devices, func(i, j int) bool {
+		return devices[i].LastSeenTime.After(devices[j].LastSeenTime)
+	})
+	for _, device := range devices {
+		if _, ok := locales[device.UserID]; !ok && device.Locale != "" {
+			locales[device.UserID] = device.Locale
+		}
+	}
+	field := t.conf.Template.ProfileLocaleField
+	if field == "" || len(locales) == len(userIDs) {
+		return locales, nil
+	}
+	var missing []string
+	for _, userID := range userIDs {
+		if _, ok := locales[userID]; !ok {
+			missing = append(missing, userID)
+		}
+	}
+	// The locale field may not be visible to the other users, the fields are read as the admin.
+	resp, err := t.userExtClient.GetVisibleUserFields(mcontext.WithOpUserIDContext(ctx, t.imAdminUserID),
+		&pbuserext.GetVisibleUserFieldsReq{UserIDs: missing})
+	if err != nil {
+		return locales, err
+	}
+	for _, user := range resp.Users {
+		var locale string
+		if err := json.Unmarshal([]byte(user.Fields[field]), &locale); err == nil && locale != "" {
+			locales[user.UserID] = locale
+		}
+	}
+	return locales, nil
+}
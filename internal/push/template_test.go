@@ -0,0 +1,159 @@
+package push
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	pbuserext "github.com/openimsdk/open-im-server/v3/pkg/protocol/userext"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+	"google.golang.org/grpc"
+)
+
+func TestFindTemplate(t *testing.T) {
+	templates := make(map[templateKey]*model.PushTemplate)
+	for _, template := range []*model.PushTemplate{
+		{ContentType: model.PushTemplateDefault, Locale: "en", Title: "New message"},
+		{ContentType: constant.Picture, Locale: "en", Title: "New picture"},
+		{ContentType: model.PushTemplateDefault, Locale: "zh", Title: "新消息"},
+	} {
+		templates[templateKey{contentType: template.ContentType, locale: template.Locale}] = template
+	}
+	for _, c := range []struct {
+		name        string
+		contentType int32
+		locale      string
+		title       string
+	}{
+		{"content type in default locale", constant.Picture, "", "New picture"},
+		{"language of the locale", constant.Text, "zh_CN", "新消息"},
+		{"locale before content type", constant.Picture, "zh-TW", "新消息"},
+		{"unknown locale", constant.Text, "fr", "New message"},
+		{"digest without template", model.PushTemplateQuietDigest, "en", ""},
+	} {
+		var title string
+		if template := findTemplate(templates, c.contentType, c.locale, "en"); template != nil {
+			title = template.Title
+		}
+		if title != c.title {
+			t.Errorf("%s: expected %q, got %q", c.name, c.title, title)
+		}
+	}
+}
+
+func TestRenderTemplate(t *testing.T) {
+	msg := &sdkws.MsgData{
+		SenderNickname: "Alice",
+		ContentType:    constant.Text,
+		Content:        []byte(`{"content":"` + strings.Repeat("a", maxPreviewLength+1) + `"}`),
+	}
+	template := &model.PushTemplate{Title: "{senderNickname} in {groupName}", Content: "{preview}"}
+	var p pushTemplates
+	title, content := renderTemplate(template, p.replacer(context.Background(), []*model.PushTemplate{template}, msg))
+	if title != "Alice in " {
+		t.Errorf("unexpected title %q", title)
+	}
+	if content != strings.Repeat("a", maxPreviewLength)+"..." {
+		t.Errorf("unexpected content %q", content)
+	}
+	if preview := msgPreview(&sdkws.MsgData{ContentType: constant.Picture}); preview != constant.ContentType2PushContent[constant.Picture] {
+		t.Errorf("unexpected picture preview %q", preview)
+	}
+}
+
+func TestRenderDigestTemplate(t *testing.T) {
+	msg := digestMsg(&model.PushQuietDigest{UserID: "alice", Count: 5, ConversationIDs: []string{"sg_team", "si_alice_bob"}}, time.Now())
+	template := &model.PushTemplate{Title: "{count} 条新消息", Content: "来自 {conversationCount} 个会话"}
+	var p pushTemplates
+	title, content := renderTemplate(template, p.replacer(context.Background(), []*model.PushTemplate{template}, msg))
+	if title != "5 条新消息" || content != "来自 2 个会话" {
+		t.Errorf("unexpected digest %q %q", title, content)
+	}
+}
+
+type localeDeviceDB struct {
+	controller.PushDeviceDatabase
+	devices []*model.PushDevice
+}
+
+func (l *localeDeviceDB) GetDevices(_ context.Context, userIDs []string) ([]*model.PushDevice, error) {
+	return datautil.Filter(l.devices, func(e *model.PushDevice) (*model.PushDevice, bool) {
+		return e, datautil.Contain(e.UserID, userIDs...)
+	}), nil
+}
+
+type localeUserExtClient struct {
+	pbuserext.UserExtClient
+	calls int
+}
+
+func (l *localeUserExtClient) GetVisibleUserFields(ctx context.Context, req *pbuserext.GetVisibleUserFieldsReq, _ ...grpc.CallOption) (*pbuserext.GetVisibleUserFieldsResp, error) {
+	l.calls++
+	if mcontext.GetOpUserID(ctx) != "admin" {
+		return &pbuserext.GetVisibleUserFieldsResp{}, nil
+	}
+	resp := &pbuserext.GetVisibleUserFieldsResp{}
+	for _, userID := range req.UserIDs {
+		if userID == "bob" {
+			resp.Users = append(resp.Users, &pbuserext.UserFields{UserID: userID, Fields: map[string]string{"locale": `"zh-CN"`}})
+		}
+	}
+	return resp, nil
+}
+
+func TestUserLocales(t *testing.T) {
+	conf := &config.Push{}
+	conf.Template.ProfileLocaleField = "locale"
+	now := time.Now()
+	deviceDB := &localeDeviceDB{devices: []*model.PushDevice{
+		{UserID: "alice", Locale: "fr", LastSeenTime: now.Add(-time.Hour)},
+		{UserID: "alice", Locale: "de", LastSeenTime: now},
+	}}
+	userExt := &localeUserExtClient{}
+	p := newPushTemplates(conf, nil, deviceDB, userExt, nil, "admin")
+	for i := 0; i < 2; i++ {
+		locales := p.userLocales(context.Background(), []string{"alice", "bob", "carol"})
+		if len(locales) != 2 || locales["alice"] != "de" || locales["bob"] != "zh-CN" {
+			t.Fatalf("unexpected locales %v", locales)
+		}
+	}
+	if userExt.calls != 1 {
+		t.Errorf("expected the locales to be cached, the fields were read %d times", userExt.calls)
+	}
+}
+
+type tenantTemplateDB struct {
+	controller.PushTemplateDatabase
+	templates map[string][]*model.PushTemplate
+}
+
+func (t *tenantTemplateDB) GetTemplates(ctx context.Context) ([]*model.PushTemplate, error) {
+	return t.templates[tenant.GetTenantID(ctx)], nil
+}
+
+func TestTemplatesPerTenant(t *testing.T) {
+	db := &tenantTemplateDB{templates: map[string][]*model.PushTemplate{
+		"a": {{ContentType: model.PushTemplateDefault, Locale: "en", Title: "Message from A"}},
+		"b": {{ContentType: model.PushTemplateDefault, Locale: "en", Title: "Message from B"}},
+	}}
+	p := newPushTemplates(&config.Push{}, db, nil, nil, nil, "admin")
+	for _, tenantID := range []string{"a", "b", "a"} {
+		templates := p.load(tenant.WithTenantID(context.Background(), tenantID))
+		template := findTemplate(templates, constant.Text, "en", "en")
+		if expected := "Message from " + strings.ToUpper(tenantID); template == nil || template.Title != expected {
+			t.Errorf("tenant %s: expected %q, got %v", tenantID, expected, template)
+		}
+	}
+	// The default tenant has no template.
+	if templates := p.load(context.Background()); len(templates) != 0 {
+		t.Errorf("expected no template in the default tenant, got %v", templates)
+	}
+}
@@ -0,0 +1,249 @@
+package auth
+
+import (
+	"context"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	pbauthext "github.com/openimsdk/open-im-server/v3/pkg/protocol/authext"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+const (
+	// apiKeyScopeAll grants every API that is not reserved for admin tokens.
+	apiKeyScopeAll = "*"
+
+	defaultApiKeyGrace = time.Hour
+)
+
+// apiKeyAdminPaths are the admin and config routes, "*" or a prefix scope never grants them, the
+// scope has to list the route itself.
+var apiKeyAdminPaths = []string{
+	"/auth/get_admin_token",
+	"/auth/rotate_signing_key",
+	"/audit/",
+	"/config/",
+	"/push_dead_letter/",
+	"/push_template/",
+	"/restart",
+}
+
+// AuthenticateApiKey is called by the api before the request has an operator, the key runs it on behalf of the admin.
+func (s *authServer) AuthenticateApiKey(ctx context.Context, req *pbauthext.AuthenticateApiKeyReq) (*pbauthext.AuthenticateApiKeyResp, error) {
+	keyID, secret, err := authverify.ParseApiKey(req.ApiKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(s.config.Share.IMAdminUserID) == 0 {
+		return nil, errs.ErrInternalServer.WrapMsg("no admin user configured")
+	}
+	now := time.Now()
+	key, err := s.apiKeyDatabase.Verify(ctx, keyID, secret, now)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, servererrs.ErrTokenInvalid.WrapMsg("invalid api key", "keyID", keyID)
+	}
+	if !apiKeyAllowIP(key.AllowIPs, req.Ip) {
+		return nil, errs.ErrNoPermission.WrapMsg("ip is not allowed for api key", "keyID", keyID, "ip", req.Ip)
+	}
+	if !apiKeyAllowPath(key.Scopes, req.Path) {
+		return nil, errs.ErrNoPermission.WrapMsg("api is out of the scope of api key", "keyID", keyID, "path", req.Path)
+	}
+	allowed, err := s.apiKeyDatabase.Allow(ctx, key, now)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, servererrs.ErrTooManyRequests.WrapMsg("api key rate limit exceeded", "keyID", keyID, "rateLimit", key.RateLimit)
+	}
+	return &pbauthext.AuthenticateApiKeyResp{
+		KeyID:    key.KeyID,
+		TenantID: key.TenantID,
+		UserID:   s.config.Share.IMAdminUserID[0],
+	}, nil
+}
+
+// apiKeyAllowPath matches the route of the request, so unrouted paths never pass.
+func apiKeyAllowPath(scopes []string, path string) bool {
+	if path == "" || strings.HasPrefix(path, "/api_key/") {
+		return false
+	}
+	if datautil.Contain(path, scopes...) {
+		return true
+	}
+	if isApiKeyAdminPath(path) {
+		return false
+	}
+	for _, scope := range scopes {
+		switch {
+		case scope == apiKeyScopeAll:
+			return true
+		case strings.HasSuffix(scope, "/") && strings.HasPrefix(path, scope):
+			return true
+		}
+	}
+	return false
+}
+
+func isApiKeyAdminPath(path string) bool {
+	for _, admin := range apiKeyAdminPaths {
+		if path == admin || (strings.HasSuffix(admin, "/") && strings.HasPrefix(path, admin)) {
+			return true
+		}
+	}
+	return false
+}
+
+func apiKeyAllowIP(allowIPs []string, clientIP string) bool {
+	if len(allowIPs) == 0 {
+		return true
+	}
+	ip := net.ParseIP(clientIP)
+	if ip == nil {
+		return false
+	}
+	for _, allow := range allowIPs {
+		if _, ipNet, err := net.ParseCIDR(allow); err == nil {
+			if ipNet.Contains(ip) {
+				return true
+			}
+		} else if allowIP := net.ParseIP(allow); allowIP != nil && allowIP.Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func checkApiKeyAllowIPs(allowIPs []string) error {
+	for _, allow := range allowIPs {
+		if _, _, err := net.ParseCIDR(allow); err != nil && net.ParseIP(allow) == nil {
+			return errs.ErrArgs.WrapMsg("invalid ip or cidr", "allowIP", allow)
+		}
+	}
+	return nil
+}
+
+func (s *authServer) CreateApiKey(ctx context.Context, req *pbauthext.CreateApiKeyReq) (*pbauthext.CreateApiKeyResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if err := checkApiKeyAllowIPs(req.AllowIPs); err != nil {
+		return nil, err
+	}
+	key := &model.ApiKey{
+		Name:           req.Name,
+		Scopes:         datautil.Distinct(req.Scopes),
+		AllowIPs:       req.AllowIPs,
+		RateLimit:      req.RateLimit,
+		OperatorUserID: mcontext.GetOpUserID(ctx),
+	}
+	if req.ExpireTime > 0 {
+		key.ExpireTime = time.UnixMilli(req.ExpireTime)
+	}
+	secret, err := s.apiKeyDatabase.Create(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return &pbauthext.CreateApiKeyResp{KeyID: key.KeyID, ApiKey: authverify.FormatApiKey(key.KeyID, secret)}, nil
+}
+
+func (s *authServer) UpdateApiKey(ctx context.Context, req *pbauthext.UpdateApiKeyReq) (*pbauthext.UpdateApiKeyResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	data := make(map[string]any)
+	if req.Name != nil {
+		data["name"] = req.Name.Value
+	}
+	if req.Scopes != nil {
+		data["scopes"] = datautil.Distinct(req.Scopes.Scopes)
+	}
+	if req.AllowIPs != nil {
+		if err := checkApiKeyAllowIPs(req.AllowIPs.AllowIPs); err != nil {
+			return nil, err
+		}
+		data["allow_ips"] = req.AllowIPs.AllowIPs
+	}
+	if req.RateLimit != nil {
+		data["rate_limit"] = req.RateLimit.Value
+	}
+	if req.ExpireTime != nil {
+		var expireTime time.Time
+		if req.ExpireTime.Value > 0 {
+			expireTime = time.UnixMilli(req.ExpireTime.Value)
+		}
+		data["expire_time"] = expireTime
+	}
+	if err := s.apiKeyDatabase.Update(ctx, req.KeyID, data); err != nil {
+		return nil, err
+	}
+	return &pbauthext.UpdateApiKeyResp{}, nil
+}
+
+func (s *authServer) RotateApiKey(ctx context.Context, req *pbauthext.RotateApiKeyReq) (*pbauthext.RotateApiKeyResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	grace := defaultApiKeyGrace
+	if req.GraceSeconds != nil {
+		grace = time.Duration(req.GraceSeconds.Value) * time.Second
+	}
+	secret, err := s.apiKeyDatabase.Rotate(ctx, req.KeyID, grace)
+	if err != nil {
+		return nil, err
+	}
+	return &pbauthext.RotateApiKeyResp{
+		ApiKey:         authverify.FormatApiKey(req.KeyID, secret),
+		PrevExpireTime: time.Now().Add(grace).UnixMilli(),
+	}, nil
+}
+
+func (s *authServer) DeleteApiKeys(ctx context.Context, req *pbauthext.DeleteApiKeysReq) (*pbauthext.DeleteApiKeysResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if err := s.apiKeyDatabase.Delete(ctx, datautil.Distinct(req.KeyIDs)); err != nil {
+		return nil, err
+	}
+	return &pbauthext.DeleteApiKeysResp{}, nil
+}
+
+func (s *authServer) SearchApiKeys(ctx context.Context, req *pbauthext.SearchApiKeysReq) (*pbauthext.SearchApiKeysResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	total, keys, err := s.apiKeyDatabase.Page(ctx, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &pbauthext.SearchApiKeysResp{
+		Total: total,
+		Keys: datautil.Slice(keys, func(e *model.ApiKey) *pbauthext.ApiKeyInfo {
+			info := &pbauthext.ApiKeyInfo{
+				KeyID:          e.KeyID,
+				Name:           e.Name,
+				Scopes:         e.Scopes,
+				AllowIPs:       e.AllowIPs,
+				RateLimit:      e.RateLimit,
+				OperatorUserID: e.OperatorUserID,
+				CreateTime:     e.CreateTime.UnixMilli(),
+				UpdateTime:     e.UpdateTime.UnixMilli(),
+			}
+			if !e.ExpireTime.IsZero() {
+				info.ExpireTime = e.ExpireTime.UnixMilli()
+			}
+			if !e.PrevExpireTime.IsZero() {
+				info.PrevExpireTime = e.PrevExpireTime.UnixMilli()
+			}
+			return info
+		}),
+	}, nil
+}
@@ -0,0 +1,66 @@
+package auth
+
+import (
+	"testing"
+)
+
+func TestApiKeyAllowPath(t *testing.T) {
+	for _, c := range []struct {
+		scopes []string
+		path   string
+		allow  bool
+	}{
+		{[]string{"*"}, "/user/user_register", true},
+		{[]string{"/user/"}, "/user/user_register", true},
+		{[]string{"/user/user_register"}, "/user/user_register", true},
+		{[]string{"/user/"}, "/group/create_group", false},
+		{[]string{"/user"}, "/user/user_register", false},
+		{[]string{"*"}, "", false},
+		// Admin and config routes need the route itself in the scope.
+		{[]string{"*"}, "/config/set_config", false},
+		{[]string{"/config/"}, "/config/set_config", false},
+		{[]string{"/config/set_config"}, "/config/set_config", true},
+		{[]string{"*"}, "/auth/get_admin_token", false},
+		{[]string{"/auth/"}, "/auth/rotate_signing_key", false},
+		{[]string{"/auth/"}, "/auth/get_user_token", true},
+		{[]string{"*"}, "/restart", false},
+		{[]string{"/restart"}, "/restart", true},
+		{[]string{"/"}, "/push_template/set", false},
+		// API keys are never managed with an API key.
+		{[]string{"*"}, "/api_key/create", false},
+		{[]string{"/api_key/create"}, "/api_key/create", false},
+	} {
+		if apiKeyAllowPath(c.scopes, c.path) != c.allow {
+			t.Fatal("unexpected result", c.scopes, c.path, "want", c.allow)
+		}
+	}
+}
+
+func TestApiKeyAllowIP(t *testing.T) {
+	allowIPs := []string{"10.0.0.0/8", "192.168.1.7", "2001:db8::/32"}
+	for _, c := range []struct {
+		ip    string
+		allow bool
+	}{
+		{"10.1.2.3", true},
+		{"192.168.1.7", true},
+		{"192.168.1.8", false},
+		{"2001:db8::1", true},
+		{"2001:db9::1", false},
+		{"", false},
+		{"not an ip", false},
+	} {
+		if apiKeyAllowIP(allowIPs, c.ip) != c.allow {
+			t.Fatal("unexpected result", c.ip, "want", c.allow)
+		}
+	}
+	if !apiKeyAllowIP(nil, "8.8.8.8") {
+		t.Fatal("no allow list must allow every ip")
+	}
+	if err := checkApiKeyAllowIPs([]string{"10.0.0.0/8", "::1"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := checkApiKeyAllowIPs([]string{"10.0.0.0/33"}); err == nil {
+		t.Fatal("invalid cidr must be rejected")
+	}
+}
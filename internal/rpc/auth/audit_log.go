@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	pbauthext "github.com/openimsdk/open-im-server/v3/pkg/protocol/authext"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+func (s *authServer) RecordAuditLog(ctx context.Context, req *pbauthext.RecordAuditLogReq) (*pbauthext.RecordAuditLogResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	s.auditLog.Record(ctx, &model.AuditLog{
+		OperationID:    mcontext.GetOperationID(ctx),
+		OperatorUserID: mcontext.GetOpUserID(ctx),
+		ApiKeyID:       req.ApiKeyID,
+		IP:             req.Ip,
+		Action:         req.Action,
+		Target:         req.Target,
+		Request:        req.Request,
+		ErrCode:        int(req.ErrCode),
+		ErrMsg:         req.ErrMsg,
+		CreateTime:     time.Now(),
+	})
+	return &pbauthext.RecordAuditLogResp{}, nil
+}
+
+func (s *authServer) SearchAuditLogs(ctx context.Context, req *pbauthext.SearchAuditLogsReq) (*pbauthext.SearchAuditLogsResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	filter := &database.AuditLogFilter{
+		OperatorUserID: req.OperatorUserID,
+		ApiKeyID:       req.ApiKeyID,
+		Action:         req.Action,
+		Target:         req.Target,
+	}
+	if req.StartTime > 0 {
+		filter.StartTime = time.UnixMilli(req.StartTime)
+	}
+	if req.EndTime > 0 {
+		filter.EndTime = time.UnixMilli(req.EndTime)
+	}
+	total, logs, err := s.auditLog.Search(ctx, filter, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &pbauthext.SearchAuditLogsResp{
+		Total: total,
+		Logs: datautil.Slice(logs, func(e *model.AuditLog) *pbauthext.AuditLogInfo {
+			return &pbauthext.AuditLogInfo{
+				OperationID:    e.OperationID,
+				OperatorUserID: e.OperatorUserID,
+				ApiKeyID:       e.ApiKeyID,
+				Ip:             e.IP,
+				Action:         e.Action,
+				Target:         e.Target,
+				Request:        e.Request,
+				ErrCode:        int32(e.ErrCode),
+				ErrMsg:         e.ErrMsg,
+				CreateTime:     e.CreateTime.UnixMilli(),
+			}
+		}),
+	}, nil
+}
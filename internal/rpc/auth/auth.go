@@ -17,11 +17,14 @@ package auth
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcli"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	redis2 "github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/redis"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/mgo"
+	"github.com/openimsdk/tools/db/mongoutil"
 	"github.com/openimsdk/tools/db/redisutil"
 	"github.com/openimsdk/tools/utils/datautil"
 	"github.com/redis/go-redis/v9"
@@ -30,29 +33,39 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	pbauthext "github.com/openimsdk/open-im-server/v3/pkg/protocol/authext"
 	pbauth "github.com/openimsdk/protocol/auth"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/msggateway"
 	"github.com/openimsdk/tools/discovery"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
-	"github.com/openimsdk/tools/tokenverify"
 	"google.golang.org/grpc"
 )
 
 type authServer struct {
 	pbauth.UnimplementedAuthServer
-	authDatabase   controller.AuthDatabase
-	RegisterCenter discovery.SvcDiscoveryRegistry
-	config         *Config
-	userClient     *rpcli.UserClient
+	pbauthext.UnimplementedAuthExtServer
+	authDatabase     controller.AuthDatabase
+	sessionDatabase  controller.TokenSessionDatabase
+	refreshDatabase  controller.RefreshTokenDatabase
+	RegisterCenter   discovery.SvcDiscoveryRegistry
+	config           *Config
+	userClient       *rpcli.UserClient
+	userSuspendDB    controller.UserSuspendDatabase
+	keySet           *authverify.KeySet
+	tokenKeyDatabase controller.TokenKeyDatabase
+	auditLog         controller.AuditLogDatabase
+	apiKeyDatabase   controller.ApiKeyDatabase
 }
 
 type Config struct {
-	RpcConfig   config.Auth
-	RedisConfig config.Redis
-	Share       config.Share
-	Discovery   config.Discovery
+	RpcConfig     config.Auth
+	RedisConfig   config.Redis
+	MongodbConfig config.Mongo
+	Share         config.Share
+	Discovery     config.Discovery
 }
 
 func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryRegistry, server *grpc.Server) error {
@@ -60,25 +73,79 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	if err != nil {
 		return err
 	}
+	mgocli, err := mongoutil.NewMongoDB(ctx, config.MongodbConfig.Build())
+	if err != nil {
+		return err
+	}
+	userSuspendDB, err := mgo.NewUserSuspendMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	tokenKeyDB, err := mgo.NewTokenKeyMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	auditLogDB, err := mgo.NewAuditLogMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	auditLogDatabase, err := controller.NewAuditLogDatabase(auditLogDB)
+	if err != nil {
+		return err
+	}
+	apiKeyDB, err := mgo.NewApiKeyMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	kek, err := config.RpcConfig.TokenPolicy.SigningKEK()
+	if err != nil {
+		return err
+	}
+	tokenKeyDatabase := controller.NewTokenKeyDatabase(tokenKeyDB, config.RpcConfig.TokenPolicy.Expire, kek)
+	keySet, err := newKeySet(ctx, config, tokenKeyDatabase)
+	if err != nil {
+		return err
+	}
 	userConn, err := client.GetConn(ctx, config.Discovery.RpcService.User)
 	if err != nil {
 		return err
 	}
-	pbauth.RegisterAuthServer(server, &authServer{
+	tokenCache := redis2.NewTokenCacheModel(rdb, config.RpcConfig.TokenPolicy.Expire)
+	sessionCache := redis2.NewTokenSessionCache(rdb)
+	s := &authServer{
 		RegisterCenter: client,
 		authDatabase: controller.NewAuthDatabase(
-			redis2.NewTokenCacheModel(rdb, config.RpcConfig.TokenPolicy.Expire),
-			config.Share.Secret,
-			config.RpcConfig.TokenPolicy.Expire,
+			tokenCache,
+			sessionCache,
+			keySet,
+			config.RpcConfig.TokenPolicy,
 			config.Share.MultiLogin,
 			config.Share.IMAdminUserID,
+			config.Share.Tenants,
 		),
-		config:     config,
-		userClient: rpcli.NewUserClient(userConn),
-	})
+		sessionDatabase:  controller.NewTokenSessionDatabase(tokenCache, sessionCache, config.RpcConfig.TokenPolicy.RefreshTTL()),
+		refreshDatabase:  controller.NewRefreshTokenDatabase(redis2.NewRefreshTokenCache(rdb), config.RpcConfig.TokenPolicy.RefreshTTL()),
+		config:           config,
+		userClient:       rpcli.NewUserClient(userConn),
+		userSuspendDB:    controller.NewUserSuspendDatabase(userSuspendDB, redis2.NewUserSuspendCacheRedis(rdb, userSuspendDB, redis2.GetRocksCacheOptions())),
+		keySet:           keySet,
+		tokenKeyDatabase: tokenKeyDatabase,
+		auditLog:         auditLogDatabase,
+		apiKeyDatabase:   controller.NewApiKeyDatabase(apiKeyDB, redis2.NewApiKeyCacheRedis(rdb, apiKeyDB, redis2.GetRocksCacheOptions())),
+	}
+	pbauth.RegisterAuthServer(server, s)
+	pbauthext.RegisterAuthExtServer(server, s)
 	return nil
 }
 
+func (s *authServer) AuditLogDatabase() controller.AuditLogDatabase {
+	return s.auditLog
+}
+
+func (s *authServer) IMAdminUserIDs() []string {
+	return s.config.Share.IMAdminUserID
+}
+
 func (s *authServer) GetAdminToken(ctx context.Context, req *pbauth.GetAdminTokenReq) (*pbauth.GetAdminTokenResp, error) {
 	resp := pbauth.GetAdminTokenResp{}
 	if req.Secret != s.config.Share.Secret {
@@ -90,6 +157,10 @@ func (s *authServer) GetAdminToken(ctx context.Context, req *pbauth.GetAdminToke
 
 	}
 
+	if err := s.checkTenant(tenant.GetTenantID(ctx)); err != nil {
+		return nil, err
+	}
+
 	if err := s.userClient.CheckUser(ctx, []string{req.UserID}); err != nil {
 		return nil, err
 	}
@@ -101,7 +172,7 @@ func (s *authServer) GetAdminToken(ctx context.Context, req *pbauth.GetAdminToke
 
 	prommetrics.UserLoginCounter.Inc()
 	resp.Token = token
-	resp.ExpireTimeSeconds = s.config.RpcConfig.TokenPolicy.Expire * 24 * 60 * 60
+	resp.ExpireTimeSeconds = int64(s.config.RpcConfig.TokenPolicy.AccessTTL() / time.Second)
 	return &resp, nil
 }
 
@@ -126,20 +197,59 @@ func (s *authServer) GetUserToken(ctx context.Context, req *pbauth.GetUserTokenR
 	if user.AppMangerLevel >= constant.AppNotificationAdmin {
 		return nil, errs.ErrArgs.WrapMsg("app account can`t get token")
 	}
+	suspend, err := s.userSuspendDB.GetSuspended(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if suspend != nil {
+		return nil, servererrs.ErrUserSuspended.WrapMsg("user is suspended", "userID", req.UserID, "expireTime", suspend.ExpireTime.UnixMilli(), "reason", suspend.Reason)
+	}
 	token, err := s.authDatabase.CreateToken(ctx, req.UserID, int(req.PlatformID))
 	if err != nil {
 		return nil, err
 	}
 	resp.Token = token
-	resp.ExpireTimeSeconds = s.config.RpcConfig.TokenPolicy.Expire * 24 * 60 * 60
+	resp.ExpireTimeSeconds = int64(s.config.RpcConfig.TokenPolicy.AccessTTL() / time.Second)
 	return &resp, nil
 }
 
-func (s *authServer) parseToken(ctx context.Context, tokensString string) (claims *tokenverify.Claims, err error) {
-	claims, err = tokenverify.GetClaimFromToken(tokensString, authverify.Secret(s.config.Share.Secret))
+// GetTenantAdminToken issues the admin token of the tenant named in the request, the admin tokens being the only way
+// into a tenant: the user tokens inherit the tenant of the admin token issuing them.
+func (s *authServer) GetTenantAdminToken(ctx context.Context, req *pbauthext.GetTenantAdminTokenReq) (*pbauthext.GetTenantAdminTokenResp, error) {
+	if req.Secret != s.config.Share.Secret {
+		return nil, errs.ErrNoPermission.WrapMsg("secret invalid")
+	}
+	if err := s.checkTenant(req.TenantID); err != nil {
+		return nil, err
+	}
+	resp, err := s.GetAdminToken(tenant.WithTenantID(ctx, req.TenantID), &pbauth.GetAdminTokenReq{Secret: req.Secret, UserID: req.UserID})
+	if err != nil {
+		return nil, err
+	}
+	return &pbauthext.GetTenantAdminTokenResp{Token: resp.Token, ExpireTimeSeconds: resp.ExpireTimeSeconds}, nil
+}
+
+// checkTenant makes sure the tenant is configured, the default tenant always exists.
+func (s *authServer) checkTenant(tenantID string) error {
+	if tenantID != "" && s.config.Share.GetTenant(tenantID) == nil {
+		return errs.ErrArgs.WrapMsg("tenant not found", "tenantID", tenantID)
+	}
+	return nil
+}
+
+func (s *authServer) parseToken(ctx context.Context, tokensString string) (claims *authverify.Claims, err error) {
+	claims, err = authverify.GetClaimFromToken(tokensString, s.keySet.Keyfunc)
 	if err != nil {
 		return nil, err
 	}
+	if err := s.checkTenant(claims.TenantID); err != nil {
+		return nil, errs.ErrTokenUnknown.WrapMsg(err.Error())
+	}
+	if tenantID := tenant.GetTenantID(ctx); tenantID != "" && tenantID != claims.TenantID {
+		return nil, errs.ErrTokenUnknown.WrapMsg("token belongs to another tenant", "tenantID", tenantID)
+	}
+	// The gateways parse the tokens before they know the tenant, the signed claim tells it.
+	ctx = tenant.WithTenantID(ctx, claims.TenantID)
 	isAdmin := authverify.IsManagerUserID(claims.UserID, s.config.Share.IMAdminUserID)
 	if isAdmin {
 		return claims, nil
@@ -186,6 +296,28 @@ func (s *authServer) ForceLogout(ctx context.Context, req *pbauth.ForceLogoutReq
 	return &pbauth.ForceLogoutResp{}, nil
 }
 
+// KickUser kicks the tokens of every platform in one write and asks each gateway once to close the connections,
+// a zero platformID standing for all the platforms of the user.
+func (s *authServer) KickUser(ctx context.Context, req *pbauthext.KickUserReq) (*pbauthext.KickUserResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if err := s.authDatabase.KickUserTokens(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	conns, err := s.RegisterCenter.GetConns(ctx, s.config.Discovery.RpcService.MessageGateway)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range conns {
+		kickReq := &msggateway.KickUserOfflineReq{KickUserIDList: []string{req.UserID}}
+		if _, err := msggateway.NewMsgGatewayClient(v).KickUserOffline(ctx, kickReq); err != nil {
+			log.ZError(ctx, "kick user failed", err, "target", v.Target(), "userID", req.UserID)
+		}
+	}
+	return &pbauthext.KickUserResp{}, nil
+}
+
 func (s *authServer) forceKickOff(ctx context.Context, userID string, platformID int32) error {
 	conns, err := s.RegisterCenter.GetConns(ctx, s.config.Discovery.RpcService.MessageGateway)
 	if err != nil {
@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	redis2 "github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/redis"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	pbauthext "github.com/openimsdk/open-im-server/v3/pkg/protocol/authext"
+	"github.com/openimsdk/protocol/constant"
+)
+
+func TestParseTokenTenantIsolation(t *testing.T) {
+	rdb, mock := redismock.NewClientMock()
+	keySet := authverify.NewKeySet("secret", authverify.AlgorithmHS256, nil)
+	share := config.Share{Tenants: []config.Tenant{{ID: "a"}, {ID: "b"}}}
+	s := &authServer{
+		config: &Config{Share: share},
+		keySet: keySet,
+		authDatabase: controller.NewAuthDatabase(redis2.NewTokenCacheModel(rdb, 1), redis2.NewTokenSessionCache(rdb),
+			keySet, config.TokenPolicy{}, config.MultiLogin{}, nil, share.Tenants),
+	}
+	token, err := keySet.Sign(authverify.BuildClaims("user1", constant.IOSPlatformID, time.Hour, "a"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A token of tenant a is rejected in a request of tenant b before any token of b is read.
+	if _, err := s.parseToken(tenant.WithTenantID(context.Background(), "b"), token); err == nil {
+		t.Fatal("token of tenant a accepted by tenant b")
+	}
+
+	// Without a tenant in ctx the signed claim selects the tokens of tenant a.
+	key := tenant.KeyOf("a", cachekey.GetTokenKey("user1", constant.IOSPlatformID))
+	mock.ExpectHGetAll(key).SetVal(map[string]string{token: strconv.Itoa(constant.NormalToken)})
+	claims, err := s.parseToken(context.Background(), token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if claims.TenantID != "a" || claims.UserID != "user1" {
+		t.Fatal("unexpected claims", claims.TenantID, claims.UserID)
+	}
+
+	// The same user of tenant b has no tokens, the token of tenant a is not found there.
+	tokenB, err := keySet.Sign(authverify.BuildClaims("user1", constant.IOSPlatformID, time.Hour, "b"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	mock.ExpectHGetAll(tenant.KeyOf("b", cachekey.GetTokenKey("user1", constant.IOSPlatformID))).SetVal(map[string]string{})
+	if _, err := s.parseToken(context.Background(), tokenB); err == nil {
+		t.Fatal("token of tenant b accepted without being stored")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetTenantAdminTokenChecks(t *testing.T) {
+	share := config.Share{Secret: "secret", IMAdminUserID: []string{"imAdmin"}, Tenants: []config.Tenant{{ID: "a"}}}
+	s := &authServer{config: &Config{Share: share}}
+	ctx := context.Background()
+
+	if _, err := s.GetTenantAdminToken(ctx, &pbauthext.GetTenantAdminTokenReq{Secret: "wrong", UserID: "imAdmin", TenantID: "a"}); err == nil {
+		t.Fatal("admin token issued with a wrong secret")
+	}
+	// Only the configured tenants can be picked.
+	if _, err := s.GetTenantAdminToken(ctx, &pbauthext.GetTenantAdminTokenReq{Secret: "secret", UserID: "imAdmin", TenantID: "unknown"}); err == nil {
+		t.Fatal("admin token issued in a tenant which is not configured")
+	}
+}
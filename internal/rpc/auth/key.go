@@ -0,0 +1,107 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	pbauthext "github.com/openimsdk/open-im-server/v3/pkg/protocol/authext"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+)
+
+const (
+	keyReloadInterval        = time.Minute
+	keyRotationCheckInterval = time.Hour
+)
+
+// newKeySet loads the signing keys and keeps them up to date. With an asymmetric algorithm
+// a key is generated when there is none, and replaced once it is older than KeyRotation days.
+// Keys stored in clear are sealed with the key encryption key first.
+func newKeySet(ctx context.Context, config *Config, keyDB controller.TokenKeyDatabase) (*authverify.KeySet, error) {
+	policy := config.RpcConfig.TokenPolicy
+	legacyUntil, err := policy.LegacySecretDeadline()
+	if err != nil {
+		return nil, err
+	}
+	if err := keyDB.EncryptStoredKeys(ctx); err != nil {
+		return nil, err
+	}
+	keySet := authverify.NewKeySet(config.Share.Secret, policy.Algorithm, keyDB.Load)
+	keySet.AcceptLegacySecretUntil(legacyUntil)
+	if err := keySet.Reload(ctx); err != nil {
+		return nil, err
+	}
+	if keySet.Algorithm() != authverify.AlgorithmHS256 {
+		if err := rotateKeyIfDue(ctx, keySet, keyDB, policy.KeyRotation); err != nil {
+			return nil, err
+		}
+		go func() {
+			ticker := time.NewTicker(keyRotationCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if err := rotateKeyIfDue(ctx, keySet, keyDB, policy.KeyRotation); err != nil {
+						log.ZError(ctx, "rotate token signing key failed", err)
+					}
+				}
+			}
+		}()
+	}
+	go keySet.Run(ctx, keyReloadInterval)
+	return keySet, nil
+}
+
+func rotateKeyIfDue(ctx context.Context, keySet *authverify.KeySet, keyDB controller.TokenKeyDatabase, rotationDays int64) error {
+	if key := keySet.Signing(); key != nil {
+		if rotationDays <= 0 || time.Since(key.CreateTime) < time.Hour*24*time.Duration(rotationDays) {
+			return nil
+		}
+	}
+	key, err := keyDB.Rotate(ctx, keySet.Algorithm())
+	if err != nil {
+		return err
+	}
+	log.ZInfo(ctx, "token signing key rotated", "kid", key.KID, "algorithm", key.Algorithm)
+	return keySet.Reload(ctx)
+}
+
+func (s *authServer) GetJWKS(ctx context.Context, req *pbauthext.GetJWKSReq) (*pbauthext.GetJWKSResp, error) {
+	jwks := s.keySet.JWKS()
+	resp := &pbauthext.GetJWKSResp{Keys: make([]*pbauthext.JWK, 0, len(jwks.Keys))}
+	for _, key := range jwks.Keys {
+		resp.Keys = append(resp.Keys, &pbauthext.JWK{
+			Kty: key.Kty,
+			Use: key.Use,
+			Alg: key.Alg,
+			Kid: key.Kid,
+			N:   key.N,
+			E:   key.E,
+			Crv: key.Crv,
+			X:   key.X,
+			Y:   key.Y,
+		})
+	}
+	return resp, nil
+}
+
+func (s *authServer) RotateSigningKey(ctx context.Context, req *pbauthext.RotateSigningKeyReq) (*pbauthext.RotateSigningKeyResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if s.keySet.Algorithm() == authverify.AlgorithmHS256 {
+		return nil, errs.ErrArgs.WrapMsg("tokens are signed with the shared secret, there is no key to rotate")
+	}
+	key, err := s.tokenKeyDatabase.Rotate(ctx, s.keySet.Algorithm())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.keySet.Reload(ctx); err != nil {
+		return nil, err
+	}
+	return &pbauthext.RotateSigningKeyResp{Kid: key.KID, Algorithm: key.Algorithm}, nil
+}
@@ -0,0 +1,95 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	pbauthext "github.com/openimsdk/open-im-server/v3/pkg/protocol/authext"
+	pbauth "github.com/openimsdk/protocol/auth"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+)
+
+func (s *authServer) IssueUserToken(ctx context.Context, req *pbauthext.IssueUserTokenReq) (*pbauthext.IssueUserTokenResp, error) {
+	tokenResp, err := s.GetUserToken(ctx, &pbauth.GetUserTokenReq{UserID: req.UserID, PlatformID: req.PlatformID})
+	if err != nil {
+		return nil, err
+	}
+	resp := &pbauthext.IssueUserTokenResp{
+		Token:             tokenResp.Token,
+		ExpireTimeSeconds: tokenResp.ExpireTimeSeconds,
+	}
+	refreshTTL := s.config.RpcConfig.TokenPolicy.RefreshTTL()
+	if refreshTTL <= 0 {
+		return resp, nil
+	}
+	resp.RefreshToken, err = s.refreshDatabase.Create(ctx, req.UserID, int(req.PlatformID), tokenResp.Token)
+	if err != nil {
+		return nil, err
+	}
+	resp.RefreshExpireTimeSeconds = int64(refreshTTL / time.Second)
+	return resp, nil
+}
+
+// RefreshToken is called without a token, the family of the refresh token tells the session and its tenant.
+func (s *authServer) RefreshToken(ctx context.Context, req *pbauthext.RefreshTokenReq) (*pbauthext.RefreshTokenResp, error) {
+	refreshTTL := s.config.RpcConfig.TokenPolicy.RefreshTTL()
+	if refreshTTL <= 0 {
+		return nil, errs.ErrNoPermission.WrapMsg("refresh token is disabled")
+	}
+	family, err := s.refreshDatabase.Use(ctx, req.RefreshToken)
+	if family != nil {
+		ctx = tenant.WithTenantID(ctx, family.TenantID)
+	}
+	if err != nil {
+		if errors.Is(err, servererrs.ErrRefreshTokenReused) {
+			s.revokeAccessToken(ctx, family)
+		}
+		return nil, err
+	}
+	token, err := s.authDatabase.RefreshToken(ctx, family.AccessToken)
+	if err != nil {
+		// The session was kicked or logged out, its refresh tokens die with it.
+		// Other errors may be transient, the refresh token is released to stay usable.
+		if servererrs.ErrTokenNotExist.Is(err) || servererrs.ErrTokenKicked.Is(err) {
+			if err := s.refreshDatabase.Revoke(ctx, family.FamilyID); err != nil {
+				log.ZWarn(ctx, "revoke refresh token family failed", err, "familyID", family.FamilyID)
+			}
+		} else {
+			s.releaseRefreshToken(ctx, req.RefreshToken)
+		}
+		return nil, err
+	}
+	refreshToken, err := s.refreshDatabase.Rotate(ctx, family, token)
+	if err != nil {
+		s.releaseRefreshToken(ctx, req.RefreshToken)
+		return nil, err
+	}
+	return &pbauthext.RefreshTokenResp{
+		Token:                    token,
+		ExpireTimeSeconds:        int64(s.config.RpcConfig.TokenPolicy.AccessTTL() / time.Second),
+		RefreshToken:             refreshToken,
+		RefreshExpireTimeSeconds: int64(refreshTTL / time.Second),
+	}, nil
+}
+
+// releaseRefreshToken lets the client retry a refresh token used by a refresh which failed, the retry is not taken for a reuse.
+func (s *authServer) releaseRefreshToken(ctx context.Context, refreshToken string) {
+	if err := s.refreshDatabase.Release(ctx, refreshToken); err != nil {
+		log.ZWarn(ctx, "release refresh token failed", err)
+	}
+}
+
+// revokeAccessToken logs out the session of a family whose refresh token leaked, whoever holds
+// the current access token has to log in again.
+func (s *authServer) revokeAccessToken(ctx context.Context, family *cache.RefreshTokenFamily) {
+	sessionIDs := []string{cachekey.GetTokenSessionID(family.AccessToken)}
+	if _, err := s.revokeSessions(ctx, family.UserID, sessionIDs); err != nil {
+		log.ZWarn(ctx, "revoke session of reused refresh token failed", err, "userID", family.UserID)
+	}
+}
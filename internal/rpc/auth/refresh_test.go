@@ -0,0 +1,159 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	pbauthext "github.com/openimsdk/open-im-server/v3/pkg/protocol/authext"
+	"github.com/openimsdk/tools/errs"
+)
+
+type fakeRefreshDB struct {
+	family   *cache.RefreshTokenFamily
+	useErr   error
+	used     int
+	released int
+	revoked  []string
+	rotated  string
+}
+
+func (f *fakeRefreshDB) Create(ctx context.Context, userID string, platformID int, accessToken string) (string, error) {
+	return "refresh", nil
+}
+
+func (f *fakeRefreshDB) Use(ctx context.Context, refreshToken string) (*cache.RefreshTokenFamily, error) {
+	f.used++
+	if f.useErr == nil && f.used > 1 {
+		return f.family, servererrs.ErrRefreshTokenReused.Wrap()
+	}
+	return f.family, f.useErr
+}
+
+func (f *fakeRefreshDB) Release(ctx context.Context, refreshToken string) error {
+	f.used--
+	f.released++
+	return nil
+}
+
+func (f *fakeRefreshDB) Rotate(ctx context.Context, family *cache.RefreshTokenFamily, accessToken string) (string, error) {
+	f.rotated = accessToken
+	return "refresh2", nil
+}
+
+func (f *fakeRefreshDB) Revoke(ctx context.Context, familyID string) error {
+	f.revoked = append(f.revoked, familyID)
+	return nil
+}
+
+type fakeRefreshAuthDB struct {
+	controller.AuthDatabase
+	err error
+	// failures is how many calls fail with err before the next ones succeed, all of them fail when it is zero.
+	failures int
+	calls    int
+}
+
+func (f *fakeRefreshAuthDB) RefreshToken(ctx context.Context, oldToken string) (string, error) {
+	f.calls++
+	if f.err != nil && (f.failures == 0 || f.calls <= f.failures) {
+		return "", f.err
+	}
+	return "access2", nil
+}
+
+type fakeSessionDB struct {
+	revoked []string
+}
+
+func (f *fakeSessionDB) GetSessions(ctx context.Context, userID string) (map[string]*cache.TokenSession, error) {
+	return nil, nil
+}
+
+func (f *fakeSessionDB) RevokeSessions(ctx context.Context, userID string, sessionIDs []string) (map[string]*cache.TokenSession, error) {
+	f.revoked = append(f.revoked, sessionIDs...)
+	return nil, nil
+}
+
+func newRefreshServer(authDB controller.AuthDatabase, refreshDB controller.RefreshTokenDatabase, sessionDB controller.TokenSessionDatabase) *authServer {
+	return &authServer{
+		config:          &Config{RpcConfig: config.Auth{TokenPolicy: config.TokenPolicy{Expire: 1, AccessExpire: 10}}},
+		authDatabase:    authDB,
+		refreshDatabase: refreshDB,
+		sessionDatabase: sessionDB,
+	}
+}
+
+func refreshToken(s *authServer) (*pbauthext.RefreshTokenResp, error) {
+	return s.RefreshToken(context.Background(), &pbauthext.RefreshTokenReq{RefreshToken: "refresh"})
+}
+
+func TestRefreshTokenRevokesFamily(t *testing.T) {
+	family := &cache.RefreshTokenFamily{FamilyID: "family1", UserID: "user1", PlatformID: 1, AccessToken: "access1"}
+	for _, c := range []struct {
+		name   string
+		err    error
+		revoke bool
+	}{
+		{"refreshed", nil, false},
+		{"kicked", servererrs.ErrTokenKicked.Wrap(), true},
+		{"logged out", servererrs.ErrTokenNotExist.Wrap(), true},
+		{"transient", errs.ErrInternalServer.WrapMsg("redis unavailable"), false},
+	} {
+		refreshDB := &fakeRefreshDB{family: family}
+		s := newRefreshServer(&fakeRefreshAuthDB{err: c.err}, refreshDB, &fakeSessionDB{})
+		_, err := refreshToken(s)
+		if revoked := len(refreshDB.revoked) > 0; revoked != c.revoke {
+			t.Fatal(c.name, "family revoked", revoked, err)
+		}
+		if released := refreshDB.released > 0; released != (c.err != nil && !c.revoke) {
+			t.Fatal(c.name, "refresh token released", released)
+		}
+		if c.err == nil && refreshDB.rotated != "access2" {
+			t.Fatal(c.name, "family not rotated to the new access token", refreshDB.rotated)
+		}
+	}
+}
+
+func TestRefreshTokenReusedRevokesSession(t *testing.T) {
+	family := &cache.RefreshTokenFamily{FamilyID: "family1", UserID: "user1", PlatformID: 1, AccessToken: "access1"}
+	refreshDB := &fakeRefreshDB{family: family, useErr: servererrs.ErrRefreshTokenReused.Wrap()}
+	sessionDB := &fakeSessionDB{}
+	s := newRefreshServer(&fakeRefreshAuthDB{}, refreshDB, sessionDB)
+	if _, err := refreshToken(s); err == nil {
+		t.Fatal("reused refresh token accepted")
+	}
+	if len(sessionDB.revoked) != 1 || sessionDB.revoked[0] != cachekey.GetTokenSessionID("access1") {
+		t.Fatal("session of the reused family not revoked", sessionDB.revoked)
+	}
+	if refreshDB.rotated != "" {
+		t.Fatal("reused refresh token must not be rotated")
+	}
+}
+
+func TestRefreshTokenRetryAfterFailure(t *testing.T) {
+	family := &cache.RefreshTokenFamily{FamilyID: "family1", UserID: "user1", PlatformID: 1, AccessToken: "access1"}
+	refreshDB := &fakeRefreshDB{family: family}
+	sessionDB := &fakeSessionDB{}
+	s := newRefreshServer(&fakeRefreshAuthDB{err: errs.ErrInternalServer.WrapMsg("redis unavailable"), failures: 1}, refreshDB, sessionDB)
+
+	refreshToken(s)
+	if refreshDB.released != 1 || refreshDB.rotated != "" {
+		t.Fatal("refresh token of the failed refresh not released", refreshDB.released)
+	}
+	// The retry with the same refresh token is served, not taken for a reuse.
+	resp, err := refreshToken(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if refreshDB.rotated != "access2" || resp.RefreshToken != "refresh2" {
+		t.Fatal("retry not refreshed", refreshDB.rotated)
+	}
+	if len(refreshDB.revoked) != 0 || len(sessionDB.revoked) != 0 {
+		t.Fatal("retry revoked the session", refreshDB.revoked, sessionDB.revoked)
+	}
+}
@@ -0,0 +1,109 @@
+package auth
+
+import (
+	"context"
+	"sort"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	pbauthext "github.com/openimsdk/open-im-server/v3/pkg/protocol/authext"
+	"github.com/openimsdk/protocol/msggateway"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+)
+
+func (s *authServer) GetSessions(ctx context.Context, req *pbauthext.GetSessionsReq) (*pbauthext.GetSessionsResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	sessions, err := s.sessionDatabase.GetSessions(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pbauthext.GetSessionsResp{Sessions: make([]*pbauthext.SessionInfo, 0, len(sessions))}
+	for sessionID, session := range sessions {
+		resp.Sessions = append(resp.Sessions, &pbauthext.SessionInfo{
+			SessionID:    sessionID,
+			PlatformID:   int32(session.PlatformID),
+			CreateTime:   session.CreateTime,
+			LastSeenIP:   session.LastSeenIP,
+			LastSeenTime: session.LastSeenTime,
+			DeviceName:   session.DeviceName,
+			Current:      req.Token != "" && session.Token == req.Token,
+		})
+	}
+	sort.Slice(resp.Sessions, func(i, j int) bool {
+		return resp.Sessions[i].CreateTime > resp.Sessions[j].CreateTime
+	})
+	return resp, nil
+}
+
+func (s *authServer) RevokeSession(ctx context.Context, req *pbauthext.RevokeSessionReq) (*pbauthext.RevokeSessionResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	revoked, err := s.revokeSessions(ctx, req.UserID, []string{req.SessionID})
+	if err != nil {
+		return nil, err
+	}
+	if len(revoked) == 0 {
+		return nil, errs.ErrRecordNotFound.WrapMsg("session not found", "sessionID", req.SessionID)
+	}
+	return &pbauthext.RevokeSessionResp{}, nil
+}
+
+func (s *authServer) RevokeOtherSessions(ctx context.Context, req *pbauthext.RevokeOtherSessionsReq) (*pbauthext.RevokeOtherSessionsResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	sessions, err := s.sessionDatabase.GetSessions(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	sessionIDs := make([]string, 0, len(sessions))
+	for sessionID, session := range sessions {
+		if req.PreservedToken == "" || session.Token != req.PreservedToken {
+			sessionIDs = append(sessionIDs, sessionID)
+		}
+	}
+	revoked, err := s.revokeSessions(ctx, req.UserID, sessionIDs)
+	if err != nil {
+		return nil, err
+	}
+	return &pbauthext.RevokeOtherSessionsResp{Count: int32(len(revoked))}, nil
+}
+
+func (s *authServer) revokeSessions(ctx context.Context, userID string, sessionIDs []string) (map[string]*cache.TokenSession, error) {
+	revoked, err := s.sessionDatabase.RevokeSessions(ctx, userID, sessionIDs)
+	if err != nil {
+		return nil, err
+	}
+	s.kickSessions(ctx, userID, revoked)
+	return revoked, nil
+}
+
+// kickSessions closes the connections of the revoked sessions. The kicks carry the revoked tokens,
+// so the other connections of the same platform stay online.
+func (s *authServer) kickSessions(ctx context.Context, userID string, revoked map[string]*cache.TokenSession) {
+	platformTokens := make(map[int][]string)
+	for _, session := range revoked {
+		platformTokens[session.PlatformID] = append(platformTokens[session.PlatformID], session.Token)
+	}
+	if len(platformTokens) == 0 || s.RegisterCenter == nil {
+		return
+	}
+	conns, err := s.RegisterCenter.GetConns(ctx, s.config.Discovery.RpcService.MessageGateway)
+	if err != nil {
+		log.ZWarn(ctx, "get msg gateway conns failed", err)
+		return
+	}
+	for platformID, tokens := range platformTokens {
+		kickCtx := authverify.WithKickTokens(ctx, tokens)
+		kickReq := &msggateway.KickUserOfflineReq{KickUserIDList: []string{userID}, PlatformID: int32(platformID)}
+		for _, conn := range conns {
+			if _, err := msggateway.NewMsgGatewayClient(conn).KickUserOffline(kickCtx, kickReq); err != nil {
+				log.ZWarn(ctx, "kick user offline failed", err, "kickReq", kickReq)
+			}
+		}
+	}
+}
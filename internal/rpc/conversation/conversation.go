@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/redis"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/mgo"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
@@ -32,6 +33,7 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	pbconversationext "github.com/openimsdk/open-im-server/v3/pkg/protocol/conversationext"
 	"github.com/openimsdk/protocol/constant"
 	pbconversation "github.com/openimsdk/protocol/conversation"
 	"github.com/openimsdk/protocol/sdkws"
@@ -45,7 +47,11 @@ import (
 
 type conversationServer struct {
 	pbconversation.UnimplementedConversationServer
+	pbconversationext.UnimplementedConversationExtServer
 	conversationDatabase controller.ConversationDatabase
+	folderDatabase       controller.ConversationFolderDatabase
+	// badgeCache holds the unread counts of the push service, which leave out the conversations which do not notify.
+	badgeCache cache.BadgeCache
 
 	conversationNotificationSender *ConversationNotificationSender
 	config                         *Config
@@ -90,16 +96,25 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	if err != nil {
 		return err
 	}
+	folderDB, err := mgo.NewConversationFolderMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
 	msgClient := rpcli.NewMsgClient(msgConn)
 	localcache.InitLocalCache(&config.LocalCacheConfig)
-	pbconversation.RegisterConversationServer(server, &conversationServer{
+	srv := &conversationServer{
 		conversationNotificationSender: NewConversationNotificationSender(&config.NotificationConfig, msgClient),
 		conversationDatabase: controller.NewConversationDatabase(conversationDB,
 			redis.NewConversationRedis(rdb, &config.LocalCacheConfig, redis.GetRocksCacheOptions(), conversationDB), mgocli.GetTx()),
-		userClient:  rpcli.NewUserClient(userConn),
-		groupClient: rpcli.NewGroupClient(groupConn),
-		msgClient:   msgClient,
-	})
+		folderDatabase: controller.NewConversationFolderDatabase(folderDB),
+		badgeCache:     redis.NewBadgeCacheRedis(rdb),
+		config:         config,
+		userClient:     rpcli.NewUserClient(userConn),
+		groupClient:    rpcli.NewGroupClient(groupConn),
+		msgClient:      msgClient,
+	}
+	pbconversation.RegisterConversationServer(server, srv)
+	pbconversationext.RegisterConversationExtServer(server, srv)
 	return nil
 }
 
@@ -373,6 +388,9 @@ func (c *conversationServer) SetConversations(ctx context.Context, req *pbconver
 		for _, v := range needUpdateUsersList {
 			c.conversationNotificationSender.ConversationChangeNotification(ctx, v, []string{req.Conversation.ConversationID})
 		}
+		if req.Conversation.RecvMsgOpt != nil {
+			c.dropBadges(ctx, needUpdateUsersList)
+		}
 	}
 	if req.Conversation.IsPrivateChat != nil && req.Conversation.ConversationType != constant.ReadGroupChatType {
 		var conversations []*dbModel.Conversation
@@ -459,6 +477,7 @@ func (c *conversationServer) SetConversationMaxSeq(ctx context.Context, req *pbc
 		map[string]any{"max_seq": req.MaxSeq}); err != nil {
 		return nil, err
 	}
+	c.dropBadges(ctx, req.OwnerUserID)
 	for _, userID := range req.OwnerUserID {
 		c.conversationNotificationSender.ConversationChangeNotification(ctx, userID, []string{req.ConversationID})
 	}
@@ -473,6 +492,7 @@ func (c *conversationServer) SetConversationMinSeq(ctx context.Context, req *pbc
 		map[string]any{"min_seq": req.MinSeq}); err != nil {
 		return nil, err
 	}
+	c.dropBadges(ctx, req.OwnerUserID)
 	for _, userID := range req.OwnerUserID {
 		c.conversationNotificationSender.ConversationChangeNotification(ctx, userID, []string{req.ConversationID})
 	}
@@ -682,10 +702,20 @@ func (c *conversationServer) UpdateConversation(ctx context.Context, req *pbconv
 		if err := c.conversationDatabase.UpdateUsersConversationField(ctx, req.UserIDs, req.ConversationID, m); err != nil {
 			return nil, err
 		}
+		if req.RecvMsgOpt != nil {
+			c.dropBadges(ctx, req.UserIDs)
+		}
 	}
 	return &pbconversation.UpdateConversationResp{}, nil
 }
 
+// dropBadges removes the cached unread counts of the users, computed again with their new receive options or seqs.
+func (c *conversationServer) dropBadges(ctx context.Context, userIDs []string) {
+	if err := c.badgeCache.DelBadges(ctx, userIDs...); err != nil {
+		log.ZWarn(ctx, "delete badges failed", err, "userIDs", userIDs)
+	}
+}
+
 func (c *conversationServer) GetOwnerConversation(ctx context.Context, req *pbconversation.GetOwnerConversationReq) (*pbconversation.GetOwnerConversationResp, error) {
 	total, conversations, err := c.conversationDatabase.GetOwnerConversation(ctx, req.UserID, req.Pagination)
 	if err != nil {
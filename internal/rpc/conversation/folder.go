@@ -0,0 +1,351 @@
+package conversation
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/internal/rpc/incrversion"
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	pbconversationext "github.com/openimsdk/open-im-server/v3/pkg/protocol/conversationext"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/openimsdk/tools/utils/idutil"
+	"github.com/openimsdk/tools/utils/jsonutil"
+	"github.com/openimsdk/tools/utils/timeutil"
+)
+
+const (
+	maxConversationFolders      = 100
+	maxConversationFolderLength = 1000
+
+	// conversationFolderChangedKey is the key of the business notification telling the other devices to sync the folders.
+	conversationFolderChangedKey = "conversationFolderChanged"
+)
+
+func (c *conversationServer) CreateConversationFolder(ctx context.Context, req *pbconversationext.CreateConversationFolderReq) (*pbconversationext.CreateConversationFolderResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, c.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	conversationIDs := datautil.Distinct(req.ConversationIDs)
+	if err := c.checkFolderConversations(ctx, req.UserID, req.Rule, conversationIDs); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	folder := &model.ConversationFolder{
+		OwnerUserID:     req.UserID,
+		Name:            req.Name,
+		Order:           req.Order,
+		Rule:            req.Rule,
+		ConversationIDs: conversationIDs,
+		Ex:              req.Ex,
+		CreateTime:      now,
+		UpdateTime:      now,
+	}
+	if err := c.folderDatabase.CreateFolder(ctx, folder, maxConversationFolders); err != nil {
+		return nil, err
+	}
+	c.folderChanged(ctx, req.UserID, []string{folder.FolderID})
+	return &pbconversationext.CreateConversationFolderResp{FolderID: folder.FolderID}, nil
+}
+
+func (c *conversationServer) UpdateConversationFolder(ctx context.Context, req *pbconversationext.UpdateConversationFolderReq) (*pbconversationext.UpdateConversationFolderResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, c.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	folder, err := c.getFolder(ctx, req.UserID, req.FolderID)
+	if err != nil {
+		return nil, err
+	}
+	args := map[string]any{"update_time": time.Now()}
+	if req.Name != nil {
+		args["name"] = req.Name.Value
+	}
+	if req.Order != nil {
+		args["order"] = req.Order.Value
+	}
+	if req.Ex != nil {
+		args["ex"] = req.Ex.Value
+	}
+	if req.ConversationIDs != nil {
+		conversationIDs := datautil.Distinct(req.ConversationIDs.ConversationIDs)
+		if err := c.checkFolderConversations(ctx, req.UserID, folder.Rule, conversationIDs); err != nil {
+			return nil, err
+		}
+		args["conversation_ids"] = conversationIDs
+	}
+	if err := c.folderDatabase.UpdateFolder(ctx, req.UserID, req.FolderID, args); err != nil {
+		return nil, err
+	}
+	c.folderChanged(ctx, req.UserID, []string{req.FolderID})
+	return &pbconversationext.UpdateConversationFolderResp{}, nil
+}
+
+func (c *conversationServer) AddFolderConversations(ctx context.Context, req *pbconversationext.AddFolderConversationsReq) (*pbconversationext.AddFolderConversationsResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, c.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	folder, err := c.getFolder(ctx, req.UserID, req.FolderID)
+	if err != nil {
+		return nil, err
+	}
+	conversationIDs := datautil.Distinct(req.ConversationIDs)
+	if err := c.checkFolderConversations(ctx, req.UserID, folder.Rule, datautil.Distinct(append(folder.ConversationIDs, conversationIDs...))); err != nil {
+		return nil, err
+	}
+	if err := c.folderDatabase.AddFolderConversations(ctx, req.UserID, req.FolderID, conversationIDs); err != nil {
+		return nil, err
+	}
+	c.folderChanged(ctx, req.UserID, []string{req.FolderID})
+	return &pbconversationext.AddFolderConversationsResp{}, nil
+}
+
+func (c *conversationServer) RemoveFolderConversations(ctx context.Context, req *pbconversationext.RemoveFolderConversationsReq) (*pbconversationext.RemoveFolderConversationsResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, c.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if _, err := c.getFolder(ctx, req.UserID, req.FolderID); err != nil {
+		return nil, err
+	}
+	if err := c.folderDatabase.RemoveFolderConversations(ctx, req.UserID, req.FolderID, datautil.Distinct(req.ConversationIDs)); err != nil {
+		return nil, err
+	}
+	c.folderChanged(ctx, req.UserID, []string{req.FolderID})
+	return &pbconversationext.RemoveFolderConversationsResp{}, nil
+}
+
+func (c *conversationServer) DeleteConversationFolders(ctx context.Context, req *pbconversationext.DeleteConversationFoldersReq) (*pbconversationext.DeleteConversationFoldersResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, c.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	folders, err := c.folderDatabase.FindFolders(ctx, req.UserID, datautil.Distinct(req.FolderIDs))
+	if err != nil {
+		return nil, err
+	}
+	folderIDs := datautil.Slice(folders, func(e *model.ConversationFolder) string { return e.FolderID })
+	if len(folderIDs) == 0 {
+		return &pbconversationext.DeleteConversationFoldersResp{}, nil
+	}
+	if err := c.folderDatabase.DeleteFolders(ctx, req.UserID, folderIDs); err != nil {
+		return nil, err
+	}
+	c.folderChanged(ctx, req.UserID, folderIDs)
+	return &pbconversationext.DeleteConversationFoldersResp{}, nil
+}
+
+func (c *conversationServer) GetIncrementalConversationFolders(ctx context.Context, req *pbconversationext.GetIncrementalConversationFoldersReq) (*pbconversationext.GetIncrementalConversationFoldersResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, c.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	opt := incrversion.Option[*pbconversationext.ConversationFolder, pbconversationext.GetIncrementalConversationFoldersResp]{
+		Ctx:           ctx,
+		VersionKey:    req.UserID,
+		VersionID:     req.VersionID,
+		VersionNumber: req.Version,
+		Version:       c.folderDatabase.FindFolderVersion,
+		Find: func(ctx context.Context, folderIDs []string) ([]*pbconversationext.ConversationFolder, error) {
+			folders, err := c.folderDatabase.FindFolders(ctx, req.UserID, folderIDs)
+			if err != nil {
+				return nil, err
+			}
+			return c.convertFolders(ctx, req.UserID, folders)
+		},
+		Resp: func(version *model.VersionLog, delIDs []string, insertList, updateList []*pbconversationext.ConversationFolder, full bool) *pbconversationext.GetIncrementalConversationFoldersResp {
+			return &pbconversationext.GetIncrementalConversationFoldersResp{
+				VersionID: version.ID.Hex(),
+				Version:   uint64(version.Version),
+				Full:      full,
+				Delete:    delIDs,
+				Insert:    insertList,
+				Update:    updateList,
+			}
+		},
+	}
+	resp, err := opt.Build()
+	if err != nil {
+		return nil, err
+	}
+	if resp.Full {
+		// A user has few folders, so a full sync returns all of them instead of only their ids.
+		folders, err := c.folderDatabase.FindAllFolders(ctx, req.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if resp.Insert, err = c.convertFolders(ctx, req.UserID, folders); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func (c *conversationServer) GetFolderConversationIDs(ctx context.Context, req *pbconversationext.GetFolderConversationIDsReq) (*pbconversationext.GetFolderConversationIDsResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, c.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	folder, err := c.getFolder(ctx, req.UserID, req.FolderID)
+	if err != nil {
+		return nil, err
+	}
+	folders, err := c.convertFolders(ctx, req.UserID, []*model.ConversationFolder{folder})
+	if err != nil {
+		return nil, err
+	}
+	return &pbconversationext.GetFolderConversationIDsResp{ConversationIDs: folders[0].ConversationIDs}, nil
+}
+
+func (c *conversationServer) getFolder(ctx context.Context, userID string, folderID string) (*model.ConversationFolder, error) {
+	folders, err := c.folderDatabase.FindFolders(ctx, userID, []string{folderID})
+	if err != nil {
+		return nil, err
+	}
+	if len(folders) == 0 {
+		return nil, errs.ErrRecordNotFound.WrapMsg("conversation folder not found", "folderID", folderID)
+	}
+	return folders[0], nil
+}
+
+// checkFolderConversations checks that the folder lists conversations of its owner, or none for a rule folder.
+func (c *conversationServer) checkFolderConversations(ctx context.Context, userID string, rule string, conversationIDs []string) error {
+	switch rule {
+	case "":
+	case model.ConversationFolderRuleGroups, model.ConversationFolderRuleUnread, model.ConversationFolderRuleMuted:
+		if len(conversationIDs) > 0 {
+			return errs.ErrArgs.WrapMsg("a rule folder can not list conversations", "rule", rule)
+		}
+		return nil
+	default:
+		return errs.ErrArgs.WrapMsg("invalid rule", "rule", rule)
+	}
+	if len(conversationIDs) > maxConversationFolderLength {
+		return errs.ErrArgs.WrapMsg("too many conversations in the folder", "max", maxConversationFolderLength)
+	}
+	if len(conversationIDs) == 0 {
+		return nil
+	}
+	conversations, err := c.conversationDatabase.FindConversations(ctx, userID, conversationIDs)
+	if err != nil {
+		return err
+	}
+	if len(conversations) != len(conversationIDs) {
+		return errs.ErrArgs.WrapMsg("conversation not found", "conversationIDs", conversationIDs)
+	}
+	return nil
+}
+
+// convertFolders converts the folders, the conversations of the rule folders are those matching
+// their rule now, the conversations of the user are only read when there is a rule folder.
+func (c *conversationServer) convertFolders(ctx context.Context, userID string, folders []*model.ConversationFolder) ([]*pbconversationext.ConversationFolder, error) {
+	var (
+		conversations []*model.Conversation
+		unread        map[string]int64
+	)
+	for _, folder := range folders {
+		if folder.Rule == "" || conversations != nil {
+			continue
+		}
+		var err error
+		if conversations, err = c.conversationDatabase.GetUserAllConversation(ctx, userID); err != nil {
+			return nil, err
+		}
+		if conversations == nil {
+			conversations = []*model.Conversation{}
+		}
+	}
+	res := make([]*pbconversationext.ConversationFolder, 0, len(folders))
+	for _, folder := range folders {
+		conversationIDs := folder.ConversationIDs
+		if folder.Rule != "" {
+			if folder.Rule == model.ConversationFolderRuleUnread && unread == nil {
+				var err error
+				if unread, err = c.unreadCounts(ctx, userID, conversations); err != nil {
+					return nil, err
+				}
+			}
+			conversationIDs = matchFolderRule(folder.Rule, conversations, unread)
+		}
+		res = append(res, &pbconversationext.ConversationFolder{
+			FolderID:        folder.FolderID,
+			Name:            folder.Name,
+			Order:           folder.Order,
+			Rule:            folder.Rule,
+			ConversationIDs: conversationIDs,
+			Ex:              folder.Ex,
+			CreateTime:      folder.CreateTime.UnixMilli(),
+			UpdateTime:      folder.UpdateTime.UnixMilli(),
+		})
+	}
+	return res, nil
+}
+
+// unreadCounts returns the unread count of every conversation, like GetSortedConversationList.
+func (c *conversationServer) unreadCounts(ctx context.Context, userID string, conversations []*model.Conversation) (map[string]int64, error) {
+	if len(conversations) == 0 {
+		return map[string]int64{}, nil
+	}
+	conversationIDs := datautil.Slice(conversations, func(e *model.Conversation) string { return e.ConversationID })
+	maxSeqs, err := c.msgClient.GetMaxSeqs(ctx, conversationIDs)
+	if err != nil {
+		return nil, err
+	}
+	hasReadSeqs, err := c.msgClient.GetHasReadSeqs(ctx, conversationIDs, userID)
+	if err != nil {
+		return nil, err
+	}
+	unread := make(map[string]int64, len(maxSeqs))
+	for conversationID, maxSeq := range maxSeqs {
+		unread[conversationID] = maxSeq - hasReadSeqs[conversationID]
+	}
+	return unread, nil
+}
+
+// matchFolderRule returns the conversations matching the rule of a folder.
+func matchFolderRule(rule string, conversations []*model.Conversation, unread map[string]int64) []string {
+	var conversationIDs []string
+	for _, conversation := range conversations {
+		var match bool
+		switch rule {
+		case model.ConversationFolderRuleGroups:
+			match = conversation.ConversationType == constant.ReadGroupChatType
+		case model.ConversationFolderRuleMuted:
+			match = conversation.RecvMsgOpt != constant.ReceiveMessage
+		case model.ConversationFolderRuleUnread:
+			match = unread[conversation.ConversationID] > 0
+		}
+		if match {
+			conversationIDs = append(conversationIDs, conversation.ConversationID)
+		}
+	}
+	return conversationIDs
+}
+
+// folderChanged tells the other devices of the user to sync the folders.
+func (c *conversationServer) folderChanged(ctx context.Context, userID string, folderIDs []string) {
+	req := &msg.SendMsgReq{
+		MsgData: &sdkws.MsgData{
+			SendID: userID,
+			RecvID: userID,
+			Content: []byte(jsonutil.StructToJsonString(&sdkws.NotificationElem{
+				Detail: jsonutil.StructToJsonString(&struct {
+					Key  string `json:"key"`
+					Data string `json:"data"`
+				}{Key: conversationFolderChangedKey, Data: jsonutil.StructToJsonString(folderIDs)}),
+			})),
+			MsgFrom:     constant.SysMsgType,
+			ContentType: constant.BusinessNotification,
+			SessionType: constant.SingleChatType,
+			CreateTime:  timeutil.GetCurrentTimestampByMill(),
+			ClientMsgID: idutil.GetMsgIDByMD5(userID),
+			Options: config.GetOptionsByNotification(config.NotificationConfig{
+				ReliabilityLevel: constant.UnreliableNotification,
+			}),
+		},
+	}
+	if _, err := c.msgClient.SendMsg(ctx, req); err != nil {
+		log.ZWarn(ctx, "send conversation folder changed notification failed", err, "userID", userID)
+	}
+}
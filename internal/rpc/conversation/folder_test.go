@@ -0,0 +1,194 @@
+package conversation
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	pbconversationext "github.com/openimsdk/open-im-server/v3/pkg/protocol/conversationext"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpcli"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+	"google.golang.org/grpc"
+)
+
+type fakeConversationDatabase struct {
+	controller.ConversationDatabase
+	conversations []*model.Conversation
+}
+
+func (f *fakeConversationDatabase) FindConversations(_ context.Context, ownerUserID string, conversationIDs []string) ([]*model.Conversation, error) {
+	var res []*model.Conversation
+	for _, conversation := range f.conversations {
+		for _, conversationID := range conversationIDs {
+			if conversation.OwnerUserID == ownerUserID && conversation.ConversationID == conversationID {
+				res = append(res, conversation)
+			}
+		}
+	}
+	return res, nil
+}
+
+func (f *fakeConversationDatabase) GetUserAllConversation(_ context.Context, ownerUserID string) ([]*model.Conversation, error) {
+	var res []*model.Conversation
+	for _, conversation := range f.conversations {
+		if conversation.OwnerUserID == ownerUserID {
+			res = append(res, conversation)
+		}
+	}
+	return res, nil
+}
+
+type fakeConversationFolderDatabase struct {
+	controller.ConversationFolderDatabase
+	folders []*model.ConversationFolder
+}
+
+func (f *fakeConversationFolderDatabase) CreateFolder(_ context.Context, folder *model.ConversationFolder, limit int) error {
+	var count int
+	for _, e := range f.folders {
+		if e.OwnerUserID == folder.OwnerUserID {
+			count++
+		}
+	}
+	if count >= limit {
+		return errs.ErrArgs.WrapMsg("too many conversation folders")
+	}
+	folder.FolderID = strconv.Itoa(len(f.folders))
+	f.folders = append(f.folders, folder)
+	return nil
+}
+
+func (f *fakeConversationFolderDatabase) FindFolders(_ context.Context, ownerUserID string, folderIDs []string) ([]*model.ConversationFolder, error) {
+	var res []*model.ConversationFolder
+	for _, folder := range f.folders {
+		for _, folderID := range folderIDs {
+			if folder.OwnerUserID == ownerUserID && folder.FolderID == folderID {
+				res = append(res, folder)
+			}
+		}
+	}
+	return res, nil
+}
+
+type fakeMsgClient struct {
+	msg.MsgClient
+	maxSeqs     map[string]int64
+	hasReadSeqs map[string]int64
+	sent        int
+}
+
+func (f *fakeMsgClient) GetMaxSeqs(context.Context, *msg.GetMaxSeqsReq, ...grpc.CallOption) (*msg.SeqsInfoResp, error) {
+	return &msg.SeqsInfoResp{MaxSeqs: f.maxSeqs}, nil
+}
+
+func (f *fakeMsgClient) GetHasReadSeqs(context.Context, *msg.GetHasReadSeqsReq, ...grpc.CallOption) (*msg.SeqsInfoResp, error) {
+	return &msg.SeqsInfoResp{MaxSeqs: f.hasReadSeqs}, nil
+}
+
+func (f *fakeMsgClient) SendMsg(context.Context, *msg.SendMsgReq, ...grpc.CallOption) (*msg.SendMsgResp, error) {
+	f.sent++
+	return &msg.SendMsgResp{}, nil
+}
+
+func newFolderTestServer(msgClient *fakeMsgClient) (*conversationServer, *fakeConversationFolderDatabase) {
+	folderDB := &fakeConversationFolderDatabase{}
+	return &conversationServer{
+		conversationDatabase: &fakeConversationDatabase{conversations: []*model.Conversation{
+			{OwnerUserID: "u1", ConversationID: "si_u1_u2", ConversationType: constant.SingleChatType, RecvMsgOpt: constant.ReceiveMessage},
+			{OwnerUserID: "u1", ConversationID: "sg_g1", ConversationType: constant.ReadGroupChatType, RecvMsgOpt: constant.NotReceiveMessage},
+			{OwnerUserID: "u1", ConversationID: "sg_g2", ConversationType: constant.ReadGroupChatType, RecvMsgOpt: constant.ReceiveMessage},
+		}},
+		folderDatabase: folderDB,
+		config:         &Config{},
+		msgClient:      &rpcli.MsgClient{MsgClient: msgClient},
+	}, folderDB
+}
+
+func TestMatchFolderRule(t *testing.T) {
+	conversations := []*model.Conversation{
+		{ConversationID: "si_u1_u2", ConversationType: constant.SingleChatType, RecvMsgOpt: constant.ReceiveMessage},
+		{ConversationID: "sg_g1", ConversationType: constant.ReadGroupChatType, RecvMsgOpt: constant.NotReceiveMessage},
+		{ConversationID: "sg_g2", ConversationType: constant.ReadGroupChatType, RecvMsgOpt: constant.ReceiveNotNotifyMessage},
+	}
+	unread := map[string]int64{"si_u1_u2": 2, "sg_g1": 0}
+	for _, c := range []struct {
+		rule string
+		want []string
+	}{
+		{model.ConversationFolderRuleGroups, []string{"sg_g1", "sg_g2"}},
+		{model.ConversationFolderRuleMuted, []string{"sg_g1", "sg_g2"}},
+		{model.ConversationFolderRuleUnread, []string{"si_u1_u2"}},
+	} {
+		if got := matchFolderRule(c.rule, conversations, unread); !reflect.DeepEqual(got, c.want) {
+			t.Fatal("unexpected conversations", c.rule, got)
+		}
+	}
+}
+
+func TestCreateConversationFolder(t *testing.T) {
+	msgClient := &fakeMsgClient{}
+	s, folderDB := newFolderTestServer(msgClient)
+	ctx := mcontext.WithOpUserIDContext(context.Background(), "u1")
+	if _, err := s.CreateConversationFolder(mcontext.WithOpUserIDContext(context.Background(), "u2"), &pbconversationext.CreateConversationFolderReq{UserID: "u1", Name: "f"}); err == nil {
+		t.Fatal("created a folder of another user")
+	}
+	if _, err := s.CreateConversationFolder(ctx, &pbconversationext.CreateConversationFolderReq{UserID: "u1", Name: "f", ConversationIDs: []string{"si_u1_u3"}}); err == nil {
+		t.Fatal("listed a conversation the user does not have")
+	}
+	if _, err := s.CreateConversationFolder(ctx, &pbconversationext.CreateConversationFolderReq{UserID: "u1", Name: "f", Rule: model.ConversationFolderRuleGroups, ConversationIDs: []string{"sg_g1"}}); err == nil {
+		t.Fatal("listed a conversation in a rule folder")
+	}
+	if _, err := s.CreateConversationFolder(ctx, &pbconversationext.CreateConversationFolderReq{UserID: "u1", Name: "f", Rule: "pinned"}); err == nil {
+		t.Fatal("created a folder with an unknown rule")
+	}
+	for i := 0; i < maxConversationFolders; i++ {
+		if _, err := s.CreateConversationFolder(ctx, &pbconversationext.CreateConversationFolderReq{UserID: "u1", Name: "f", ConversationIDs: []string{"sg_g1", "sg_g1"}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := s.CreateConversationFolder(ctx, &pbconversationext.CreateConversationFolderReq{UserID: "u1", Name: "f"}); err == nil {
+		t.Fatal("created more folders than the limit")
+	}
+	if len(folderDB.folders) != maxConversationFolders || !reflect.DeepEqual(folderDB.folders[0].ConversationIDs, []string{"sg_g1"}) {
+		t.Fatal("unexpected folders", len(folderDB.folders), folderDB.folders[0].ConversationIDs)
+	}
+	if msgClient.sent != maxConversationFolders {
+		t.Fatal("unexpected notifications", msgClient.sent)
+	}
+}
+
+func TestGetFolderConversationIDs(t *testing.T) {
+	s, _ := newFolderTestServer(&fakeMsgClient{
+		maxSeqs:     map[string]int64{"si_u1_u2": 5, "sg_g1": 3, "sg_g2": 7},
+		hasReadSeqs: map[string]int64{"si_u1_u2": 5, "sg_g1": 1},
+	})
+	ctx := mcontext.WithOpUserIDContext(context.Background(), "u1")
+	for _, c := range []struct {
+		rule            string
+		conversationIDs []string
+		want            []string
+	}{
+		{"", []string{"si_u1_u2"}, []string{"si_u1_u2"}},
+		{model.ConversationFolderRuleGroups, nil, []string{"sg_g1", "sg_g2"}},
+		{model.ConversationFolderRuleMuted, nil, []string{"sg_g1"}},
+		{model.ConversationFolderRuleUnread, nil, []string{"sg_g1", "sg_g2"}},
+	} {
+		created, err := s.CreateConversationFolder(ctx, &pbconversationext.CreateConversationFolderReq{UserID: "u1", Name: "f", Rule: c.rule, ConversationIDs: c.conversationIDs})
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := s.GetFolderConversationIDs(ctx, &pbconversationext.GetFolderConversationIDsReq{UserID: "u1", FolderID: created.FolderID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(resp.ConversationIDs, c.want) {
+			t.Fatal("unexpected conversations", c.rule, resp.ConversationIDs)
+		}
+	}
+}
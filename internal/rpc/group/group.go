@@ -63,6 +63,7 @@ type groupServer struct {
 	userClient         *rpcli.UserClient
 	msgClient          *rpcli.MsgClient
 	conversationClient *rpcli.ConversationClient
+	auditLog           controller.AuditLogDatabase
 }
 
 type Config struct {
@@ -97,6 +98,14 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	if err != nil {
 		return err
 	}
+	auditLogDB, err := mgo.NewAuditLogMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	auditLogDatabase, err := controller.NewAuditLogDatabase(auditLogDB)
+	if err != nil {
+		return err
+	}
 
 	//userRpcClient := rpcclient.NewUserRpcClient(client, config.Share.RpcRegisterName.User, config.Share.IMAdminUserID)
 	//msgRpcClient := rpcclient.NewMessageRpcClient(client, config.Share.RpcRegisterName.Msg)
@@ -116,10 +125,11 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	}
 	gs := groupServer{
 		config:             config,
-		webhookClient:      webhook.NewWebhookClient(config.WebhooksConfig.URL),
+		webhookClient:      webhook.NewWebhookClient(&config.WebhooksConfig),
 		userClient:         rpcli.NewUserClient(userConn),
 		msgClient:          rpcli.NewMsgClient(msgConn),
 		conversationClient: rpcli.NewConversationClient(conversationConn),
+		auditLog:           auditLogDatabase,
 	}
 	gs.db = controller.NewGroupDatabase(rdb, &config.LocalCacheConfig, groupDB, groupMemberDB, groupRequestDB, mgocli.GetTx(), grouphash.NewGroupHashFromGroupServer(&gs))
 	gs.notification = NewNotificationSender(gs.db, config, gs.userClient, gs.msgClient, gs.conversationClient)
@@ -128,6 +138,14 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	return nil
 }
 
+func (g *groupServer) AuditLogDatabase() controller.AuditLogDatabase {
+	return g.auditLog
+}
+
+func (g *groupServer) IMAdminUserIDs() []string {
+	return g.config.Share.IMAdminUserID
+}
+
 func (g *groupServer) NotificationUserInfoUpdate(ctx context.Context, req *pbgroup.NotificationUserInfoUpdateReq) (*pbgroup.NotificationUserInfoUpdateResp, error) {
 	members, err := g.db.FindGroupMemberUser(ctx, nil, req.UserID)
 	if err != nil {
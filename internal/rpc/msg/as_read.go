@@ -19,6 +19,7 @@ import (
 	"errors"
 
 	cbapi "github.com/openimsdk/open-im-server/v3/pkg/callbackstruct"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/msg"
 	"github.com/openimsdk/protocol/sdkws"
@@ -82,9 +83,15 @@ func (m *msgServer) SetConversationHasReadSeq(ctx context.Context, req *msg.SetC
 	if req.HasReadSeq > maxSeq {
 		return nil, errs.ErrArgs.WrapMsg("hasReadSeq must not be bigger than maxSeq")
 	}
+	hasReadSeq, err := m.MsgDatabase.GetHasReadSeq(ctx, req.UserID, req.ConversationID)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
 	if err := m.MsgDatabase.SetHasReadSeq(ctx, req.UserID, req.ConversationID, req.HasReadSeq); err != nil {
 		return nil, err
 	}
+	m.readBadge(ctx, req.UserID, req.ConversationID, hasReadSeq, req.HasReadSeq)
+	m.pruneMentions(ctx, req.UserID, req.ConversationID, req.HasReadSeq)
 	m.sendMarkAsReadNotification(ctx, req.ConversationID, constant.SingleChatType, req.UserID, req.UserID, nil, req.HasReadSeq)
 	return &msg.SetConversationHasReadSeqResp{}, nil
 }
@@ -117,6 +124,7 @@ func (m *msgServer) MarkMsgsAsRead(ctx context.Context, req *msg.MarkMsgsAsReadR
 		if err != nil {
 			return nil, err
 		}
+		m.readBadge(ctx, req.UserID, req.ConversationID, currentHasReadSeq, hasReadSeq)
 	}
 
 	reqCallback := &cbapi.CallbackSingleMsgReadReq{
@@ -164,6 +172,7 @@ func (m *msgServer) MarkConversationAsRead(ctx context.Context, req *msg.MarkCon
 			if err != nil {
 				return nil, err
 			}
+			m.readBadge(ctx, req.UserID, req.ConversationID, hasReadSeq, req.HasReadSeq)
 			hasReadSeq = req.HasReadSeq
 		}
 		m.sendMarkAsReadNotification(ctx, req.ConversationID, conversation.ConversationType, req.UserID,
@@ -175,8 +184,10 @@ func (m *msgServer) MarkConversationAsRead(ctx context.Context, req *msg.MarkCon
 			if err != nil {
 				return nil, err
 			}
+			m.readBadge(ctx, req.UserID, req.ConversationID, hasReadSeq, req.HasReadSeq)
 			hasReadSeq = req.HasReadSeq
 		}
+		m.pruneMentions(ctx, req.UserID, req.ConversationID, hasReadSeq)
 		m.sendMarkAsReadNotification(ctx, req.ConversationID, constant.SingleChatType, req.UserID,
 			req.UserID, seqs, hasReadSeq)
 	}
@@ -211,3 +222,40 @@ func (m *msgServer) sendMarkAsReadNotification(ctx context.Context, conversation
 	m.notificationSender.NotificationWithSessionType(ctx, sendID, recvID, constant.HasReadReceipt, sessionType, tips)
 
 }
+
+// pruneMentions drops the mentions of the group conversation the user has read.
+func (m *msgServer) pruneMentions(ctx context.Context, userID string, conversationID string, hasReadSeq int64) {
+	if !msgprocessor.IsGroupConversationID(conversationID) {
+		return
+	}
+	if err := m.MentionDatabase.PruneMentions(ctx, userID, conversationID, hasReadSeq); err != nil {
+		log.ZWarn(ctx, "prune mentions failed", err, "userID", userID, "conversationID", conversationID, "hasReadSeq", hasReadSeq)
+	}
+}
+
+// readBadge removes the messages read from the app badge of the user computed by the push service,
+// the conversations which do not notify are not counted in it.
+func (m *msgServer) readBadge(ctx context.Context, userID string, conversationID string, hasReadSeq int64, newHasReadSeq int64) {
+	conversation, err := m.ConversationLocalCache.GetConversation(ctx, userID, conversationID)
+	if err != nil {
+		log.ZWarn(ctx, "get conversation of badge failed", err, "userID", userID, "conversationID", conversationID)
+		if err := m.badgeCache.DelBadges(ctx, userID); err != nil {
+			log.ZWarn(ctx, "delete badge failed", err, "userID", userID)
+		}
+		return
+	}
+	if conversation.RecvMsgOpt != constant.ReceiveMessage {
+		return
+	}
+	// The badge counts the messages after the min seq and up to the max seq the conversation was left at.
+	hasReadSeq = max(hasReadSeq, conversation.MinSeq-1)
+	if conversation.MaxSeq != 0 {
+		newHasReadSeq = min(newHasReadSeq, conversation.MaxSeq)
+	}
+	if newHasReadSeq <= hasReadSeq {
+		return
+	}
+	if err := m.badgeCache.ReadBadge(ctx, userID, conversationID, hasReadSeq, newHasReadSeq); err != nil {
+		log.ZWarn(ctx, "decr badge failed", err, "userID", userID, "conversationID", conversationID)
+	}
+}
@@ -157,5 +157,9 @@ func (m *msgServer) clearConversation(ctx context.Context, conversationIDs []str
 	if err := m.MsgDatabase.UserSetHasReadSeqs(ctx, userID, maxSeqs); err != nil {
 		return err
 	}
+	// The cleared messages are no longer unread, the count of the user is computed again.
+	if err := m.badgeCache.DelBadges(ctx, userID); err != nil {
+		log.ZWarn(ctx, "delete badge failed", err, "userID", userID)
+	}
 	return nil
 }
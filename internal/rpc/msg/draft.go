@@ -0,0 +1,102 @@
+package msg
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/internal/rpc/incrversion"
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/notification"
+	pbmsgext "github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+func draftDB2Pb(draft *model.ConversationDraft) *pbmsgext.ConversationDraft {
+	return &pbmsgext.ConversationDraft{
+		ConversationID:   draft.ConversationID,
+		Text:             draft.Text,
+		ReplyClientMsgID: draft.ReplyClientMsgID,
+		UpdateTime:       draft.UpdateTime.UnixMilli(),
+	}
+}
+
+func (m *msgServer) SetConversationDraft(ctx context.Context, req *pbmsgext.SetConversationDraftReq) (*pbmsgext.SetConversationDraftResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	draft := &model.ConversationDraft{
+		OwnerUserID:      req.UserID,
+		ConversationID:   req.ConversationID,
+		Text:             req.Text,
+		ReplyClientMsgID: req.ReplyClientMsgID,
+		UpdateTime:       time.Now(),
+	}
+	stored, err := m.draftDatabase.SetDraft(ctx, draft)
+	if err != nil {
+		return nil, err
+	}
+	if stored {
+		// The other devices of the user learn about the draft.
+		notification := rpcclient.NewSelfBusinessNotification(draft.OwnerUserID, rpcclient.ConversationDraftChangedKey, convert.ConversationDraftDB2Api(draft))
+		if _, err := m.SendMsg(ctx, notification); err != nil {
+			log.ZWarn(ctx, "send conversation draft changed notification failed", err, "userID", draft.OwnerUserID, "conversationID", draft.ConversationID)
+		}
+	}
+	return &pbmsgext.SetConversationDraftResp{}, nil
+}
+
+func (m *msgServer) GetConversationDrafts(ctx context.Context, req *pbmsgext.GetConversationDraftsReq) (*pbmsgext.GetConversationDraftsResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	drafts, err := m.draftDatabase.GetDrafts(ctx, req.UserID, req.ConversationIDs)
+	if err != nil {
+		return nil, err
+	}
+	return &pbmsgext.GetConversationDraftsResp{Drafts: datautil.Slice(drafts, draftDB2Pb)}, nil
+}
+
+func (m *msgServer) GetIncrementalConversationDrafts(ctx context.Context, req *pbmsgext.GetIncrementalConversationDraftsReq) (*pbmsgext.GetIncrementalConversationDraftsResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	opt := incrversion.Option[*pbmsgext.ConversationDraft, pbmsgext.GetIncrementalConversationDraftsResp]{
+		Ctx:           ctx,
+		VersionKey:    req.UserID,
+		VersionID:     req.VersionID,
+		VersionNumber: req.Version,
+		Version:       m.draftDatabase.FindDraftVersion,
+		Find: func(ctx context.Context, conversationIDs []string) ([]*pbmsgext.ConversationDraft, error) {
+			drafts, err := m.draftDatabase.GetDrafts(ctx, req.UserID, conversationIDs)
+			if err != nil {
+				return nil, err
+			}
+			return datautil.Slice(drafts, draftDB2Pb), nil
+		},
+		Resp: func(version *model.VersionLog, delIDs []string, insertList, updateList []*pbmsgext.ConversationDraft, full bool) *pbmsgext.GetIncrementalConversationDraftsResp {
+			return &pbmsgext.GetIncrementalConversationDraftsResp{
+				VersionID: version.ID.Hex(),
+				Version:   uint64(version.Version),
+				Full:      full,
+				Delete:    delIDs,
+				Insert:    insertList,
+				Update:    updateList,
+			}
+		},
+	}
+	resp, err := opt.Build()
+	if err != nil {
+		return nil, err
+	}
+	if resp.Full {
+		drafts, err := m.draftDatabase.GetAllDrafts(ctx, req.UserID)
+		if err != nil {
+			return nil, err
+		}
+		resp.Insert = datautil.Slice(drafts, draftDB2Pb)
+	}
+	return resp, nil
+}
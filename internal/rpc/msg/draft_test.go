@@ -0,0 +1,82 @@
+package msg
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	pbmsgext "github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/tools/mcontext"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type fakeConversationDraftDatabase struct {
+	controller.ConversationDraftDatabase
+	version *model.VersionLog
+	drafts  map[string]*model.ConversationDraft
+}
+
+func (f *fakeConversationDraftDatabase) FindDraftVersion(context.Context, string, uint, int) (*model.VersionLog, error) {
+	return f.version, nil
+}
+
+func (f *fakeConversationDraftDatabase) GetDrafts(_ context.Context, _ string, conversationIDs []string) ([]*model.ConversationDraft, error) {
+	var drafts []*model.ConversationDraft
+	for _, conversationID := range conversationIDs {
+		if draft, ok := f.drafts[conversationID]; ok {
+			drafts = append(drafts, draft)
+		}
+	}
+	return drafts, nil
+}
+
+func (f *fakeConversationDraftDatabase) GetAllDrafts(context.Context, string) ([]*model.ConversationDraft, error) {
+	return []*model.ConversationDraft{f.drafts["si_u1_u2"], f.drafts["si_u1_u3"]}, nil
+}
+
+func TestGetIncrementalConversationDrafts(t *testing.T) {
+	versionID := primitive.NewObjectID()
+	db := &fakeConversationDraftDatabase{
+		version: &model.VersionLog{
+			ID:      versionID,
+			Version: 5,
+			Logs: []model.VersionLogElem{
+				{EID: "si_u1_u3", State: model.VersionStateUpdate, Version: 5},
+				{EID: "si_u1_u4", State: model.VersionStateDelete, Version: 4},
+			},
+			LogLen: 2,
+		},
+		drafts: map[string]*model.ConversationDraft{
+			"si_u1_u2": {ConversationID: "si_u1_u2", Text: "a", UpdateTime: time.UnixMilli(1)},
+			"si_u1_u3": {ConversationID: "si_u1_u3", Text: "b", UpdateTime: time.UnixMilli(2)},
+		},
+	}
+	s := &msgServer{draftDatabase: db, config: &Config{}}
+	ctx := mcontext.WithOpUserIDContext(context.Background(), "u1")
+	req := &pbmsgext.GetIncrementalConversationDraftsReq{UserID: "u1", VersionID: versionID.Hex(), Version: 3}
+	resp, err := s.GetIncrementalConversationDrafts(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Full || resp.Version != 5 || len(resp.Insert) != 0 || len(resp.Update) != 1 || resp.Update[0].ConversationID != "si_u1_u3" ||
+		!reflect.DeepEqual(resp.Delete, []string{"si_u1_u4"}) {
+		t.Fatal("unexpected incremental drafts", resp)
+	}
+
+	// Without a version every draft is returned.
+	req.VersionID, req.Version = "", 0
+	resp, err = s.GetIncrementalConversationDrafts(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.Full || len(resp.Insert) != 2 || resp.Insert[0].ConversationID != "si_u1_u2" {
+		t.Fatal("unexpected full drafts", resp)
+	}
+
+	if _, err := s.GetIncrementalConversationDrafts(mcontext.WithOpUserIDContext(context.Background(), "u2"), req); err == nil {
+		t.Fatal("expected the drafts of another user to be denied")
+	}
+}
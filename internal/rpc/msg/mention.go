@@ -0,0 +1,83 @@
+package msg
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	pbmsgext "github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// GetUnreadMentions counts the unread mentions of the user. Without conversations it looks in the group conversations
+// of the user, the mentions of all the members are stored for the conversation so they can not be found by user.
+func (m *msgServer) GetUnreadMentions(ctx context.Context, req *pbmsgext.GetUnreadMentionsReq) (*pbmsgext.GetUnreadMentionsResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	conversationIDs := req.ConversationIDs
+	if len(conversationIDs) == 0 {
+		userConversationIDs, err := m.conversationClient.GetConversationIDs(ctx, req.UserID)
+		if err != nil {
+			return nil, err
+		}
+		conversationIDs = datautil.Filter(userConversationIDs, func(conversationID string) (string, bool) {
+			return conversationID, msgprocessor.IsGroupConversationID(conversationID)
+		})
+	}
+	mentions, err := m.MentionDatabase.GetMentions(ctx, req.UserID, conversationIDs)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pbmsgext.GetUnreadMentionsResp{Mentions: make([]*pbmsgext.ConversationMention, 0, len(mentions))}
+	if len(mentions) == 0 {
+		return resp, nil
+	}
+	hasReadSeqs, err := m.MsgDatabase.GetHasReadSeqs(ctx, req.UserID, datautil.Slice(mentions, func(e *model.ConversationMention) string {
+		return e.ConversationID
+	}))
+	if err != nil {
+		return nil, err
+	}
+	for _, mention := range mentions {
+		unread := mention.UnreadSeqs(hasReadSeqs[mention.ConversationID])
+		if len(unread) == 0 {
+			continue
+		}
+		resp.Mentions = append(resp.Mentions, &pbmsgext.ConversationMention{
+			ConversationID: mention.ConversationID,
+			UnreadCount:    int32(len(unread)),
+			FirstSeq:       unread[0],
+		})
+	}
+	return resp, nil
+}
+
+// GetNextMention returns the next unread message mentioning the user after the given seq.
+func (m *msgServer) GetNextMention(ctx context.Context, req *pbmsgext.GetNextMentionReq) (*pbmsgext.GetNextMentionResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, m.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	mentions, err := m.MentionDatabase.GetMentions(ctx, req.UserID, []string{req.ConversationID})
+	if err != nil {
+		return nil, err
+	}
+	resp := &pbmsgext.GetNextMentionResp{}
+	if len(mentions) == 0 {
+		return resp, nil
+	}
+	hasReadSeq, err := m.MsgDatabase.GetHasReadSeq(ctx, req.UserID, req.ConversationID)
+	if err != nil {
+		return nil, err
+	}
+	unread := mentions[0].UnreadSeqs(hasReadSeq)
+	resp.UnreadCount = int32(len(unread))
+	for _, seq := range unread {
+		if seq > req.Seq {
+			resp.Seq = seq
+			break
+		}
+	}
+	return resp, nil
+}
@@ -16,9 +16,13 @@ package msg
 
 import (
 	"context"
+	"time"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/apistruct"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+	"github.com/openimsdk/open-im-server/v3/pkg/notification"
 	"github.com/openimsdk/open-im-server/v3/pkg/util/conversationutil"
 	"github.com/openimsdk/protocol/constant"
 	pbconv "github.com/openimsdk/protocol/conversation"
@@ -72,6 +76,7 @@ func (m *msgServer) sendMsgGroupChat(ctx context.Context, req *pbmsg.SendMsgReq)
 	if req.MsgData.ContentType == constant.AtText {
 		go m.setConversationAtInfo(ctx, req.MsgData)
 	}
+	m.clearDraft(ctx, req.MsgData)
 
 	m.webhookAfterSendGroupMsg(ctx, &m.config.WebhooksConfig.AfterSendGroupMsg, req)
 	prommetrics.GroupChatMsgProcessSuccessCounter.Inc()
@@ -92,7 +97,7 @@ func (m *msgServer) setConversationAtInfo(nctx context.Context, msg *sdkws.MsgDa
 		}
 	}()
 
-	ctx := mcontext.NewCtx("@@@" + mcontext.GetOperationID(nctx))
+	ctx := tenant.WithTenantID(mcontext.NewCtx("@@@"+mcontext.GetOperationID(nctx)), tenant.GetTenantID(nctx))
 
 	var atUserID []string
 
@@ -187,6 +192,7 @@ func (m *msgServer) sendMsgSingleChat(ctx context.Context, req *pbmsg.SendMsgReq
 			return nil, err
 		}
 		m.webhookAfterSendSingleMsg(ctx, &m.config.WebhooksConfig.AfterSendSingleMsg, req)
+		m.clearDraft(ctx, req.MsgData)
 		prommetrics.SingleChatMsgProcessSuccessCounter.Inc()
 		return &pbmsg.SendMsgResp{
 			ServerMsgID: req.MsgData.ServerMsgID,
@@ -195,3 +201,23 @@ func (m *msgServer) sendMsgSingleChat(ctx context.Context, req *pbmsg.SendMsgReq
 		}, nil
 	}
 }
+
+// clearDraft removes the draft the sender kept in the conversation, the message sent replaces it.
+func (m *msgServer) clearDraft(ctx context.Context, msg *sdkws.MsgData) {
+	if msg.MsgFrom != constant.UserMsgType || msgprocessor.IsNotificationByMsg(msg) {
+		return
+	}
+	userID, conversationID := msg.SendID, msgprocessor.GetConversationIDByMsg(msg)
+	cleared, err := m.draftDatabase.ClearDraft(ctx, userID, conversationID)
+	if err != nil {
+		log.ZWarn(ctx, "clear conversation draft failed", err, "userID", userID, "conversationID", conversationID)
+		return
+	}
+	if !cleared {
+		return
+	}
+	draft := &apistruct.ConversationDraft{ConversationID: conversationID, UpdateTime: time.Now().UnixMilli()}
+	if _, err := m.SendMsg(ctx, rpcclient.NewSelfBusinessNotification(userID, rpcclient.ConversationDraftChangedKey, draft)); err != nil {
+		log.ZWarn(ctx, "send conversation draft changed notification failed", err, "userID", userID, "conversationID", conversationID)
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcli"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/redis"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/mgo"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/webhook"
@@ -28,6 +29,7 @@ import (
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
 	"github.com/openimsdk/open-im-server/v3/pkg/notification"
+	pbmsgext "github.com/openimsdk/open-im-server/v3/pkg/protocol/msgext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpccache"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/conversation"
@@ -56,9 +58,12 @@ type Config struct {
 // MsgServer encapsulates dependencies required for message handling.
 type msgServer struct {
 	msg.UnimplementedMsgServer
+	pbmsgext.UnimplementedMsgExtServer
 	RegisterCenter         discovery.SvcDiscoveryRegistry // Service discovery registry for service registration.
 	MsgDatabase            controller.CommonMsgDatabase   // Interface for message database operations.
 	StreamMsgDatabase      controller.StreamMsgDatabase
+	UserSuspendDatabase    controller.UserSuspendDatabase
+	MentionDatabase        controller.ConversationMentionDatabase
 	UserLocalCache         *rpccache.UserLocalCache         // Local cache for user data.
 	FriendLocalCache       *rpccache.FriendLocalCache       // Local cache for friend data.
 	GroupLocalCache        *rpccache.GroupLocalCache        // Local cache for group data.
@@ -69,6 +74,9 @@ type msgServer struct {
 	config                 *Config                          // Global configuration settings.
 	webhookClient          *webhook.Client
 	conversationClient     *rpcli.ConversationClient
+	auditLog               controller.AuditLogDatabase
+	draftDatabase          controller.ConversationDraftDatabase
+	badgeCache             cache.BadgeCache
 }
 
 func (m *msgServer) addInterceptorHandler(interceptorFunc ...MessageInterceptorFunc) {
@@ -104,6 +112,26 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 		return err
 	}
 	seqUserCache := redis.NewSeqUserCacheRedis(rdb, seqUser)
+	userSuspend, err := mgo.NewUserSuspendMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	auditLogDB, err := mgo.NewAuditLogMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	auditLogDatabase, err := controller.NewAuditLogDatabase(auditLogDB)
+	if err != nil {
+		return err
+	}
+	conversationDraft, err := mgo.NewConversationDraftMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	conversationMention, err := mgo.NewConversationMentionMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
 	msgDatabase, err := controller.NewCommonMsgDatabase(msgDocModel, msgModel, seqUserCache, seqConversationCache, &config.KafkaConfig)
 	if err != nil {
 		return err
@@ -128,24 +156,38 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	s := &msgServer{
 		MsgDatabase:            msgDatabase,
 		StreamMsgDatabase:      controller.NewStreamMsgDatabase(streamMsg),
+		UserSuspendDatabase:    controller.NewUserSuspendDatabase(userSuspend, redis.NewUserSuspendCacheRedis(rdb, userSuspend, redis.GetRocksCacheOptions())),
+		MentionDatabase:        controller.NewConversationMentionDatabase(conversationMention),
 		RegisterCenter:         client,
 		UserLocalCache:         rpccache.NewUserLocalCache(rpcli.NewUserClient(userConn), &config.LocalCacheConfig, rdb),
 		GroupLocalCache:        rpccache.NewGroupLocalCache(rpcli.NewGroupClient(groupConn), &config.LocalCacheConfig, rdb),
 		ConversationLocalCache: rpccache.NewConversationLocalCache(conversationClient, &config.LocalCacheConfig, rdb),
 		FriendLocalCache:       rpccache.NewFriendLocalCache(rpcli.NewRelationClient(friendConn), &config.LocalCacheConfig, rdb),
 		config:                 config,
-		webhookClient:          webhook.NewWebhookClient(config.WebhooksConfig.URL),
+		webhookClient:          webhook.NewWebhookClient(&config.WebhooksConfig),
 		conversationClient:     conversationClient,
+		auditLog:               auditLogDatabase,
+		draftDatabase:          controller.NewConversationDraftDatabase(conversationDraft, redis.NewConversationDraftCacheRedis(rdb, conversationDraft, redis.GetRocksCacheOptions())),
+		badgeCache:             redis.NewBadgeCacheRedis(rdb),
 	}
 
 	s.notificationSender = rpcclient.NewNotificationSender(&config.NotificationConfig, rpcclient.WithLocalSendMsg(s.SendMsg))
 	s.msgNotificationSender = NewMsgNotificationSender(config, rpcclient.WithLocalSendMsg(s.SendMsg))
 
 	msg.RegisterMsgServer(server, s)
+	pbmsgext.RegisterMsgExtServer(server, s)
 
 	return nil
 }
 
+func (m *msgServer) AuditLogDatabase() controller.AuditLogDatabase {
+	return m.auditLog
+}
+
+func (m *msgServer) IMAdminUserIDs() []string {
+	return m.config.Share.IMAdminUserID
+}
+
 func (m *msgServer) conversationAndGetRecvID(conversation *conversation.Conversation, userID string) string {
 	if conversation.ConversationType == constant.SingleChatType ||
 		conversation.ConversationType == constant.NotificationChatType {
@@ -59,6 +59,9 @@ func (m *msgServer) messageVerification(ctx context.Context, data *msg.SendMsgRe
 			data.MsgData.ContentType >= constant.NotificationBegin {
 			return nil
 		}
+		if err := m.checkSenderSuspended(ctx, data.MsgData.SendID); err != nil {
+			return err
+		}
 		if err := m.webhookBeforeSendSingleMsg(ctx, &m.config.WebhooksConfig.BeforeSendSingleMsg, data); err != nil {
 			return err
 		}
@@ -100,6 +103,9 @@ func (m *msgServer) messageVerification(ctx context.Context, data *msg.SendMsgRe
 			data.MsgData.ContentType >= constant.NotificationBegin {
 			return nil
 		}
+		if err := m.checkSenderSuspended(ctx, data.MsgData.SendID); err != nil {
+			return err
+		}
 		memberIDs, err := m.GroupLocalCache.GetGroupMemberIDMap(ctx, data.MsgData.GroupID)
 		if err != nil {
 			return err
@@ -131,6 +137,17 @@ func (m *msgServer) messageVerification(ctx context.Context, data *msg.SendMsgRe
 	}
 }
 
+func (m *msgServer) checkSenderSuspended(ctx context.Context, sendID string) error {
+	suspend, err := m.UserSuspendDatabase.GetSuspended(ctx, sendID)
+	if err != nil {
+		return err
+	}
+	if suspend != nil {
+		return servererrs.ErrUserSuspended.WrapMsg("sender is suspended", "sendID", sendID, "expireTime", suspend.ExpireTime.UnixMilli())
+	}
+	return nil
+}
+
 func (m *msgServer) encapsulateMsgData(msg *sdkws.MsgData) {
 	msg.ServerMsgID = GetMsgID(msg.SendID)
 	if msg.SendTime == 0 {
@@ -124,7 +124,7 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 		notificationSender: notificationSender,
 		RegisterCenter:     client,
 		config:             config,
-		webhookClient:      webhook.NewWebhookClient(config.WebhooksConfig.URL),
+		webhookClient:      webhook.NewWebhookClient(&config.WebhooksConfig),
 		queue:              memamq.NewMemoryQueue(16, 1024*1024),
 		userClient:         userClient,
 	})
@@ -0,0 +1,169 @@
+package third
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	pbthirdext "github.com/openimsdk/open-im-server/v3/pkg/protocol/thirdext"
+	"github.com/openimsdk/open-im-server/v3/pkg/util/webpushutil"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// pushProviderPlatforms are the platforms whose devices can get a token of each provider.
+var pushProviderPlatforms = map[string][]int{
+	model.PushProviderFCM:     {constant.IOSPlatformID, constant.IPadPlatformID, constant.AndroidPlatformID, constant.AndroidPadPlatformID, constant.WebPlatformID},
+	model.PushProviderAPNs:    {constant.IOSPlatformID, constant.IPadPlatformID},
+	model.PushProviderHuawei:  {constant.AndroidPlatformID, constant.AndroidPadPlatformID},
+	model.PushProviderHonor:   {constant.AndroidPlatformID, constant.AndroidPadPlatformID},
+	model.PushProviderXiaomi:  {constant.AndroidPlatformID, constant.AndroidPadPlatformID},
+	model.PushProviderOPPO:    {constant.AndroidPlatformID, constant.AndroidPadPlatformID},
+	model.PushProviderVivo:    {constant.AndroidPlatformID, constant.AndroidPadPlatformID},
+	model.PushProviderWebPush: {constant.WebPlatformID, constant.WindowsPlatformID, constant.OSXPlatformID, constant.LinuxPlatformID},
+}
+
+func checkPushDevicePlatform(provider string, platformID int32) error {
+	if _, ok := constant.PlatformID2Name[int(platformID)]; !ok {
+		return errs.ErrArgs.WrapMsg("invalid platformID", "platformID", platformID)
+	}
+	if !datautil.Contain(int(platformID), pushProviderPlatforms[provider]...) {
+		return errs.ErrArgs.WrapMsg("the platform has no such push provider", "provider", provider, "platformID", platformID)
+	}
+	return nil
+}
+
+func (t *thirdServer) RegisterPushDevice(ctx context.Context, req *pbthirdext.RegisterPushDeviceReq) (*pbthirdext.RegisterPushDeviceResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, t.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if !datautil.Contain(req.Provider, model.TokenPushProviders...) {
+		return nil, errs.ErrArgs.WrapMsg("unsupported push provider", "provider", req.Provider)
+	}
+	if err := checkPushDevicePlatform(req.Provider, req.PlatformID); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	device := &model.PushDevice{
+		UserID:       req.UserID,
+		DeviceID:     req.DeviceID,
+		PlatformID:   req.PlatformID,
+		Provider:     req.Provider,
+		Token:        req.Token,
+		AppVersion:   req.AppVersion,
+		Locale:       req.Locale,
+		Enabled:      true,
+		CreateTime:   now,
+		UpdateTime:   now,
+		LastSeenTime: now,
+	}
+	if err := t.pushDeviceDatabase.RegisterDevice(ctx, device); err != nil {
+		return nil, err
+	}
+	return &pbthirdext.RegisterPushDeviceResp{}, nil
+}
+
+func (t *thirdServer) RegisterWebPush(ctx context.Context, req *pbthirdext.RegisterWebPushReq) (*pbthirdext.RegisterWebPushResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, t.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if err := checkPushDevicePlatform(model.PushProviderWebPush, req.PlatformID); err != nil {
+		return nil, err
+	}
+	sub := req.Subscription
+	// The server posts to the endpoint, it must not be pointed at an internal service.
+	if err := webpushutil.CheckEndpoint(ctx, sub.Endpoint); err != nil {
+		return nil, err
+	}
+	if !isBase64URLKey(sub.Keys.P256Dh, 65) || !isBase64URLKey(sub.Keys.Auth, 16) {
+		return nil, errs.ErrArgs.WrapMsg("invalid subscription keys")
+	}
+	now := time.Now()
+	device := &model.PushDevice{
+		UserID:       req.UserID,
+		DeviceID:     req.DeviceID,
+		PlatformID:   req.PlatformID,
+		Provider:     model.PushProviderWebPush,
+		Token:        sub.Endpoint,
+		P256dh:       sub.Keys.P256Dh,
+		Auth:         sub.Keys.Auth,
+		AppVersion:   req.AppVersion,
+		Locale:       req.Locale,
+		Enabled:      true,
+		CreateTime:   now,
+		UpdateTime:   now,
+		LastSeenTime: now,
+	}
+	if err := t.pushDeviceDatabase.RegisterDevice(ctx, device); err != nil {
+		return nil, err
+	}
+	return &pbthirdext.RegisterWebPushResp{}, nil
+}
+
+func (t *thirdServer) UpdatePushDevice(ctx context.Context, req *pbthirdext.UpdatePushDeviceReq) (*pbthirdext.UpdatePushDeviceResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, t.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	data := map[string]any{
+		"update_time":    now,
+		"last_seen_time": now,
+	}
+	if req.AppVersion != nil {
+		data["app_version"] = req.AppVersion.Value
+	}
+	if req.Locale != nil {
+		data["locale"] = req.Locale.Value
+	}
+	if req.Enabled != nil {
+		data["enabled"] = req.Enabled.Value
+	}
+	if err := t.pushDeviceDatabase.UpdateDevice(ctx, req.UserID, req.DeviceID, data); err != nil {
+		return nil, err
+	}
+	return &pbthirdext.UpdatePushDeviceResp{}, nil
+}
+
+func (t *thirdServer) GetPushDevices(ctx context.Context, req *pbthirdext.GetPushDevicesReq) (*pbthirdext.GetPushDevicesResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, t.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	devices, err := t.pushDeviceDatabase.GetUserDevices(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &pbthirdext.GetPushDevicesResp{
+		Devices: datautil.Slice(devices, func(e *model.PushDevice) *pbthirdext.PushDeviceInfo {
+			return &pbthirdext.PushDeviceInfo{
+				DeviceID:     e.DeviceID,
+				PlatformID:   e.PlatformID,
+				Provider:     e.Provider,
+				AppVersion:   e.AppVersion,
+				Locale:       e.Locale,
+				Enabled:      e.Enabled,
+				CreateTime:   e.CreateTime.UnixMilli(),
+				LastSeenTime: e.LastSeenTime.UnixMilli(),
+			}
+		}),
+	}, nil
+}
+
+func (t *thirdServer) UnregisterPushDevice(ctx context.Context, req *pbthirdext.UnregisterPushDeviceReq) (*pbthirdext.UnregisterPushDeviceResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, t.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if err := t.pushDeviceDatabase.UnregisterDevice(ctx, req.UserID, req.DeviceID); err != nil {
+		return nil, err
+	}
+	return &pbthirdext.UnregisterPushDeviceResp{}, nil
+}
+
+// isBase64URLKey reports whether s is a base64url encoded key of size bytes, the padding is optional.
+func isBase64URLKey(s string, size int) bool {
+	key, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+	return err == nil && len(key) == size
+}
@@ -0,0 +1,113 @@
+package third
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	pbthirdext "github.com/openimsdk/open-im-server/v3/pkg/protocol/thirdext"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+const (
+	maxQuietWindows   = 32
+	maxQuietOverrides = 200
+	minutesPerDay     = 24 * 60
+)
+
+func (t *thirdServer) SetPushQuietHours(ctx context.Context, req *pbthirdext.SetPushQuietHoursReq) (*pbthirdext.SetPushQuietHoursResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, t.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if err := checkQuietHours(req); err != nil {
+		return nil, err
+	}
+	quietHours := &model.PushQuietHours{
+		UserID:   req.UserID,
+		TimeZone: req.TimeZone,
+		Windows: datautil.Slice(req.Windows, func(e *pbthirdext.PushQuietWindow) model.PushQuietWindow {
+			weekdays := datautil.Slice(datautil.Distinct(e.Weekdays), func(weekday int32) int { return int(weekday) })
+			return model.PushQuietWindow{Weekdays: weekdays, Start: int(e.Start), End: int(e.End)}
+		}),
+		Overrides: datautil.Slice(req.Overrides, func(e *pbthirdext.PushQuietOverride) model.PushQuietOverride {
+			return model.PushQuietOverride{ConversationID: e.ConversationID, Notify: e.Notify}
+		}),
+		Digest:     req.Digest,
+		UpdateTime: time.Now(),
+	}
+	if err := t.pushQuietHoursDatabase.SetQuietHours(ctx, quietHours); err != nil {
+		return nil, err
+	}
+	return &pbthirdext.SetPushQuietHoursResp{}, nil
+}
+
+func checkQuietHours(req *pbthirdext.SetPushQuietHoursReq) error {
+	if _, err := time.LoadLocation(req.TimeZone); err != nil {
+		return errs.ErrArgs.WrapMsg("invalid time zone", "timeZone", req.TimeZone)
+	}
+	if len(req.Windows) > maxQuietWindows {
+		return errs.ErrArgs.WrapMsg("too many quiet windows", "max", maxQuietWindows)
+	}
+	for _, window := range req.Windows {
+		if window == nil || window.Start < 0 || window.Start >= minutesPerDay || window.End < 0 || window.End > minutesPerDay {
+			return errs.ErrArgs.WrapMsg("quiet window start and end must be minutes of the day")
+		}
+		for _, weekday := range window.Weekdays {
+			if weekday < int32(time.Sunday) || weekday > int32(time.Saturday) {
+				return errs.ErrArgs.WrapMsg("invalid quiet window weekday", "weekday", weekday)
+			}
+		}
+	}
+	if len(req.Overrides) > maxQuietOverrides {
+		return errs.ErrArgs.WrapMsg("too many quiet hours overrides", "max", maxQuietOverrides)
+	}
+	for _, override := range req.Overrides {
+		if override == nil || !datautil.Contain(override.Notify, model.QuietNotifyMentions, model.QuietNotifyAlways) {
+			return errs.ErrArgs.WrapMsg("quiet hours override notify must be mentions or always")
+		}
+	}
+	return nil
+}
+
+func (t *thirdServer) GetPushQuietHours(ctx context.Context, req *pbthirdext.GetPushQuietHoursReq) (*pbthirdext.GetPushQuietHoursResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, t.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	quietHours, err := t.pushQuietHoursDatabase.GetQuietHours(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pbthirdext.GetPushQuietHoursResp{}
+	if quietHours == nil {
+		return resp, nil
+	}
+	resp.TimeZone = quietHours.TimeZone
+	resp.Windows = datautil.Slice(quietHours.Windows, func(e model.PushQuietWindow) *pbthirdext.PushQuietWindow {
+		weekdays := datautil.Slice(e.Weekdays, func(weekday int) int32 { return int32(weekday) })
+		return &pbthirdext.PushQuietWindow{Weekdays: weekdays, Start: int32(e.Start), End: int32(e.End)}
+	})
+	resp.Overrides = datautil.Slice(quietHours.Overrides, func(e model.PushQuietOverride) *pbthirdext.PushQuietOverride {
+		return &pbthirdext.PushQuietOverride{ConversationID: e.ConversationID, Notify: e.Notify}
+	})
+	if quietHours.SnoozeUntil.After(time.Now()) {
+		resp.SnoozeUntil = quietHours.SnoozeUntil.UnixMilli()
+	}
+	resp.Digest = quietHours.Digest
+	return resp, nil
+}
+
+func (t *thirdServer) SnoozePush(ctx context.Context, req *pbthirdext.SnoozePushReq) (*pbthirdext.SnoozePushResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, t.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	var until time.Time
+	if req.SnoozeUntil > 0 {
+		until = time.UnixMilli(req.SnoozeUntil)
+	}
+	if err := t.pushQuietHoursDatabase.Snooze(ctx, req.UserID, until); err != nil {
+		return nil, err
+	}
+	return &pbthirdext.SnoozePushResp{}, nil
+}
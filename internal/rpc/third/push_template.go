@@ -0,0 +1,76 @@
+package third
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	pbthirdext "github.com/openimsdk/open-im-server/v3/pkg/protocol/thirdext"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+const (
+	maxPushTemplateTitle   = 256
+	maxPushTemplateContent = 1024
+)
+
+func (t *thirdServer) SetPushTemplate(ctx context.Context, req *pbthirdext.SetPushTemplateReq) (*pbthirdext.SetPushTemplateResp, error) {
+	if err := authverify.CheckAdmin(ctx, t.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if req.ContentType < model.PushTemplateDefault && req.ContentType != model.PushTemplateQuietDigest {
+		return nil, errs.ErrArgs.WrapMsg("invalid push template content type", "contentType", req.ContentType)
+	}
+	if controller.NormalizeLocale(req.Locale) == "" {
+		return nil, errs.ErrArgs.WrapMsg("push template locale is empty")
+	}
+	if utf8.RuneCountInString(req.Title) > maxPushTemplateTitle || utf8.RuneCountInString(req.Content) > maxPushTemplateContent {
+		return nil, errs.ErrArgs.WrapMsg("push template too long", "maxTitle", maxPushTemplateTitle, "maxContent", maxPushTemplateContent)
+	}
+	template := &model.PushTemplate{
+		ContentType: req.ContentType,
+		Locale:      req.Locale,
+		Title:       req.Title,
+		Content:     req.Content,
+		UpdateTime:  time.Now(),
+	}
+	if err := t.pushTemplateDatabase.SetTemplate(ctx, template); err != nil {
+		return nil, err
+	}
+	return &pbthirdext.SetPushTemplateResp{}, nil
+}
+
+func (t *thirdServer) GetPushTemplates(ctx context.Context, req *pbthirdext.GetPushTemplatesReq) (*pbthirdext.GetPushTemplatesResp, error) {
+	if err := authverify.CheckAdmin(ctx, t.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	templates, err := t.pushTemplateDatabase.GetTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbthirdext.GetPushTemplatesResp{
+		Templates: datautil.Slice(templates, func(e *model.PushTemplate) *pbthirdext.PushTemplateInfo {
+			return &pbthirdext.PushTemplateInfo{
+				ContentType: e.ContentType,
+				Locale:      e.Locale,
+				Title:       e.Title,
+				Content:     e.Content,
+				UpdateTime:  e.UpdateTime.UnixMilli(),
+			}
+		}),
+	}, nil
+}
+
+func (t *thirdServer) DeletePushTemplate(ctx context.Context, req *pbthirdext.DeletePushTemplateReq) (*pbthirdext.DeletePushTemplateResp, error) {
+	if err := authverify.CheckAdmin(ctx, t.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if err := t.pushTemplateDatabase.DeleteTemplate(ctx, req.ContentType, req.Locale); err != nil {
+		return nil, err
+	}
+	return &pbthirdext.DeletePushTemplateResp{}, nil
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/protocol/third"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
@@ -75,7 +76,7 @@ func (t *thirdServer) InitiateMultipartUpload(ctx context.Context, req *third.In
 				return nil, err
 			}
 			return &third.InitiateMultipartUploadResp{
-				Url: t.apiAddress(req.UrlPrefix, obj.Name),
+				Url: t.apiAddress(ctx, req.UrlPrefix, obj.Name),
 			}, nil
 		}
 		return nil, err
@@ -152,7 +153,7 @@ func (t *thirdServer) CompleteMultipartUpload(ctx context.Context, req *third.Co
 		return nil, err
 	}
 	return &third.CompleteMultipartUploadResp{
-		Url: t.apiAddress(req.UrlPrefix, obj.Name),
+		Url: t.apiAddress(ctx, req.UrlPrefix, obj.Name),
 	}, nil
 }
 
@@ -277,11 +278,12 @@ func (t *thirdServer) CompleteFormData(ctx context.Context, req *third.CompleteF
 	if err := t.s3dataBase.SetObject(ctx, obj); err != nil {
 		return nil, err
 	}
-	return &third.CompleteFormDataResp{Url: t.apiAddress(req.UrlPrefix, mate.Name)}, nil
+	return &third.CompleteFormDataResp{Url: t.apiAddress(ctx, req.UrlPrefix, mate.Name)}, nil
 }
 
-func (t *thirdServer) apiAddress(prefix, name string) string {
-	return prefix + name
+// apiAddress names the object with its tenant, the object redirect is requested without a token.
+func (t *thirdServer) apiAddress(ctx context.Context, prefix, name string) string {
+	return prefix + tenant.ObjectKey(ctx, name)
 }
 
 func (t *thirdServer) DeleteOutdatedData(ctx context.Context, req *third.DeleteOutdatedDataReq) (*third.DeleteOutdatedDataResp, error) {
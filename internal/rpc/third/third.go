@@ -23,7 +23,9 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/redis"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/mgo"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/open-im-server/v3/pkg/localcache"
+	pbthirdext "github.com/openimsdk/open-im-server/v3/pkg/protocol/thirdext"
 	"github.com/openimsdk/tools/s3/aws"
 	"github.com/openimsdk/tools/s3/kodo"
 
@@ -41,12 +43,17 @@ import (
 
 type thirdServer struct {
 	third.UnimplementedThirdServer
-	thirdDatabase controller.ThirdDatabase
-	s3dataBase    controller.S3Database
-	defaultExpire time.Duration
-	config        *Config
-	s3            s3.Interface
-	userClient    *rpcli.UserClient
+	pbthirdext.UnimplementedThirdExtServer
+	thirdDatabase      controller.ThirdDatabase
+	s3dataBase         controller.S3Database
+	pushDeviceDatabase controller.PushDeviceDatabase
+	// pushQuietHoursDatabase shares its cache with the push service, which reads the settings before each offline push.
+	pushQuietHoursDatabase controller.PushQuietHoursDatabase
+	pushTemplateDatabase   controller.PushTemplateDatabase
+	defaultExpire          time.Duration
+	config                 *Config
+	s3                     s3.Interface
+	userClient             *rpcli.UserClient
 }
 
 type Config struct {
@@ -77,6 +84,22 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	if err != nil {
 		return err
 	}
+	pushDeviceDB, err := mgo.NewPushDeviceMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	pushTemplateDB, err := mgo.NewPushTemplateMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	pushQuietHoursDB, err := mgo.NewPushQuietHoursMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	pushQuietDigestDB, err := mgo.NewPushQuietDigestMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
 
 	// Select the oss method according to the profile policy
 	enable := config.RpcConfig.Object.Enable
@@ -100,19 +123,26 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 	if err != nil {
 		return err
 	}
+	o = tenant.NewS3(o)
 	userConn, err := client.GetConn(ctx, config.Discovery.RpcService.User)
 	if err != nil {
 		return err
 	}
 	localcache.InitLocalCache(&config.LocalCacheConfig)
-	third.RegisterThirdServer(server, &thirdServer{
-		thirdDatabase: controller.NewThirdDatabase(redis.NewThirdCache(rdb), logdb),
-		s3dataBase:    controller.NewS3Database(rdb, o, s3db),
-		defaultExpire: time.Hour * 24 * 7,
-		config:        config,
-		s3:            o,
-		userClient:    rpcli.NewUserClient(userConn),
-	})
+	srv := &thirdServer{
+		thirdDatabase:      controller.NewThirdDatabase(redis.NewThirdCache(rdb), logdb),
+		s3dataBase:         controller.NewS3Database(rdb, o, s3db),
+		pushDeviceDatabase: controller.NewPushDeviceDatabase(pushDeviceDB),
+		pushQuietHoursDatabase: controller.NewPushQuietHoursDatabase(pushQuietHoursDB, pushQuietDigestDB,
+			redis.NewPushQuietHoursCacheRedis(rdb, pushQuietHoursDB, redis.GetRocksCacheOptions())),
+		pushTemplateDatabase: controller.NewPushTemplateDatabase(pushTemplateDB),
+		defaultExpire:        time.Hour * 24 * 7,
+		config:               config,
+		s3:                   o,
+		userClient:           rpcli.NewUserClient(userConn),
+	}
+	third.RegisterThirdServer(server, srv)
+	pbthirdext.RegisterThirdExtServer(server, srv)
 	return nil
 }
 
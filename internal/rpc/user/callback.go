@@ -22,6 +22,7 @@ import (
 
 	cbapi "github.com/openimsdk/open-im-server/v3/pkg/callbackstruct"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	pbuserext "github.com/openimsdk/open-im-server/v3/pkg/protocol/userext"
 	pbuser "github.com/openimsdk/protocol/user"
 )
 
@@ -113,3 +114,60 @@ func (s *userServer) webhookAfterUserRegister(ctx context.Context, after *config
 
 	s.webhookClient.AsyncPost(ctx, cbReq.GetCallbackCommand(), cbReq, &cbapi.CallbackAfterUserRegisterResp{}, after)
 }
+
+func (s *userServer) webhookBeforeUserSuspend(ctx context.Context, before *config.BeforeConfig, req *pbuserext.SuspendUserReq) error {
+	return webhook.WithCondition(ctx, before, func(ctx context.Context) error {
+		cbReq := &cbapi.CallbackBeforeUserSuspendReq{
+			CallbackCommand: cbapi.CallbackBeforeUserSuspendCommand,
+			UserID:          req.UserID,
+			Reason:          req.Reason,
+			HideProfile:     req.HideProfile,
+			ExpireTime:      req.ExpireTime,
+		}
+		resp := &cbapi.CallbackBeforeUserSuspendResp{}
+		if err := s.webhookClient.SyncPost(ctx, cbReq.GetCallbackCommand(), cbReq, resp, before); err != nil {
+			return err
+		}
+		datautil.NotNilReplace(&req.Reason, resp.Reason)
+		datautil.NotNilReplace(&req.ExpireTime, resp.ExpireTime)
+		return nil
+	})
+}
+
+func (s *userServer) webhookAfterUserSuspend(ctx context.Context, after *config.AfterConfig, req *pbuserext.SuspendUserReq) {
+	cbReq := &cbapi.CallbackAfterUserSuspendReq{
+		CallbackCommand: cbapi.CallbackAfterUserSuspendCommand,
+		UserID:          req.UserID,
+		Reason:          req.Reason,
+		HideProfile:     req.HideProfile,
+		ExpireTime:      req.ExpireTime,
+	}
+	s.webhookClient.AsyncPost(ctx, cbReq.GetCallbackCommand(), cbReq, &cbapi.CallbackAfterUserSuspendResp{}, after)
+}
+
+func (s *userServer) webhookBeforeSetUserFields(ctx context.Context, before *config.BeforeConfig, req *pbuserext.SetUserFieldsReq) error {
+	return webhook.WithCondition(ctx, before, func(ctx context.Context) error {
+		cbReq := &cbapi.CallbackBeforeSetUserFieldsReq{
+			CallbackCommand: cbapi.CallbackBeforeSetUserFieldsCommand,
+			UserID:          req.UserID,
+			Fields:          req.Fields,
+		}
+		resp := &cbapi.CallbackBeforeSetUserFieldsResp{}
+		if err := s.webhookClient.SyncPost(ctx, cbReq.GetCallbackCommand(), cbReq, resp, before); err != nil {
+			return err
+		}
+		if resp.Fields != nil {
+			req.Fields = resp.Fields
+		}
+		return nil
+	})
+}
+
+func (s *userServer) webhookAfterSetUserFields(ctx context.Context, after *config.AfterConfig, req *pbuserext.SetUserFieldsReq) {
+	cbReq := &cbapi.CallbackAfterSetUserFieldsReq{
+		CallbackCommand: cbapi.CallbackAfterSetUserFieldsCommand,
+		UserID:          req.UserID,
+		Fields:          req.Fields,
+	}
+	s.webhookClient.AsyncPost(ctx, cbReq.GetCallbackCommand(), cbReq, &cbapi.CallbackAfterSetUserFieldsResp{}, after)
+}
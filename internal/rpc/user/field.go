@@ -0,0 +1,370 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"maps"
+	"math"
+	"regexp"
+	"time"
+	"unicode/utf8"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	pbuserext "github.com/openimsdk/open-im-server/v3/pkg/protocol/userext"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// userFieldKeyRegexp keeps the keys usable as mongo field names.
+var userFieldKeyRegexp = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]{0,63}$`)
+
+func (s *userServer) SetUserFieldSchema(ctx context.Context, req *pbuserext.SetUserFieldSchemaReq) (*pbuserext.SetUserFieldSchemaResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	schema, err := s.getUserFieldSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	fields := make([]*model.UserField, 0, len(req.Fields))
+	for _, info := range req.Fields {
+		if err := checkUserFieldInfo(info); err != nil {
+			return nil, err
+		}
+		field := &model.UserField{
+			Key:        info.Key,
+			Name:       info.Name,
+			Type:       info.Type,
+			MaxLength:  int(info.MaxLength),
+			Pattern:    info.Pattern,
+			Min:        info.Min,
+			Max:        info.Max,
+			Options:    info.Options,
+			Visibility: info.Visibility,
+			Searchable: info.Searchable,
+			CreateTime: now,
+		}
+		if old, ok := schema[info.Key]; ok {
+			field.CreateTime = old.CreateTime
+		}
+		fields = append(fields, field)
+	}
+	if err := s.fieldDB.SetFields(ctx, fields); err != nil {
+		return nil, err
+	}
+	return &pbuserext.SetUserFieldSchemaResp{}, nil
+}
+
+func (s *userServer) DeleteUserFieldSchema(ctx context.Context, req *pbuserext.DeleteUserFieldSchemaReq) (*pbuserext.DeleteUserFieldSchemaResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if err := s.fieldDB.DeleteFields(ctx, datautil.Distinct(req.Keys)); err != nil {
+		return nil, err
+	}
+	return &pbuserext.DeleteUserFieldSchemaResp{}, nil
+}
+
+func (s *userServer) GetUserFieldSchema(ctx context.Context, req *pbuserext.GetUserFieldSchemaReq) (*pbuserext.GetUserFieldSchemaResp, error) {
+	fields, err := s.fieldDB.GetFields(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbuserext.GetUserFieldSchemaResp{
+		Fields: datautil.Slice(fields, func(e *model.UserField) *pbuserext.UserFieldInfo {
+			return &pbuserext.UserFieldInfo{
+				Key:        e.Key,
+				Name:       e.Name,
+				Type:       e.Type,
+				MaxLength:  int32(e.MaxLength),
+				Pattern:    e.Pattern,
+				Min:        e.Min,
+				Max:        e.Max,
+				Options:    e.Options,
+				Visibility: e.Visibility,
+				Searchable: e.Searchable,
+				CreateTime: e.CreateTime.UnixMilli(),
+			}
+		}),
+	}, nil
+}
+
+func (s *userServer) SetUserFields(ctx context.Context, req *pbuserext.SetUserFieldsReq) (*pbuserext.SetUserFieldsResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if err := s.webhookBeforeSetUserFields(ctx, &s.config.WebhooksConfig.BeforeSetUserFields, req); err != nil {
+		return nil, err
+	}
+	schema, err := s.getUserFieldSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.db.GetUserByID(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	fields := maps.Clone(user.Fields)
+	if fields == nil {
+		fields = make(map[string]any)
+	}
+	for key, data := range req.Fields {
+		field, ok := schema[key]
+		if !ok {
+			return nil, errs.ErrArgs.WrapMsg("unknown user field", "key", key)
+		}
+		value, err := decodeUserFieldValue(key, data)
+		if err != nil {
+			return nil, err
+		}
+		if value == nil {
+			delete(fields, key)
+			continue
+		}
+		val, err := checkUserFieldValue(field, value)
+		if err != nil {
+			return nil, err
+		}
+		fields[key] = val
+	}
+	if err := s.db.UpdateByMap(ctx, req.UserID, map[string]any{"fields": fields}); err != nil {
+		return nil, err
+	}
+	s.friendNotificationSender.UserInfoUpdatedNotification(ctx, req.UserID)
+	s.webhookAfterSetUserFields(ctx, &s.config.WebhooksConfig.AfterSetUserFields, req)
+	return &pbuserext.SetUserFieldsResp{}, nil
+}
+
+func (s *userServer) SearchUsersByFields(ctx context.Context, req *pbuserext.SearchUsersByFieldsReq) (*pbuserext.SearchUsersByFieldsResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	schema, err := s.getUserFieldSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	filter := make(map[string]any, len(req.Fields))
+	for key, data := range req.Fields {
+		field, ok := schema[key]
+		if !ok || !field.Searchable {
+			return nil, errs.ErrArgs.WrapMsg("user field is not searchable", "key", key)
+		}
+		value, err := decodeUserFieldValue(key, data)
+		if err != nil {
+			return nil, err
+		}
+		val, err := checkUserFieldValue(field, value)
+		if err != nil {
+			return nil, err
+		}
+		filter[key] = val
+	}
+	total, users, err := s.db.PageFindUserWithKeyword(ctx, constant.IMOrdinaryUser, constant.AppOrdinaryUsers, req.UserID, req.Nickname, filter, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pbuserext.SearchUsersByFieldsResp{Total: total}
+	for _, user := range users {
+		fields, err := encodeUserFields(user.Fields)
+		if err != nil {
+			return nil, err
+		}
+		resp.Users = append(resp.Users, &pbuserext.UserInfoWithFields{UserInfo: convert.UserDB2Pb(user), Fields: fields})
+	}
+	return resp, nil
+}
+
+// GetVisibleUserFields returns the custom fields of the users the caller is allowed to see.
+func (s *userServer) GetVisibleUserFields(ctx context.Context, req *pbuserext.GetVisibleUserFieldsReq) (*pbuserext.GetVisibleUserFieldsResp, error) {
+	resp := &pbuserext.GetVisibleUserFieldsResp{}
+	if len(req.UserIDs) == 0 {
+		return resp, nil
+	}
+	schema, err := s.getUserFieldSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(schema) == 0 {
+		return resp, nil
+	}
+	users, err := s.db.Find(ctx, datautil.Distinct(req.UserIDs))
+	if err != nil {
+		return nil, err
+	}
+	opUserID := mcontext.GetOpUserID(ctx)
+	isAdmin := authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID)
+	for _, user := range users {
+		if len(user.Fields) == 0 {
+			continue
+		}
+		var isFriend *bool
+		fields := make(map[string]any, len(user.Fields))
+		for key, value := range user.Fields {
+			field, ok := schema[key]
+			if !ok {
+				continue
+			}
+			visible := isAdmin || user.UserID == opUserID || field.Visibility == model.UserFieldVisibilityPublic
+			if !visible && field.Visibility == model.UserFieldVisibilityFriends {
+				if isFriend == nil {
+					ok, err := s.relationClient.IsFriend(ctx, user.UserID, opUserID)
+					if err != nil {
+						return nil, err
+					}
+					isFriend = &ok
+				}
+				visible = *isFriend
+			}
+			if visible {
+				fields[key] = value
+			}
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		encoded, err := encodeUserFields(fields)
+		if err != nil {
+			return nil, err
+		}
+		resp.Users = append(resp.Users, &pbuserext.UserFields{UserID: user.UserID, Fields: encoded})
+	}
+	return resp, nil
+}
+
+func (s *userServer) getUserFieldSchema(ctx context.Context) (map[string]*model.UserField, error) {
+	fields, err := s.fieldDB.GetFields(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return datautil.SliceToMap(fields, func(e *model.UserField) string { return e.Key }), nil
+}
+
+// decodeUserFieldValue decodes a JSON encoded value, null and an empty string are decoded to nil.
+func decodeUserFieldValue(key string, data string) (any, error) {
+	if data == "" {
+		return nil, nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader([]byte(data)))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, errs.ErrArgs.WrapMsg("user field value is not valid json", "key", key)
+	}
+	return value, nil
+}
+
+func encodeUserFields(fields map[string]any) (map[string]string, error) {
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	res := make(map[string]string, len(fields))
+	for key, value := range fields {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, errs.WrapMsg(err, "encode user field failed", "key", key)
+		}
+		res[key] = string(data)
+	}
+	return res, nil
+}
+
+func checkUserFieldInfo(info *pbuserext.UserFieldInfo) error {
+	if !userFieldKeyRegexp.MatchString(info.Key) {
+		return errs.ErrArgs.WrapMsg("invalid user field key", "key", info.Key)
+	}
+	switch info.Type {
+	case model.UserFieldTypeString, model.UserFieldTypeInt, model.UserFieldTypeBool:
+	case model.UserFieldTypeEnum:
+		if len(info.Options) == 0 {
+			return errs.ErrArgs.WrapMsg("enum user field requires options", "key", info.Key)
+		}
+	default:
+		return errs.ErrArgs.WrapMsg("invalid user field type", "key", info.Key, "type", info.Type)
+	}
+	switch info.Visibility {
+	case model.UserFieldVisibilitySelf, model.UserFieldVisibilityFriends, model.UserFieldVisibilityPublic:
+	default:
+		return errs.ErrArgs.WrapMsg("invalid user field visibility", "key", info.Key, "visibility", info.Visibility)
+	}
+	if info.MaxLength < 0 {
+		return errs.ErrArgs.WrapMsg("invalid user field max length", "key", info.Key)
+	}
+	if info.Pattern != "" {
+		if _, err := regexp.Compile(info.Pattern); err != nil {
+			return errs.ErrArgs.WrapMsg("invalid user field pattern", "key", info.Key, "err", err.Error())
+		}
+	}
+	if info.Min != nil && info.Max != nil && *info.Min > *info.Max {
+		return errs.ErrArgs.WrapMsg("user field min is greater than max", "key", info.Key)
+	}
+	return nil
+}
+
+// checkUserFieldValue validates the value against the field and returns it in its stored form.
+func checkUserFieldValue(field *model.UserField, value any) (any, error) {
+	switch field.Type {
+	case model.UserFieldTypeString, model.UserFieldTypeEnum:
+		s, ok := value.(string)
+		if !ok {
+			return nil, errs.ErrArgs.WrapMsg("user field value must be a string", "key", field.Key)
+		}
+		if field.Type == model.UserFieldTypeEnum && !datautil.Contain(s, field.Options...) {
+			return nil, errs.ErrArgs.WrapMsg("user field value is not an option", "key", field.Key, "value", s)
+		}
+		if field.MaxLength > 0 && utf8.RuneCountInString(s) > field.MaxLength {
+			return nil, errs.ErrArgs.WrapMsg("user field value is too long", "key", field.Key, "maxLength", field.MaxLength)
+		}
+		if field.Pattern != "" {
+			re, err := regexp.Compile(field.Pattern)
+			if err != nil {
+				return nil, errs.WrapMsg(err, "invalid user field pattern", "key", field.Key)
+			}
+			if !re.MatchString(s) {
+				return nil, errs.ErrArgs.WrapMsg("user field value does not match the pattern", "key", field.Key)
+			}
+		}
+		return s, nil
+	case model.UserFieldTypeInt:
+		var n int64
+		switch v := value.(type) {
+		case float64:
+			if v != math.Trunc(v) || v > math.MaxInt64 || v < math.MinInt64 {
+				return nil, errs.ErrArgs.WrapMsg("user field value must be an integer", "key", field.Key)
+			}
+			n = int64(v)
+		case json.Number:
+			i, err := v.Int64()
+			if err != nil {
+				return nil, errs.ErrArgs.WrapMsg("user field value must be an integer", "key", field.Key)
+			}
+			n = i
+		case int64:
+			n = v
+		case int:
+			n = int64(v)
+		default:
+			return nil, errs.ErrArgs.WrapMsg("user field value must be an integer", "key", field.Key)
+		}
+		if field.Min != nil && n < *field.Min {
+			return nil, errs.ErrArgs.WrapMsg("user field value is too small", "key", field.Key, "min", *field.Min)
+		}
+		if field.Max != nil && n > *field.Max {
+			return nil, errs.ErrArgs.WrapMsg("user field value is too large", "key", field.Key, "max", *field.Max)
+		}
+		return n, nil
+	case model.UserFieldTypeBool:
+		b, ok := value.(bool)
+		if !ok {
+			return nil, errs.ErrArgs.WrapMsg("user field value must be a boolean", "key", field.Key)
+		}
+		return b, nil
+	default:
+		return nil, errs.ErrArgs.WrapMsg("unknown user field type", "key", field.Key, "type", field.Type)
+	}
+}
@@ -0,0 +1,152 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/internal/rpc/relation"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/webhook"
+	pbuserext "github.com/openimsdk/open-im-server/v3/pkg/protocol/userext"
+	"github.com/openimsdk/open-im-server/v3/pkg/rpcli"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+	"google.golang.org/grpc"
+)
+
+type fakeUserFieldDatabase struct {
+	fields []*model.UserField
+}
+
+func (f *fakeUserFieldDatabase) SetFields(_ context.Context, fields []*model.UserField) error {
+	f.fields = append(f.fields, fields...)
+	return nil
+}
+
+func (f *fakeUserFieldDatabase) DeleteFields(context.Context, []string) error {
+	return nil
+}
+
+func (f *fakeUserFieldDatabase) GetFields(context.Context) ([]*model.UserField, error) {
+	return f.fields, nil
+}
+
+func (f *fakeUserDatabase) GetUserByID(_ context.Context, userID string) (*model.User, error) {
+	user, ok := f.users[userID]
+	if !ok {
+		return nil, errs.ErrRecordNotFound.WrapMsg("user not found", "userID", userID)
+	}
+	return user, nil
+}
+
+func (f *fakeUserDatabase) Find(_ context.Context, userIDs []string) ([]*model.User, error) {
+	var users []*model.User
+	for _, userID := range userIDs {
+		if user, ok := f.users[userID]; ok {
+			users = append(users, user)
+		}
+	}
+	return users, nil
+}
+
+func (f *fakeUserDatabase) UpdateByMap(_ context.Context, userID string, args map[string]any) error {
+	f.users[userID].Fields = args["fields"].(map[string]any)
+	return nil
+}
+
+// unavailableConn fails every call, the notifications sent in the tests are dropped.
+type unavailableConn struct{}
+
+func (unavailableConn) Invoke(context.Context, string, any, any, ...grpc.CallOption) error {
+	return errors.New("unavailable")
+}
+
+func (unavailableConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, errors.New("unavailable")
+}
+
+func newFieldTestServer() (*userServer, *fakeUserDatabase) {
+	maxAge := int64(150)
+	db := &fakeUserDatabase{users: map[string]*model.User{"u1": {UserID: "u1", Fields: map[string]any{"city": "Paris"}}}}
+	conf := &Config{Share: config.Share{IMAdminUserID: []string{"admin"}}}
+	s := &userServer{
+		config:                   conf,
+		db:                       db,
+		fieldDB:                  &fakeUserFieldDatabase{fields: []*model.UserField{{Key: "city", Type: model.UserFieldTypeString, MaxLength: 8, Visibility: model.UserFieldVisibilityPublic}, {Key: "age", Type: model.UserFieldTypeInt, Max: &maxAge, Visibility: model.UserFieldVisibilitySelf}}},
+		webhookClient:            webhook.NewWebhookClient(&conf.WebhooksConfig),
+		friendNotificationSender: relation.NewFriendNotificationSender(&conf.NotificationConfig, rpcli.NewMsgClient(unavailableConn{})),
+	}
+	return s, db
+}
+
+func TestSetUserFields(t *testing.T) {
+	s, db := newFieldTestServer()
+	ctx := mcontext.WithOpUserIDContext(context.Background(), "u1")
+
+	if _, err := s.SetUserFields(mcontext.WithOpUserIDContext(context.Background(), "u2"), &pbuserext.SetUserFieldsReq{UserID: "u1", Fields: map[string]string{"age": "30"}}); err == nil {
+		t.Error("expected another user to be refused")
+	}
+	for _, fields := range []map[string]string{
+		{"unknown": `"x"`},
+		{"age": `"30"`},
+		{"age": "30.5"},
+		{"age": "151"},
+		{"city": `"Amsterdam!"`},
+		{"city": "{"},
+	} {
+		if _, err := s.SetUserFields(ctx, &pbuserext.SetUserFieldsReq{UserID: "u1", Fields: fields}); !errs.ErrArgs.Is(err) {
+			t.Errorf("expected %v to be refused, got %v", fields, err)
+		}
+	}
+	if _, err := s.SetUserFields(ctx, &pbuserext.SetUserFieldsReq{UserID: "u1", Fields: map[string]string{"age": "30", "city": "null"}}); err != nil {
+		t.Fatal(err)
+	}
+	fields := db.users["u1"].Fields
+	if len(fields) != 1 || fields["age"] != int64(30) {
+		t.Errorf("unexpected fields %v", fields)
+	}
+}
+
+func TestGetVisibleUserFields(t *testing.T) {
+	s, db := newFieldTestServer()
+	db.users["u1"].Fields["age"] = int64(30)
+	for opUserID, want := range map[string]string{
+		"u1":    `{"age":"30","city":"\"Paris\""}`,
+		"u2":    `{"city":"\"Paris\""}`,
+		"admin": `{"age":"30","city":"\"Paris\""}`,
+	} {
+		resp, err := s.GetVisibleUserFields(mcontext.WithOpUserIDContext(context.Background(), opUserID), &pbuserext.GetVisibleUserFieldsReq{UserIDs: []string{"u1"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(resp.Users) != 1 {
+			t.Fatalf("%s: unexpected users %v", opUserID, resp.Users)
+		}
+		got, _ := json.Marshal(resp.Users[0].Fields)
+		if string(got) != want {
+			t.Errorf("%s: expected %s, got %s", opUserID, want, got)
+		}
+	}
+}
+
+func TestCheckUserFieldInfo(t *testing.T) {
+	minAge, maxAge := int64(10), int64(1)
+	for _, info := range []*pbuserext.UserFieldInfo{
+		{Key: "1city", Type: model.UserFieldTypeString, Visibility: model.UserFieldVisibilityPublic},
+		{Key: "city", Type: "float", Visibility: model.UserFieldVisibilityPublic},
+		{Key: "city", Type: model.UserFieldTypeEnum, Visibility: model.UserFieldVisibilityPublic},
+		{Key: "city", Type: model.UserFieldTypeString, Visibility: "everyone"},
+		{Key: "city", Type: model.UserFieldTypeString, Visibility: model.UserFieldVisibilityPublic, Pattern: "("},
+		{Key: "age", Type: model.UserFieldTypeInt, Visibility: model.UserFieldVisibilityPublic, Min: &minAge, Max: &maxAge},
+	} {
+		if err := checkUserFieldInfo(info); err == nil {
+			t.Errorf("expected %+v to be refused", info)
+		}
+	}
+	if err := checkUserFieldInfo(&pbuserext.UserFieldInfo{Key: "level", Type: model.UserFieldTypeEnum, Options: []string{"gold"}, Visibility: model.UserFieldVisibilityFriends}); err != nil {
+		t.Error(err)
+	}
+}
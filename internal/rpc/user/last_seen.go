@@ -0,0 +1,83 @@
+package user
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	pbuserext "github.com/openimsdk/open-im-server/v3/pkg/protocol/userext"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+const maxGetUsersLastSeen = 1000
+
+func (s *userServer) SetLastSeenPrivacy(ctx context.Context, req *pbuserext.SetLastSeenPrivacyReq) (*pbuserext.SetLastSeenPrivacyResp, error) {
+	switch req.Privacy {
+	case model.LastSeenPrivacyEveryone, model.LastSeenPrivacyFriends, model.LastSeenPrivacyNobody:
+	default:
+		return nil, errs.ErrArgs.WrapMsg("invalid privacy", "privacy", req.Privacy)
+	}
+	if err := authverify.CheckAccessV3(ctx, req.UserID, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if err := s.lastSeenDB.SetLastSeenPrivacy(ctx, req.UserID, req.Privacy); err != nil {
+		return nil, err
+	}
+	return &pbuserext.SetLastSeenPrivacyResp{}, nil
+}
+
+func (s *userServer) GetLastSeenPrivacy(ctx context.Context, req *pbuserext.GetLastSeenPrivacyReq) (*pbuserext.GetLastSeenPrivacyResp, error) {
+	if err := authverify.CheckAccessV3(ctx, req.UserID, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	privacy, err := s.lastSeenDB.GetLastSeenPrivacy(ctx, []string{req.UserID})
+	if err != nil {
+		return nil, err
+	}
+	return &pbuserext.GetLastSeenPrivacyResp{Privacy: privacy[req.UserID]}, nil
+}
+
+func (s *userServer) GetUsersLastSeen(ctx context.Context, req *pbuserext.GetUsersLastSeenReq) (*pbuserext.GetUsersLastSeenResp, error) {
+	userIDs := datautil.Distinct(req.UserIDs)
+	if len(userIDs) > maxGetUsersLastSeen {
+		return nil, errs.ErrArgs.WrapMsg("too many userIDs", "max", maxGetUsersLastSeen)
+	}
+	lastSeen, err := s.lastSeenDB.GetLastSeen(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	privacy, err := s.lastSeenDB.GetLastSeenPrivacy(ctx, datautil.Keys(lastSeen))
+	if err != nil {
+		return nil, err
+	}
+	var (
+		opUserID = mcontext.GetOpUserID(ctx)
+		isAdmin  = authverify.IsAppManagerUid(ctx, s.config.Share.IMAdminUserID)
+		resp     = &pbuserext.GetUsersLastSeenResp{Users: make([]*pbuserext.UserLastSeen, 0, len(lastSeen))}
+	)
+	for _, userID := range userIDs {
+		t, ok := lastSeen[userID]
+		if !ok {
+			continue
+		}
+		visible := isAdmin || userID == opUserID
+		if !visible {
+			switch privacy[userID] {
+			case model.LastSeenPrivacyNobody:
+			case model.LastSeenPrivacyFriends:
+				visible, err = s.relationClient.IsFriend(ctx, userID, opUserID)
+				if err != nil {
+					return nil, err
+				}
+			default:
+				visible = true
+			}
+		}
+		if visible {
+			resp.Users = append(resp.Users, &pbuserext.UserLastSeen{UserID: userID, LastSeenTime: t})
+		}
+	}
+	return resp, nil
+}
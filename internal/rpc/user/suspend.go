@@ -0,0 +1,118 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	pbauthext "github.com/openimsdk/open-im-server/v3/pkg/protocol/authext"
+	pbuserext "github.com/openimsdk/open-im-server/v3/pkg/protocol/userext"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+func (s *userServer) SuspendUser(ctx context.Context, req *pbuserext.SuspendUserReq) (*pbuserext.SuspendUserResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if authverify.IsManagerUserID(req.UserID, s.config.Share.IMAdminUserID) {
+		return nil, errs.ErrNoPermission.WrapMsg("admin can not be suspended")
+	}
+	if _, err := s.db.FindWithError(ctx, []string{req.UserID}); err != nil {
+		return nil, err
+	}
+	if err := s.webhookBeforeUserSuspend(ctx, &s.config.WebhooksConfig.BeforeUserSuspend, req); err != nil {
+		return nil, err
+	}
+	// The webhook may have changed the expire time, so it is checked last.
+	now := time.Now()
+	if req.ExpireTime <= now.UnixMilli() {
+		return nil, errs.ErrArgs.WrapMsg("expireTime must be in the future", "expireTime", req.ExpireTime)
+	}
+	suspend := &model.UserSuspend{
+		UserID:         req.UserID,
+		Reason:         req.Reason,
+		HideProfile:    req.HideProfile,
+		OperatorUserID: mcontext.GetOpUserID(ctx),
+		CreateTime:     now,
+		ExpireTime:     time.UnixMilli(req.ExpireTime),
+	}
+	if err := s.suspendDB.Suspend(ctx, suspend); err != nil {
+		return nil, err
+	}
+	// The suspension is kept when the kick fails, the request can be sent again to kick the user.
+	if _, err := s.authExtClient.KickUser(ctx, &pbauthext.KickUserReq{UserID: req.UserID}); err != nil {
+		return nil, err
+	}
+	s.webhookAfterUserSuspend(ctx, &s.config.WebhooksConfig.AfterUserSuspend, req)
+	return &pbuserext.SuspendUserResp{}, nil
+}
+
+func (s *userServer) UnsuspendUser(ctx context.Context, req *pbuserext.UnsuspendUserReq) (*pbuserext.UnsuspendUserResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	if err := s.suspendDB.Unsuspend(ctx, datautil.Distinct(req.UserIDs)); err != nil {
+		return nil, err
+	}
+	return &pbuserext.UnsuspendUserResp{}, nil
+}
+
+func (s *userServer) GetSuspendedUsers(ctx context.Context, req *pbuserext.GetSuspendedUsersReq) (*pbuserext.GetSuspendedUsersResp, error) {
+	if err := authverify.CheckAdmin(ctx, s.config.Share.IMAdminUserID); err != nil {
+		return nil, err
+	}
+	var (
+		total    int64
+		suspends []*model.UserSuspend
+	)
+	if len(req.UserIDs) > 0 {
+		m, err := s.suspendDB.FindSuspended(ctx, req.UserIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, userID := range datautil.Distinct(req.UserIDs) {
+			if suspend, ok := m[userID]; ok {
+				suspends = append(suspends, suspend)
+			}
+		}
+		total = int64(len(suspends))
+	} else {
+		var err error
+		total, suspends, err = s.suspendDB.PageSuspended(ctx, req.Pagination)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &pbuserext.GetSuspendedUsersResp{
+		Total: total,
+		Users: datautil.Slice(suspends, func(e *model.UserSuspend) *pbuserext.UserSuspendInfo {
+			return &pbuserext.UserSuspendInfo{
+				UserID:         e.UserID,
+				Reason:         e.Reason,
+				HideProfile:    e.HideProfile,
+				OperatorUserID: e.OperatorUserID,
+				CreateTime:     e.CreateTime.UnixMilli(),
+				ExpireTime:     e.ExpireTime.UnixMilli(),
+			}
+		}),
+	}, nil
+}
+
+// GetHiddenUserIDs returns the suspended users that hide their profile, it is open to every user
+// as the profile lookups of the users leave them out.
+func (s *userServer) GetHiddenUserIDs(ctx context.Context, req *pbuserext.GetHiddenUserIDsReq) (*pbuserext.GetHiddenUserIDsResp, error) {
+	suspends, err := s.suspendDB.FindSuspended(ctx, req.UserIDs)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pbuserext.GetHiddenUserIDsResp{}
+	for _, userID := range datautil.Distinct(req.UserIDs) {
+		if suspend, ok := suspends[userID]; ok && suspend.HideProfile {
+			resp.UserIDs = append(resp.UserIDs, userID)
+		}
+	}
+	return resp, nil
+}
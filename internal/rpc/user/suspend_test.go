@@ -0,0 +1,161 @@
+package user
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/webhook"
+	pbauthext "github.com/openimsdk/open-im-server/v3/pkg/protocol/authext"
+	pbuserext "github.com/openimsdk/open-im-server/v3/pkg/protocol/userext"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+	"google.golang.org/grpc"
+)
+
+type fakeUserDatabase struct {
+	controller.UserDatabase
+	users map[string]*model.User
+}
+
+func (f *fakeUserDatabase) FindWithError(_ context.Context, userIDs []string) ([]*model.User, error) {
+	var users []*model.User
+	for _, userID := range userIDs {
+		user, ok := f.users[userID]
+		if !ok {
+			return nil, errs.ErrRecordNotFound.WrapMsg("user not found", "userID", userID)
+		}
+		users = append(users, user)
+	}
+	return users, nil
+}
+
+type fakeUserSuspendDatabase struct {
+	suspends map[string]*model.UserSuspend
+}
+
+func (f *fakeUserSuspendDatabase) Suspend(_ context.Context, suspend *model.UserSuspend) error {
+	f.suspends[suspend.UserID] = suspend
+	return nil
+}
+
+func (f *fakeUserSuspendDatabase) Unsuspend(_ context.Context, userIDs []string) error {
+	for _, userID := range userIDs {
+		delete(f.suspends, userID)
+	}
+	return nil
+}
+
+func (f *fakeUserSuspendDatabase) GetSuspended(ctx context.Context, userID string) (*model.UserSuspend, error) {
+	m, _ := f.FindSuspended(ctx, []string{userID})
+	return m[userID], nil
+}
+
+func (f *fakeUserSuspendDatabase) FindSuspended(_ context.Context, userIDs []string) (map[string]*model.UserSuspend, error) {
+	m := make(map[string]*model.UserSuspend)
+	for _, userID := range userIDs {
+		if suspend, ok := f.suspends[userID]; ok && suspend.IsActive(time.Now()) {
+			m[userID] = suspend
+		}
+	}
+	return m, nil
+}
+
+func (f *fakeUserSuspendDatabase) PageSuspended(context.Context, pagination.Pagination) (int64, []*model.UserSuspend, error) {
+	return 0, nil, nil
+}
+
+type fakeAuthClient struct {
+	pbauthext.AuthExtClient
+	kicked []string
+}
+
+func (f *fakeAuthClient) KickUser(_ context.Context, req *pbauthext.KickUserReq, _ ...grpc.CallOption) (*pbauthext.KickUserResp, error) {
+	f.kicked = append(f.kicked, req.UserID)
+	return &pbauthext.KickUserResp{}, nil
+}
+
+func newSuspendTestServer(webhookURL string) (*userServer, *fakeUserSuspendDatabase, *fakeAuthClient) {
+	conf := &Config{
+		Share: config.Share{IMAdminUserID: []string{"admin"}},
+		WebhooksConfig: config.Webhooks{
+			URL:               webhookURL,
+			BeforeUserSuspend: config.BeforeConfig{Enable: webhookURL != "", Timeout: 5},
+		},
+	}
+	suspendDB := &fakeUserSuspendDatabase{suspends: make(map[string]*model.UserSuspend)}
+	authClient := &fakeAuthClient{}
+	s := &userServer{
+		config:        conf,
+		db:            &fakeUserDatabase{users: map[string]*model.User{"u1": {UserID: "u1"}, "admin": {UserID: "admin"}}},
+		suspendDB:     suspendDB,
+		authExtClient: authClient,
+		webhookClient: webhook.NewWebhookClient(&conf.WebhooksConfig),
+	}
+	return s, suspendDB, authClient
+}
+
+func TestSuspendUser(t *testing.T) {
+	s, suspendDB, authClient := newSuspendTestServer("")
+	ctx := mcontext.WithOpUserIDContext(context.Background(), "admin")
+	expireTime := time.Now().Add(time.Hour).UnixMilli()
+
+	if _, err := s.SuspendUser(mcontext.WithOpUserIDContext(context.Background(), "u1"), &pbuserext.SuspendUserReq{UserID: "u1", ExpireTime: expireTime}); err == nil {
+		t.Error("expected a user to be refused")
+	}
+	if _, err := s.SuspendUser(ctx, &pbuserext.SuspendUserReq{UserID: "admin", ExpireTime: expireTime}); err == nil {
+		t.Error("expected an admin not to be suspended")
+	}
+	if _, err := s.SuspendUser(ctx, &pbuserext.SuspendUserReq{UserID: "u2", ExpireTime: expireTime}); err == nil {
+		t.Error("expected an unknown user not to be suspended")
+	}
+	if _, err := s.SuspendUser(ctx, &pbuserext.SuspendUserReq{UserID: "u1", ExpireTime: time.Now().Add(-time.Hour).UnixMilli()}); err == nil {
+		t.Error("expected a past expire time to be refused")
+	}
+	if _, err := s.SuspendUser(ctx, &pbuserext.SuspendUserReq{UserID: "u1", ExpireTime: expireTime, Reason: "spam", HideProfile: true}); err != nil {
+		t.Fatal(err)
+	}
+	suspend := suspendDB.suspends["u1"]
+	if suspend == nil || suspend.Reason != "spam" || suspend.OperatorUserID != "admin" || suspend.ExpireTime.UnixMilli() != expireTime {
+		t.Errorf("unexpected suspension %+v", suspend)
+	}
+	if len(authClient.kicked) != 1 || authClient.kicked[0] != "u1" {
+		t.Errorf("expected the user to be kicked once, got %v", authClient.kicked)
+	}
+
+	hidden, err := s.GetHiddenUserIDs(ctx, &pbuserext.GetHiddenUserIDsReq{UserIDs: []string{"u1", "u3"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hidden.UserIDs) != 1 || hidden.UserIDs[0] != "u1" {
+		t.Errorf("unexpected hidden users %v", hidden.UserIDs)
+	}
+	if _, err := s.UnsuspendUser(ctx, &pbuserext.UnsuspendUserReq{UserIDs: []string{"u1"}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := suspendDB.suspends["u1"]; ok {
+		t.Error("expected the suspension to be removed")
+	}
+}
+
+func TestSuspendUserWebhookExpireTime(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"actionCode":0,"errCode":0,"expireTime":1}`))
+	}))
+	defer srv.Close()
+	s, suspendDB, _ := newSuspendTestServer(srv.URL)
+	ctx := mcontext.WithOpUserIDContext(context.Background(), "admin")
+	_, err := s.SuspendUser(ctx, &pbuserext.SuspendUserReq{UserID: "u1", ExpireTime: time.Now().Add(time.Hour).UnixMilli()})
+	if !errs.ErrArgs.Is(err) {
+		t.Errorf("expected the expire time set by the webhook to be refused, got %v", err)
+	}
+	if len(suspendDB.suspends) != 0 {
+		t.Error("expected no suspension")
+	}
+}
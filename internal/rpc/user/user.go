@@ -31,6 +31,8 @@ import (
 	tablerelation "github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/webhook"
 	"github.com/openimsdk/open-im-server/v3/pkg/localcache"
+	pbauthext "github.com/openimsdk/open-im-server/v3/pkg/protocol/authext"
+	pbuserext "github.com/openimsdk/open-im-server/v3/pkg/protocol/userext"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcli"
 	"github.com/openimsdk/protocol/group"
 	friendpb "github.com/openimsdk/protocol/relation"
@@ -53,6 +55,7 @@ import (
 
 type userServer struct {
 	pbuser.UnimplementedUserServer
+	pbuserext.UnimplementedUserExtServer
 	online                   cache.OnlineCache
 	db                       controller.UserDatabase
 	friendNotificationSender *relation.FriendNotificationSender
@@ -62,6 +65,11 @@ type userServer struct {
 	webhookClient            *webhook.Client
 	groupClient              *rpcli.GroupClient
 	relationClient           *rpcli.RelationClient
+	authExtClient            pbauthext.AuthExtClient
+	suspendDB                controller.UserSuspendDatabase
+	fieldDB                  controller.UserFieldDatabase
+	lastSeenDB               controller.UserLastSeenDatabase
+	auditLog                 controller.AuditLogDatabase
 }
 
 type Config struct {
@@ -94,6 +102,26 @@ func Start(ctx context.Context, config *Config, client registry.SvcDiscoveryRegi
 	if err != nil {
 		return err
 	}
+	auditLogDB, err := mgo.NewAuditLogMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	auditLogDatabase, err := controller.NewAuditLogDatabase(auditLogDB)
+	if err != nil {
+		return err
+	}
+	userSuspendDB, err := mgo.NewUserSuspendMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	userFieldDB, err := mgo.NewUserFieldMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+	userPrivacyDB, err := mgo.NewUserPrivacyMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
 	msgConn, err := client.GetConn(ctx, config.Discovery.RpcService.Msg)
 	if err != nil {
 		return err
@@ -106,6 +134,10 @@ func Start(ctx context.Context, config *Config, client registry.SvcDiscoveryRegi
 	if err != nil {
 		return err
 	}
+	authConn, err := client.GetConn(ctx, config.Discovery.RpcService.Auth)
+	if err != nil {
+		return err
+	}
 	msgClient := rpcli.NewMsgClient(msgConn)
 	userCache := redis.NewUserCacheRedis(rdb, &config.LocalCacheConfig, userDB, redis.GetRocksCacheOptions())
 	database := controller.NewUserDatabase(userDB, userCache, mgocli.GetTx())
@@ -117,15 +149,29 @@ func Start(ctx context.Context, config *Config, client registry.SvcDiscoveryRegi
 		friendNotificationSender: relation.NewFriendNotificationSender(&config.NotificationConfig, msgClient, relation.WithDBFunc(database.FindWithError)),
 		userNotificationSender:   NewUserNotificationSender(config, msgClient, WithUserFunc(database.FindWithError)),
 		config:                   config,
-		webhookClient:            webhook.NewWebhookClient(config.WebhooksConfig.URL),
+		webhookClient:            webhook.NewWebhookClient(&config.WebhooksConfig),
 
 		groupClient:    rpcli.NewGroupClient(groupConn),
 		relationClient: rpcli.NewRelationClient(friendConn),
+		authExtClient:  pbauthext.NewAuthExtClient(authConn),
+		suspendDB:      controller.NewUserSuspendDatabase(userSuspendDB, redis.NewUserSuspendCacheRedis(rdb, userSuspendDB, redis.GetRocksCacheOptions())),
+		fieldDB:        controller.NewUserFieldDatabase(userFieldDB, redis.NewUserFieldCacheRedis(rdb, userFieldDB, redis.GetRocksCacheOptions())),
+		lastSeenDB:     controller.NewUserLastSeenDatabase(userPrivacyDB, redis.NewUserLastSeenCacheRedis(rdb, userPrivacyDB, redis.GetRocksCacheOptions())),
+		auditLog:       auditLogDatabase,
 	}
 	pbuser.RegisterUserServer(server, u)
+	pbuserext.RegisterUserExtServer(server, u)
 	return u.db.InitOnce(context.Background(), users)
 }
 
+func (s *userServer) AuditLogDatabase() controller.AuditLogDatabase {
+	return s.auditLog
+}
+
+func (s *userServer) IMAdminUserIDs() []string {
+	return s.config.Share.IMAdminUserID
+}
+
 func (s *userServer) GetDesignateUsers(ctx context.Context, req *pbuser.GetDesignateUsersReq) (resp *pbuser.GetDesignateUsersResp, err error) {
 	resp = &pbuser.GetDesignateUsersResp{}
 	users, err := s.db.Find(ctx, req.UserIDs)
@@ -258,7 +304,7 @@ func (s *userServer) GetPaginationUsers(ctx context.Context, req *pbuser.GetPagi
 		}
 		return &pbuser.GetPaginationUsersResp{Total: int32(total), Users: convert.UsersDB2Pb(users)}, err
 	} else {
-		total, users, err := s.db.PageFindUserWithKeyword(ctx, constant.IMOrdinaryUser, constant.AppOrdinaryUsers, req.UserID, req.NickName, req.Pagination)
+		total, users, err := s.db.PageFindUserWithKeyword(ctx, constant.IMOrdinaryUser, constant.AppOrdinaryUsers, req.UserID, req.NickName, nil, req.Pagination)
 		if err != nil {
 			return nil, err
 		}
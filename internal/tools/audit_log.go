@@ -0,0 +1,24 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+)
+
+func (c *cronServer) clearAuditLog(ctx context.Context) {
+	now := time.Now()
+	operationID := fmt.Sprintf("cron_audit_log_%d_%d", os.Getpid(), now.UnixMilli())
+	ctx = mcontext.SetOperationID(ctx, operationID)
+	log.ZDebug(ctx, "clear audit log cron start")
+	count, err := c.auditLogDB.DeleteBefore(ctx, now.AddDate(0, 0, -c.config.CronTask.RetainAuditLogs))
+	if err != nil {
+		log.ZError(ctx, "clear audit log failed", err)
+		return
+	}
+	log.ZDebug(ctx, "clear audit log cron task completed", "cost", time.Since(now), "count", count)
+}
@@ -6,16 +6,19 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	kdisc "github.com/openimsdk/open-im-server/v3/pkg/common/discovery"
 	disetcd "github.com/openimsdk/open-im-server/v3/pkg/common/discovery/etcd"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database/mgo"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	pbconversation "github.com/openimsdk/protocol/conversation"
 	"github.com/openimsdk/protocol/msg"
 	"github.com/openimsdk/protocol/third"
+	"github.com/openimsdk/tools/db/mongoutil"
 	"github.com/openimsdk/tools/discovery/etcd"
 
 	"github.com/openimsdk/tools/mcontext"
 	"github.com/openimsdk/tools/mw"
 	"github.com/openimsdk/tools/utils/runtimeenv"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
@@ -23,9 +26,10 @@ import (
 )
 
 type CronTaskConfig struct {
-	CronTask  config.CronTask
-	Share     config.Share
-	Discovery config.Discovery
+	CronTask      config.CronTask
+	Share         config.Share
+	Discovery     config.Discovery
+	MongodbConfig config.Mongo
 
 	runTimeEnv string
 }
@@ -41,7 +45,11 @@ func Start(ctx context.Context, conf *CronTaskConfig) error {
 	if err != nil {
 		return errs.WrapMsg(err, "failed to register discovery service")
 	}
-	client.AddOption(mw.GrpcClient(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	creds, err := kdisc.ClientCredentials(&conf.Discovery, kdisc.TLSNameCronTask)
+	if err != nil {
+		return err
+	}
+	client.AddOption(mw.GrpcClient(), grpc.WithTransportCredentials(creds))
 	ctx = mcontext.SetOpUserID(ctx, conf.Share.IMAdminUserID[0])
 
 	msgConn, err := client.GetConn(ctx, conf.Discovery.RpcService.Msg)
@@ -59,11 +67,21 @@ func Start(ctx context.Context, conf *CronTaskConfig) error {
 		return err
 	}
 
+	mgocli, err := mongoutil.NewMongoDB(ctx, conf.MongodbConfig.Build())
+	if err != nil {
+		return err
+	}
+	auditLogDB, err := mgo.NewAuditLogMongo(mgocli.GetDB())
+	if err != nil {
+		return err
+	}
+
 	if conf.Discovery.Enable == config.ETCD {
 		cm := disetcd.NewConfigManager(client.(*etcd.SvcDiscoveryRegistryImpl).GetClient(), []string{
 			conf.CronTask.GetConfigFileName(),
 			conf.Share.GetConfigFileName(),
 			conf.Discovery.GetConfigFileName(),
+			conf.MongodbConfig.GetConfigFileName(),
 		})
 		cm.Watch(ctx)
 	}
@@ -75,6 +93,7 @@ func Start(ctx context.Context, conf *CronTaskConfig) error {
 		msgClient:          msg.NewMsgClient(msgConn),
 		conversationClient: pbconversation.NewConversationClient(conversationConn),
 		thirdClient:        third.NewThirdClient(thirdConn),
+		auditLogDB:         auditLogDB,
 	}
 
 	if err := srv.registerClearS3(); err != nil {
@@ -86,6 +105,9 @@ func Start(ctx context.Context, conf *CronTaskConfig) error {
 	if err := srv.registerClearUserMsg(); err != nil {
 		return err
 	}
+	if err := srv.registerClearAuditLog(); err != nil {
+		return err
+	}
 	log.ZDebug(ctx, "start cron task", "CronExecuteTime", conf.CronTask.CronExecuteTime)
 	srv.cron.Start()
 	<-ctx.Done()
@@ -99,6 +121,7 @@ type cronServer struct {
 	msgClient          msg.MsgClient
 	conversationClient pbconversation.ConversationClient
 	thirdClient        third.ThirdClient
+	auditLogDB         database.AuditLog
 }
 
 func (c *cronServer) registerClearS3() error {
@@ -106,7 +129,7 @@ func (c *cronServer) registerClearS3() error {
 		log.ZInfo(c.ctx, "disable scheduled cleanup of s3", "fileExpireTime", c.config.CronTask.FileExpireTime, "deleteObjectType", c.config.CronTask.DeleteObjectType)
 		return nil
 	}
-	_, err := c.cron.AddFunc(c.config.CronTask.CronExecuteTime, c.clearS3)
+	_, err := c.cron.AddFunc(c.config.CronTask.CronExecuteTime, c.eachTenant(c.clearS3))
 	return errs.WrapMsg(err, "failed to register clear s3 cron task")
 }
 
@@ -115,11 +138,31 @@ func (c *cronServer) registerDeleteMsg() error {
 		log.ZInfo(c.ctx, "disable scheduled cleanup of chat records", "retainChatRecords", c.config.CronTask.RetainChatRecords)
 		return nil
 	}
-	_, err := c.cron.AddFunc(c.config.CronTask.CronExecuteTime, c.deleteMsg)
+	_, err := c.cron.AddFunc(c.config.CronTask.CronExecuteTime, c.eachTenant(c.deleteMsg))
 	return errs.WrapMsg(err, "failed to register delete msg cron task")
 }
 
 func (c *cronServer) registerClearUserMsg() error {
-	_, err := c.cron.AddFunc(c.config.CronTask.CronExecuteTime, c.clearUserMsg)
+	_, err := c.cron.AddFunc(c.config.CronTask.CronExecuteTime, c.eachTenant(c.clearUserMsg))
 	return errs.WrapMsg(err, "failed to register clear user msg cron task")
 }
+
+func (c *cronServer) registerClearAuditLog() error {
+	if c.config.CronTask.RetainAuditLogs <= 0 {
+		log.ZInfo(c.ctx, "disable scheduled cleanup of audit logs", "retainAuditLogs", c.config.CronTask.RetainAuditLogs)
+		return nil
+	}
+	_, err := c.cron.AddFunc(c.config.CronTask.CronExecuteTime, c.eachTenant(c.clearAuditLog))
+	return errs.WrapMsg(err, "failed to register clear audit log cron task")
+}
+
+// eachTenant runs task for the default tenant and then for every configured tenant, as the data of
+// each tenant is stored apart.
+func (c *cronServer) eachTenant(task func(ctx context.Context)) func() {
+	return func() {
+		task(c.ctx)
+		for _, t := range c.config.Share.Tenants {
+			task(tenant.WithTenantID(c.ctx, t.ID))
+		}
+	}
+}
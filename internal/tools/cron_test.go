@@ -58,7 +58,7 @@ func TestName(t *testing.T) {
 		conversationClient: pbconversation.NewConversationClient(conversationConn),
 		thirdClient:        third.NewThirdClient(thirdConn),
 	}
-	srv.deleteMsg()
+	srv.deleteMsg(ctx)
 	//srv.clearS3()
 	//srv.clearUserMsg()
 }
@@ -1,6 +1,7 @@
 package tools
 
 import (
+	"context"
 	"fmt"
 	"github.com/openimsdk/protocol/msg"
 	"github.com/openimsdk/tools/log"
@@ -9,11 +10,11 @@ import (
 	"time"
 )
 
-func (c *cronServer) deleteMsg() {
+func (c *cronServer) deleteMsg(ctx context.Context) {
 	now := time.Now()
 	deltime := now.Add(-time.Hour * 24 * time.Duration(c.config.CronTask.RetainChatRecords))
 	operationID := fmt.Sprintf("cron_msg_%d_%d", os.Getpid(), deltime.UnixMilli())
-	ctx := mcontext.SetOperationID(c.ctx, operationID)
+	ctx = mcontext.SetOperationID(ctx, operationID)
 	log.ZDebug(ctx, "Destruct chat records", "deltime", deltime, "timestamp", deltime.UnixMilli())
 	const (
 		deleteCount = 10000
@@ -21,7 +22,7 @@ func (c *cronServer) deleteMsg() {
 	)
 	var count int
 	for i := 1; i <= deleteCount; i++ {
-		ctx := mcontext.SetOperationID(c.ctx, fmt.Sprintf("%s_%d", operationID, i))
+		ctx := mcontext.SetOperationID(ctx, fmt.Sprintf("%s_%d", operationID, i))
 		resp, err := c.msgClient.DestructMsgs(ctx, &msg.DestructMsgsReq{Timestamp: deltime.UnixMilli(), Limit: deleteLimit})
 		if err != nil {
 			log.ZError(ctx, "cron destruct chat records failed", err)
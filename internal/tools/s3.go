@@ -1,6 +1,7 @@
 package tools
 
 import (
+	"context"
 	"fmt"
 	"github.com/openimsdk/protocol/third"
 	"github.com/openimsdk/tools/log"
@@ -9,11 +10,11 @@ import (
 	"time"
 )
 
-func (c *cronServer) clearS3() {
+func (c *cronServer) clearS3(ctx context.Context) {
 	start := time.Now()
 	deleteTime := start.Add(-time.Hour * 24 * time.Duration(c.config.CronTask.FileExpireTime))
 	operationID := fmt.Sprintf("cron_s3_%d_%d", os.Getpid(), deleteTime.UnixMilli())
-	ctx := mcontext.SetOperationID(c.ctx, operationID)
+	ctx = mcontext.SetOperationID(ctx, operationID)
 	log.ZDebug(ctx, "deleteoutDatedData", "deletetime", deleteTime, "timestamp", deleteTime.UnixMilli())
 	const (
 		deleteCount = 10000
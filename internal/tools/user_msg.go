@@ -1,6 +1,7 @@
 package tools
 
 import (
+	"context"
 	"fmt"
 	pbconversation "github.com/openimsdk/protocol/conversation"
 	"github.com/openimsdk/tools/log"
@@ -9,10 +10,10 @@ import (
 	"time"
 )
 
-func (c *cronServer) clearUserMsg() {
+func (c *cronServer) clearUserMsg(ctx context.Context) {
 	now := time.Now()
 	operationID := fmt.Sprintf("cron_user_msg_%d_%d", os.Getpid(), now.UnixMilli())
-	ctx := mcontext.SetOperationID(c.ctx, operationID)
+	ctx = mcontext.SetOperationID(ctx, operationID)
 	log.ZDebug(ctx, "clear user msg cron start")
 	const (
 		deleteCount = 10000
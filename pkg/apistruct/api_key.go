@@ -0,0 +1,11 @@
+package apistruct
+
+// UpdateApiKeyReq leaves the omitted fields unchanged, an empty allowIPs allows every ip.
+type UpdateApiKeyReq struct {
+	KeyID      string   `json:"keyID" binding:"required"`
+	Name       *string  `json:"name"`
+	Scopes     []string `json:"scopes"`
+	AllowIPs   []string `json:"allowIPs"`
+	RateLimit  *int64   `json:"rateLimit"`
+	ExpireTime *int64   `json:"expireTime"`
+}
@@ -0,0 +1,6 @@
+package apistruct
+
+type ExchangeOIDCTokenReq struct {
+	IDToken    string `json:"idToken" binding:"required"`
+	PlatformID int32  `json:"platformID" binding:"required"`
+}
@@ -0,0 +1,9 @@
+package apistruct
+
+// ConversationDraft is the unsent input of the user in a conversation, an empty text and reply mean no draft.
+type ConversationDraft struct {
+	ConversationID   string `json:"conversationID"`
+	Text             string `json:"text"`
+	ReplyClientMsgID string `json:"replyClientMsgID"`
+	UpdateTime       int64  `json:"updateTime"`
+}
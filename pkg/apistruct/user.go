@@ -0,0 +1,35 @@
+package apistruct
+
+import (
+	"github.com/openimsdk/protocol/sdkws"
+)
+
+type SetUserFieldsReq struct {
+	UserID string `json:"userID" binding:"required"`
+	// Fields are merged into the existing values, a null value removes the field.
+	Fields map[string]any `json:"fields" binding:"required"`
+}
+
+type SetUserFieldsResp struct{}
+
+type SearchUsersByFieldsReq struct {
+	UserID   string `json:"userID"`
+	Nickname string `json:"nickname"`
+	// Fields must all match exactly, only searchable fields are allowed.
+	Fields     map[string]any           `json:"fields"`
+	Pagination *sdkws.RequestPagination `json:"pagination" binding:"required"`
+}
+
+type SearchUsersByFieldsResp struct {
+	Total int64                 `json:"total"`
+	Users []*UserInfoWithFields `json:"users"`
+}
+
+type UserInfoWithFields struct {
+	*sdkws.UserInfo
+	Fields map[string]any `json:"fields,omitempty"`
+}
+
+type GetUsersPublicInfoResp struct {
+	UsersInfo []*UserInfoWithFields `json:"usersInfo"`
+}
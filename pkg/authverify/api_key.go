@@ -0,0 +1,54 @@
+package authverify
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+// ApiKeyPrefix tells API keys apart from JWT tokens in the token header.
+const ApiKeyPrefix = "imak_"
+
+// GenerateApiKeySecret returns a random secret, only its hash is stored.
+func GenerateApiKeySecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", errs.Wrap(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func HashApiKeySecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// ApiKeySecretMatch compares a presented secret with a stored hash in constant time.
+func ApiKeySecretMatch(secret string, hash string) bool {
+	if hash == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(HashApiKeySecret(secret)), []byte(hash)) == 1
+}
+
+// FormatApiKey builds the value sent in the token header: imak_<keyID>.<secret>.
+func FormatApiKey(keyID string, secret string) string {
+	return ApiKeyPrefix + keyID + "." + secret
+}
+
+func IsApiKey(token string) bool {
+	return strings.HasPrefix(token, ApiKeyPrefix)
+}
+
+func ParseApiKey(token string) (keyID string, secret string, err error) {
+	keyID, secret, ok := strings.Cut(strings.TrimPrefix(token, ApiKeyPrefix), ".")
+	if !IsApiKey(token) || !ok || keyID == "" || secret == "" {
+		return "", "", errs.ErrArgs.WrapMsg("malformed api key")
+	}
+	return keyID, secret, nil
+}
@@ -0,0 +1,31 @@
+package authverify
+
+import (
+	"testing"
+)
+
+func TestApiKey(t *testing.T) {
+	secret, err := GenerateApiKeySecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	token := FormatApiKey("0123456789abcdef", secret)
+	if !IsApiKey(token) {
+		t.Fatal("api key not recognized", token)
+	}
+	keyID, parsed, err := ParseApiKey(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if keyID != "0123456789abcdef" || !ApiKeySecretMatch(parsed, HashApiKeySecret(secret)) {
+		t.Fatal("unexpected api key", keyID, parsed)
+	}
+	if ApiKeySecretMatch("other", HashApiKeySecret(secret)) || ApiKeySecretMatch(secret, "") {
+		t.Fatal("wrong secret must not match")
+	}
+	for _, malformed := range []string{"imak_", "imak_abc", "imak_.secret", "imak_abc.", "eyJhbGciOi.x.y"} {
+		if _, _, err := ParseApiKey(malformed); err == nil {
+			t.Fatal("malformed api key accepted", malformed)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package authverify
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/tokenverify"
+)
+
+// Claims extends tokenverify.Claims with the tenant the token was issued for.
+type Claims struct {
+	tokenverify.Claims
+	TenantID string `json:"TenantID,omitempty"`
+}
+
+func BuildClaims(userID string, platformID int, ttl time.Duration, tenantID string) Claims {
+	now := time.Now()
+	return Claims{
+		Claims: tokenverify.Claims{
+			UserID:     userID,
+			PlatformID: platformID,
+			RegisteredClaims: jwt.RegisteredClaims{
+				ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+				IssuedAt:  jwt.NewNumericDate(now.Add(-time.Second * 5)),
+			},
+		},
+		TenantID: tenantID,
+	}
+}
+
+func GetClaimFromToken(tokensString string, secretFunc jwt.Keyfunc) (*Claims, error) {
+	token, err := jwt.ParseWithClaims(tokensString, &Claims{}, secretFunc)
+	if err == nil {
+		if claims, ok := token.Claims.(*Claims); ok && token.Valid {
+			return claims, nil
+		}
+		return nil, errs.WrapMsg(errs.ErrTokenUnknown, "claims unknown", "token", tokensString)
+	}
+	var ve *jwt.ValidationError
+	if errors.As(err, &ve) {
+		return nil, errs.WrapMsg(mapValidationError(ve), "jwt parse error", "token", tokensString)
+	}
+	return nil, errs.WrapMsg(err, "jwt parse error", "token", tokensString)
+}
+
+// GetClaimIgnoreExpire verifies the signature of a token that may have expired.
+func GetClaimIgnoreExpire(tokensString string, secretFunc jwt.Keyfunc) (*Claims, error) {
+	var claims Claims
+	if _, err := jwt.NewParser(jwt.WithoutClaimsValidation()).ParseWithClaims(tokensString, &claims, secretFunc); err != nil {
+		return nil, errs.WrapMsg(errs.ErrTokenUnknown, err.Error(), "token", tokensString)
+	}
+	return &claims, nil
+}
+
+// TokenAlive reports whether the login session of a token still exists. With refresh tokens
+// (refreshTTL > 0) an expired token stays alive while it can be refreshed.
+func TokenAlive(tokensString string, secretFunc jwt.Keyfunc, refreshTTL time.Duration) bool {
+	if refreshTTL <= 0 {
+		_, err := GetClaimFromToken(tokensString, secretFunc)
+		return err == nil
+	}
+	claims, err := GetClaimIgnoreExpire(tokensString, secretFunc)
+	if err != nil || claims.IssuedAt == nil {
+		return false
+	}
+	return time.Now().Before(claims.IssuedAt.Add(refreshTTL))
+}
+
+// IssuedTokenAlive is TokenAlive for the tokens kept in the token cache, which only holds tokens
+// signed by the auth rpc. It reads their claims without the keys, so keys that can not be loaded
+// never end a login session.
+func IssuedTokenAlive(tokensString string, refreshTTL time.Duration) bool {
+	claims, err := ParseClaimsUnverified(tokensString)
+	if err != nil {
+		return false
+	}
+	if refreshTTL <= 0 {
+		return claims.ExpiresAt == nil || time.Now().Before(claims.ExpiresAt.Time)
+	}
+	return claims.IssuedAt != nil && time.Now().Before(claims.IssuedAt.Add(refreshTTL))
+}
+
+// ParseClaimsUnverified reads the claims without checking the signature,
+// it must only be used on tokens that have already been verified.
+func ParseClaimsUnverified(tokensString string) (*Claims, error) {
+	var claims Claims
+	if _, _, err := jwt.NewParser().ParseUnverified(tokensString, &claims); err != nil {
+		return nil, errs.WrapMsg(errs.ErrTokenMalformed, err.Error(), "token", tokensString)
+	}
+	return &claims, nil
+}
+
+func mapValidationError(ve *jwt.ValidationError) error {
+	if ve.Errors&jwt.ValidationErrorMalformed != 0 {
+		return errs.ErrTokenMalformed
+	} else if ve.Errors&jwt.ValidationErrorExpired != 0 {
+		return errs.ErrTokenExpired
+	} else if ve.Errors&jwt.ValidationErrorNotValidYet != 0 {
+		return errs.ErrTokenNotValidYet
+	}
+	return errs.NewCodeError(errs.TokenUnknownError, ve.Error())
+}
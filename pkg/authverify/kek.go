@@ -0,0 +1,60 @@
+package authverify
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+// EncryptPrivateKey seals a PEM private key with AES-256-GCM under the key encryption key,
+// the kid is authenticated so a sealed key can not be swapped with another one.
+// The result is the base64 encoded nonce followed by the ciphertext.
+func EncryptPrivateKey(kek []byte, kid string, privateKey string) (string, error) {
+	aead, err := newKEKCipher(kek)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(privateKey)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", errs.Wrap(err)
+	}
+	return base64.StdEncoding.EncodeToString(aead.Seal(nonce, nonce, []byte(privateKey), []byte(kid))), nil
+}
+
+// DecryptPrivateKey opens a private key sealed by EncryptPrivateKey.
+func DecryptPrivateKey(kek []byte, kid string, data string) (string, error) {
+	aead, err := newKEKCipher(kek)
+	if err != nil {
+		return "", err
+	}
+	sealed, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return "", errs.WrapMsg(err, "invalid encrypted private key", "kid", kid)
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", errs.New("encrypted private key too short", "kid", kid).Wrap()
+	}
+	privateKey, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], []byte(kid))
+	if err != nil {
+		return "", errs.WrapMsg(err, "decrypt private key failed", "kid", kid)
+	}
+	return string(privateKey), nil
+}
+
+func newKEKCipher(kek []byte) (cipher.AEAD, error) {
+	if len(kek) != 32 {
+		return nil, errs.New("key encryption key must be 32 bytes").Wrap()
+	}
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return aead, nil
+}
@@ -0,0 +1,42 @@
+package authverify
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestEncryptPrivateKey(t *testing.T) {
+	key, err := GenerateKey(AlgorithmEdDSA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pemKey, err := MarshalPrivateKey(key.PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	kek := bytes.Repeat([]byte{1}, 32)
+	sealed, err := EncryptPrivateKey(kek, key.KID, pemKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(sealed, "PRIVATE KEY") {
+		t.Fatal("private key stored in clear")
+	}
+	opened, err := DecryptPrivateKey(kek, key.KID, sealed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opened != pemKey {
+		t.Fatal("decrypted key differs")
+	}
+	if _, err := DecryptPrivateKey(bytes.Repeat([]byte{2}, 32), key.KID, sealed); err == nil {
+		t.Fatal("key opened with another key encryption key")
+	}
+	if _, err := DecryptPrivateKey(kek, "other", sealed); err == nil {
+		t.Fatal("key opened under another kid")
+	}
+	if _, err := EncryptPrivateKey(kek[:16], key.KID, pemKey); err == nil {
+		t.Fatal("short key encryption key accepted")
+	}
+}
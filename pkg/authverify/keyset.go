@@ -0,0 +1,293 @@
+package authverify
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+)
+
+const (
+	AlgorithmHS256 = "HS256"
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+)
+
+const (
+	rsaKeyBits = 2048
+	// reloadInterval limits how often an unknown kid triggers a reload of the keys.
+	reloadInterval = time.Second * 10
+)
+
+// Key is an asymmetric key that signs tokens while it is the newest one, and verifies them afterwards.
+type Key struct {
+	KID        string
+	Algorithm  string
+	PrivateKey crypto.Signer
+	CreateTime time.Time
+}
+
+func (k *Key) method() jwt.SigningMethod {
+	return jwt.GetSigningMethod(k.Algorithm)
+}
+
+// GenerateKey creates a new key for RS256 or EdDSA.
+func GenerateKey(algorithm string) (*Key, error) {
+	var (
+		privateKey crypto.Signer
+		err        error
+	)
+	switch algorithm {
+	case AlgorithmRS256:
+		privateKey, err = rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	case AlgorithmEdDSA:
+		_, privateKey, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		return nil, errs.ErrArgs.WrapMsg("unsupported signing algorithm", "algorithm", algorithm)
+	}
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	kid := make([]byte, 16)
+	if _, err := rand.Read(kid); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &Key{
+		KID:        base64.RawURLEncoding.EncodeToString(kid),
+		Algorithm:  algorithm,
+		PrivateKey: privateKey,
+		CreateTime: time.Now(),
+	}, nil
+}
+
+// MarshalPrivateKey encodes the private key as a PKCS #8 PEM block.
+func MarshalPrivateKey(privateKey crypto.Signer) (string, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})), nil
+}
+
+func ParsePrivateKey(data string) (crypto.Signer, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil {
+		return nil, errs.New("invalid private key pem").Wrap()
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, errs.New("private key is not a signer").Wrap()
+	}
+	return signer, nil
+}
+
+// JWK is the public part of a Key as described in RFC 7517.
+type JWK struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+type JWKS struct {
+	Keys []*JWK `json:"keys"`
+}
+
+func (k *Key) JWK() *JWK {
+	jwk := &JWK{Use: "sig", Alg: k.Algorithm, Kid: k.KID}
+	switch pub := k.PrivateKey.Public().(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+	}
+	return jwk
+}
+
+// KeySet signs new tokens and verifies existing ones.
+// Tokens without a kid are HS256 tokens signed with the shared secret, after switching to an
+// asymmetric algorithm they are only accepted until the deadline set by AcceptLegacySecretUntil.
+type KeySet struct {
+	secret      []byte
+	algorithm   string
+	load        func(ctx context.Context) ([]*Key, error)
+	legacyUntil time.Time
+
+	lock       sync.RWMutex
+	keys       map[string]*Key
+	signing    *Key
+	lastReload time.Time
+}
+
+// NewKeySet creates a key set signing with algorithm, load returns the stored keys newest first.
+func NewKeySet(secret string, algorithm string, load func(ctx context.Context) ([]*Key, error)) *KeySet {
+	if algorithm == "" {
+		algorithm = AlgorithmHS256
+	}
+	return &KeySet{
+		secret:    []byte(secret),
+		algorithm: algorithm,
+		load:      load,
+		keys:      make(map[string]*Key),
+	}
+}
+
+// AcceptLegacySecretUntil keeps the tokens signed with the shared secret valid until deadline
+// when signing with an asymmetric algorithm. It must be called before the key set is used.
+func (k *KeySet) AcceptLegacySecretUntil(deadline time.Time) {
+	k.legacyUntil = deadline
+}
+
+func (k *KeySet) Algorithm() string {
+	return k.algorithm
+}
+
+// Reload replaces the keys with the stored ones.
+func (k *KeySet) Reload(ctx context.Context) error {
+	if k.load == nil {
+		return nil
+	}
+	keys, err := k.load(ctx)
+	if err != nil {
+		return err
+	}
+	m := make(map[string]*Key, len(keys))
+	var signing *Key
+	for _, key := range keys {
+		m[key.KID] = key
+		if key.Algorithm == k.algorithm && (signing == nil || key.CreateTime.After(signing.CreateTime)) {
+			signing = key
+		}
+	}
+	k.lock.Lock()
+	k.keys = m
+	k.signing = signing
+	k.lastReload = time.Now()
+	k.lock.Unlock()
+	return nil
+}
+
+// Run reloads the keys every interval until ctx is done.
+func (k *KeySet) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := k.Reload(ctx); err != nil {
+				log.ZWarn(ctx, "reload token keys failed", err)
+			}
+		}
+	}
+}
+
+// Signing returns the key new tokens are signed with, nil when signing with the secret.
+func (k *KeySet) Signing() *Key {
+	if k.algorithm == AlgorithmHS256 {
+		return nil
+	}
+	k.lock.RLock()
+	defer k.lock.RUnlock()
+	return k.signing
+}
+
+func (k *KeySet) Sign(claims jwt.Claims) (string, error) {
+	if k.algorithm == AlgorithmHS256 {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(k.secret)
+		if err != nil {
+			return "", errs.WrapMsg(err, "token.SignedString")
+		}
+		return token, nil
+	}
+	key := k.Signing()
+	if key == nil {
+		return "", errs.New("no signing key", "algorithm", k.algorithm).Wrap()
+	}
+	token := jwt.NewWithClaims(key.method(), claims)
+	token.Header["kid"] = key.KID
+	tokenString, err := token.SignedString(key.PrivateKey)
+	if err != nil {
+		return "", errs.WrapMsg(err, "token.SignedString")
+	}
+	return tokenString, nil
+}
+
+// Keyfunc resolves the verification key of a token, it is meant for GetClaimFromToken.
+func (k *KeySet) Keyfunc(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errs.New("token without kid must be HS256").Wrap()
+		}
+		if k.algorithm != AlgorithmHS256 && !time.Now().Before(k.legacyUntil) {
+			return nil, errs.New("tokens signed with the secret are no longer accepted").Wrap()
+		}
+		return k.secret, nil
+	}
+	key := k.getKey(kid)
+	if key == nil {
+		return nil, errs.New("unknown token kid", "kid", kid).Wrap()
+	}
+	if token.Method.Alg() != key.Algorithm {
+		return nil, errs.New("token alg does not match its key", "kid", kid, "alg", token.Method.Alg()).Wrap()
+	}
+	return key.PrivateKey.Public(), nil
+}
+
+// getKey looks up kid, reloading once in a while in case another instance rotated the keys.
+func (k *KeySet) getKey(kid string) *Key {
+	k.lock.Lock()
+	key := k.keys[kid]
+	if key != nil || time.Since(k.lastReload) < reloadInterval {
+		k.lock.Unlock()
+		return key
+	}
+	k.lastReload = time.Now()
+	k.lock.Unlock()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	if err := k.Reload(ctx); err != nil {
+		log.ZWarn(ctx, "reload token keys failed", err, "kid", kid)
+		return nil
+	}
+	k.lock.RLock()
+	defer k.lock.RUnlock()
+	return k.keys[kid]
+}
+
+// JWKS returns the public keys that tokens may currently be verified with.
+func (k *KeySet) JWKS() *JWKS {
+	k.lock.RLock()
+	defer k.lock.RUnlock()
+	jwks := &JWKS{Keys: make([]*JWK, 0, len(k.keys))}
+	for _, key := range k.keys {
+		jwks.Keys = append(jwks.Keys, key.JWK())
+	}
+	return jwks
+}
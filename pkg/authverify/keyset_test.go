@@ -0,0 +1,113 @@
+package authverify
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestKeySetSignAndVerify(t *testing.T) {
+	for _, algorithm := range []string{AlgorithmRS256, AlgorithmEdDSA} {
+		key, err := GenerateKey(algorithm)
+		if err != nil {
+			t.Fatal(err)
+		}
+		keySet := NewKeySet("secret", algorithm, func(ctx context.Context) ([]*Key, error) {
+			return []*Key{key}, nil
+		})
+		if err := keySet.Reload(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		token, err := keySet.Sign(BuildClaims("user1", 1, time.Hour, ""))
+		if err != nil {
+			t.Fatal(err)
+		}
+		claims, err := GetClaimFromToken(token, keySet.Keyfunc)
+		if err != nil {
+			t.Fatal(algorithm, err)
+		}
+		if claims.UserID != "user1" {
+			t.Fatal(algorithm, "unexpected userID", claims.UserID)
+		}
+		if jwks := keySet.JWKS(); len(jwks.Keys) != 1 || jwks.Keys[0].Kid != key.KID {
+			t.Fatal(algorithm, "unexpected jwks", jwks.Keys)
+		}
+	}
+}
+
+func TestKeySetLegacySecret(t *testing.T) {
+	legacy := NewKeySet("secret", AlgorithmHS256, nil)
+	token, err := legacy.Sign(BuildClaims("user1", 1, time.Hour, ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := GenerateKey(AlgorithmEdDSA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keySet := NewKeySet("secret", AlgorithmEdDSA, func(ctx context.Context) ([]*Key, error) {
+		return []*Key{key}, nil
+	})
+	if _, err := GetClaimFromToken(token, keySet.Keyfunc); err == nil {
+		t.Fatal("hs256 token must be rejected after switching algorithm without a deadline")
+	}
+	keySet.AcceptLegacySecretUntil(time.Now().Add(time.Hour))
+	if _, err := GetClaimFromToken(token, keySet.Keyfunc); err != nil {
+		t.Fatal("hs256 token must stay valid until the deadline", err)
+	}
+	keySet.AcceptLegacySecretUntil(time.Now().Add(-time.Second))
+	if _, err := GetClaimFromToken(token, keySet.Keyfunc); err == nil {
+		t.Fatal("hs256 token must be rejected after the deadline")
+	}
+	if _, err := GetClaimFromToken(token, NewKeySet("other", AlgorithmHS256, nil).Keyfunc); err == nil {
+		t.Fatal("token signed with another secret must be rejected")
+	}
+}
+
+func TestTokenAlive(t *testing.T) {
+	keySet := NewKeySet("secret", AlgorithmHS256, nil)
+	token, err := keySet.Sign(BuildClaims("user1", 1, -time.Minute, ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if TokenAlive(token, keySet.Keyfunc, 0) {
+		t.Fatal("expired token must not be alive without refresh tokens")
+	}
+	if !TokenAlive(token, keySet.Keyfunc, time.Hour) {
+		t.Fatal("expired token must stay alive within the refresh window")
+	}
+	if TokenAlive(token, NewKeySet("other", AlgorithmHS256, nil).Keyfunc, time.Hour) {
+		t.Fatal("token signed with another secret must not be alive")
+	}
+}
+
+func TestIssuedTokenAlive(t *testing.T) {
+	// The keys of the token are not loaded, the token is still alive.
+	keySet := NewKeySet("", AlgorithmEdDSA, func(ctx context.Context) ([]*Key, error) {
+		key, err := GenerateKey(AlgorithmEdDSA)
+		return []*Key{key}, err
+	})
+	if err := keySet.Reload(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	token, err := keySet.Sign(BuildClaims("user1", 1, time.Hour, ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IssuedTokenAlive(token, 0) || !IssuedTokenAlive(token, time.Hour) {
+		t.Fatal("unexpired token must be alive")
+	}
+	expired, err := keySet.Sign(BuildClaims("user1", 1, -time.Minute, ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if IssuedTokenAlive(expired, 0) {
+		t.Fatal("expired token must not be alive without refresh tokens")
+	}
+	if !IssuedTokenAlive(expired, time.Hour) {
+		t.Fatal("expired token must stay alive within the refresh window")
+	}
+	if IssuedTokenAlive("malformed", time.Hour) {
+		t.Fatal("malformed token must not be alive")
+	}
+}
@@ -0,0 +1,31 @@
+package authverify
+
+import (
+	"context"
+
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// KickTokens is the context key and rpc metadata key of the tokens a KickUserOffline request is limited to.
+const KickTokens = "kickTokens"
+
+// WithKickTokens limits the KickUserOffline requests made with ctx to the connections of tokens,
+// KickUserOfflineReq only names a platform. The tokens travel as a rpc custom header.
+func WithKickTokens(ctx context.Context, tokens []string) context.Context {
+	if len(tokens) == 0 {
+		return ctx
+	}
+	keys, _ := ctx.Value(constant.RpcCustomHeader).([]string)
+	if !datautil.Contain(KickTokens, keys...) {
+		keys = append(append(make([]string, 0, len(keys)+1), keys...), KickTokens)
+		ctx = context.WithValue(ctx, constant.RpcCustomHeader, keys)
+	}
+	return context.WithValue(ctx, KickTokens, tokens)
+}
+
+// GetKickTokens returns the tokens set by WithKickTokens, nil means every connection of the platform.
+func GetKickTokens(ctx context.Context) []string {
+	tokens, _ := ctx.Value(KickTokens).([]string)
+	return tokens
+}
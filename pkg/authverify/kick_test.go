@@ -0,0 +1,23 @@
+package authverify
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/protocol/constant"
+)
+
+func TestWithKickTokens(t *testing.T) {
+	ctx := context.WithValue(context.Background(), constant.RpcCustomHeader, []string{"tenantID"})
+	ctx = WithKickTokens(ctx, []string{"token1", "token2"})
+	if tokens := GetKickTokens(ctx); len(tokens) != 2 || tokens[0] != "token1" {
+		t.Fatal("unexpected tokens", tokens)
+	}
+	keys, _ := ctx.Value(constant.RpcCustomHeader).([]string)
+	if len(keys) != 2 || keys[0] != "tenantID" || keys[1] != KickTokens {
+		t.Fatal("kick tokens are not forwarded as rpc header", keys)
+	}
+	if GetKickTokens(context.Background()) != nil {
+		t.Fatal("a kick without tokens must close every connection of the platform")
+	}
+}
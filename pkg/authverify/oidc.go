@@ -0,0 +1,314 @@
+package authverify
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+)
+
+const defaultOIDCUserIDClaim = "sub"
+
+// oidcMethods are the algorithms accepted for ID tokens, symmetric ones are never trusted.
+var oidcMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}
+
+// OIDCIdentity is the OpenIM user an ID token maps to.
+type OIDCIdentity struct {
+	Issuer       string
+	UserID       string
+	Nickname     string
+	FaceURL      string
+	AutoRegister bool
+	TenantID     string
+}
+
+// OIDCVerifier validates ID tokens of the configured issuers, their keys are cached locally.
+type OIDCVerifier struct {
+	client    *http.Client
+	cacheTime time.Duration
+	issuers   map[string]*oidcIssuer
+}
+
+type oidcIssuer struct {
+	conf config.OIDCIssuer
+
+	lock       sync.Mutex
+	jwksURL    string
+	keys       map[string]crypto.PublicKey
+	expireTime time.Time
+	lastFetch  time.Time
+	// fetching is closed when the running JWKS fetch is done, nil when none runs.
+	fetching chan struct{}
+}
+
+func NewOIDCVerifier(conf *config.OIDC) *OIDCVerifier {
+	v := &OIDCVerifier{
+		client:    &http.Client{Timeout: time.Second * 10},
+		cacheTime: time.Duration(conf.JWKSCacheTime) * time.Second,
+		issuers:   make(map[string]*oidcIssuer, len(conf.Issuers)),
+	}
+	if v.cacheTime <= 0 {
+		v.cacheTime = time.Hour
+	}
+	for _, issuer := range conf.Issuers {
+		v.issuers[strings.TrimSuffix(issuer.Issuer, "/")] = &oidcIssuer{conf: issuer, jwksURL: issuer.JWKSURL}
+	}
+	return v
+}
+
+// Verify checks the signature, issuer, audience and lifetime of an ID token and maps its claims.
+func (v *OIDCVerifier) Verify(ctx context.Context, idToken string) (*OIDCIdentity, error) {
+	var unverified jwt.MapClaims
+	if _, _, err := jwt.NewParser().ParseUnverified(idToken, &unverified); err != nil {
+		return nil, errs.ErrArgs.WrapMsg("malformed id token", "err", err.Error())
+	}
+	iss, _ := unverified["iss"].(string)
+	issuer, ok := v.issuers[strings.TrimSuffix(iss, "/")]
+	if !ok {
+		return nil, errs.ErrNoPermission.WrapMsg("id token issuer is not trusted", "iss", iss)
+	}
+	var claims jwt.MapClaims
+	_, err := jwt.NewParser(jwt.WithValidMethods(oidcMethods)).ParseWithClaims(idToken, &claims, func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		return v.getKey(ctx, issuer, kid)
+	})
+	if err != nil {
+		return nil, errs.ErrNoPermission.WrapMsg("invalid id token", "iss", iss, "err", err.Error())
+	}
+	// jwt v4 only checks exp when it is present, an ID token without one would never expire.
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return nil, errs.ErrNoPermission.WrapMsg("id token has no expiration", "iss", iss)
+	}
+	if !hasAudience(claims, issuer.conf.Audience) {
+		return nil, errs.ErrNoPermission.WrapMsg("id token audience is not trusted", "iss", iss, "aud", claims["aud"])
+	}
+	userIDClaim := issuer.conf.UserIDClaim
+	if userIDClaim == "" {
+		userIDClaim = defaultOIDCUserIDClaim
+	}
+	subject := claimString(claims, userIDClaim)
+	if subject == "" {
+		return nil, errs.ErrArgs.WrapMsg("id token has no user id claim", "claim", userIDClaim)
+	}
+	return &OIDCIdentity{
+		Issuer:       iss,
+		UserID:       issuer.conf.UserIDPrefix + subject,
+		Nickname:     claimString(claims, issuer.conf.NicknameClaim),
+		FaceURL:      claimString(claims, issuer.conf.FaceURLClaim),
+		AutoRegister: issuer.conf.AutoRegister,
+		TenantID:     issuer.conf.TenantID,
+	}, nil
+}
+
+// getKey returns the key of kid, the JWKS is fetched again when the cache expired or the kid is
+// unknown, at most once per reloadInterval so bogus kids can not flood the issuer. The fetch runs
+// outside the lock, concurrent callers wait for it or keep using the key they already have.
+func (v *OIDCVerifier) getKey(ctx context.Context, issuer *oidcIssuer, kid string) (crypto.PublicKey, error) {
+	for {
+		issuer.lock.Lock()
+		now := time.Now()
+		key, ok := issuer.lookup(kid)
+		if ok && now.Before(issuer.expireTime) {
+			issuer.lock.Unlock()
+			return key, nil
+		}
+		if fetching := issuer.fetching; fetching != nil {
+			issuer.lock.Unlock()
+			if ok {
+				return key, nil
+			}
+			select {
+			case <-fetching:
+				continue
+			case <-ctx.Done():
+				return nil, errs.Wrap(ctx.Err())
+			}
+		}
+		if now.Sub(issuer.lastFetch) < reloadInterval {
+			issuer.lock.Unlock()
+			if ok {
+				return key, nil
+			}
+			return nil, errs.New("unknown id token kid", "kid", kid).Wrap()
+		}
+		done := make(chan struct{})
+		issuer.fetching = done
+		issuer.lastFetch = now
+		jwksURL := issuer.jwksURL
+		issuer.lock.Unlock()
+
+		keys, jwksURL, err := v.fetchKeys(ctx, &issuer.conf, jwksURL)
+
+		issuer.lock.Lock()
+		issuer.fetching = nil
+		close(done)
+		if err == nil {
+			issuer.keys = keys
+			issuer.jwksURL = jwksURL
+			issuer.expireTime = now.Add(v.cacheTime)
+		}
+		newKey, found := issuer.lookup(kid)
+		issuer.lock.Unlock()
+		if err != nil {
+			if ok {
+				// The issuer is unreachable, keep using the keys we have.
+				log.ZWarn(ctx, "fetch oidc jwks failed", err, "issuer", issuer.conf.Issuer)
+				return key, nil
+			}
+			return nil, err
+		}
+		if found {
+			return newKey, nil
+		}
+		return nil, errs.New("unknown id token kid", "kid", kid).Wrap()
+	}
+}
+
+// lookup finds the key of kid, a token without kid is only accepted when the issuer has a single key.
+func (o *oidcIssuer) lookup(kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(o.keys) == 1 {
+		for _, key := range o.keys {
+			return key, true
+		}
+	}
+	key, ok := o.keys[kid]
+	return key, ok
+}
+
+// fetchKeys downloads the signing keys of the issuer, jwksURL is discovered when empty and returned.
+func (v *OIDCVerifier) fetchKeys(ctx context.Context, conf *config.OIDCIssuer, jwksURL string) (map[string]crypto.PublicKey, string, error) {
+	if jwksURL == "" {
+		var discovery struct {
+			JWKSURI string `json:"jwks_uri"`
+		}
+		if err := v.getJSON(ctx, strings.TrimSuffix(conf.Issuer, "/")+"/.well-known/openid-configuration", &discovery); err != nil {
+			return nil, "", err
+		}
+		if discovery.JWKSURI == "" {
+			return nil, "", errs.New("openid configuration has no jwks_uri", "issuer", conf.Issuer).Wrap()
+		}
+		jwksURL = discovery.JWKSURI
+	}
+	var jwks JWKS
+	if err := v.getJSON(ctx, jwksURL, &jwks); err != nil {
+		return nil, "", err
+	}
+	keys := make(map[string]crypto.PublicKey, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.PublicKey()
+		if err != nil {
+			log.ZWarn(ctx, "skip unsupported oidc jwk", err, "issuer", conf.Issuer, "kid", jwk.Kid)
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	return keys, jwksURL, nil
+}
+
+func (v *OIDCVerifier) getJSON(ctx context.Context, url string, value any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return errs.WrapMsg(err, "new request failed", "url", url)
+	}
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return errs.WrapMsg(err, "request failed", "url", url)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errs.New("unexpected status code", "url", url, "status", resp.Status).Wrap()
+	}
+	if err := json.NewDecoder(resp.Body).Decode(value); err != nil {
+		return errs.WrapMsg(err, "decode response failed", "url", url)
+	}
+	return nil
+}
+
+// PublicKey decodes the RSA, EC or Ed25519 public key of the JWK.
+func (j *JWK) PublicKey() (crypto.PublicKey, error) {
+	switch j.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(j.N)
+		if err != nil {
+			return nil, errs.WrapMsg(err, "invalid jwk n")
+		}
+		e, err := base64.RawURLEncoding.DecodeString(j.E)
+		if err != nil {
+			return nil, errs.WrapMsg(err, "invalid jwk e")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch j.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, errs.New("unsupported jwk curve", "crv", j.Crv).Wrap()
+		}
+		x, err := base64.RawURLEncoding.DecodeString(j.X)
+		if err != nil {
+			return nil, errs.WrapMsg(err, "invalid jwk x")
+		}
+		y, err := base64.RawURLEncoding.DecodeString(j.Y)
+		if err != nil {
+			return nil, errs.WrapMsg(err, "invalid jwk y")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	case "OKP":
+		if j.Crv != "Ed25519" {
+			return nil, errs.New("unsupported jwk curve", "crv", j.Crv).Wrap()
+		}
+		x, err := base64.RawURLEncoding.DecodeString(j.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, errs.New("invalid jwk x").Wrap()
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, errs.New("unsupported jwk kty", "kty", j.Kty).Wrap()
+	}
+}
+
+func hasAudience(claims jwt.MapClaims, audience []string) bool {
+	for _, aud := range audience {
+		if claims.VerifyAudience(aud, true) {
+			return true
+		}
+	}
+	return false
+}
+
+func claimString(claims jwt.MapClaims, name string) string {
+	if name == "" {
+		return ""
+	}
+	switch v := claims[name].(type) {
+	case string:
+		return v
+	case float64:
+		return fmt.Sprint(int64(v))
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,162 @@
+package authverify
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+)
+
+func TestOIDCVerifier(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var issuer string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{"issuer": issuer, "jwks_uri": issuer + "/keys"})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(&JWKS{Keys: []*JWK{{
+			Kty: "RSA",
+			Use: "sig",
+			Alg: "RS256",
+			Kid: "stub",
+			N:   base64.RawURLEncoding.EncodeToString(privateKey.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(privateKey.E)).Bytes()),
+		}}})
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	issuer = server.URL
+
+	verifier := NewOIDCVerifier(&config.OIDC{Issuers: []config.OIDCIssuer{{
+		Issuer:        issuer,
+		Audience:      []string{"openim"},
+		UserIDPrefix:  "corp_",
+		NicknameClaim: "name",
+		TenantID:      "corp",
+	}}})
+	sign := func(claims jwt.MapClaims) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		token.Header["kid"] = "stub"
+		s, err := token.SignedString(privateKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+	claims := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":  issuer,
+			"aud":  []string{"openim"},
+			"sub":  "alice",
+			"name": "Alice",
+			"exp":  time.Now().Add(time.Minute).Unix(),
+		}
+	}
+
+	identity, err := verifier.Verify(context.Background(), sign(claims()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if identity.UserID != "corp_alice" || identity.Nickname != "Alice" || identity.TenantID != "corp" {
+		t.Fatal("unexpected identity", identity)
+	}
+
+	wrongAudience := claims()
+	wrongAudience["aud"] = "other"
+	expired := claims()
+	expired["exp"] = time.Now().Add(-time.Minute).Unix()
+	noExpiration := claims()
+	delete(noExpiration, "exp")
+	untrusted := claims()
+	untrusted["iss"] = "https://evil.example.com"
+	for name, c := range map[string]jwt.MapClaims{"audience": wrongAudience, "expired": expired, "noExpiration": noExpiration, "issuer": untrusted} {
+		if _, err := verifier.Verify(context.Background(), sign(c)); err == nil {
+			t.Fatal(name, "id token must be rejected")
+		}
+	}
+	hmac, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims()).SignedString([]byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := verifier.Verify(context.Background(), hmac); err == nil {
+		t.Fatal("hs256 id token must be rejected")
+	}
+}
+
+func TestOIDCVerifierFetchOnce(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fetches atomic.Int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		<-release
+		_ = json.NewEncoder(w).Encode(&JWKS{Keys: []*JWK{{
+			Kty: "RSA",
+			Kid: "stub",
+			N:   base64.RawURLEncoding.EncodeToString(privateKey.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(privateKey.E)).Bytes()),
+		}}})
+	}))
+	defer server.Close()
+
+	verifier := NewOIDCVerifier(&config.OIDC{Issuers: []config.OIDCIssuer{{
+		Issuer:   "https://accounts.example.com",
+		JWKSURL:  server.URL,
+		Audience: []string{"openim"},
+	}}})
+	sign := func(kid string) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"iss": "https://accounts.example.com",
+			"aud": "openim",
+			"sub": "alice",
+			"exp": time.Now().Add(time.Minute).Unix(),
+		})
+		token.Header["kid"] = kid
+		s, err := token.SignedString(privateKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+
+	// Callers arriving while the keys are fetched wait for that fetch instead of starting their own.
+	const callers = 8
+	results := make(chan error, callers)
+	for i := 0; i < callers; i++ {
+		go func() {
+			_, err := verifier.Verify(context.Background(), sign("stub"))
+			results <- err
+		}()
+	}
+	time.Sleep(time.Millisecond * 100)
+	close(release)
+	for i := 0; i < callers; i++ {
+		if err := <-results; err != nil {
+			t.Fatal(err)
+		}
+	}
+	// An unknown kid right after the fetch does not hit the issuer again.
+	if _, err := verifier.Verify(context.Background(), sign("bogus")); err == nil {
+		t.Fatal("unknown kid must be rejected")
+	}
+	if n := fetches.Load(); n != 1 {
+		t.Fatal("jwks fetched", n, "times")
+	}
+}
@@ -62,4 +62,8 @@ const (
 	CallbackBeforeMembersJoinGroupCommand   = "callbackBeforeMembersJoinGroupCommand"
 	CallbackBeforeSetGroupMemberInfoCommand = "callbackBeforeSetGroupMemberInfoCommand"
 	CallbackAfterSetGroupMemberInfoCommand  = "callbackAfterSetGroupMemberInfoCommand"
+	CallbackBeforeUserSuspendCommand        = "callbackBeforeUserSuspendCommand"
+	CallbackAfterUserSuspendCommand         = "callbackAfterUserSuspendCommand"
+	CallbackBeforeSetUserFieldsCommand      = "callbackBeforeSetUserFieldsCommand"
+	CallbackAfterSetUserFieldsCommand       = "callbackAfterSetUserFieldsCommand"
 )
@@ -88,3 +88,51 @@ type CallbackAfterUserRegisterReq struct {
 type CallbackAfterUserRegisterResp struct {
 	CommonCallbackResp
 }
+
+type CallbackBeforeUserSuspendReq struct {
+	CallbackCommand `json:"callbackCommand"`
+	UserID          string `json:"userID"`
+	Reason          string `json:"reason"`
+	HideProfile     bool   `json:"hideProfile"`
+	ExpireTime      int64  `json:"expireTime"`
+}
+
+type CallbackBeforeUserSuspendResp struct {
+	CommonCallbackResp
+	Reason     *string `json:"reason"`
+	ExpireTime *int64  `json:"expireTime"`
+}
+
+type CallbackAfterUserSuspendReq struct {
+	CallbackCommand `json:"callbackCommand"`
+	UserID          string `json:"userID"`
+	Reason          string `json:"reason"`
+	HideProfile     bool   `json:"hideProfile"`
+	ExpireTime      int64  `json:"expireTime"`
+}
+
+type CallbackAfterUserSuspendResp struct {
+	CommonCallbackResp
+}
+
+type CallbackBeforeSetUserFieldsReq struct {
+	CallbackCommand `json:"callbackCommand"`
+	UserID          string `json:"userID"`
+	// Fields are JSON encoded, a null value removes the field.
+	Fields map[string]string `json:"fields"`
+}
+
+type CallbackBeforeSetUserFieldsResp struct {
+	CommonCallbackResp
+	Fields map[string]string `json:"fields"`
+}
+
+type CallbackAfterSetUserFieldsReq struct {
+	CallbackCommand `json:"callbackCommand"`
+	UserID          string            `json:"userID"`
+	Fields          map[string]string `json:"fields"`
+}
+
+type CallbackAfterSetUserFieldsResp struct {
+	CommonCallbackResp
+}
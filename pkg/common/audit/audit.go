@@ -0,0 +1,97 @@
+// Package audit builds the request summaries of the audit logs recorded by the api and the rpc services.
+package audit
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// RequestMaxLen caps the request summary kept in an audit log.
+	RequestMaxLen = 16 << 10
+	// BodyMaxLen caps the request parsed to build the summary.
+	BodyMaxLen = 1 << 20
+	// Redacted replaces the values of secret fields in the recorded requests.
+	Redacted = "***"
+)
+
+// secretFields are the field names, lower case, whose string values are redacted, config
+// data may hold credentials.
+var secretFields = []string{"password", "secret", "token", "accesskey", "appkey", "apikey", "privatekey", "encryptionkey", "credential"}
+
+// Summary returns the object a request acts on and the request with its secrets redacted,
+// a body that is not a JSON object or exceeds the limit is kept as an escaped string.
+func Summary(body []byte) (string, string) {
+	var req map[string]any
+	if len(body) > BodyMaxLen || json.Unmarshal(body, &req) != nil || req == nil {
+		return "", Escape(body)
+	}
+	var target string
+	for _, field := range []string{"configName", "keyID", "userID", "groupID"} {
+		if v, ok := req[field].(string); ok && v != "" {
+			target = v
+			break
+		}
+	}
+	// The config managed by /config/set_config is a JSON document in data.
+	if data, ok := req["data"].(string); ok {
+		var value any
+		if err := json.Unmarshal([]byte(data), &value); err == nil {
+			req["data"] = value
+		} else {
+			req["data"] = Redacted
+		}
+	}
+	summary, err := json.Marshal(Redact(req))
+	if err != nil {
+		return target, ""
+	}
+	if len(summary) > RequestMaxLen {
+		return target, Escape(summary)
+	}
+	return target, string(summary)
+}
+
+// Redact replaces the string values of secret fields at any depth.
+func Redact(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if s, ok := field.(string); ok && s != "" && isSecretField(key) {
+				v[key] = Redacted
+				continue
+			}
+			v[key] = Redact(field)
+		}
+	case []any:
+		for i := range v {
+			v[i] = Redact(v[i])
+		}
+	}
+	return value
+}
+
+func isSecretField(name string) bool {
+	name = strings.ToLower(name)
+	for _, secret := range secretFields {
+		if strings.Contains(name, secret) {
+			return true
+		}
+	}
+	return false
+}
+
+// Escape truncates b and quotes it, so binary or cut off bodies are stored as valid text.
+// The cut is moved back to the start of a character so none is split.
+func Escape(b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+	n := min(len(b), RequestMaxLen)
+	for n < len(b) && n > 0 && !utf8.RuneStart(b[n]) {
+		n--
+	}
+	return strconv.Quote(string(b[:n]))
+}
@@ -0,0 +1,65 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestSummary(t *testing.T) {
+	data, err := json.Marshal(map[string]any{
+		"mongo": map[string]any{"uri": "mongodb://mongo", "password": "pw"},
+		"push":  map[string]any{"getui": map[string]any{"appKey": "ak", "masterSecret": "ms"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := json.Marshal(map[string]any{"configName": "openim-push.yml", "data": string(data), "token": "t"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	target, summary := Summary(body)
+	if target != "openim-push.yml" {
+		t.Fatal("unexpected target", target)
+	}
+	var req struct {
+		Data struct {
+			Mongo map[string]string `json:"mongo"`
+			Push  struct {
+				Getui map[string]string `json:"getui"`
+			} `json:"push"`
+		} `json:"data"`
+		Token string `json:"token"`
+	}
+	if err := json.Unmarshal([]byte(summary), &req); err != nil {
+		t.Fatal(summary, err)
+	}
+	if req.Data.Mongo["uri"] != "mongodb://mongo" {
+		t.Fatal("data must be recorded", summary)
+	}
+	for _, v := range []string{req.Data.Mongo["password"], req.Data.Push.Getui["appKey"], req.Data.Push.Getui["masterSecret"], req.Token} {
+		if v != Redacted {
+			t.Fatal("secret must be redacted", summary)
+		}
+	}
+
+	// A body that is not JSON is truncated and escaped.
+	raw := append([]byte{0xff, '"', '\n'}, bytes.Repeat([]byte("a"), RequestMaxLen)...)
+	if _, summary := Summary(raw); summary != strconv.Quote(string(raw[:RequestMaxLen])) {
+		t.Fatal("unexpected summary", summary[:16])
+	}
+	if _, summary := Summary(nil); summary != "" {
+		t.Fatal("unexpected summary", summary)
+	}
+}
+
+func TestEscapeKeepsCharacters(t *testing.T) {
+	// The limit falls inside the last character, which is left out whole.
+	b := append(bytes.Repeat([]byte("a"), RequestMaxLen-1), []byte("é")...)
+	unquoted, err := strconv.Unquote(Escape(b))
+	if err != nil || !utf8.ValidString(unquoted) || len(unquoted) != RequestMaxLen-1 {
+		t.Fatal("character split by the escape", err, len(unquoted))
+	}
+}
@@ -38,6 +38,7 @@ func NewAuthRpcCmd() *AuthRpcCmd {
 	ret.configMap = map[string]any{
 		config.OpenIMRPCAuthCfgFileName: &authConfig.RpcConfig,
 		config.RedisConfigFileName:      &authConfig.RedisConfig,
+		config.MongodbConfigFileName:    &authConfig.MongodbConfig,
 		config.ShareFileName:            &authConfig.Share,
 		config.DiscoveryConfigFilename:  &authConfig.Discovery,
 	}
@@ -62,6 +63,7 @@ func (a *AuthRpcCmd) runE() error {
 			a.authConfig.RpcConfig.GetConfigFileName(),
 			a.authConfig.Share.GetConfigFileName(),
 			a.authConfig.RedisConfig.GetConfigFileName(),
+			a.authConfig.MongodbConfig.GetConfigFileName(),
 			a.authConfig.Discovery.GetConfigFileName(),
 		},
 		[]string{
@@ -38,6 +38,7 @@ func NewCronTaskCmd() *CronTaskCmd {
 		config.OpenIMCronTaskCfgFileName: &cronTaskConfig.CronTask,
 		config.ShareFileName:             &cronTaskConfig.Share,
 		config.DiscoveryConfigFilename:   &cronTaskConfig.Discovery,
+		config.MongodbConfigFileName:     &cronTaskConfig.MongodbConfig,
 	}
 	ret.RootCmd = NewRootCmd(program.GetProcessName(), WithConfigMap(ret.configMap))
 	ret.ctx = context.WithValue(context.Background(), "version", version.Version)
@@ -39,6 +39,7 @@ func NewMsgGatewayCmd() *MsgGatewayCmd {
 		config.OpenIMMsgGatewayCfgFileName: &msgGatewayConfig.MsgGateway,
 		config.ShareFileName:               &msgGatewayConfig.Share,
 		config.RedisConfigFileName:         &msgGatewayConfig.RedisConfig,
+		config.MongodbConfigFileName:       &msgGatewayConfig.MongodbConfig,
 		config.WebhooksConfigFileName:      &msgGatewayConfig.WebhooksConfig,
 		config.DiscoveryConfigFilename:     &msgGatewayConfig.Discovery,
 	}
@@ -38,6 +38,7 @@ func NewPushRpcCmd() *PushRpcCmd {
 	ret.configMap = map[string]any{
 		config.OpenIMPushCfgFileName:    &pushConfig.RpcConfig,
 		config.RedisConfigFileName:      &pushConfig.RedisConfig,
+		config.MongodbConfigFileName:    &pushConfig.MongodbConfig,
 		config.KafkaConfigFileName:      &pushConfig.KafkaConfig,
 		config.ShareFileName:            &pushConfig.Share,
 		config.NotificationFileName:     &pushConfig.NotificationConfig,
@@ -65,6 +66,7 @@ func (a *PushRpcCmd) runE() error {
 		[]string{
 			a.pushConfig.RpcConfig.GetConfigFileName(),
 			a.pushConfig.RedisConfig.GetConfigFileName(),
+			a.pushConfig.MongodbConfig.GetConfigFileName(),
 			a.pushConfig.KafkaConfig.GetConfigFileName(),
 			a.pushConfig.NotificationConfig.GetConfigFileName(),
 			a.pushConfig.Share.GetConfigFileName(),
@@ -15,11 +15,14 @@
 package config
 
 import (
+	"encoding/base64"
 	"strings"
 	"time"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/tools/db/mongoutil"
 	"github.com/openimsdk/tools/db/redisutil"
+	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/mq/kafka"
 	"github.com/openimsdk/tools/s3/aws"
 	"github.com/openimsdk/tools/s3/cos"
@@ -88,6 +91,9 @@ type Kafka struct {
 	ToMongoGroupID     string   `mapstructure:"toMongoGroupID"`
 	ToPushGroupID      string   `mapstructure:"toPushGroupID"`
 	ToOfflineGroupID   string   `mapstructure:"toOfflinePushGroupID"`
+	// ToOfflinePushRetryTopic prefixes the topics holding the failed offline pushes until they are retried, one per retry delay.
+	ToOfflinePushRetryTopic   string `mapstructure:"toOfflinePushRetryTopic"`
+	ToOfflinePushRetryGroupID string `mapstructure:"toOfflinePushRetryGroupID"`
 
 	Tls TLSConfig `mapstructure:"tls"`
 }
@@ -105,6 +111,8 @@ type API struct {
 		ListenIP         string `mapstructure:"listenIP"`
 		Ports            []int  `mapstructure:"ports"`
 		CompressionLevel int    `mapstructure:"compressionLevel"`
+		// TrustedProxies are the IPs or CIDRs X-Forwarded-For is read from, empty uses the peer address.
+		TrustedProxies []string `mapstructure:"trustedProxies"`
 	} `mapstructure:"api"`
 	Prometheus struct {
 		Enable       bool   `mapstructure:"enable"`
@@ -112,6 +120,99 @@ type API struct {
 		Ports        []int  `mapstructure:"ports"`
 		GrafanaURL   string `mapstructure:"grafanaURL"`
 	} `mapstructure:"prometheus"`
+	OIDC      OIDC      `mapstructure:"oidc"`
+	RateLimit RateLimit `mapstructure:"rateLimit"`
+}
+
+// Validate checks the OIDC issuers and the rate limits.
+func (a *API) Validate() error {
+	if err := a.OIDC.Validate(); err != nil {
+		return err
+	}
+	return a.RateLimit.Validate()
+}
+
+type RateLimit struct {
+	Enable bool `mapstructure:"enable"`
+	// ExemptAdmin lets the app admins through, requests made with an API key are still limited.
+	ExemptAdmin bool `mapstructure:"exemptAdmin"`
+	// Default applies to the routes without an override, a zero limit leaves them unlimited.
+	Default RateLimitRule    `mapstructure:"default"`
+	Routes  []RateLimitRoute `mapstructure:"routes"`
+}
+
+type RateLimitRule struct {
+	// Key is what requests are counted by: user, ip, route or apiKey.
+	Key   string `mapstructure:"key"`
+	Limit int64  `mapstructure:"limit"`
+	// Window is the period the limit applies to, in seconds.
+	Window int64 `mapstructure:"window"`
+}
+
+// Validate rejects limits finer than the microsecond the limiter counts in.
+func (r *RateLimit) Validate() error {
+	rules := []*RateLimitRule{&r.Default}
+	for i := range r.Routes {
+		rules = append(rules, &r.Routes[i].RateLimitRule)
+	}
+	for _, rule := range rules {
+		switch rule.Key {
+		case "", "user", "ip", "route", "apiKey":
+		default:
+			return errs.ErrArgs.WrapMsg("unknown rate limit key", "key", rule.Key)
+		}
+		if rule.Limit < 0 || rule.Window < 0 {
+			return errs.ErrArgs.WrapMsg("rate limit must not be negative", "limit", rule.Limit, "window", rule.Window)
+		}
+		if rule.Window > 0 && rule.Limit > rule.Window*int64(time.Second/time.Microsecond) {
+			return errs.ErrArgs.WrapMsg("rate limit exceeds one request per microsecond", "limit", rule.Limit, "window", rule.Window)
+		}
+	}
+	return nil
+}
+
+type RateLimitRoute struct {
+	Path          string `mapstructure:"path"`
+	RateLimitRule `mapstructure:",squash"`
+}
+
+type OIDC struct {
+	// JWKSCacheTime is how long the keys of an issuer are cached, in seconds.
+	JWKSCacheTime int64        `mapstructure:"jwksCacheTime"`
+	Issuers       []OIDCIssuer `mapstructure:"issuers"`
+}
+
+type OIDCIssuer struct {
+	Issuer string `mapstructure:"issuer"`
+	// JWKSURL is discovered from the issuer's openid-configuration when empty.
+	JWKSURL       string   `mapstructure:"jwksURL"`
+	Audience      []string `mapstructure:"audience"`
+	UserIDClaim   string   `mapstructure:"userIDClaim"`
+	UserIDPrefix  string   `mapstructure:"userIDPrefix"`
+	NicknameClaim string   `mapstructure:"nicknameClaim"`
+	FaceURLClaim  string   `mapstructure:"faceURLClaim"`
+	AutoRegister  bool     `mapstructure:"autoRegister"`
+	// TenantID is the tenant the users of the issuer belong to, empty is the default tenant.
+	TenantID string `mapstructure:"tenantID"`
+}
+
+// Validate requires an audience per issuer, otherwise an ID token issued to any client of the
+// issuer could be exchanged.
+func (o *OIDC) Validate() error {
+	for _, issuer := range o.Issuers {
+		if issuer.Issuer == "" {
+			return errs.ErrArgs.WrapMsg("oidc issuer is empty")
+		}
+		if len(issuer.Audience) == 0 {
+			return errs.ErrArgs.WrapMsg("oidc issuer has no audience", "issuer", issuer.Issuer)
+		}
+		if issuer.TenantID != "" {
+			if err := tenant.CheckTenantID(issuer.TenantID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 type CronTask struct {
@@ -119,6 +220,7 @@ type CronTask struct {
 	RetainChatRecords int      `mapstructure:"retainChatRecords"`
 	FileExpireTime    int      `mapstructure:"fileExpireTime"`
 	DeleteObjectType  []string `mapstructure:"deleteObjectType"`
+	RetainAuditLogs   int      `mapstructure:"retainAuditLogs"`
 }
 
 type OfflinePushConfig struct {
@@ -189,6 +291,8 @@ type MsgGateway struct {
 		WebsocketMaxConnNum int   `mapstructure:"websocketMaxConnNum"`
 		WebsocketMaxMsgLen  int   `mapstructure:"websocketMaxMsgLen"`
 		WebsocketTimeout    int   `mapstructure:"websocketTimeout"`
+		// TrustedProxies are the IPs or CIDRs whose X-Forwarded-For header is believed.
+		TrustedProxies []string `mapstructure:"trustedProxies"`
 	} `mapstructure:"longConnSvr"`
 }
 
@@ -209,8 +313,37 @@ type Push struct {
 	} `mapstructure:"rpc"`
 	Prometheus           Prometheus `mapstructure:"prometheus"`
 	MaxConcurrentWorkers int        `mapstructure:"maxConcurrentWorkers"`
-	Enable               string     `mapstructure:"enable"`
-	GeTui                struct {
+	PushProviders        `mapstructure:",squash"`
+	// Tenants replace the providers and their credentials for the pushes of their users.
+	Tenants []PushTenant `mapstructure:"tenants"`
+	Retry   struct {
+		// MaxAttempts is how many times a push is tried before it is kept as a dead letter, 1 disables the retries.
+		MaxAttempts int `mapstructure:"maxAttempts"`
+		// Interval is the delay of the first retry in seconds, doubled on each retry up to MaxInterval.
+		Interval    int `mapstructure:"interval"`
+		MaxInterval int `mapstructure:"maxInterval"`
+		// DeadLetterRetention is how many days the dead letters are kept, 30 when it is not set.
+		DeadLetterRetention int `mapstructure:"deadLetterRetention"`
+	} `mapstructure:"retry"`
+	Template struct {
+		// DefaultLocale is the locale of the templates used when the user has no template in their locale.
+		DefaultLocale string `mapstructure:"defaultLocale"`
+		// ProfileLocaleField is the custom profile field holding the locale of the users without a locale on their devices.
+		ProfileLocaleField string `mapstructure:"profileLocaleField"`
+	} `mapstructure:"template"`
+	IOSPush struct {
+		PushSound string `mapstructure:"pushSound"`
+		// BadgeCount pushes the unread count computed from the seqs as the app badge of the apns and fcm pushes.
+		BadgeCount bool `mapstructure:"badgeCount"`
+		Production bool `mapstructure:"production"`
+	} `mapstructure:"iosPush"`
+	FullUserCache bool `mapstructure:"fullUserCache"`
+}
+
+// PushProviders are the offline push providers and their credentials.
+type PushProviders struct {
+	Enable string `mapstructure:"enable"`
+	GeTui  struct {
 		PushUrl      string `mapstructure:"pushUrl"`
 		MasterSecret string `mapstructure:"masterSecret"`
 		AppKey       string `mapstructure:"appKey"`
@@ -228,12 +361,97 @@ type Push struct {
 		PushURL      string `mapstructure:"pushURL"`
 		PushIntent   string `mapstructure:"pushIntent"`
 	} `mapstructure:"jpush"`
-	IOSPush struct {
-		PushSound  string `mapstructure:"pushSound"`
-		BadgeCount bool   `mapstructure:"badgeCount"`
-		Production bool   `mapstructure:"production"`
-	} `mapstructure:"iosPush"`
-	FullUserCache bool `mapstructure:"fullUserCache"`
+	APNs struct {
+		// KeyFilePath is the .p8 token signing key, joined with the config directory.
+		KeyFilePath string `mapstructure:"keyFilePath"`
+		KeyID       string `mapstructure:"keyID"`
+		TeamID      string `mapstructure:"teamID"`
+		BundleID    string `mapstructure:"bundleID"`
+	} `mapstructure:"apns"`
+	Vendor struct {
+		Huawei struct {
+			AppID        string `mapstructure:"appID"`
+			ClientSecret string `mapstructure:"clientSecret"`
+		} `mapstructure:"huawei"`
+		Honor struct {
+			AppID        string `mapstructure:"appID"`
+			ClientID     string `mapstructure:"clientID"`
+			ClientSecret string `mapstructure:"clientSecret"`
+		} `mapstructure:"honor"`
+		Xiaomi struct {
+			AppSecret   string `mapstructure:"appSecret"`
+			PackageName string `mapstructure:"packageName"`
+			ChannelID   string `mapstructure:"channelID"`
+		} `mapstructure:"xiaomi"`
+		OPPO struct {
+			AppKey       string `mapstructure:"appKey"`
+			MasterSecret string `mapstructure:"masterSecret"`
+			ChannelID    string `mapstructure:"channelID"`
+		} `mapstructure:"oppo"`
+		Vivo struct {
+			AppID     string `mapstructure:"appID"`
+			AppKey    string `mapstructure:"appKey"`
+			AppSecret string `mapstructure:"appSecret"`
+			// Production sends to the devices, otherwise vivo only delivers to its test devices.
+			Production bool `mapstructure:"production"`
+		} `mapstructure:"vivo"`
+	} `mapstructure:"vendor"`
+	WebPush struct {
+		// PrivateKey is the base64url encoded P-256 VAPID private key, browsers subscribe with its public key.
+		PrivateKey string `mapstructure:"privateKey"`
+		// Subject is the mailto: or https: contact of the application server.
+		Subject string `mapstructure:"subject"`
+		// TTL is how many seconds the push service keeps a message for an offline browser.
+		TTL int `mapstructure:"ttl"`
+	} `mapstructure:"webPush"`
+	HTTP struct {
+		// URL receives the offline pushes of the in-house push service.
+		URL string `mapstructure:"url"`
+		// Secret signs the requests with HMAC-SHA256, the signature is not sent when it is empty.
+		Secret    string `mapstructure:"secret"`
+		BatchSize int    `mapstructure:"batchSize"`
+		// MaxRetry is how many times a request failing temporarily is retried, 0 disables the retries and 3 are made when it is not set.
+		MaxRetry *int `mapstructure:"maxRetry"`
+		// Timeout of a request in seconds.
+		Timeout int `mapstructure:"timeout"`
+	} `mapstructure:"http"`
+	Router PushRouter `mapstructure:"router"`
+}
+
+// PushTenant overrides the push providers of a tenant, none of the shared ones is inherited.
+type PushTenant struct {
+	ID            string `mapstructure:"id"`
+	PushProviders `mapstructure:",squash"`
+}
+
+// PushRouter names the provider pushing to each class of devices, the classes left empty are not pushed to.
+type PushRouter struct {
+	IOS     string `mapstructure:"ios"`
+	Android string `mapstructure:"android"`
+	// AndroidVendor pushes to the Android users who registered a vendor device, instead of Android.
+	AndroidVendor string `mapstructure:"androidVendor"`
+	Web           string `mapstructure:"web"`
+	// Fallback names the provider pushing again to the users of a route whose provider failed.
+	Fallback map[string]string `mapstructure:"fallback"`
+}
+
+// DeadLetterRetention returns how long the dead letters of the failed offline pushes are kept.
+func (p *Push) DeadLetterRetention() time.Duration {
+	days := p.Retry.DeadLetterRetention
+	if days <= 0 {
+		days = 30
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// GetProviders returns the push providers of the tenant, the shared ones unless the tenant has its own.
+func (p *Push) GetProviders(tenantID string) *PushProviders {
+	for i := range p.Tenants {
+		if p.Tenants[i].ID == tenantID {
+			return &p.Tenants[i].PushProviders
+		}
+	}
+	return &p.PushProviders
 }
 
 type Auth struct {
@@ -243,10 +461,86 @@ type Auth struct {
 		AutoSetPorts bool   `mapstructure:"autoSetPorts"`
 		Ports        []int  `mapstructure:"ports"`
 	} `mapstructure:"rpc"`
-	Prometheus  Prometheus `mapstructure:"prometheus"`
-	TokenPolicy struct {
-		Expire int64 `mapstructure:"expire"`
-	} `mapstructure:"tokenPolicy"`
+	Prometheus  Prometheus  `mapstructure:"prometheus"`
+	TokenPolicy TokenPolicy `mapstructure:"tokenPolicy"`
+}
+
+type TokenPolicy struct {
+	Expire       int64  `mapstructure:"expire"`
+	AccessExpire int64  `mapstructure:"accessExpire"`
+	Algorithm    string `mapstructure:"algorithm"`
+	KeyRotation  int64  `mapstructure:"keyRotation"`
+	// LegacySecretUntil is the RFC 3339 time until which tokens signed with share.secret are still
+	// accepted after switching to RS256 or EdDSA, empty rejects them right away.
+	LegacySecretUntil string `mapstructure:"legacySecretUntil"`
+	// KeyEncryptionKey is the base64 AES-256 key the RS256 and EdDSA private keys are stored encrypted with.
+	KeyEncryptionKey string `mapstructure:"keyEncryptionKey"`
+}
+
+func (a *Auth) Validate() error {
+	return a.TokenPolicy.Validate()
+}
+
+// Validate checks the format of the token policy. The key encryption key is only required by
+// the auth rpc, see SigningKEK, the other services verify the tokens through it.
+func (t *TokenPolicy) Validate() error {
+	if _, err := t.LegacySecretDeadline(); err != nil {
+		return err
+	}
+	_, err := t.KEK()
+	return err
+}
+
+// SigningKEK returns the key encryption key, which is required by the asymmetric algorithms.
+func (t *TokenPolicy) SigningKEK() ([]byte, error) {
+	kek, err := t.KEK()
+	if err != nil {
+		return nil, err
+	}
+	if kek == nil && t.Algorithm != "" && t.Algorithm != "HS256" {
+		return nil, errs.ErrArgs.WrapMsg("tokenPolicy.keyEncryptionKey is required by " + t.Algorithm)
+	}
+	return kek, nil
+}
+
+// LegacySecretDeadline parses LegacySecretUntil, it is the zero time when unset.
+func (t *TokenPolicy) LegacySecretDeadline() (time.Time, error) {
+	if t.LegacySecretUntil == "" {
+		return time.Time{}, nil
+	}
+	deadline, err := time.Parse(time.RFC3339, t.LegacySecretUntil)
+	if err != nil {
+		return time.Time{}, errs.ErrArgs.WrapMsg("tokenPolicy.legacySecretUntil must be a RFC 3339 time", "value", t.LegacySecretUntil)
+	}
+	return deadline, nil
+}
+
+// KEK decodes KeyEncryptionKey, it is nil when unset.
+func (t *TokenPolicy) KEK() ([]byte, error) {
+	if t.KeyEncryptionKey == "" {
+		return nil, nil
+	}
+	kek, err := base64.StdEncoding.DecodeString(t.KeyEncryptionKey)
+	if err != nil || len(kek) != 32 {
+		return nil, errs.ErrArgs.WrapMsg("tokenPolicy.keyEncryptionKey must be 32 base64 encoded bytes")
+	}
+	return kek, nil
+}
+
+// AccessTTL is the lifetime of the tokens used to call the APIs.
+func (t *TokenPolicy) AccessTTL() time.Duration {
+	if t.AccessExpire > 0 {
+		return time.Duration(t.AccessExpire) * time.Minute
+	}
+	return time.Duration(t.Expire) * time.Hour * 24
+}
+
+// RefreshTTL is the lifetime of refresh tokens, it is 0 when refresh tokens are disabled.
+func (t *TokenPolicy) RefreshTTL() time.Duration {
+	if t.AccessExpire > 0 {
+		return time.Duration(t.Expire) * time.Hour * 24
+	}
+	return 0
 }
 
 type Conversation struct {
@@ -383,6 +677,54 @@ type Share struct {
 	Secret        string     `mapstructure:"secret"`
 	IMAdminUserID []string   `mapstructure:"imAdminUserID"`
 	MultiLogin    MultiLogin `mapstructure:"multiLogin"`
+	Tenants       []Tenant   `mapstructure:"tenants"`
+}
+
+type Tenant struct {
+	ID         string      `mapstructure:"id"`
+	MultiLogin *MultiLogin `mapstructure:"multiLogin"`
+}
+
+// TenantIDs returns the IDs of the configured tenants, without the default tenant.
+func (s *Share) TenantIDs() []string {
+	ids := make([]string, 0, len(s.Tenants))
+	for _, t := range s.Tenants {
+		ids = append(ids, t.ID)
+	}
+	return ids
+}
+
+// GetTenant returns the configured tenant, or nil if it does not exist.
+func (s *Share) GetTenant(tenantID string) *Tenant {
+	for i := range s.Tenants {
+		if s.Tenants[i].ID == tenantID {
+			return &s.Tenants[i]
+		}
+	}
+	return nil
+}
+
+// GetMultiLogin returns the multi login policy of the tenant, the shared one unless the tenant overrides it.
+func (s *Share) GetMultiLogin(tenantID string) MultiLogin {
+	if t := s.GetTenant(tenantID); t != nil && t.MultiLogin != nil {
+		return *t.MultiLogin
+	}
+	return s.MultiLogin
+}
+
+// Validate makes sure the tenant IDs can scope keys and collections and are unique.
+func (s *Share) Validate() error {
+	ids := make(map[string]struct{}, len(s.Tenants))
+	for _, t := range s.Tenants {
+		if err := tenant.CheckTenantID(t.ID); err != nil {
+			return err
+		}
+		if _, ok := ids[t.ID]; ok {
+			return errs.ErrArgs.WrapMsg("duplicate tenant", "tenantID", t.ID)
+		}
+		ids[t.ID] = struct{}{}
+	}
+	return nil
 }
 
 type MultiLogin struct {
@@ -467,6 +809,18 @@ type Webhooks struct {
 	BeforeImportFriends      BeforeConfig `mapstructure:"beforeImportFriends"`
 	AfterImportFriends       AfterConfig  `mapstructure:"afterImportFriends"`
 	AfterRemoveBlack         AfterConfig  `mapstructure:"afterRemoveBlack"`
+	BeforeUserSuspend        BeforeConfig `mapstructure:"beforeUserSuspend"`
+	AfterUserSuspend         AfterConfig  `mapstructure:"afterUserSuspend"`
+	BeforeSetUserFields      BeforeConfig `mapstructure:"beforeSetUserFields"`
+	AfterSetUserFields       AfterConfig  `mapstructure:"afterSetUserFields"`
+
+	// Tenants overrides the url per tenant.
+	Tenants []WebhookTenant `mapstructure:"tenants"`
+}
+
+type WebhookTenant struct {
+	ID  string `mapstructure:"id"`
+	URL string `mapstructure:"url"`
 }
 
 type ZooKeeper struct {
@@ -481,6 +835,72 @@ type Discovery struct {
 	Etcd       Etcd       `mapstructure:"etcd"`
 	Kubernetes Kubernetes `mapstructure:"kubernetes"`
 	RpcService RpcService `mapstructure:"rpcService"`
+	TLS        RpcTLS     `mapstructure:"tls"`
+}
+
+// Validate checks the rpc TLS settings.
+func (d *Discovery) Validate() error {
+	return d.TLS.Validate()
+}
+
+// RpcTLS secures the connections between the rpc services with mutual TLS.
+type RpcTLS struct {
+	Enable bool   `mapstructure:"enable"`
+	CAFile string `mapstructure:"caFile"`
+	// CertFile and KeyFile are used by the services and components without an entry in Certs.
+	CertFile string    `mapstructure:"certFile"`
+	KeyFile  string    `mapstructure:"keyFile"`
+	Certs    []RpcCert `mapstructure:"certs"`
+	// ServerName must be a SAN of every server certificate, the services are dialed by ip.
+	ServerName string `mapstructure:"serverName"`
+	// ReloadInterval is how often the files are checked for changes, in seconds.
+	ReloadInterval int64               `mapstructure:"reloadInterval"`
+	AllowedClients []RpcAllowedClients `mapstructure:"allowedClients"`
+}
+
+// RpcCert is the certificate of one rpc service, by its register name, or of a component that only
+// calls them: openim-api, openim-msgtransfer or openim-crontask.
+type RpcCert struct {
+	Name     string `mapstructure:"name"`
+	CertFile string `mapstructure:"certFile"`
+	KeyFile  string `mapstructure:"keyFile"`
+}
+
+// Validate requires a server name, without it any certificate signed by the CA, a client
+// certificate included, could serve any rpc service.
+func (t *RpcTLS) Validate() error {
+	if !t.Enable {
+		return nil
+	}
+	if t.CAFile == "" {
+		return errs.ErrArgs.WrapMsg("tls.caFile is required")
+	}
+	if t.ServerName == "" {
+		return errs.ErrArgs.WrapMsg("tls.serverName is required")
+	}
+	for _, cert := range t.Certs {
+		if cert.Name == "" || cert.CertFile == "" || cert.KeyFile == "" {
+			return errs.ErrArgs.WrapMsg("tls.certs needs a name, certFile and keyFile", "name", cert.Name)
+		}
+	}
+	return nil
+}
+
+// CertOf returns the certificate and key files of the service or component name.
+func (t *RpcTLS) CertOf(name string) (string, string) {
+	for _, cert := range t.Certs {
+		if cert.Name == name {
+			return cert.CertFile, cert.KeyFile
+		}
+	}
+	return t.CertFile, t.KeyFile
+}
+
+// RpcAllowedClients lists the certificate identities that may call a service,
+// a service without an entry accepts every certificate signed by the CA.
+type RpcAllowedClients struct {
+	Service string   `mapstructure:"service"`
+	Clients []string `mapstructure:"clients"`
 }
 
 type Kubernetes struct {
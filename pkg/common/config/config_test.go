@@ -0,0 +1,96 @@
+package config
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+)
+
+func TestTokenPolicyValidate(t *testing.T) {
+	kek := base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", 32)))
+	for _, c := range []struct {
+		policy TokenPolicy
+		valid  bool
+	}{
+		{TokenPolicy{Algorithm: "HS256"}, true},
+		// The services other than the auth rpc run without the key encryption key.
+		{TokenPolicy{Algorithm: "RS256"}, true},
+		{TokenPolicy{Algorithm: "RS256", KeyEncryptionKey: "short"}, false},
+		{TokenPolicy{Algorithm: "EdDSA", KeyEncryptionKey: kek}, true},
+		{TokenPolicy{Algorithm: "EdDSA", KeyEncryptionKey: kek, LegacySecretUntil: "2026-01-02T15:04:05Z"}, true},
+		{TokenPolicy{Algorithm: "EdDSA", KeyEncryptionKey: kek, LegacySecretUntil: "tomorrow"}, false},
+	} {
+		if err := c.policy.Validate(); (err == nil) != c.valid {
+			t.Fatal("unexpected result", c.policy.Algorithm, c.policy.KeyEncryptionKey, c.policy.LegacySecretUntil, err)
+		}
+	}
+}
+
+func TestTokenPolicySigningKEK(t *testing.T) {
+	kek := base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", 32)))
+	for _, c := range []struct {
+		policy TokenPolicy
+		valid  bool
+	}{
+		{TokenPolicy{Algorithm: "HS256"}, true},
+		{TokenPolicy{Algorithm: "RS256"}, false},
+		{TokenPolicy{Algorithm: "RS256", KeyEncryptionKey: "short"}, false},
+		{TokenPolicy{Algorithm: "EdDSA", KeyEncryptionKey: kek}, true},
+	} {
+		if _, err := c.policy.SigningKEK(); (err == nil) != c.valid {
+			t.Fatal("unexpected result", c.policy.Algorithm, c.policy.KeyEncryptionKey, err)
+		}
+	}
+}
+
+func TestOIDCValidate(t *testing.T) {
+	for _, c := range []struct {
+		issuer OIDCIssuer
+		valid  bool
+	}{
+		{OIDCIssuer{Issuer: "https://accounts.example.com", Audience: []string{"openim"}}, true},
+		{OIDCIssuer{Issuer: "https://accounts.example.com"}, false},
+		{OIDCIssuer{Audience: []string{"openim"}}, false},
+		{OIDCIssuer{Issuer: "https://accounts.example.com", Audience: []string{"openim"}, TenantID: "corp"}, true},
+		{OIDCIssuer{Issuer: "https://accounts.example.com", Audience: []string{"openim"}, TenantID: "corp:a"}, false},
+	} {
+		oidc := OIDC{Issuers: []OIDCIssuer{c.issuer}}
+		if err := oidc.Validate(); (err == nil) != c.valid {
+			t.Fatal("unexpected result", c.issuer, err)
+		}
+	}
+}
+
+func TestRateLimitValidate(t *testing.T) {
+	for _, c := range []struct {
+		rule  RateLimitRule
+		valid bool
+	}{
+		{RateLimitRule{Key: "user", Limit: 100, Window: 1}, true},
+		{RateLimitRule{}, true},
+		{RateLimitRule{Key: "ip", Limit: 1_000_000, Window: 1}, true},
+		{RateLimitRule{Key: "ip", Limit: 1_000_001, Window: 1}, false},
+		{RateLimitRule{Key: "user", Limit: -1, Window: 1}, false},
+		{RateLimitRule{Key: "session", Limit: 1, Window: 1}, false},
+	} {
+		rateLimit := RateLimit{Routes: []RateLimitRoute{{Path: "/msg/send_msg", RateLimitRule: c.rule}}}
+		if err := rateLimit.Validate(); (err == nil) != c.valid {
+			t.Fatal("unexpected result", c.rule, err)
+		}
+	}
+}
+
+func TestGetMultiLogin(t *testing.T) {
+	share := Share{
+		MultiLogin: MultiLogin{Policy: 1, MaxNumOneEnd: 30},
+		Tenants: []Tenant{
+			{ID: "t1", MultiLogin: &MultiLogin{Policy: 4, MaxNumOneEnd: 1}},
+			{ID: "t2"},
+		},
+	}
+	for tenantID, policy := range map[string]int{"": 1, "t1": 4, "t2": 1, "unknown": 1} {
+		if got := share.GetMultiLogin(tenantID).Policy; got != policy {
+			t.Error("unexpected policy", tenantID, got, policy)
+		}
+	}
+}
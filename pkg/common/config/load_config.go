@@ -39,5 +39,15 @@ func loadConfig(path string, envPrefix string, config any) error {
 	}); err != nil {
 		return errs.WrapMsg(err, "failed to unmarshal config", "path", path, "envPrefix", envPrefix)
 	}
+	if v, ok := config.(validator); ok {
+		if err := v.Validate(); err != nil {
+			return errs.WrapMsg(err, "invalid config", "path", path)
+		}
+	}
 	return nil
 }
+
+// validator is implemented by the configs that check their values once loaded.
+type validator interface {
+	Validate() error
+}
@@ -15,6 +15,7 @@
 package convert
 
 import (
+	"github.com/openimsdk/open-im-server/v3/pkg/apistruct"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
 	"github.com/openimsdk/protocol/conversation"
 	"github.com/openimsdk/tools/utils/datautil"
@@ -59,3 +60,12 @@ func ConversationsPb2DB(conversationsPB []*conversation.Conversation) (conversat
 	}
 	return conversationsDB
 }
+
+func ConversationDraftDB2Api(draft *model.ConversationDraft) *apistruct.ConversationDraft {
+	return &apistruct.ConversationDraft{
+		ConversationID:   draft.ConversationID,
+		Text:             draft.Text,
+		ReplyClientMsgID: draft.ReplyClientMsgID,
+		UpdateTime:       draft.UpdateTime.UnixMilli(),
+	}
+}
@@ -21,7 +21,7 @@ import (
 	"github.com/openimsdk/tools/discovery"
 	"google.golang.org/grpc"
 
-	"github.com/openimsdk/tools/discovery/kubernetes"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/discovery/kubernetes"
 
 	"github.com/openimsdk/tools/discovery/etcd"
 	"github.com/openimsdk/tools/errs"
@@ -30,6 +30,7 @@ import (
 // NewDiscoveryRegister creates a new service discovery and registry client based on the provided environment type.
 func NewDiscoveryRegister(discovery *config.Discovery, runtimeEnv string, watchNames []string) (discovery.SvcDiscoveryRegistry, error) {
 	if runtimeEnv == config.KUBERNETES {
+		// The local connection manager, unlike the one of tools, dials with the credentials added by AddOption.
 		return kubernetes.NewKubernetesConnManager(discovery.Kubernetes.Namespace,
 			grpc.WithDefaultCallOptions(
 				grpc.MaxCallSendMsgSize(1024*1024*20),
@@ -54,7 +54,20 @@ func NewKubernetesConnManager(namespace string, options ...grpc.DialOption) (*Ku
 	return k, nil
 }
 
-func (k *KubernetesConnManager) initializeConns(serviceName string) error {
+// dialOpts puts the options of the manager and the call after the defaults, so the transport
+// credentials added with AddOption replace the plaintext default.
+func (k *KubernetesConnManager) dialOpts(opts ...grpc.DialOption) []grpc.DialOption {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	dialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(1024*1024*10), grpc.MaxCallSendMsgSize(1024*1024*20)),
+	}
+	dialOpts = append(dialOpts, k.dialOptions...)
+	return append(dialOpts, opts...)
+}
+
+func (k *KubernetesConnManager) initializeConns(serviceName string, opts ...grpc.DialOption) error {
 	port, err := k.getServicePort(serviceName)
 	if err != nil {
 		return err
@@ -72,7 +85,7 @@ func (k *KubernetesConnManager) initializeConns(serviceName string) error {
 		for _, address := range subset.Addresses {
 			target := fmt.Sprintf("%s:%d", address.IP, port)
 			// fmt.Println("IP target:", target)
-			conn, err := grpc.Dial(target, append(k.dialOptions, grpc.WithTransportCredentials(insecure.NewCredentials()))...)
+			conn, err := grpc.Dial(target, k.dialOpts(opts...)...)
 			if err != nil {
 				return fmt.Errorf("failed to dial endpoint %s: %v", target, err)
 			}
@@ -100,16 +113,18 @@ func (k *KubernetesConnManager) GetConns(ctx context.Context, serviceName string
 	k.mu.Lock()
 	// Check if another goroutine has already initialized the connections when we released the read lock
 	conns, exists = k.connMap[serviceName]
+	k.mu.Unlock()
 	if exists {
 		return conns, nil
 	}
-	k.mu.Unlock()
 
-	if err := k.initializeConns(serviceName); err != nil {
+	if err := k.initializeConns(serviceName, opts...); err != nil {
 		fmt.Println("Failed to initialize connections:", err)
 		return nil, fmt.Errorf("failed to initialize connections for service %s: %v", serviceName, err)
 	}
 
+	k.mu.RLock()
+	defer k.mu.RUnlock()
 	return k.connMap[serviceName], nil
 }
 
@@ -132,14 +147,7 @@ func (k *KubernetesConnManager) GetConn(ctx context.Context, serviceName string,
 		target = k.rpcTargets[serviceName]
 	}
 
-	return grpc.DialContext(
-		ctx,
-		target,
-		append([]grpc.DialOption{
-			grpc.WithTransportCredentials(insecure.NewCredentials()),
-			grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(1024*1024*10), grpc.MaxCallSendMsgSize(1024*1024*20)),
-		}, k.dialOptions...)...,
-	)
+	return grpc.DialContext(ctx, target, k.dialOpts(opts...)...)
 }
 
 // GetSelfConnTarget returns the connection target for the current service.
@@ -0,0 +1,223 @@
+package discovery
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const defaultTLSReloadInterval = time.Minute
+
+// The names the certificates of the components that only call the rpc services are configured by,
+// the rpc services use their register name.
+const (
+	TLSNameAPI         = "openim-api"
+	TLSNameMsgTransfer = "openim-msgtransfer"
+	TLSNameCronTask    = "openim-crontask"
+)
+
+// ServerCredentials returns the credentials of the rpc server registered as serviceName.
+// With TLS enabled the clients must present a certificate signed by the CA, and be allowed
+// to call the service when it has an allowlist.
+func ServerCredentials(discovery *config.Discovery, serviceName string) (credentials.TransportCredentials, error) {
+	if !discovery.TLS.Enable {
+		return insecure.NewCredentials(), nil
+	}
+	loader, err := newCertLoader(&discovery.TLS, serviceName)
+	if err != nil {
+		return nil, err
+	}
+	var allowed map[string]struct{}
+	for _, allow := range discovery.TLS.AllowedClients {
+		if allow.Service != serviceName {
+			continue
+		}
+		if allowed == nil {
+			allowed = make(map[string]struct{})
+		}
+		for _, client := range allow.Clients {
+			allowed[client] = struct{}{}
+		}
+	}
+	verifyClient := func(cs tls.ConnectionState) error {
+		if allowed == nil {
+			return nil
+		}
+		if len(cs.PeerCertificates) == 0 {
+			return errs.New("no client certificate").Wrap()
+		}
+		for _, identity := range certIdentities(cs.PeerCertificates[0]) {
+			if _, ok := allowed[identity]; ok {
+				return nil
+			}
+		}
+		return errs.New("client is not allowed to call the service", "service", serviceName, "subject", cs.PeerCertificates[0].Subject.String()).Wrap()
+	}
+	return credentials.NewTLS(&tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// The config is built per handshake so that renewed certificates are picked up without a restart.
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, pool, err := loader.load()
+			if err != nil {
+				return nil, err
+			}
+			return &tls.Config{
+				MinVersion:       tls.VersionTLS12,
+				NextProtos:       []string{"h2"},
+				Certificates:     []tls.Certificate{*cert},
+				ClientAuth:       tls.RequireAndVerifyClientCert,
+				ClientCAs:        pool,
+				VerifyConnection: verifyClient,
+			}, nil
+		},
+	}), nil
+}
+
+// ClientCredentials returns the credentials name, a service register name or one of the TLSName
+// components, dials the rpc services with. The servers must present a certificate for the
+// configured server name.
+func ClientCredentials(discovery *config.Discovery, name string) (credentials.TransportCredentials, error) {
+	if !discovery.TLS.Enable {
+		return insecure.NewCredentials(), nil
+	}
+	if discovery.TLS.ServerName == "" {
+		return nil, errs.New("tls.serverName is required").Wrap()
+	}
+	loader, err := newCertLoader(&discovery.TLS, name)
+	if err != nil {
+		return nil, err
+	}
+	return credentials.NewTLS(&tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// The servers are dialed by ip, the chain is verified in VerifyConnection against the reloadable CA instead.
+		InsecureSkipVerify: true,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, _, err := loader.load()
+			return cert, err
+		},
+		VerifyConnection: func(cs tls.ConnectionState) error {
+			if len(cs.PeerCertificates) == 0 {
+				return errs.New("no server certificate").Wrap()
+			}
+			_, pool, err := loader.load()
+			if err != nil {
+				return err
+			}
+			opts := x509.VerifyOptions{
+				Roots:         pool,
+				Intermediates: x509.NewCertPool(),
+				DNSName:       discovery.TLS.ServerName,
+				KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+			}
+			for _, cert := range cs.PeerCertificates[1:] {
+				opts.Intermediates.AddCert(cert)
+			}
+			_, err = cs.PeerCertificates[0].Verify(opts)
+			return errs.WrapMsg(err, "verify server certificate failed")
+		},
+	}), nil
+}
+
+// certIdentities returns the names a certificate can be allowed by.
+func certIdentities(cert *x509.Certificate) []string {
+	identities := make([]string, 0, 1+len(cert.DNSNames)+len(cert.URIs))
+	if cert.Subject.CommonName != "" {
+		identities = append(identities, cert.Subject.CommonName)
+	}
+	identities = append(identities, cert.DNSNames...)
+	for _, uri := range cert.URIs {
+		identities = append(identities, uri.String())
+	}
+	return identities
+}
+
+// certLoader keeps the certificate and CA read from the files, and reads them again once they change.
+type certLoader struct {
+	conf     *config.RpcTLS
+	certFile string
+	keyFile  string
+	interval time.Duration
+
+	lock      sync.Mutex
+	checkTime time.Time
+	modTime   time.Time
+	cert      *tls.Certificate
+	pool      *x509.CertPool
+}
+
+func newCertLoader(conf *config.RpcTLS, name string) (*certLoader, error) {
+	l := &certLoader{conf: conf, interval: defaultTLSReloadInterval}
+	l.certFile, l.keyFile = conf.CertOf(name)
+	if conf.ReloadInterval > 0 {
+		l.interval = time.Duration(conf.ReloadInterval) * time.Second
+	}
+	if _, _, err := l.load(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (l *certLoader) load() (*tls.Certificate, *x509.CertPool, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	now := time.Now()
+	if l.cert != nil && now.Sub(l.checkTime) < l.interval {
+		return l.cert, l.pool, nil
+	}
+	l.checkTime = now
+	modTime, err := l.lastModified()
+	if err == nil && l.cert != nil && modTime.Equal(l.modTime) {
+		return l.cert, l.pool, nil
+	}
+	if err == nil {
+		err = l.read(modTime)
+	}
+	if err != nil {
+		if l.cert == nil {
+			return nil, nil, err
+		}
+		// Keep serving with the previous files, they may be in the middle of being replaced.
+		log.ZWarn(context.Background(), "reload rpc tls certificate failed", err, "certFile", l.certFile)
+	}
+	return l.cert, l.pool, nil
+}
+
+func (l *certLoader) lastModified() (time.Time, error) {
+	var last time.Time
+	for _, name := range []string{l.conf.CAFile, l.certFile, l.keyFile} {
+		info, err := os.Stat(name)
+		if err != nil {
+			return time.Time{}, errs.WrapMsg(err, "stat tls file failed", "file", name)
+		}
+		if info.ModTime().After(last) {
+			last = info.ModTime()
+		}
+	}
+	return last, nil
+}
+
+func (l *certLoader) read(modTime time.Time) error {
+	cert, err := tls.LoadX509KeyPair(l.certFile, l.keyFile)
+	if err != nil {
+		return errs.WrapMsg(err, "load tls key pair failed", "certFile", l.certFile, "keyFile", l.keyFile)
+	}
+	ca, err := os.ReadFile(l.conf.CAFile)
+	if err != nil {
+		return errs.WrapMsg(err, "read tls ca failed", "caFile", l.conf.CAFile)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return errs.New("no certificate found in tls ca", "caFile", l.conf.CAFile).Wrap()
+	}
+	l.cert, l.pool, l.modTime = &cert, pool, modTime
+	return nil
+}
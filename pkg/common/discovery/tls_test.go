@@ -0,0 +1,224 @@
+package discovery
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCA(t *testing.T, dir string) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "openim-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writePEM(t, filepath.Join(dir, "ca.crt"), "CERTIFICATE", der)
+	cert, _ := x509.ParseCertificate(der)
+	return &testCA{cert: cert, key: key}
+}
+
+const testServerName = "openim-rpc"
+
+// issue writes a certificate for name usable as both server and client, and returns its tls config.
+func (ca *testCA) issue(t *testing.T, dir string, name string, dnsNames ...string) config.RpcTLS {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     dnsNames,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writePEM(t, filepath.Join(dir, name+".crt"), "CERTIFICATE", der)
+	writePEM(t, filepath.Join(dir, name+".key"), "EC PRIVATE KEY", keyDER)
+	return config.RpcTLS{
+		Enable:     true,
+		CAFile:     filepath.Join(dir, "ca.crt"),
+		CertFile:   filepath.Join(dir, name+".crt"),
+		KeyFile:    filepath.Join(dir, name+".key"),
+		ServerName: testServerName,
+	}
+}
+
+func writePEM(t *testing.T, name string, typ string, der []byte) {
+	if err := os.WriteFile(name, pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t, dir)
+
+	serverTLS := ca.issue(t, dir, "openim-rpc-msg", testServerName)
+	serverTLS.AllowedClients = []config.RpcAllowedClients{{Service: "msg-rpc-service", Clients: []string{"openim-api"}}}
+	serverCreds, err := ServerCredentials(&config.Discovery{TLS: serverTLS}, "msg-rpc-service")
+	if err != nil {
+		t.Fatal(err)
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer(grpc.Creds(serverCreds))
+	grpc_health_v1.RegisterHealthServer(srv, health.NewServer())
+	go srv.Serve(listener)
+	defer srv.Stop()
+
+	otherDir := t.TempDir()
+	otherCA := newTestCA(t, otherDir)
+
+	tests := []struct {
+		name string
+		conf config.RpcTLS
+		ok   bool
+	}{
+		{"allowed client", ca.issue(t, dir, "openim-api"), true},
+		{"client not in allowlist", ca.issue(t, dir, "openim-push"), false},
+		{"client of another ca", otherCA.issue(t, otherDir, "openim-api"), false},
+		{"plaintext client", config.RpcTLS{}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			creds, err := ClientCredentials(&config.Discovery{TLS: test.conf}, TLSNameAPI)
+			if err != nil {
+				t.Fatal(err)
+			}
+			conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(creds))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer conn.Close()
+			ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+			defer cancel()
+			_, err = grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+			if test.ok && err != nil {
+				t.Fatalf("expected the call to succeed, got %v", err)
+			}
+			if !test.ok && err == nil {
+				t.Fatal("expected the call to be rejected")
+			}
+		})
+	}
+}
+
+// serve starts a health server with the credentials of serviceName and returns its address.
+func serve(t *testing.T, conf config.RpcTLS, serviceName string) string {
+	creds, err := ServerCredentials(&config.Discovery{TLS: conf}, serviceName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer(grpc.Creds(creds))
+	grpc_health_v1.RegisterHealthServer(srv, health.NewServer())
+	go srv.Serve(listener)
+	t.Cleanup(srv.Stop)
+	return listener.Addr().String()
+}
+
+func check(t *testing.T, conf config.RpcTLS, name string, addr string) error {
+	creds, err := ClientCredentials(&config.Discovery{TLS: conf}, name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	_, err = grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	return err
+}
+
+func TestCertPerService(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t, dir)
+	// The shared certificate has no SAN for the server name, only the msg service can serve.
+	conf := ca.issue(t, dir, "openim-rpc")
+	msg := ca.issue(t, dir, "openim-rpc-msg", testServerName)
+	api := ca.issue(t, dir, "openim-api")
+	conf.Certs = []config.RpcCert{
+		{Name: "msg-rpc-service", CertFile: msg.CertFile, KeyFile: msg.KeyFile},
+		{Name: TLSNameAPI, CertFile: api.CertFile, KeyFile: api.KeyFile},
+	}
+	conf.AllowedClients = []config.RpcAllowedClients{{Service: "msg-rpc-service", Clients: []string{"openim-api"}}}
+
+	msgAddr := serve(t, conf, "msg-rpc-service")
+	if err := check(t, conf, TLSNameAPI, msgAddr); err != nil {
+		t.Fatal("api must reach the msg service", err)
+	}
+	if err := check(t, conf, "push-rpc-service", msgAddr); err == nil {
+		t.Fatal("the shared certificate is not allowed to call the msg service")
+	}
+	userAddr := serve(t, conf, "user-rpc-service")
+	if err := check(t, conf, TLSNameAPI, userAddr); err == nil {
+		t.Fatal("a server certificate without the server name must be rejected")
+	}
+}
+
+func TestRpcTLSValidate(t *testing.T) {
+	for _, c := range []struct {
+		conf  config.RpcTLS
+		valid bool
+	}{
+		{config.RpcTLS{}, true},
+		{config.RpcTLS{Enable: true, CAFile: "ca.crt", ServerName: testServerName}, true},
+		{config.RpcTLS{Enable: true, CAFile: "ca.crt"}, false},
+		{config.RpcTLS{Enable: true, ServerName: testServerName}, false},
+		{config.RpcTLS{Enable: true, CAFile: "ca.crt", ServerName: testServerName, Certs: []config.RpcCert{{Name: "msg-rpc-service"}}}, false},
+	} {
+		if err := c.conf.Validate(); (err == nil) != c.valid {
+			t.Fatal("unexpected result", c.conf, err)
+		}
+	}
+}
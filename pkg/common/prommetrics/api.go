@@ -22,6 +22,13 @@ var (
 		},
 		[]string{"path", "method", "status"},
 	)
+	rateLimitCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "api_rate_limit_count",
+			Help: "Total number of API calls checked by the rate limiter",
+		},
+		[]string{"path", "result"},
+	)
 )
 
 func ApiInit(listener net.Listener) error {
@@ -30,6 +37,7 @@ func ApiInit(listener net.Listener) error {
 		baseCollector,
 		apiCounter,
 		httpCounter,
+		rateLimitCounter,
 	)
 	return Init(apiRegistry, listener, commonPath, promhttp.HandlerFor(apiRegistry, promhttp.HandlerOpts{}), cs...)
 }
@@ -42,6 +50,11 @@ func HttpCall(path string, method string, status int) {
 	httpCounter.With(prometheus.Labels{"path": path, "method": method, "status": strconv.Itoa(status)}).Inc()
 }
 
+// RateLimitCall counts a request checked by the rate limiter, result is allowed, rejected or error.
+func RateLimitCall(path string, result string) {
+	rateLimitCounter.With(prometheus.Labels{"path": path, "result": result}).Inc()
+}
+
 //func ApiHandler() http.Handler {
 //	return promhttp.InstrumentMetricHandler(
 //		apiRegistry, promhttp.HandlerFor(apiRegistry, promhttp.HandlerOpts{}),
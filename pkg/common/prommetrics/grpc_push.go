@@ -27,4 +27,31 @@ var (
 		Name: "msg_long_time_push_total",
 		Help: "The number of messages with a push time exceeding 10 seconds",
 	})
+	OfflinePushProviderCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "offline_push_provider_total",
+		Help: "The number of device tokens pushed through each offline push provider",
+	}, []string{"provider", "result"})
+	OfflinePushRouteCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "offline_push_route_total",
+		Help: "The number of offline pushes of each route of the push router, by provider",
+	}, []string{"route", "provider", "result"})
+	OfflinePushRetryCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "offline_push_retry_total",
+		Help: "The number of failed offline pushes scheduled again or kept as dead letters, by provider and error class",
+	}, []string{"provider", "class", "result"})
 )
+
+// OfflinePushProviderCall counts the device tokens a provider was asked to push to, result is success or failed.
+func OfflinePushProviderCall(provider string, result string, count int) {
+	OfflinePushProviderCounter.With(prometheus.Labels{"provider": provider, "result": result}).Add(float64(count))
+}
+
+// OfflinePushRouteCall counts a push of a route through a provider, result is success or failed.
+func OfflinePushRouteCall(route string, provider string, result string) {
+	OfflinePushRouteCounter.With(prometheus.Labels{"route": route, "provider": provider, "result": result}).Inc()
+}
+
+// OfflinePushRetryCall counts a failed offline push, result is retry or dead.
+func OfflinePushRetryCall(provider string, class string, result string) {
+	OfflinePushRetryCounter.With(prometheus.Labels{"provider": provider, "class": class, "result": result}).Inc()
+}
@@ -58,6 +58,9 @@ func GetGrpcCusMetrics(registerName string, discovery *config.Discovery) []prome
 		return []prometheus.Collector{
 			MsgOfflinePushFailedCounter,
 			MsgLoneTimePushCounter,
+			OfflinePushProviderCounter,
+			OfflinePushRouteCounter,
+			OfflinePushRetryCounter,
 		}
 	case discovery.RpcService.Auth:
 		return []prometheus.Collector{UserLoginCounter}
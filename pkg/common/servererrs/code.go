@@ -50,10 +50,12 @@ const (
 	NoPermissionError   = 1002 // Insufficient permission
 	DuplicateKeyError   = 1003
 	RecordNotFoundError = 1004 // Record does not exist
+	TooManyRequests     = 1005 // Request rate limit exceeded
 
 	// Account error codes.
 	UserIDNotFoundError    = 1101 // UserID does not exist or is not registered
 	RegisteredAlreadyError = 1102 // user is already registered
+	UserSuspendedError     = 1103 // user is suspended by the admin
 
 	// Group error codes.
 	GroupIDNotFoundError  = 1201 // GroupID does not exist
@@ -83,6 +85,8 @@ const (
 	TokenUnknownError     = 1505
 	TokenKickedError      = 1506
 	TokenNotExistError    = 1507
+	RefreshTokenInvalid   = 1508 // Refresh token does not exist or was revoked
+	RefreshTokenReused    = 1509 // Refresh token was already used, its family is revoked
 
 	// Long connection gateway error codes.
 	ConnOverMaxNumLimit  = 1601
@@ -22,13 +22,15 @@ var (
 	ErrCallback         = errs.NewCodeError(CallbackError, "CallbackError")
 	ErrCallbackContinue = errs.NewCodeError(CallbackError, "ErrCallbackContinue")
 
-	ErrInternalServer = errs.NewCodeError(ServerInternalError, "ServerInternalError")
-	ErrArgs           = errs.NewCodeError(ArgsError, "ArgsError")
-	ErrNoPermission   = errs.NewCodeError(NoPermissionError, "NoPermissionError")
-	ErrDuplicateKey   = errs.NewCodeError(DuplicateKeyError, "DuplicateKeyError")
-	ErrRecordNotFound = errs.NewCodeError(RecordNotFoundError, "RecordNotFoundError")
+	ErrInternalServer  = errs.NewCodeError(ServerInternalError, "ServerInternalError")
+	ErrArgs            = errs.NewCodeError(ArgsError, "ArgsError")
+	ErrNoPermission    = errs.NewCodeError(NoPermissionError, "NoPermissionError")
+	ErrDuplicateKey    = errs.NewCodeError(DuplicateKeyError, "DuplicateKeyError")
+	ErrRecordNotFound  = errs.NewCodeError(RecordNotFoundError, "RecordNotFoundError")
+	ErrTooManyRequests = errs.NewCodeError(TooManyRequests, "TooManyRequests")
 
 	ErrUserIDNotFound  = errs.NewCodeError(UserIDNotFoundError, "UserIDNotFoundError")
+	ErrUserSuspended   = errs.NewCodeError(UserSuspendedError, "UserSuspendedError")
 	ErrGroupIDNotFound = errs.NewCodeError(GroupIDNotFoundError, "GroupIDNotFoundError")
 	ErrGroupIDExisted  = errs.NewCodeError(GroupIDExisted, "GroupIDExisted")
 
@@ -47,6 +49,9 @@ var (
 	ErrTokenKicked      = errs.NewCodeError(TokenKickedError, "TokenKickedError")
 	ErrTokenNotExist    = errs.NewCodeError(TokenNotExistError, "TokenNotExistError") //
 
+	ErrRefreshTokenInvalid = errs.NewCodeError(RefreshTokenInvalid, "RefreshTokenInvalid")
+	ErrRefreshTokenReused  = errs.NewCodeError(RefreshTokenReused, "RefreshTokenReused")
+
 	ErrMessageHasReadDisable = errs.NewCodeError(MessageHasReadDisable, "MessageHasReadDisable")
 
 	ErrCanNotAddYourself   = errs.NewCodeError(CanNotAddYourselfError, "CanNotAddYourselfError")
@@ -0,0 +1,87 @@
+package startrpc
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/audit"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	pbuserext "github.com/openimsdk/open-im-server/v3/pkg/protocol/userext"
+	pbauth "github.com/openimsdk/protocol/auth"
+	pbgroup "github.com/openimsdk/protocol/group"
+	pbmsg "github.com/openimsdk/protocol/msg"
+	pbuser "github.com/openimsdk/protocol/user"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/mcontext"
+	"google.golang.org/grpc"
+)
+
+// Auditable is implemented by the rpc servers whose admin operations are audited.
+type Auditable interface {
+	AuditLogDatabase() controller.AuditLogDatabase
+	IMAdminUserIDs() []string
+}
+
+// auditedMethods are the admin operations that are recorded, mapped to the target they act on.
+var auditedMethods = map[string]func(req any) string{
+	pbauth.Auth_ForceLogout_FullMethodName: func(req any) string {
+		return req.(*pbauth.ForceLogoutReq).GetUserID()
+	},
+	pbgroup.Group_KickGroupMember_FullMethodName: func(req any) string {
+		return req.(*pbgroup.KickGroupMemberReq).GetGroupID()
+	},
+	pbgroup.Group_DismissGroup_FullMethodName: func(req any) string {
+		return req.(*pbgroup.DismissGroupReq).GetGroupID()
+	},
+	pbmsg.Msg_DeleteMsgPhysical_FullMethodName: func(req any) string {
+		ids, _ := json.Marshal(req.(*pbmsg.DeleteMsgPhysicalReq).GetConversationIDs())
+		return string(ids)
+	},
+	pbuser.User_UpdateUserInfo_FullMethodName: func(req any) string {
+		return req.(*pbuser.UpdateUserInfoReq).GetUserInfo().GetUserID()
+	},
+	pbuser.User_UpdateUserInfoEx_FullMethodName: func(req any) string {
+		return req.(*pbuser.UpdateUserInfoExReq).GetUserInfo().GetUserID()
+	},
+	pbuserext.UserExt_SuspendUser_FullMethodName: func(req any) string {
+		return req.(*pbuserext.SuspendUserReq).GetUserID()
+	},
+}
+
+// auditUnaryInterceptor records the audited methods called by an admin once they return.
+func auditUnaryInterceptor() grpc.ServerOption {
+	return grpc.ChainUnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		target, ok := auditedMethods[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+		server, ok := info.Server.(Auditable)
+		if !ok || !authverify.IsManagerUserID(mcontext.GetOpUserID(ctx), server.IMAdminUserIDs()) {
+			return handler(ctx, req)
+		}
+		resp, err := handler(ctx, req)
+		auditLog := &model.AuditLog{
+			OperationID:    mcontext.GetOperationID(ctx),
+			OperatorUserID: mcontext.GetOpUserID(ctx),
+			Action:         info.FullMethod,
+			Target:         target(req),
+			CreateTime:     time.Now(),
+		}
+		if data, err := json.Marshal(req); err == nil {
+			_, auditLog.Request = audit.Summary(data)
+		}
+		if err != nil {
+			auditLog.ErrCode = -1
+			auditLog.ErrMsg = err.Error()
+			if codeErr, ok := errs.Unwrap(err).(errs.CodeError); ok {
+				auditLog.ErrCode = codeErr.Code()
+				auditLog.ErrMsg = codeErr.Msg()
+			}
+		}
+		server.AuditLogDatabase().Record(ctx, auditLog)
+		return resp, err
+	})
+}
@@ -43,7 +43,6 @@ import (
 	"github.com/openimsdk/tools/mw"
 	"github.com/openimsdk/tools/utils/network"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 // Start rpc server.
@@ -101,7 +100,16 @@ func Start[T any](ctx context.Context, discovery *conf.Discovery, prometheusConf
 	}
 
 	defer client.Close()
-	client.AddOption(mw.GrpcClient(), grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithDefaultServiceConfig(fmt.Sprintf(`{"LoadBalancingPolicy": "%s"}`, "round_robin")))
+	clientCreds, err := kdisc.ClientCredentials(discovery, rpcRegisterName)
+	if err != nil {
+		return err
+	}
+	serverCreds, err := kdisc.ServerCredentials(discovery, rpcRegisterName)
+	if err != nil {
+		return err
+	}
+	client.AddOption(mw.GrpcClient(), grpc.WithTransportCredentials(clientCreds), grpc.WithDefaultServiceConfig(fmt.Sprintf(`{"LoadBalancingPolicy": "%s"}`, "round_robin")))
+	options = append(options, grpc.Creds(serverCreds))
 
 	// var reg *prometheus.Registry
 	// var metric *grpcprometheus.ServerMetrics
@@ -114,6 +122,7 @@ func Start[T any](ctx context.Context, discovery *conf.Discovery, prometheusConf
 			options, mw.GrpcServer(),
 			prommetricsUnaryInterceptor(rpcRegisterName),
 			prommetricsStreamInterceptor(rpcRegisterName),
+			auditUnaryInterceptor(),
 		)
 
 		var (
@@ -157,7 +166,7 @@ func Start[T any](ctx context.Context, discovery *conf.Discovery, prometheusConf
 			// }
 		}()
 	} else {
-		options = append(options, mw.GrpcServer())
+		options = append(options, mw.GrpcServer(), auditUnaryInterceptor())
 	}
 
 	listener, port, err := getAutoPort()
@@ -180,7 +189,7 @@ func Start[T any](ctx context.Context, discovery *conf.Discovery, prometheusConf
 		rpcRegisterName,
 		registerIP,
 		port,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(clientCreds),
 	)
 	if err != nil {
 		return err
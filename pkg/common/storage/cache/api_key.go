@@ -0,0 +1,19 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type ApiKeyCache interface {
+	BatchDeleter
+	CloneApiKeyCache() ApiKeyCache
+	// GetApiKey returns nil if the key does not exist.
+	GetApiKey(ctx context.Context, keyID string) (*model.ApiKey, error)
+	DelApiKeys(keyIDs ...string) ApiKeyCache
+	// IncrApiKeyRequests counts a request of the key in the window starting at now truncated to window,
+	// and returns the number of requests in that window so far.
+	IncrApiKeyRequests(ctx context.Context, keyID string, now time.Time, window time.Duration) (int64, error)
+}
@@ -0,0 +1,19 @@
+package cache
+
+import (
+	"context"
+)
+
+// BadgeCache holds the unread count of the users shown on the app icon, kept up to date as messages are sent and read.
+type BadgeCache interface {
+	// GetBadges returns the cached unread counts of the users, the users without one are left out.
+	GetBadges(ctx context.Context, userIDs []string) (map[string]int64, error)
+	SetBadge(ctx context.Context, userID string, count int64) error
+	// IncrBadges adds delta to the cached unread counts of the users, never below zero.
+	// The users without a cached count are skipped, theirs is computed when it is needed.
+	IncrBadges(ctx context.Context, userIDs []string, delta int64) error
+	// ReadBadge removes the messages of the conversation read from hasReadSeq to newHasReadSeq from the cached count
+	// of the user. The seqs already removed by a concurrent read are removed once.
+	ReadBadge(ctx context.Context, userID string, conversationID string, hasReadSeq int64, newHasReadSeq int64) error
+	DelBadges(ctx context.Context, userIDs ...string) error
+}
@@ -0,0 +1,19 @@
+package cachekey
+
+import (
+	"strconv"
+)
+
+const (
+	ApiKeyKey     = "API_KEY:"
+	ApiKeyRateKey = "API_KEY_RATE:"
+)
+
+func GetApiKeyKey(keyID string) string {
+	return ApiKeyKey + keyID
+}
+
+// GetApiKeyRateKey counts the requests of a key within one fixed window.
+func GetApiKeyRateKey(keyID string, window int64) string {
+	return ApiKeyRateKey + keyID + ":" + strconv.FormatInt(window, 10)
+}
@@ -0,0 +1,10 @@
+package cachekey
+
+const (
+	UserBadgeKey = "USER_BADGE:"
+)
+
+// GetUserBadgeKey holds the unread count of the user computed by the push service.
+func GetUserBadgeKey(userID string) string {
+	return UserBadgeKey + userID
+}
@@ -26,8 +26,14 @@ const (
 	SuperGroupRecvMsgNotNotifyUserIDsHashKey = "SUPER_GROUP_RECV_MSG_NOT_NOTIFY_USER_IDS_HASH:"
 	ConversationNotReceiveMessageUserIDsKey  = "CONVERSATION_NOT_RECEIVE_MESSAGE_USER_IDS:"
 	ConversationUserMaxKey                   = "CONVERSATION_USER_MAX:"
+	ConversationDraftIDsKey                  = "CONVERSATION_DRAFT_IDS:"
 )
 
+// GetConversationDraftIDsKey holds the conversations of the user that have a draft.
+func GetConversationDraftIDsKey(ownerUserID string) string {
+	return ConversationDraftIDsKey + ownerUserID
+}
+
 func GetConversationKey(ownerUserID, conversationID string) string {
 	return ConversationKey + ownerUserID + ":" + conversationID
 }
@@ -0,0 +1,10 @@
+package cachekey
+
+const (
+	RateLimitKey = "RATE_LIMIT:"
+)
+
+// GetRateLimitKey holds the limiter state of a caller on a route, an empty caller shares it among everyone.
+func GetRateLimitKey(route string, caller string) string {
+	return RateLimitKey + route + ":" + caller
+}
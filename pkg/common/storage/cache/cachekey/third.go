@@ -23,8 +23,13 @@ const (
 	getuiTaskID             = "GETUI_TASK_ID"
 	fmcToken                = "FCM_TOKEN:"
 	userBadgeUnreadCountSum = "USER_BADGE_UNREAD_COUNT_SUM:"
+	pushQuietHours          = "PUSH_QUIET_HOURS:"
 )
 
+func GetPushQuietHoursKey(userID string) string {
+	return pushQuietHours + userID
+}
+
 func GetFcmAccountTokenKey(account string, platformID int) string {
 	return fmcToken + account + ":" + strconv.Itoa(platformID)
 }
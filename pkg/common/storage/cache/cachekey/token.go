@@ -1,8 +1,10 @@
 package cachekey
 
 import (
-	"github.com/openimsdk/protocol/constant"
 	"strings"
+
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/utils/encrypt"
 )
 
 const (
@@ -26,3 +28,30 @@ func GetPlatformIDByTokenKey(key string) int {
 	platform := splitKey[len(splitKey)-1]
 	return constant.PlatformNameToID(platform)
 }
+
+const (
+	TokenSession = "TOKEN_SESSION:"
+)
+
+func GetTokenSessionKey(userID string) string {
+	return TokenSession + userID
+}
+
+// GetTokenSessionID derives the session ID of a token, so the token itself is never exposed.
+func GetTokenSessionID(token string) string {
+	return encrypt.Md5(token)
+}
+
+const (
+	RefreshToken       = "REFRESH_TOKEN:"
+	RefreshTokenFamily = "REFRESH_TOKEN_FAMILY:"
+)
+
+// GetRefreshTokenKey stores the refresh token by its hash, so a leaked redis dump can not be replayed.
+func GetRefreshTokenKey(refreshToken string) string {
+	return RefreshToken + encrypt.Md5(refreshToken)
+}
+
+func GetRefreshTokenFamilyKey(familyID string) string {
+	return RefreshTokenFamily + familyID
+}
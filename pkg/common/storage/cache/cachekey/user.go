@@ -26,3 +26,28 @@ func GetUserInfoKey(userID string) string {
 func GetUserGlobalRecvMsgOptKey(userID string) string {
 	return UserGlobalRecvMsgOptKey + userID
 }
+
+const UserSuspendKey = "USER_SUSPEND:"
+
+func GetUserSuspendKey(userID string) string {
+	return UserSuspendKey + userID
+}
+
+const UserFieldKey = "USER_FIELD"
+
+func GetUserFieldKey() string {
+	return UserFieldKey
+}
+
+const (
+	UserPrivacyKey  = "USER_PRIVACY:"
+	UserLastSeenKey = "USER_LAST_SEEN:"
+)
+
+func GetUserPrivacyKey(userID string) string {
+	return UserPrivacyKey + userID
+}
+
+func GetUserLastSeenKey(userID string) string {
+	return UserLastSeenKey + userID
+}
@@ -0,0 +1,14 @@
+package cache
+
+import (
+	"context"
+)
+
+// ConversationDraftCache caches which conversations of a user have a draft, so that sending a
+// message usually needs only a redis round trip to find out whether a draft has to be cleared.
+type ConversationDraftCache interface {
+	BatchDeleter
+	CloneConversationDraftCache() ConversationDraftCache
+	GetDraftConversationIDs(ctx context.Context, userID string) ([]string, error)
+	DelDraftConversationIDs(userIDs ...string) ConversationDraftCache
+}
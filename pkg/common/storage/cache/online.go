@@ -4,6 +4,8 @@ import "context"
 
 type OnlineCache interface {
 	GetOnline(ctx context.Context, userID string) ([]int32, error)
+	// SetUserOnline updates the online platforms of the user shared by all gateways, and records
+	// the last-seen time once the user has no platform left, before the change is published.
 	SetUserOnline(ctx context.Context, userID string, online, offline []int32) error
 	GetAllOnlineUsers(ctx context.Context, cursor uint64) (map[string][]int32, uint64, error)
 }
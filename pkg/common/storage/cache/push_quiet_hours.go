@@ -0,0 +1,15 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type PushQuietHoursCache interface {
+	BatchDeleter
+	ClonePushQuietHoursCache() PushQuietHoursCache
+	// GetQuietHours returns the settings of the users who have some.
+	GetQuietHours(ctx context.Context, userIDs []string) ([]*model.PushQuietHours, error)
+	DelQuietHours(userIDs ...string) PushQuietHoursCache
+}
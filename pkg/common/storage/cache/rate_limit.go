@@ -0,0 +1,12 @@
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+type RateLimitCache interface {
+	// Allow takes one request from the allowance of route and caller, which is limit requests per window.
+	// When the request is rejected it returns false and how long to wait before retrying.
+	Allow(ctx context.Context, route string, caller string, limit int64, window time.Duration) (bool, time.Duration, error)
+}
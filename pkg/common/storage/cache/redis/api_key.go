@@ -0,0 +1,92 @@
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/dtm-labs/rockscache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const apiKeyExpireTime = time.Hour * 12
+
+type ApiKeyCacheRedis struct {
+	cache.BatchDeleter
+	rdb        redis.UniversalClient
+	db         database.ApiKey
+	expireTime time.Duration
+	rcClient   *rockscache.Client
+}
+
+func NewApiKeyCacheRedis(rdb redis.UniversalClient, db database.ApiKey, options *rockscache.Options) cache.ApiKeyCache {
+	return &ApiKeyCacheRedis{
+		BatchDeleter: NewBatchDeleterRedis(rdb, options, nil),
+		rdb:          rdb,
+		db:           db,
+		expireTime:   apiKeyExpireTime,
+		rcClient:     rockscache.NewClient(rdb, *options),
+	}
+}
+
+func (a *ApiKeyCacheRedis) CloneApiKeyCache() cache.ApiKeyCache {
+	return &ApiKeyCacheRedis{
+		BatchDeleter: a.BatchDeleter.Clone(),
+		rdb:          a.rdb,
+		db:           a.db,
+		expireTime:   a.expireTime,
+		rcClient:     a.rcClient,
+	}
+}
+
+func (a *ApiKeyCacheRedis) getApiKeyKey(keyID string) string {
+	return cachekey.GetApiKeyKey(keyID)
+}
+
+func (a *ApiKeyCacheRedis) getKeyID(key *model.ApiKey) string {
+	return key.KeyID
+}
+
+// GetApiKey ignores the tenant of ctx, a key is looked up before its tenant is known.
+func (a *ApiKeyCacheRedis) GetApiKey(ctx context.Context, keyID string) (*model.ApiKey, error) {
+	ctx = tenant.Shared(ctx)
+	keys, err := batchGetCache2(ctx, a.rcClient, a.expireTime, []string{keyID}, a.getApiKeyKey, a.getKeyID, a.db.Find)
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	return keys[0], nil
+}
+
+// ChainExecDel deletes the shared keys whatever the tenant of ctx.
+func (a *ApiKeyCacheRedis) ChainExecDel(ctx context.Context) error {
+	return a.BatchDeleter.ChainExecDel(tenant.Shared(ctx))
+}
+
+func (a *ApiKeyCacheRedis) DelApiKeys(keyIDs ...string) cache.ApiKeyCache {
+	keys := make([]string, 0, len(keyIDs))
+	for _, keyID := range keyIDs {
+		keys = append(keys, a.getApiKeyKey(keyID))
+	}
+	c := a.CloneApiKeyCache()
+	c.AddKeys(keys...)
+	return c
+}
+
+func (a *ApiKeyCacheRedis) IncrApiKeyRequests(ctx context.Context, keyID string, now time.Time, window time.Duration) (int64, error) {
+	key := cachekey.GetApiKeyRateKey(keyID, now.Truncate(window).Unix())
+	pipe := a.rdb.Pipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.Expire(ctx, key, window*2)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, errs.Wrap(err)
+	}
+	return incr.Val(), nil
+}
@@ -0,0 +1,132 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+// badgeExpire bounds how long a count drifting from the seqs, e.g. after leaving a group, is kept.
+const badgeExpire = 24 * time.Hour
+
+// badgeCountField is the field of the badge hash holding the count, the other fields are the read seqs
+// of the conversations already removed from it.
+const badgeCountField = "count"
+
+// incrBadgeScript adds ARGV[1] to the count of KEYS[1] if it exists, never going below zero.
+var incrBadgeScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+    return 0
+end
+local value = redis.call('HINCRBY', KEYS[1], 'count', ARGV[1])
+if value < 0 then
+    redis.call('HSET', KEYS[1], 'count', 0)
+end
+return 1
+`)
+
+// readBadgeScript removes the seqs of the conversation ARGV[1] read from ARGV[2] to ARGV[3] from the count of KEYS[1]
+// if it exists. The read seq removed last is kept in the field of the conversation, the seqs before it are not removed again.
+var readBadgeScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+    return 0
+end
+local read = tonumber(ARGV[2])
+local removed = redis.call('HGET', KEYS[1], ARGV[1])
+if removed and tonumber(removed) > read then
+    read = tonumber(removed)
+end
+local seq = tonumber(ARGV[3])
+if seq <= read then
+    return 0
+end
+redis.call('HSET', KEYS[1], ARGV[1], seq)
+local value = redis.call('HINCRBY', KEYS[1], 'count', read - seq)
+if value < 0 then
+    redis.call('HSET', KEYS[1], 'count', 0)
+end
+return 1
+`)
+
+type BadgeCacheRedis struct {
+	rdb redis.UniversalClient
+}
+
+func NewBadgeCacheRedis(rdb redis.UniversalClient) cache.BadgeCache {
+	return &BadgeCacheRedis{rdb: rdb}
+}
+
+func (b *BadgeCacheRedis) getBadgeKey(ctx context.Context, userID string) string {
+	return tenant.Key(ctx, cachekey.GetUserBadgeKey(userID))
+}
+
+func (b *BadgeCacheRedis) GetBadges(ctx context.Context, userIDs []string) (map[string]int64, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	pipe := b.rdb.Pipeline()
+	cmds := make([]*redis.StringCmd, len(userIDs))
+	for i, userID := range userIDs {
+		cmds[i] = pipe.HGet(ctx, b.getBadgeKey(ctx, userID), badgeCountField)
+	}
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return nil, errs.Wrap(err)
+	}
+	badges := make(map[string]int64, len(userIDs))
+	for i, cmd := range cmds {
+		count, err := cmd.Int64()
+		if err == nil {
+			badges[userIDs[i]] = count
+		}
+	}
+	return badges, nil
+}
+
+// SetBadge replaces the count of the user, the read seqs removed from the count before are dropped with it.
+func (b *BadgeCacheRedis) SetBadge(ctx context.Context, userID string, count int64) error {
+	key := b.getBadgeKey(ctx, userID)
+	pipe := b.rdb.TxPipeline()
+	pipe.Del(ctx, key)
+	pipe.HSet(ctx, key, badgeCountField, count)
+	pipe.Expire(ctx, key, badgeExpire)
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (b *BadgeCacheRedis) IncrBadges(ctx context.Context, userIDs []string, delta int64) error {
+	if len(userIDs) == 0 || delta == 0 {
+		return nil
+	}
+	pipe := b.rdb.Pipeline()
+	for _, userID := range userIDs {
+		incrBadgeScript.Eval(ctx, pipe, []string{b.getBadgeKey(ctx, userID)}, delta)
+	}
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (b *BadgeCacheRedis) ReadBadge(ctx context.Context, userID string, conversationID string, hasReadSeq int64, newHasReadSeq int64) error {
+	if newHasReadSeq <= hasReadSeq {
+		return nil
+	}
+	err := readBadgeScript.Run(ctx, b.rdb, []string{b.getBadgeKey(ctx, userID)}, conversationID, hasReadSeq, newHasReadSeq).Err()
+	return errs.Wrap(err)
+}
+
+func (b *BadgeCacheRedis) DelBadges(ctx context.Context, userIDs ...string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	pipe := b.rdb.Pipeline()
+	for _, userID := range userIDs {
+		pipe.Del(ctx, b.getBadgeKey(ctx, userID))
+	}
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
@@ -0,0 +1,62 @@
+package redis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// evalArgsMatch compares the EVAL arguments but the script source, which is the second one.
+func evalArgsMatch(expected, actual []any) error {
+	if len(expected) != len(actual) {
+		return assert.AnError
+	}
+	for i := range expected {
+		if i != 1 && expected[i] != actual[i] {
+			return assert.AnError
+		}
+	}
+	return nil
+}
+
+func TestIncrBadges(t *testing.T) {
+	rdb, mock := redismock.NewClientMock()
+	mock.MatchExpectationsInOrder(true)
+	c := NewBadgeCacheRedis(rdb)
+	ctx := tenant.WithTenantID(context.Background(), "a")
+
+	// The counts of the users are incremented by the script, which skips the users without one.
+	for _, userID := range []string{"alice", "bob"} {
+		key := "TENANT:a:" + cachekey.GetUserBadgeKey(userID)
+		mock.CustomMatch(evalArgsMatch).ExpectEval("", []string{key}, int64(1)).SetVal(int64(1))
+	}
+	require.NoError(t, c.IncrBadges(ctx, []string{"alice", "bob"}, 1))
+
+	// Nothing is sent without users or delta.
+	require.NoError(t, c.IncrBadges(ctx, nil, 1))
+	require.NoError(t, c.IncrBadges(ctx, []string{"alice"}, 0))
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReadBadge(t *testing.T) {
+	rdb, mock := redismock.NewClientMock()
+	mock.MatchExpectationsInOrder(true)
+	c := NewBadgeCacheRedis(rdb)
+	ctx := tenant.WithTenantID(context.Background(), "a")
+	key := "TENANT:a:" + cachekey.GetUserBadgeKey("alice")
+
+	// The script removes the seqs not removed yet by another read of the conversation.
+	mock.ExpectEvalSha(readBadgeScript.Hash(), []string{key}, "sg_team", int64(3), int64(10)).SetVal(int64(1))
+	require.NoError(t, c.ReadBadge(ctx, "alice", "sg_team", 3, 10))
+
+	// Nothing is read when the read seq does not move forward.
+	require.NoError(t, c.ReadBadge(ctx, "alice", "sg_team", 10, 10))
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
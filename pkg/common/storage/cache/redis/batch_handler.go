@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"github.com/dtm-labs/rockscache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/open-im-server/v3/pkg/localcache"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
@@ -64,6 +65,7 @@ func (c *BatchDeleterRedis) ChainExecDel(ctx context.Context) error {
 // execDel performs batch deletion and publishes the keys that have been deleted to update the local cache information of other nodes.
 func (c *BatchDeleterRedis) execDel(ctx context.Context, keys []string) error {
 	if len(keys) > 0 {
+		keys = datautil.Slice(keys, func(key string) string { return tenant.Key(ctx, key) })
 		log.ZDebug(ctx, "delete cache", "topic", c.redisPubTopics, "keys", keys)
 		// Batch delete keys
 		err := ProcessKeysBySlot(ctx, c.redisClient, keys, func(ctx context.Context, slot int64, keys []string) error {
@@ -118,7 +120,10 @@ func GetRocksCacheOptions() *rockscache.Options {
 	return &opts
 }
 
+// getCache scopes key by the tenant of ctx, as do batchGetCache2 and the BatchDeleter, so the keys
+// built by cachekey are never shared between tenants.
 func getCache[T any](ctx context.Context, rcClient *rockscache.Client, key string, expire time.Duration, fn func(ctx context.Context) (T, error)) (T, error) {
+	key = tenant.Key(ctx, key)
 	var t T
 	var write bool
 	v, err := rcClient.Fetch2(ctx, key, expire, func() (s string, err error) {
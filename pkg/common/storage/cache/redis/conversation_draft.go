@@ -0,0 +1,55 @@
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/dtm-labs/rockscache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/redis/go-redis/v9"
+)
+
+const conversationDraftExpireTime = time.Hour * 12
+
+type ConversationDraftCacheRedis struct {
+	cache.BatchDeleter
+	db         database.ConversationDraft
+	expireTime time.Duration
+	rcClient   *rockscache.Client
+}
+
+func NewConversationDraftCacheRedis(rdb redis.UniversalClient, db database.ConversationDraft, options *rockscache.Options) cache.ConversationDraftCache {
+	return &ConversationDraftCacheRedis{
+		BatchDeleter: NewBatchDeleterRedis(rdb, options, nil),
+		db:           db,
+		expireTime:   conversationDraftExpireTime,
+		rcClient:     rockscache.NewClient(rdb, *options),
+	}
+}
+
+func (c *ConversationDraftCacheRedis) CloneConversationDraftCache() cache.ConversationDraftCache {
+	return &ConversationDraftCacheRedis{
+		BatchDeleter: c.BatchDeleter.Clone(),
+		db:           c.db,
+		expireTime:   c.expireTime,
+		rcClient:     c.rcClient,
+	}
+}
+
+func (c *ConversationDraftCacheRedis) GetDraftConversationIDs(ctx context.Context, userID string) ([]string, error) {
+	return getCache(ctx, c.rcClient, cachekey.GetConversationDraftIDsKey(userID), c.expireTime, func(ctx context.Context) ([]string, error) {
+		return c.db.FindConversationIDs(ctx, userID)
+	})
+}
+
+func (c *ConversationDraftCacheRedis) DelDraftConversationIDs(userIDs ...string) cache.ConversationDraftCache {
+	keys := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		keys = append(keys, cachekey.GetConversationDraftIDsKey(userID))
+	}
+	c2 := c.CloneConversationDraftCache()
+	c2.AddKeys(keys...)
+	return c2
+}
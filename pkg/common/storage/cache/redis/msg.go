@@ -8,6 +8,7 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/utils/datautil"
 	"github.com/redis/go-redis/v9"
@@ -31,16 +32,16 @@ type msgCache struct {
 	msgDocDatabase database.Msg
 }
 
-func (c *msgCache) getSendMsgKey(id string) string {
-	return cachekey.GetSendMsgKey(id)
+func (c *msgCache) getSendMsgKey(ctx context.Context, id string) string {
+	return tenant.Key(ctx, cachekey.GetSendMsgKey(id))
 }
 
 func (c *msgCache) SetSendMsgStatus(ctx context.Context, id string, status int32) error {
-	return errs.Wrap(c.rdb.Set(ctx, c.getSendMsgKey(id), status, time.Hour*24).Err())
+	return errs.Wrap(c.rdb.Set(ctx, c.getSendMsgKey(ctx, id), status, time.Hour*24).Err())
 }
 
 func (c *msgCache) GetSendMsgStatus(ctx context.Context, id string) (int32, error) {
-	result, err := c.rdb.Get(ctx, c.getSendMsgKey(id)).Int()
+	result, err := c.rdb.Get(ctx, c.getSendMsgKey(ctx, id)).Int()
 	return int32(result), errs.Wrap(err)
 }
 
@@ -65,7 +66,7 @@ func (c *msgCache) DelMessageBySeqs(ctx context.Context, conversationID string,
 		return nil
 	}
 	keys := datautil.Slice(seqs, func(seq int64) string {
-		return cachekey.GetMsgCacheKey(conversationID, seq)
+		return tenant.Key(ctx, cachekey.GetMsgCacheKey(conversationID, seq))
 	})
 	slotKeys, err := groupKeysBySlot(ctx, getRocksCacheRedisClient(c.rcClient), keys)
 	if err != nil {
@@ -88,7 +89,7 @@ func (c *msgCache) SetMessageBySeqs(ctx context.Context, conversationID string,
 		if err != nil {
 			return err
 		}
-		if err := c.rcClient.RawSet(ctx, cachekey.GetMsgCacheKey(conversationID, msg.Msg.Seq), string(data), msgCacheTimeout); err != nil {
+		if err := c.rcClient.RawSet(ctx, tenant.Key(ctx, cachekey.GetMsgCacheKey(conversationID, msg.Msg.Seq)), string(data), msgCacheTimeout); err != nil {
 			return err
 		}
 	}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
@@ -14,6 +15,32 @@ import (
 	"time"
 )
 
+// setUserOnlineScript removes the expired and offline platforms, adds the online ones and returns the
+// platforms left followed by "1" when they changed, or only "0".
+const setUserOnlineScript = `
+	local key = KEYS[1]
+	local score = ARGV[3]
+	local num1 = redis.call("ZCARD", key)
+	redis.call("ZREMRANGEBYSCORE", key, "-inf", ARGV[2])
+	for i = 5, tonumber(ARGV[4])+4 do
+		redis.call("ZREM", key, ARGV[i])
+	end
+	local num2 = redis.call("ZCARD", key)
+	for i = 5+tonumber(ARGV[4]), #ARGV do
+		redis.call("ZADD", key, score, ARGV[i])
+	end
+	redis.call("EXPIRE", key, ARGV[1])
+	local num3 = redis.call("ZCARD", key)
+	local change = (num1 ~= num2) or (num2 ~= num3)
+	if change then
+		local members = redis.call("ZRANGE", key, 0, -1)
+		table.insert(members, "1")
+		return members
+	else
+		return {"0"}
+	end
+`
+
 func NewUserOnline(rdb redis.UniversalClient) cache.OnlineCache {
 	return &userOnline{
 		rdb:         rdb,
@@ -28,12 +55,12 @@ type userOnline struct {
 	channelName string
 }
 
-func (s *userOnline) getUserOnlineKey(userID string) string {
-	return cachekey.GetOnlineKey(userID)
+func (s *userOnline) getUserOnlineKey(ctx context.Context, userID string) string {
+	return tenant.Key(ctx, cachekey.GetOnlineKey(userID))
 }
 
 func (s *userOnline) GetOnline(ctx context.Context, userID string) ([]int32, error) {
-	members, err := s.rdb.ZRangeByScore(ctx, s.getUserOnlineKey(userID), &redis.ZRangeBy{
+	members, err := s.rdb.ZRangeByScore(ctx, s.getUserOnlineKey(ctx, userID), &redis.ZRangeBy{
 		Min: strconv.FormatInt(time.Now().Unix(), 10),
 		Max: "+inf",
 	}).Result()
@@ -54,13 +81,14 @@ func (s *userOnline) GetOnline(ctx context.Context, userID string) ([]int32, err
 func (s *userOnline) GetAllOnlineUsers(ctx context.Context, cursor uint64) (map[string][]int32, uint64, error) {
 	result := make(map[string][]int32)
 
-	keys, nextCursor, err := s.rdb.Scan(ctx, cursor, fmt.Sprintf("%s*", cachekey.OnlineKey), constant.ParamMaxLength).Result()
+	keys, nextCursor, err := s.rdb.Scan(ctx, cursor, fmt.Sprintf("%s*", tenant.Key(ctx, cachekey.OnlineKey)), constant.ParamMaxLength).Result()
 	if err != nil {
 		return nil, 0, err
 	}
 
 	for _, key := range keys {
-		userID := cachekey.GetOnlineKeyUserID(key)
+		_, unscoped := tenant.SplitKey(key)
+		userID := cachekey.GetOnlineKeyUserID(unscoped)
 		strValues, err := s.rdb.ZRange(ctx, key, 0, -1).Result()
 		if err != nil {
 			return nil, 0, err
@@ -82,29 +110,6 @@ func (s *userOnline) GetAllOnlineUsers(ctx context.Context, cursor uint64) (map[
 }
 
 func (s *userOnline) SetUserOnline(ctx context.Context, userID string, online, offline []int32) error {
-	script := `
-	local key = KEYS[1]
-	local score = ARGV[3]
-	local num1 = redis.call("ZCARD", key)
-	redis.call("ZREMRANGEBYSCORE", key, "-inf", ARGV[2])
-	for i = 5, tonumber(ARGV[4])+4 do
-		redis.call("ZREM", key, ARGV[i])
-	end
-	local num2 = redis.call("ZCARD", key)
-	for i = 5+tonumber(ARGV[4]), #ARGV do
-		redis.call("ZADD", key, score, ARGV[i])
-	end
-	redis.call("EXPIRE", key, ARGV[1])
-	local num3 = redis.call("ZCARD", key)
-	local change = (num1 ~= num2) or (num2 ~= num3)
-	if change then
-		local members = redis.call("ZRANGE", key, 0, -1)
-		table.insert(members, "1")
-		return members
-	else
-		return {"0"}
-	end
-`
 	now := time.Now()
 	argv := make([]any, 0, 2+len(online)+len(offline))
 	argv = append(argv, int32(s.expire/time.Second), now.Unix(), now.Add(s.expire).Unix(), int32(len(offline)))
@@ -114,8 +119,8 @@ func (s *userOnline) SetUserOnline(ctx context.Context, userID string, online, o
 	for _, platformID := range online {
 		argv = append(argv, platformID)
 	}
-	keys := []string{s.getUserOnlineKey(userID)}
-	platformIDs, err := s.rdb.Eval(ctx, script, keys, argv).StringSlice()
+	keys := []string{s.getUserOnlineKey(ctx, userID)}
+	platformIDs, err := s.rdb.Eval(ctx, setUserOnlineScript, keys, argv).StringSlice()
 	if err != nil {
 		log.ZError(ctx, "redis SetUserOnline", err, "userID", userID, "online", online, "offline", offline)
 		return err
@@ -124,8 +129,15 @@ func (s *userOnline) SetUserOnline(ctx context.Context, userID string, online, o
 		return errs.ErrInternalServer.WrapMsg("SetUserOnline redis lua invalid return value")
 	}
 	if platformIDs[len(platformIDs)-1] != "0" {
+		if len(platformIDs) == 1 {
+			// The subscribers read the last-seen time when they receive the offline status.
+			if err := s.rdb.Set(ctx, tenant.Key(ctx, cachekey.GetUserLastSeenKey(userID)), now.UnixMilli(), 0).Err(); err != nil {
+				return errs.Wrap(err)
+			}
+		}
 		log.ZDebug(ctx, "redis SetUserOnline push", "userID", userID, "online", online, "offline", offline, "platformIDs", platformIDs[:len(platformIDs)-1])
-		platformIDs[len(platformIDs)-1] = userID
+		// The subscribers of every tenant share the channel, so the tenant travels in the user ID.
+		platformIDs[len(platformIDs)-1] = tenant.Key(ctx, userID)
 		msg := strings.Join(platformIDs, ":")
 		if err := s.rdb.Publish(ctx, s.channelName, msg).Err(); err != nil {
 			return errs.Wrap(err)
@@ -0,0 +1,62 @@
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/dtm-labs/rockscache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/redis/go-redis/v9"
+)
+
+const pushQuietHoursExpireTime = time.Hour * 12
+
+type PushQuietHoursCacheRedis struct {
+	cache.BatchDeleter
+	db         database.PushQuietHours
+	expireTime time.Duration
+	rcClient   *rockscache.Client
+}
+
+func NewPushQuietHoursCacheRedis(rdb redis.UniversalClient, db database.PushQuietHours, options *rockscache.Options) cache.PushQuietHoursCache {
+	return &PushQuietHoursCacheRedis{
+		BatchDeleter: NewBatchDeleterRedis(rdb, options, nil),
+		db:           db,
+		expireTime:   pushQuietHoursExpireTime,
+		rcClient:     rockscache.NewClient(rdb, *options),
+	}
+}
+
+func (p *PushQuietHoursCacheRedis) ClonePushQuietHoursCache() cache.PushQuietHoursCache {
+	return &PushQuietHoursCacheRedis{
+		BatchDeleter: p.BatchDeleter.Clone(),
+		db:           p.db,
+		expireTime:   p.expireTime,
+		rcClient:     p.rcClient,
+	}
+}
+
+func (p *PushQuietHoursCacheRedis) getPushQuietHoursKey(userID string) string {
+	return cachekey.GetPushQuietHoursKey(userID)
+}
+
+func (p *PushQuietHoursCacheRedis) getUserID(quietHours *model.PushQuietHours) string {
+	return quietHours.UserID
+}
+
+func (p *PushQuietHoursCacheRedis) GetQuietHours(ctx context.Context, userIDs []string) ([]*model.PushQuietHours, error) {
+	return batchGetCache2(ctx, p.rcClient, p.expireTime, userIDs, p.getPushQuietHoursKey, p.getUserID, p.db.Find)
+}
+
+func (p *PushQuietHoursCacheRedis) DelQuietHours(userIDs ...string) cache.PushQuietHoursCache {
+	keys := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		keys = append(keys, p.getPushQuietHoursKey(userID))
+	}
+	c := p.ClonePushQuietHoursCache()
+	c.AddKeys(keys...)
+	return c
+}
@@ -0,0 +1,59 @@
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/redis/go-redis/v9"
+)
+
+// rateLimitScript implements GCRA, the key holds the theoretical arrival time of the next request.
+// It takes the emission interval, the window and the current time in microseconds,
+// and returns 0 when the request is allowed or the microseconds to wait otherwise.
+var rateLimitScript = redis.NewScript(`
+local interval = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+local tat = tonumber(redis.call('GET', KEYS[1]))
+if tat == nil or tat < now then
+    tat = now
+end
+local newTat = tat + interval
+local retryAfter = newTat - window - now
+if retryAfter > 0 then
+    return math.ceil(retryAfter)
+end
+redis.call('SET', KEYS[1], string.format('%d', newTat), 'PX', math.ceil((newTat - now) / 1000))
+return 0
+`)
+
+type RateLimitCacheRedis struct {
+	rdb redis.UniversalClient
+}
+
+func NewRateLimitCacheRedis(rdb redis.UniversalClient) cache.RateLimitCache {
+	return &RateLimitCacheRedis{rdb: rdb}
+}
+
+func (r *RateLimitCacheRedis) Allow(ctx context.Context, route string, caller string, limit int64, window time.Duration) (bool, time.Duration, error) {
+	args := rateLimitArgs(limit, window, time.Now())
+	res, err := callLua(ctx, r.rdb, rateLimitScript, []string{tenant.Key(ctx, cachekey.GetRateLimitKey(route, caller))}, args)
+	if err != nil {
+		return false, 0, err
+	}
+	wait, _ := res.(int64)
+	if wait > 0 {
+		return false, time.Duration(wait) * time.Microsecond, nil
+	}
+	return true, 0, nil
+}
+
+// rateLimitArgs returns the arguments of rateLimitScript, the interval is at least a microsecond,
+// a zero interval would never advance the arrival time and let every request through.
+func rateLimitArgs(limit int64, window time.Duration, now time.Time) []any {
+	interval := max(window.Microseconds()/limit, 1)
+	return []any{interval, window.Microseconds(), now.UnixMicro()}
+}
@@ -0,0 +1,57 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rateLimitArgsMatch compares the script arguments but the current time, which is the last one.
+func rateLimitArgsMatch(expected, actual []any) error {
+	if len(expected) != len(actual) {
+		return assert.AnError
+	}
+	for i := 0; i < len(expected)-1; i++ {
+		if expected[i] != actual[i] {
+			return assert.AnError
+		}
+	}
+	return nil
+}
+
+func TestRateLimitArgs(t *testing.T) {
+	now := time.UnixMicro(1_000_000)
+	assert.Equal(t, []any{int64(10_000), int64(1_000_000), int64(1_000_000)}, rateLimitArgs(100, time.Second, now))
+	// More requests than microseconds in the window must not make the interval zero.
+	assert.Equal(t, []any{int64(1), int64(1_000_000), int64(1_000_000)}, rateLimitArgs(10_000_000, time.Second, now))
+}
+
+func TestRateLimitAllow(t *testing.T) {
+	rdb, mock := redismock.NewClientMock()
+	mock.MatchExpectationsInOrder(true)
+	c := NewRateLimitCacheRedis(rdb)
+	ctx := tenant.WithTenantID(context.Background(), "a")
+	key := "TENANT:a:" + cachekey.GetRateLimitKey("/msg/send_msg", "user_1")
+
+	// 10 requests per minute, one every 6 seconds.
+	mock.CustomMatch(rateLimitArgsMatch).ExpectEvalSha(rateLimitScript.Hash(), []string{key}, int64(6_000_000), int64(60_000_000), int64(0)).SetVal(int64(0))
+	allowed, retryAfter, err := c.Allow(ctx, "/msg/send_msg", "user_1", 10, time.Minute)
+	require.NoError(t, err)
+	assert.True(t, allowed)
+	assert.Zero(t, retryAfter)
+
+	// The script returns the microseconds to wait once the burst is used up.
+	mock.CustomMatch(rateLimitArgsMatch).ExpectEvalSha(rateLimitScript.Hash(), []string{key}, int64(6_000_000), int64(60_000_000), int64(0)).SetVal(int64(1_500_000))
+	allowed, retryAfter, err = c.Allow(ctx, "/msg/send_msg", "user_1", 10, time.Minute)
+	require.NoError(t, err)
+	assert.False(t, allowed)
+	assert.Equal(t, time.Millisecond*1500, retryAfter)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -3,8 +3,10 @@ package redis
 import (
 	"context"
 	"github.com/dtm-labs/rockscache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
 	"github.com/redis/go-redis/v9"
 	"golang.org/x/sync/errgroup"
 )
@@ -198,6 +200,7 @@ func ProcessKeysBySlot(
 }
 
 func DeleteCacheBySlot(ctx context.Context, rcClient *rockscache.Client, keys []string) error {
+	keys = datautil.Slice(keys, func(key string) string { return tenant.Key(ctx, key) })
 	switch len(keys) {
 	case 0:
 		return nil
@@ -0,0 +1,103 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+var useRefreshTokenScript = redis.NewScript(`
+local familyID = redis.call('HGET', KEYS[1], 'family_id')
+if not familyID then
+    return false
+end
+return {familyID, redis.call('HINCRBY', KEYS[1], 'used', 1)}
+`)
+
+// unuseRefreshTokenScript resets the use count of KEYS[1] only when it was used once, a second use
+// already revoked its family.
+var unuseRefreshTokenScript = redis.NewScript(`
+if redis.call('HGET', KEYS[1], 'used') ~= '1' then
+    return 0
+end
+redis.call('HSET', KEYS[1], 'used', 0)
+return 1
+`)
+
+// refreshTokenCache is shared by all tenants, a refresh request only carries the refresh token and
+// the family records the tenant of the session.
+type refreshTokenCache struct {
+	rdb redis.UniversalClient
+}
+
+func NewRefreshTokenCache(rdb redis.UniversalClient) cache.RefreshTokenCache {
+	return &refreshTokenCache{rdb: rdb}
+}
+
+func (c *refreshTokenCache) SetRefreshTokenFamily(ctx context.Context, family *cache.RefreshTokenFamily, expire time.Duration) error {
+	data, err := json.Marshal(family)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	return errs.Wrap(c.rdb.Set(ctx, cachekey.GetRefreshTokenFamilyKey(family.FamilyID), data, expire).Err())
+}
+
+func (c *refreshTokenCache) GetRefreshTokenFamily(ctx context.Context, familyID string) (*cache.RefreshTokenFamily, error) {
+	data, err := c.rdb.Get(ctx, cachekey.GetRefreshTokenFamilyKey(familyID)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, errs.Wrap(err)
+	}
+	var family cache.RefreshTokenFamily
+	if err := json.Unmarshal([]byte(data), &family); err != nil {
+		return nil, errs.WrapMsg(err, "unmarshal refresh token family failed", "familyID", familyID)
+	}
+	return &family, nil
+}
+
+func (c *refreshTokenCache) DeleteRefreshTokenFamily(ctx context.Context, familyID string) error {
+	return errs.Wrap(c.rdb.Del(ctx, cachekey.GetRefreshTokenFamilyKey(familyID)).Err())
+}
+
+func (c *refreshTokenCache) AddRefreshToken(ctx context.Context, refreshToken string, familyID string, expire time.Duration) error {
+	key := cachekey.GetRefreshTokenKey(refreshToken)
+	pipe := c.rdb.Pipeline()
+	pipe.HSet(ctx, key, "family_id", familyID, "used", 0)
+	pipe.Expire(ctx, key, expire)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errs.Wrap(err)
+	}
+	return nil
+}
+
+func (c *refreshTokenCache) UseRefreshToken(ctx context.Context, refreshToken string) (string, int64, error) {
+	res, err := useRefreshTokenScript.Run(ctx, c.rdb, []string{cachekey.GetRefreshTokenKey(refreshToken)}).Slice()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return "", 0, nil
+		}
+		return "", 0, errs.Wrap(err)
+	}
+	if len(res) != 2 {
+		return "", 0, errs.New("invalid use refresh token result", "result", res).Wrap()
+	}
+	familyID, _ := res[0].(string)
+	used, _ := res[1].(int64)
+	return familyID, used, nil
+}
+
+func (c *refreshTokenCache) UnuseRefreshToken(ctx context.Context, refreshToken string) (bool, error) {
+	res, err := unuseRefreshTokenScript.Run(ctx, c.rdb, []string{cachekey.GetRefreshTokenKey(refreshToken)}).Int64()
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	return res == 1, nil
+}
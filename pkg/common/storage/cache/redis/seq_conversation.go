@@ -8,6 +8,7 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
@@ -147,7 +148,7 @@ func (s *seqConversationCacheRedis) GetMaxSeqs(ctx context.Context, conversation
 	keys := make([]string, 0, len(conversationIDs))
 	keyConversationID := make(map[string]string, len(conversationIDs))
 	for _, conversationID := range conversationIDs {
-		key := s.getSeqMallocKey(conversationID)
+		key := s.getSeqMallocKey(ctx, conversationID)
 		if _, ok := keyConversationID[key]; ok {
 			continue
 		}
@@ -180,7 +181,7 @@ func (s *seqConversationCacheRedis) GetMaxSeqsWithTime(ctx context.Context, conv
 	keys := make([]string, 0, len(conversationIDs))
 	keyConversationID := make(map[string]string, len(conversationIDs))
 	for _, conversationID := range conversationIDs {
-		key := s.getSeqMallocKey(conversationID)
+		key := s.getSeqMallocKey(ctx, conversationID)
 		if _, ok := keyConversationID[key]; ok {
 			continue
 		}
@@ -203,8 +204,8 @@ func (s *seqConversationCacheRedis) GetMaxSeqsWithTime(ctx context.Context, conv
 	return seqs, nil
 }
 
-func (s *seqConversationCacheRedis) getSeqMallocKey(conversationID string) string {
-	return cachekey.GetMallocSeqKey(conversationID)
+func (s *seqConversationCacheRedis) getSeqMallocKey(ctx context.Context, conversationID string) string {
+	return tenant.Key(ctx, cachekey.GetMallocSeqKey(conversationID))
 }
 
 func (s *seqConversationCacheRedis) setSeq(ctx context.Context, key string, owner int64, currSeq int64, lastSeq int64, mill int64) (int64, error) {
@@ -370,7 +371,7 @@ func (s *seqConversationCacheRedis) mallocTime(ctx context.Context, conversation
 	if size < 0 {
 		return 0, 0, errs.New("size must be greater than 0")
 	}
-	key := s.getSeqMallocKey(conversationID)
+	key := s.getSeqMallocKey(ctx, conversationID)
 	for i := 0; i < 10; i++ {
 		states, err := s.malloc(ctx, key, size)
 		if err != nil {
@@ -449,7 +450,7 @@ func (s *seqConversationCacheRedis) GetCacheMaxSeqWithTime(ctx context.Context,
 	key2conversationID := make(map[string]string)
 	keys := make([]string, 0, len(conversationIDs))
 	for _, conversationID := range conversationIDs {
-		key := s.getSeqMallocKey(conversationID)
+		key := s.getSeqMallocKey(ctx, conversationID)
 		if _, ok := key2conversationID[key]; ok {
 			continue
 		}
@@ -6,6 +6,7 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/tools/errs"
 	"github.com/redis/go-redis/v9"
 	"strconv"
@@ -54,7 +55,7 @@ func (s *seqUserCacheRedis) SetUserMaxSeq(ctx context.Context, conversationID st
 	if err := s.mgo.SetUserMaxSeq(ctx, conversationID, userID, seq); err != nil {
 		return err
 	}
-	return s.rocks.TagAsDeleted2(ctx, s.getSeqUserMaxSeqKey(conversationID, userID))
+	return s.rocks.TagAsDeleted2(ctx, tenant.Key(ctx, s.getSeqUserMaxSeqKey(conversationID, userID)))
 }
 
 func (s *seqUserCacheRedis) GetUserMinSeq(ctx context.Context, conversationID string, userID string) (int64, error) {
@@ -79,7 +80,7 @@ func (s *seqUserCacheRedis) SetUserReadSeq(ctx context.Context, conversationID s
 		return err
 	}
 	if dbSeq < seq {
-		if err := s.rocks.RawSet(ctx, s.getSeqUserReadSeqKey(conversationID, userID), strconv.Itoa(int(seq)), s.readExpireTime); err != nil {
+		if err := s.rocks.RawSet(ctx, tenant.Key(ctx, s.getSeqUserReadSeqKey(conversationID, userID)), strconv.Itoa(int(seq)), s.readExpireTime); err != nil {
 			return errs.Wrap(err)
 		}
 	}
@@ -105,7 +106,7 @@ func (s *seqUserCacheRedis) setUserRedisReadSeqs(ctx context.Context, userID str
 	keys := make([]string, 0, len(seqs))
 	keySeq := make(map[string]int64)
 	for conversationID, seq := range seqs {
-		key := s.getSeqUserReadSeqKey(conversationID, userID)
+		key := tenant.Key(ctx, s.getSeqUserReadSeqKey(conversationID, userID))
 		keys = append(keys, key)
 		keySeq[key] = seq
 	}
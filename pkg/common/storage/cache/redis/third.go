@@ -18,6 +18,7 @@ import (
 	"context"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/tools/errs"
 	"github.com/redis/go-redis/v9"
 	"time"
@@ -31,28 +32,28 @@ type thirdCache struct {
 	rdb redis.UniversalClient
 }
 
-func (c *thirdCache) getGetuiTokenKey() string {
-	return cachekey.GetGetuiTokenKey()
+func (c *thirdCache) getGetuiTokenKey(ctx context.Context) string {
+	return tenant.Key(ctx, cachekey.GetGetuiTokenKey())
 }
 
-func (c *thirdCache) getGetuiTaskIDKey() string {
-	return cachekey.GetGetuiTaskIDKey()
+func (c *thirdCache) getGetuiTaskIDKey(ctx context.Context) string {
+	return tenant.Key(ctx, cachekey.GetGetuiTaskIDKey())
 }
 
-func (c *thirdCache) getUserBadgeUnreadCountSumKey(userID string) string {
-	return cachekey.GetUserBadgeUnreadCountSumKey(userID)
+func (c *thirdCache) getUserBadgeUnreadCountSumKey(ctx context.Context, userID string) string {
+	return tenant.Key(ctx, cachekey.GetUserBadgeUnreadCountSumKey(userID))
 }
 
-func (c *thirdCache) getFcmAccountTokenKey(account string, platformID int) string {
-	return cachekey.GetFcmAccountTokenKey(account, platformID)
+func (c *thirdCache) getFcmAccountTokenKey(ctx context.Context, account string, platformID int) string {
+	return tenant.Key(ctx, cachekey.GetFcmAccountTokenKey(account, platformID))
 }
 
 func (c *thirdCache) SetFcmToken(ctx context.Context, account string, platformID int, fcmToken string, expireTime int64) (err error) {
-	return errs.Wrap(c.rdb.Set(ctx, c.getFcmAccountTokenKey(account, platformID), fcmToken, time.Duration(expireTime)*time.Second).Err())
+	return errs.Wrap(c.rdb.Set(ctx, c.getFcmAccountTokenKey(ctx, account, platformID), fcmToken, time.Duration(expireTime)*time.Second).Err())
 }
 
 func (c *thirdCache) GetFcmToken(ctx context.Context, account string, platformID int) (string, error) {
-	val, err := c.rdb.Get(ctx, c.getFcmAccountTokenKey(account, platformID)).Result()
+	val, err := c.rdb.Get(ctx, c.getFcmAccountTokenKey(ctx, account, platformID)).Result()
 	if err != nil {
 		return "", errs.Wrap(err)
 	}
@@ -60,30 +61,54 @@ func (c *thirdCache) GetFcmToken(ctx context.Context, account string, platformID
 }
 
 func (c *thirdCache) DelFcmToken(ctx context.Context, account string, platformID int) error {
-	return errs.Wrap(c.rdb.Del(ctx, c.getFcmAccountTokenKey(account, platformID)).Err())
+	return errs.Wrap(c.rdb.Del(ctx, c.getFcmAccountTokenKey(ctx, account, platformID)).Err())
+}
+
+func (c *thirdCache) GetFcmTokenPlatforms(ctx context.Context, accounts []string, platformIDs []int) (map[string][]int, error) {
+	if len(accounts) == 0 || len(platformIDs) == 0 {
+		return nil, nil
+	}
+	pipe := c.rdb.Pipeline()
+	cmds := make([]*redis.IntCmd, 0, len(accounts)*len(platformIDs))
+	for _, account := range accounts {
+		for _, platformID := range platformIDs {
+			cmds = append(cmds, pipe.Exists(ctx, c.getFcmAccountTokenKey(ctx, account, platformID)))
+		}
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	platforms := make(map[string][]int)
+	for i, cmd := range cmds {
+		if cmd.Val() > 0 {
+			account := accounts[i/len(platformIDs)]
+			platforms[account] = append(platforms[account], platformIDs[i%len(platformIDs)])
+		}
+	}
+	return platforms, nil
 }
 
 func (c *thirdCache) IncrUserBadgeUnreadCountSum(ctx context.Context, userID string) (int, error) {
-	seq, err := c.rdb.Incr(ctx, c.getUserBadgeUnreadCountSumKey(userID)).Result()
+	seq, err := c.rdb.Incr(ctx, c.getUserBadgeUnreadCountSumKey(ctx, userID)).Result()
 
 	return int(seq), errs.Wrap(err)
 }
 
 func (c *thirdCache) SetUserBadgeUnreadCountSum(ctx context.Context, userID string, value int) error {
-	return errs.Wrap(c.rdb.Set(ctx, c.getUserBadgeUnreadCountSumKey(userID), value, 0).Err())
+	return errs.Wrap(c.rdb.Set(ctx, c.getUserBadgeUnreadCountSumKey(ctx, userID), value, 0).Err())
 }
 
 func (c *thirdCache) GetUserBadgeUnreadCountSum(ctx context.Context, userID string) (int, error) {
-	val, err := c.rdb.Get(ctx, c.getUserBadgeUnreadCountSumKey(userID)).Int()
+	val, err := c.rdb.Get(ctx, c.getUserBadgeUnreadCountSumKey(ctx, userID)).Int()
 	return val, errs.Wrap(err)
 }
 
 func (c *thirdCache) SetGetuiToken(ctx context.Context, token string, expireTime int64) error {
-	return errs.Wrap(c.rdb.Set(ctx, c.getGetuiTokenKey(), token, time.Duration(expireTime)*time.Second).Err())
+	return errs.Wrap(c.rdb.Set(ctx, c.getGetuiTokenKey(ctx), token, time.Duration(expireTime)*time.Second).Err())
 }
 
 func (c *thirdCache) GetGetuiToken(ctx context.Context) (string, error) {
-	val, err := c.rdb.Get(ctx, c.getGetuiTokenKey()).Result()
+	val, err := c.rdb.Get(ctx, c.getGetuiTokenKey(ctx)).Result()
 	if err != nil {
 		return "", errs.Wrap(err)
 	}
@@ -91,11 +116,11 @@ func (c *thirdCache) GetGetuiToken(ctx context.Context) (string, error) {
 }
 
 func (c *thirdCache) SetGetuiTaskID(ctx context.Context, taskID string, expireTime int64) error {
-	return errs.Wrap(c.rdb.Set(ctx, c.getGetuiTaskIDKey(), taskID, time.Duration(expireTime)*time.Second).Err())
+	return errs.Wrap(c.rdb.Set(ctx, c.getGetuiTaskIDKey(ctx), taskID, time.Duration(expireTime)*time.Second).Err())
 }
 
 func (c *thirdCache) GetGetuiTaskID(ctx context.Context) (string, error) {
-	val, err := c.rdb.Get(ctx, c.getGetuiTaskIDKey()).Result()
+	val, err := c.rdb.Get(ctx, c.getGetuiTaskIDKey(ctx)).Result()
 	if err != nil {
 		return "", errs.Wrap(err)
 	}
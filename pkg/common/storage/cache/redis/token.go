@@ -8,7 +8,9 @@ import (
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
 	"github.com/redis/go-redis/v9"
 )
 
@@ -24,12 +26,12 @@ func NewTokenCacheModel(rdb redis.UniversalClient, accessExpire int64) cache.Tok
 }
 
 func (c *tokenCache) SetTokenFlag(ctx context.Context, userID string, platformID int, token string, flag int) error {
-	return errs.Wrap(c.rdb.HSet(ctx, cachekey.GetTokenKey(userID, platformID), token, flag).Err())
+	return errs.Wrap(c.rdb.HSet(ctx, tenant.Key(ctx, cachekey.GetTokenKey(userID, platformID)), token, flag).Err())
 }
 
 // SetTokenFlagEx set token and flag with expire time
 func (c *tokenCache) SetTokenFlagEx(ctx context.Context, userID string, platformID int, token string, flag int) error {
-	key := cachekey.GetTokenKey(userID, platformID)
+	key := tenant.Key(ctx, cachekey.GetTokenKey(userID, platformID))
 	if err := c.rdb.HSet(ctx, key, token, flag).Err(); err != nil {
 		return errs.Wrap(err)
 	}
@@ -40,7 +42,7 @@ func (c *tokenCache) SetTokenFlagEx(ctx context.Context, userID string, platform
 }
 
 func (c *tokenCache) GetTokensWithoutError(ctx context.Context, userID string, platformID int) (map[string]int, error) {
-	m, err := c.rdb.HGetAll(ctx, cachekey.GetTokenKey(userID, platformID)).Result()
+	m, err := c.rdb.HGetAll(ctx, tenant.Key(ctx, cachekey.GetTokenKey(userID, platformID))).Result()
 	if err != nil {
 		return nil, errs.Wrap(err)
 	}
@@ -61,7 +63,7 @@ func (c *tokenCache) GetAllTokensWithoutError(ctx context.Context, userID string
 		resLock = sync.Mutex{}
 	)
 
-	keys := cachekey.GetAllPlatformTokenKey(userID)
+	keys := datautil.Slice(cachekey.GetAllPlatformTokenKey(userID), func(key string) string { return tenant.Key(ctx, key) })
 	if err := ProcessKeysBySlot(ctx, c.rdb, keys, func(ctx context.Context, slot int64, keys []string) error {
 		pipe := c.rdb.Pipeline()
 		mapRes := make([]*redis.MapStringStringCmd, len(keys))
@@ -97,7 +99,7 @@ func (c *tokenCache) SetTokenMapByUidPid(ctx context.Context, userID string, pla
 	for k, v := range m {
 		mm[k] = v
 	}
-	return errs.Wrap(c.rdb.HSet(ctx, cachekey.GetTokenKey(userID, platformID), mm).Err())
+	return errs.Wrap(c.rdb.HSet(ctx, tenant.Key(ctx, cachekey.GetTokenKey(userID, platformID)), mm).Err())
 }
 
 func (c *tokenCache) BatchSetTokenMapByUidPid(ctx context.Context, tokens map[string]map[string]any) error {
@@ -113,7 +115,7 @@ func (c *tokenCache) BatchSetTokenMapByUidPid(ctx context.Context, tokens map[st
 }
 
 func (c *tokenCache) DeleteTokenByUidPid(ctx context.Context, userID string, platformID int, fields []string) error {
-	return errs.Wrap(c.rdb.HDel(ctx, cachekey.GetTokenKey(userID, platformID), fields...).Err())
+	return errs.Wrap(c.rdb.HDel(ctx, tenant.Key(ctx, cachekey.GetTokenKey(userID, platformID)), fields...).Err())
 }
 
 func (c *tokenCache) getExpireTime(t int64) time.Duration {
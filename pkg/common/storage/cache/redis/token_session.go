@@ -0,0 +1,91 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+var updateTokenSessionScript = redis.NewScript(`
+if redis.call('HEXISTS', KEYS[1], ARGV[1]) == 0 then
+    return 0
+end
+redis.call('HSET', KEYS[1], ARGV[1], ARGV[2])
+return 1
+`)
+
+type tokenSessionCache struct {
+	rdb redis.UniversalClient
+}
+
+func NewTokenSessionCache(rdb redis.UniversalClient) cache.TokenSessionCache {
+	return &tokenSessionCache{rdb: rdb}
+}
+
+func (c *tokenSessionCache) AddTokenSession(ctx context.Context, userID string, sessionID string, session *cache.TokenSession, expire time.Duration) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	key := tenant.Key(ctx, cachekey.GetTokenSessionKey(userID))
+	pipe := c.rdb.Pipeline()
+	pipe.HSet(ctx, key, sessionID, data)
+	pipe.Expire(ctx, key, expire)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errs.Wrap(err)
+	}
+	return nil
+}
+
+func (c *tokenSessionCache) UpdateTokenSession(ctx context.Context, userID string, sessionID string, session *cache.TokenSession) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	return errs.Wrap(updateTokenSessionScript.Run(ctx, c.rdb, []string{tenant.Key(ctx, cachekey.GetTokenSessionKey(userID))}, sessionID, data).Err())
+}
+
+func (c *tokenSessionCache) GetTokenSession(ctx context.Context, userID string, sessionID string) (*cache.TokenSession, error) {
+	data, err := c.rdb.HGet(ctx, tenant.Key(ctx, cachekey.GetTokenSessionKey(userID)), sessionID).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, errs.Wrap(err)
+	}
+	var session cache.TokenSession
+	if err := json.Unmarshal([]byte(data), &session); err != nil {
+		return nil, errs.WrapMsg(err, "unmarshal token session failed", "userID", userID, "sessionID", sessionID)
+	}
+	return &session, nil
+}
+
+func (c *tokenSessionCache) GetTokenSessions(ctx context.Context, userID string) (map[string]*cache.TokenSession, error) {
+	m, err := c.rdb.HGetAll(ctx, tenant.Key(ctx, cachekey.GetTokenSessionKey(userID))).Result()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	sessions := make(map[string]*cache.TokenSession, len(m))
+	for sessionID, data := range m {
+		var session cache.TokenSession
+		if err := json.Unmarshal([]byte(data), &session); err != nil {
+			return nil, errs.WrapMsg(err, "unmarshal token session failed", "userID", userID, "sessionID", sessionID)
+		}
+		sessions[sessionID] = &session
+	}
+	return sessions, nil
+}
+
+func (c *tokenSessionCache) DeleteTokenSessions(ctx context.Context, userID string, sessionIDs []string) error {
+	if len(sessionIDs) == 0 {
+		return nil
+	}
+	return errs.Wrap(c.rdb.HDel(ctx, tenant.Key(ctx, cachekey.GetTokenSessionKey(userID)), sessionIDs...).Err())
+}
@@ -0,0 +1,50 @@
+package redis
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenCacheTenantIsolation(t *testing.T) {
+	rdb, mock := redismock.NewClientMock()
+	c := NewTokenCacheModel(rdb, 1)
+	ctxA := tenant.WithTenantID(context.Background(), "a")
+	ctxB := tenant.WithTenantID(context.Background(), "b")
+	key := cachekey.GetTokenKey("user1", constant.IOSPlatformID)
+
+	mock.ExpectHSet("TENANT:a:"+key, "token1", constant.NormalToken).SetVal(1)
+	require.NoError(t, c.SetTokenFlag(ctxA, "user1", constant.IOSPlatformID, "token1", constant.NormalToken))
+
+	mock.ExpectHGetAll("TENANT:b:" + key).SetVal(map[string]string{})
+	tokens, err := c.GetTokensWithoutError(ctxB, "user1", constant.IOSPlatformID)
+	require.NoError(t, err)
+	assert.Empty(t, tokens)
+
+	mock.ExpectHGetAll("TENANT:a:" + key).SetVal(map[string]string{"token1": strconv.Itoa(constant.NormalToken)})
+	tokens, err = c.GetTokensWithoutError(ctxA, "user1", constant.IOSPlatformID)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"token1": constant.NormalToken}, tokens)
+
+	mock.ExpectHDel("TENANT:b:"+key, "token1").SetVal(0)
+	require.NoError(t, c.DeleteTokenByUidPid(ctxB, "user1", constant.IOSPlatformID, []string{"token1"}))
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTokenCacheDefaultTenant(t *testing.T) {
+	rdb, mock := redismock.NewClientMock()
+	c := NewTokenCacheModel(rdb, 1)
+	key := cachekey.GetTokenKey("user1", constant.IOSPlatformID)
+
+	mock.ExpectHSet(key, "token1", constant.NormalToken).SetVal(1)
+	require.NoError(t, c.SetTokenFlag(context.Background(), "user1", constant.IOSPlatformID, "token1", constant.NormalToken))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,52 @@
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/dtm-labs/rockscache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/redis/go-redis/v9"
+)
+
+const userFieldExpireTime = time.Hour * 12
+
+type UserFieldCacheRedis struct {
+	cache.BatchDeleter
+	db         database.UserField
+	expireTime time.Duration
+	rcClient   *rockscache.Client
+}
+
+func NewUserFieldCacheRedis(rdb redis.UniversalClient, db database.UserField, options *rockscache.Options) cache.UserFieldCache {
+	return &UserFieldCacheRedis{
+		BatchDeleter: NewBatchDeleterRedis(rdb, options, nil),
+		db:           db,
+		expireTime:   userFieldExpireTime,
+		rcClient:     rockscache.NewClient(rdb, *options),
+	}
+}
+
+func (u *UserFieldCacheRedis) CloneUserFieldCache() cache.UserFieldCache {
+	return &UserFieldCacheRedis{
+		BatchDeleter: u.BatchDeleter.Clone(),
+		db:           u.db,
+		expireTime:   u.expireTime,
+		rcClient:     u.rcClient,
+	}
+}
+
+func (u *UserFieldCacheRedis) GetUserFields(ctx context.Context) ([]*model.UserField, error) {
+	return getCache(ctx, u.rcClient, cachekey.GetUserFieldKey(), u.expireTime, func(ctx context.Context) ([]*model.UserField, error) {
+		return u.db.FindAll(ctx)
+	})
+}
+
+func (u *UserFieldCacheRedis) DelUserFields() cache.UserFieldCache {
+	c := u.CloneUserFieldCache()
+	c.AddKeys(cachekey.GetUserFieldKey())
+	return c
+}
@@ -0,0 +1,109 @@
+package redis
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dtm-labs/rockscache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const userPrivacyExpireTime = time.Hour * 12
+
+type UserLastSeenCacheRedis struct {
+	cache.BatchDeleter
+	rdb        redis.UniversalClient
+	db         database.UserPrivacy
+	expireTime time.Duration
+	rcClient   *rockscache.Client
+}
+
+func NewUserLastSeenCacheRedis(rdb redis.UniversalClient, db database.UserPrivacy, options *rockscache.Options) cache.UserLastSeenCache {
+	return &UserLastSeenCacheRedis{
+		BatchDeleter: NewBatchDeleterRedis(rdb, options, nil),
+		rdb:          rdb,
+		db:           db,
+		expireTime:   userPrivacyExpireTime,
+		rcClient:     rockscache.NewClient(rdb, *options),
+	}
+}
+
+func (u *UserLastSeenCacheRedis) CloneUserLastSeenCache() cache.UserLastSeenCache {
+	return &UserLastSeenCacheRedis{
+		BatchDeleter: u.BatchDeleter.Clone(),
+		rdb:          u.rdb,
+		db:           u.db,
+		expireTime:   u.expireTime,
+		rcClient:     u.rcClient,
+	}
+}
+
+func (u *UserLastSeenCacheRedis) getUserPrivacyKey(userID string) string {
+	return cachekey.GetUserPrivacyKey(userID)
+}
+
+func (u *UserLastSeenCacheRedis) getUserID(privacy *model.UserPrivacy) string {
+	return privacy.UserID
+}
+
+func (u *UserLastSeenCacheRedis) GetLastSeen(ctx context.Context, userIDs []string) (map[string]int64, error) {
+	if len(userIDs) == 0 {
+		return map[string]int64{}, nil
+	}
+	keys := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		keys = append(keys, tenant.Key(ctx, cachekey.GetUserLastSeenKey(userID)))
+	}
+	var (
+		res  = make(map[string]int64, len(userIDs))
+		lock sync.Mutex
+	)
+	err := ProcessKeysBySlot(ctx, u.rdb, keys, func(ctx context.Context, slot int64, keys []string) error {
+		values, err := u.rdb.MGet(ctx, keys...).Result()
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		lock.Lock()
+		defer lock.Unlock()
+		for i, value := range values {
+			str, ok := value.(string)
+			if !ok {
+				continue
+			}
+			lastSeen, err := strconv.ParseInt(str, 10, 64)
+			if err != nil {
+				return errs.WrapMsg(err, "redis last seen value is not int", "key", keys[i], "value", str)
+			}
+			_, key := tenant.SplitKey(keys[i])
+			res[strings.TrimPrefix(key, cachekey.UserLastSeenKey)] = lastSeen
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (u *UserLastSeenCacheRedis) GetUsersPrivacy(ctx context.Context, userIDs []string) ([]*model.UserPrivacy, error) {
+	return batchGetCache2(ctx, u.rcClient, u.expireTime, userIDs, u.getUserPrivacyKey, u.getUserID, u.db.Find)
+}
+
+func (u *UserLastSeenCacheRedis) DelUsersPrivacy(userIDs ...string) cache.UserLastSeenCache {
+	keys := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		keys = append(keys, u.getUserPrivacyKey(userID))
+	}
+	c := u.CloneUserLastSeenCache()
+	c.AddKeys(keys...)
+	return c
+}
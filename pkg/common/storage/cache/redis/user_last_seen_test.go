@@ -0,0 +1,41 @@
+package redis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// anyArgs matches the commands by their leading arguments, the others carry the current time.
+func anyArgs(expected, actual []any) error {
+	for i := 0; i < len(expected) && i < 4; i++ {
+		if _, ok := expected[i].(int); !ok && expected[i] != actual[i] {
+			return assert.AnError
+		}
+	}
+	return nil
+}
+
+func TestSetUserOnlineRecordsLastSeen(t *testing.T) {
+	rdb, mock := redismock.NewClientMock()
+	mock.MatchExpectationsInOrder(true)
+	c := NewUserOnline(rdb)
+	ctx := context.Background()
+
+	// The last platform goes offline, the last-seen time is written before the change is published.
+	mock.CustomMatch(anyArgs).ExpectEval(setUserOnlineScript, []string{cachekey.GetOnlineKey("user1")}, 0, 0, 0, 0, 0).SetVal([]any{"1"})
+	mock.CustomMatch(anyArgs).ExpectSet(cachekey.GetUserLastSeenKey("user1"), 0, 0).SetVal("OK")
+	mock.ExpectPublish(cachekey.OnlineChannel, "user1").SetVal(1)
+	require.NoError(t, c.SetUserOnline(ctx, "user1", nil, []int32{1}))
+
+	// Another platform is still online, the user is not offline yet.
+	mock.CustomMatch(anyArgs).ExpectEval(setUserOnlineScript, []string{cachekey.GetOnlineKey("user1")}, 0, 0, 0, 0, 0).SetVal([]any{"2", "1"})
+	mock.ExpectPublish(cachekey.OnlineChannel, "2:user1").SetVal(1)
+	require.NoError(t, c.SetUserOnline(ctx, "user1", nil, []int32{1}))
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
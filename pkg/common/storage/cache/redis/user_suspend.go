@@ -0,0 +1,62 @@
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/dtm-labs/rockscache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/redis/go-redis/v9"
+)
+
+const userSuspendExpireTime = time.Hour * 12
+
+type UserSuspendCacheRedis struct {
+	cache.BatchDeleter
+	db         database.UserSuspend
+	expireTime time.Duration
+	rcClient   *rockscache.Client
+}
+
+func NewUserSuspendCacheRedis(rdb redis.UniversalClient, db database.UserSuspend, options *rockscache.Options) cache.UserSuspendCache {
+	return &UserSuspendCacheRedis{
+		BatchDeleter: NewBatchDeleterRedis(rdb, options, nil),
+		db:           db,
+		expireTime:   userSuspendExpireTime,
+		rcClient:     rockscache.NewClient(rdb, *options),
+	}
+}
+
+func (u *UserSuspendCacheRedis) CloneUserSuspendCache() cache.UserSuspendCache {
+	return &UserSuspendCacheRedis{
+		BatchDeleter: u.BatchDeleter.Clone(),
+		db:           u.db,
+		expireTime:   u.expireTime,
+		rcClient:     u.rcClient,
+	}
+}
+
+func (u *UserSuspendCacheRedis) getUserSuspendKey(userID string) string {
+	return cachekey.GetUserSuspendKey(userID)
+}
+
+func (u *UserSuspendCacheRedis) getUserID(suspend *model.UserSuspend) string {
+	return suspend.UserID
+}
+
+func (u *UserSuspendCacheRedis) GetUsersSuspend(ctx context.Context, userIDs []string) ([]*model.UserSuspend, error) {
+	return batchGetCache2(ctx, u.rcClient, u.expireTime, userIDs, u.getUserSuspendKey, u.getUserID, u.db.Find)
+}
+
+func (u *UserSuspendCacheRedis) DelUsersSuspend(userIDs ...string) cache.UserSuspendCache {
+	keys := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		keys = append(keys, u.getUserSuspendKey(userID))
+	}
+	c := u.CloneUserSuspendCache()
+	c.AddKeys(keys...)
+	return c
+}
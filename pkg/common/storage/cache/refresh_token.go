@@ -0,0 +1,31 @@
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// RefreshTokenFamily is the chain of refresh tokens rotated from one login.
+type RefreshTokenFamily struct {
+	FamilyID    string `json:"familyID"`
+	UserID      string `json:"userID"`
+	PlatformID  int    `json:"platformID"`
+	AccessToken string `json:"accessToken"`
+	// TenantID is the tenant of the session, the refresh request carries no other proof of it.
+	TenantID string `json:"tenantID,omitempty"`
+}
+
+type RefreshTokenCache interface {
+	// SetRefreshTokenFamily stores the family and extends its lifetime to expire.
+	SetRefreshTokenFamily(ctx context.Context, family *RefreshTokenFamily, expire time.Duration) error
+	// GetRefreshTokenFamily returns nil if the family does not exist.
+	GetRefreshTokenFamily(ctx context.Context, familyID string) (*RefreshTokenFamily, error)
+	DeleteRefreshTokenFamily(ctx context.Context, familyID string) error
+	AddRefreshToken(ctx context.Context, refreshToken string, familyID string, expire time.Duration) error
+	// UseRefreshToken marks the refresh token as used and returns its family and how often it has
+	// been used, including this time. An empty familyID means the refresh token does not exist.
+	UseRefreshToken(ctx context.Context, refreshToken string) (familyID string, used int64, err error)
+	// UnuseRefreshToken takes back the single use of the refresh token, it returns false when the
+	// refresh token was used again meanwhile or does not exist.
+	UnuseRefreshToken(ctx context.Context, refreshToken string) (bool, error)
+}
@@ -8,6 +8,8 @@ type ThirdCache interface {
 	SetFcmToken(ctx context.Context, account string, platformID int, fcmToken string, expireTime int64) (err error)
 	GetFcmToken(ctx context.Context, account string, platformID int) (string, error)
 	DelFcmToken(ctx context.Context, account string, platformID int) error
+	// GetFcmTokenPlatforms returns the platforms, among platformIDs, each account holds a token on.
+	GetFcmTokenPlatforms(ctx context.Context, accounts []string, platformIDs []int) (map[string][]int, error)
 	IncrUserBadgeUnreadCountSum(ctx context.Context, userID string) (int, error)
 	SetUserBadgeUnreadCountSum(ctx context.Context, userID string, value int) error
 	GetUserBadgeUnreadCountSum(ctx context.Context, userID string) (int, error)
@@ -0,0 +1,27 @@
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// TokenSession describes the device a token was issued to.
+type TokenSession struct {
+	Token        string `json:"token"`
+	PlatformID   int    `json:"platformID"`
+	CreateTime   int64  `json:"createTime"`
+	LastSeenIP   string `json:"lastSeenIP"`
+	LastSeenTime int64  `json:"lastSeenTime"`
+	DeviceName   string `json:"deviceName"`
+}
+
+type TokenSessionCache interface {
+	// AddTokenSession stores a session and extends the lifetime of all sessions of the user to expire.
+	AddTokenSession(ctx context.Context, userID string, sessionID string, session *TokenSession, expire time.Duration) error
+	// UpdateTokenSession overwrites an existing session, a session that no longer exists is not recreated.
+	UpdateTokenSession(ctx context.Context, userID string, sessionID string, session *TokenSession) error
+	// GetTokenSession returns nil if the session does not exist.
+	GetTokenSession(ctx context.Context, userID string, sessionID string) (*TokenSession, error)
+	GetTokenSessions(ctx context.Context, userID string) (map[string]*TokenSession, error)
+	DeleteTokenSessions(ctx context.Context, userID string, sessionIDs []string) error
+}
@@ -0,0 +1,14 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type UserFieldCache interface {
+	BatchDeleter
+	CloneUserFieldCache() UserFieldCache
+	GetUserFields(ctx context.Context) ([]*model.UserField, error)
+	DelUserFields() UserFieldCache
+}
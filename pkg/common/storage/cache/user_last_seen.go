@@ -0,0 +1,17 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type UserLastSeenCache interface {
+	BatchDeleter
+	CloneUserLastSeenCache() UserLastSeenCache
+	// GetLastSeen returns the last-seen times keyed by userID, users never seen are omitted.
+	// The times are written by OnlineCache.SetUserOnline and live only in redis.
+	GetLastSeen(ctx context.Context, userIDs []string) (map[string]int64, error)
+	GetUsersPrivacy(ctx context.Context, userIDs []string) ([]*model.UserPrivacy, error)
+	DelUsersPrivacy(userIDs ...string) UserLastSeenCache
+}
@@ -0,0 +1,15 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type UserSuspendCache interface {
+	BatchDeleter
+	CloneUserSuspendCache() UserSuspendCache
+	// GetUsersSuspend returns the stored suspensions, expired ones included.
+	GetUsersSuspend(ctx context.Context, userIDs []string) ([]*model.UserSuspend, error)
+	DelUsersSuspend(userIDs ...string) UserSuspendCache
+}
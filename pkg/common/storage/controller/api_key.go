@@ -0,0 +1,138 @@
+package controller
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+)
+
+const apiKeyRateWindow = time.Minute
+
+type ApiKeyDatabase interface {
+	// Create fills in the ID and secret hash of key, stores it and returns the secret.
+	Create(ctx context.Context, key *model.ApiKey) (string, error)
+	Update(ctx context.Context, keyID string, data map[string]any) error
+	// Rotate replaces the secret of the key. The old secret stays valid for grace, so the
+	// integration can switch to the new one without downtime.
+	Rotate(ctx context.Context, keyID string, grace time.Duration) (string, error)
+	Delete(ctx context.Context, keyIDs []string) error
+	// Get returns nil if the key does not exist.
+	Get(ctx context.Context, keyID string) (*model.ApiKey, error)
+	Page(ctx context.Context, pagination pagination.Pagination) (int64, []*model.ApiKey, error)
+	// Verify returns the key if it exists, has not expired and secret is one of its valid secrets.
+	Verify(ctx context.Context, keyID string, secret string, now time.Time) (*model.ApiKey, error)
+	// Allow counts a request of the key and reports whether it is within the key's rate limit.
+	Allow(ctx context.Context, key *model.ApiKey, now time.Time) (bool, error)
+}
+
+func NewApiKeyDatabase(db database.ApiKey, cache cache.ApiKeyCache) ApiKeyDatabase {
+	return &apiKeyDatabase{db: db, cache: cache}
+}
+
+type apiKeyDatabase struct {
+	db    database.ApiKey
+	cache cache.ApiKeyCache
+}
+
+func (a *apiKeyDatabase) Create(ctx context.Context, key *model.ApiKey) (string, error) {
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return "", errs.Wrap(err)
+	}
+	secret, err := authverify.GenerateApiKeySecret()
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
+	key.KeyID = hex.EncodeToString(id)
+	key.SecretHash = authverify.HashApiKeySecret(secret)
+	key.CreateTime = now
+	key.UpdateTime = now
+	if err := a.db.Create(ctx, key); err != nil {
+		return "", err
+	}
+	return secret, nil
+}
+
+func (a *apiKeyDatabase) Update(ctx context.Context, keyID string, data map[string]any) error {
+	data["update_time"] = time.Now()
+	if err := a.db.Update(ctx, keyID, data); err != nil {
+		return err
+	}
+	return a.cache.DelApiKeys(keyID).ChainExecDel(ctx)
+}
+
+func (a *apiKeyDatabase) Rotate(ctx context.Context, keyID string, grace time.Duration) (string, error) {
+	key, err := a.Get(ctx, keyID)
+	if err != nil {
+		return "", err
+	}
+	if key == nil || key.TenantID != tenant.GetTenantID(ctx) {
+		return "", errs.ErrRecordNotFound.WrapMsg("api key not found", "keyID", keyID)
+	}
+	secret, err := authverify.GenerateApiKeySecret()
+	if err != nil {
+		return "", err
+	}
+	err = a.Update(ctx, keyID, map[string]any{
+		"secret_hash":      authverify.HashApiKeySecret(secret),
+		"prev_secret_hash": key.SecretHash,
+		"prev_expire_time": time.Now().Add(grace),
+	})
+	if err != nil {
+		return "", err
+	}
+	return secret, nil
+}
+
+func (a *apiKeyDatabase) Delete(ctx context.Context, keyIDs []string) error {
+	if err := a.db.Delete(ctx, keyIDs); err != nil {
+		return err
+	}
+	return a.cache.DelApiKeys(keyIDs...).ChainExecDel(ctx)
+}
+
+func (a *apiKeyDatabase) Get(ctx context.Context, keyID string) (*model.ApiKey, error) {
+	return a.cache.GetApiKey(ctx, keyID)
+}
+
+func (a *apiKeyDatabase) Page(ctx context.Context, pagination pagination.Pagination) (int64, []*model.ApiKey, error) {
+	return a.db.Page(ctx, pagination)
+}
+
+func (a *apiKeyDatabase) Verify(ctx context.Context, keyID string, secret string, now time.Time) (*model.ApiKey, error) {
+	key, err := a.Get(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil || key.IsExpired(now) {
+		return nil, nil
+	}
+	if authverify.ApiKeySecretMatch(secret, key.SecretHash) {
+		return key, nil
+	}
+	if now.Before(key.PrevExpireTime) && authverify.ApiKeySecretMatch(secret, key.PrevSecretHash) {
+		return key, nil
+	}
+	return nil, nil
+}
+
+func (a *apiKeyDatabase) Allow(ctx context.Context, key *model.ApiKey, now time.Time) (bool, error) {
+	if key.RateLimit <= 0 {
+		return true, nil
+	}
+	count, err := a.cache.IncrApiKeyRequests(ctx, key.KeyID, now, apiKeyRateWindow)
+	if err != nil {
+		return false, err
+	}
+	return count <= key.RateLimit, nil
+}
@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/openimsdk/open-im-server/v3/pkg/tools/batcher"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/log"
+)
+
+const auditLogPutTimeout = time.Second
+
+type AuditLogDatabase interface {
+	// Record queues the log, logs are written in batches in the background.
+	Record(ctx context.Context, auditLog *model.AuditLog)
+	Search(ctx context.Context, filter *database.AuditLogFilter, pagination pagination.Pagination) (int64, []*model.AuditLog, error)
+	// DeleteBefore removes the logs created before t and returns how many were removed.
+	DeleteBefore(ctx context.Context, t time.Time) (int64, error)
+}
+
+func NewAuditLogDatabase(db database.AuditLog) (AuditLogDatabase, error) {
+	a := &auditLogDatabase{db: db}
+	a.batcher = batcher.New[model.AuditLog](batcher.WithWorker(1))
+	a.batcher.Sharding = func(string) int { return 0 }
+	// The logs of each tenant are batched apart, they are written to the collection of their tenant.
+	a.batcher.Key = func(auditLog *model.AuditLog) string { return auditLog.TenantID }
+	a.batcher.Do = a.write
+	if err := a.batcher.Start(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+type auditLogDatabase struct {
+	db      database.AuditLog
+	batcher *batcher.Batcher[model.AuditLog]
+}
+
+func (a *auditLogDatabase) Record(ctx context.Context, auditLog *model.AuditLog) {
+	auditLog.TenantID = tenant.GetTenantID(ctx)
+	// The request context may be canceled as soon as the response is written.
+	putCtx, cancel := context.WithTimeout(context.Background(), auditLogPutTimeout)
+	defer cancel()
+	if err := a.batcher.Put(putCtx, auditLog); err != nil {
+		log.ZError(ctx, "queue audit log failed", err, "action", auditLog.Action, "operatorUserID", auditLog.OperatorUserID)
+	}
+}
+
+func (a *auditLogDatabase) write(ctx context.Context, _ int, msg *batcher.Msg[model.AuditLog]) {
+	ctx = tenant.WithTenantID(ctx, msg.Key())
+	if err := a.db.Create(ctx, msg.Val()); err != nil {
+		log.ZError(ctx, "write audit logs failed", err, "count", len(msg.Val()))
+	}
+}
+
+func (a *auditLogDatabase) Search(ctx context.Context, filter *database.AuditLogFilter, pagination pagination.Pagination) (int64, []*model.AuditLog, error) {
+	return a.db.Search(ctx, filter, pagination)
+}
+
+func (a *auditLogDatabase) DeleteBefore(ctx context.Context, t time.Time) (int64, error) {
+	return a.db.DeleteBefore(ctx, t)
+}
@@ -0,0 +1,54 @@
+package controller
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+)
+
+type tenantAuditLogDB struct {
+	database.AuditLog
+	lock sync.Mutex
+	logs map[string][]string
+}
+
+func (t *tenantAuditLogDB) Create(ctx context.Context, logs []*model.AuditLog) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	tenantID := tenant.GetTenantID(ctx)
+	for _, auditLog := range logs {
+		t.logs[tenantID] = append(t.logs[tenantID], auditLog.Action)
+	}
+	return nil
+}
+
+func TestAuditLogTenants(t *testing.T) {
+	db := &tenantAuditLogDB{logs: make(map[string][]string)}
+	a, err := NewAuditLogDatabase(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.Record(tenant.WithTenantID(context.Background(), "a"), &model.AuditLog{Action: "a1"})
+	a.Record(tenant.WithTenantID(context.Background(), "b"), &model.AuditLog{Action: "b1"})
+	a.Record(context.Background(), &model.AuditLog{Action: "default1"})
+	a.Record(tenant.WithTenantID(context.Background(), "a"), &model.AuditLog{Action: "a2"})
+	a.(*auditLogDatabase).batcher.Close()
+
+	db.lock.Lock()
+	defer db.lock.Unlock()
+	expected := map[string][]string{"a": {"a1", "a2"}, "b": {"b1"}, "": {"default1"}}
+	for tenantID, actions := range expected {
+		if len(db.logs[tenantID]) != len(actions) {
+			t.Fatalf("tenant %q: expected %v, got %v", tenantID, actions, db.logs[tenantID])
+		}
+		for i, action := range actions {
+			if db.logs[tenantID][i] != action {
+				t.Fatalf("tenant %q: expected %v, got %v", tenantID, actions, db.logs[tenantID])
+			}
+		}
+	}
+}
@@ -2,16 +2,18 @@ package controller
 
 import (
 	"context"
+	"time"
 
-	"github.com/golang-jwt/jwt/v4"
 	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
-	"github.com/openimsdk/tools/tokenverify"
+	"github.com/openimsdk/tools/utils/datautil"
 )
 
 type AuthDatabase interface {
@@ -19,10 +21,15 @@ type AuthDatabase interface {
 	GetTokensWithoutError(ctx context.Context, userID string, platformID int) (map[string]int, error)
 	// Create token
 	CreateToken(ctx context.Context, userID string, platformID int) (string, error)
+	// RefreshToken replaces the token of a session with a new one. The old token does not count
+	// against the multi-login policy, so a session is never kicked by its own refresh.
+	RefreshToken(ctx context.Context, oldToken string) (string, error)
 
 	BatchSetTokenMapByUidPid(ctx context.Context, tokens []string) error
 
 	SetTokenMapByUidPid(ctx context.Context, userID string, platformID int, m map[string]int) error
+	// KickUserTokens marks the tokens of every platform of the user as kicked, in one write.
+	KickUserTokens(ctx context.Context, userID string) error
 }
 
 type multiLoginConfig struct {
@@ -32,21 +39,42 @@ type multiLoginConfig struct {
 
 type authDatabase struct {
 	cache        cache.TokenModel
-	accessSecret string
-	accessExpire int64
+	session      cache.TokenSessionCache
+	keySet       *authverify.KeySet
+	accessTTL    time.Duration
+	refreshTTL   time.Duration
 	multiLogin   multiLoginConfig
 	adminUserIDs []string
+	// tenantMultiLogin overrides multiLogin for the tenant of the request.
+	tenantMultiLogin map[string]multiLoginConfig
 }
 
-func NewAuthDatabase(cache cache.TokenModel, accessSecret string, accessExpire int64, multiLogin config.MultiLogin, adminUserIDs []string) AuthDatabase {
-	return &authDatabase{cache: cache, accessSecret: accessSecret, accessExpire: accessExpire, multiLogin: multiLoginConfig{
+func NewAuthDatabase(cache cache.TokenModel, session cache.TokenSessionCache, keySet *authverify.KeySet, tokenPolicy config.TokenPolicy, multiLogin config.MultiLogin, adminUserIDs []string, tenants []config.Tenant) AuthDatabase {
+	tenantMultiLogin := make(map[string]multiLoginConfig)
+	for _, tenant := range tenants {
+		if tenant.MultiLogin != nil {
+			tenantMultiLogin[tenant.ID] = multiLoginConfig{
+				Policy:       tenant.MultiLogin.Policy,
+				MaxNumOneEnd: tenant.MultiLogin.MaxNumOneEnd,
+			}
+		}
+	}
+	return &authDatabase{cache: cache, session: session, keySet: keySet, accessTTL: tokenPolicy.AccessTTL(), refreshTTL: tokenPolicy.RefreshTTL(), multiLogin: multiLoginConfig{
 		Policy:       multiLogin.Policy,
 		MaxNumOneEnd: multiLogin.MaxNumOneEnd,
 	},
-		adminUserIDs: adminUserIDs,
+		adminUserIDs:     adminUserIDs,
+		tenantMultiLogin: tenantMultiLogin,
 	}
 }
 
+func (a *authDatabase) getMultiLogin(ctx context.Context) multiLoginConfig {
+	if multiLogin, ok := a.tenantMultiLogin[tenant.GetTenantID(ctx)]; ok {
+		return multiLogin
+	}
+	return a.multiLogin
+}
+
 // If the result is empty.
 func (a *authDatabase) GetTokensWithoutError(ctx context.Context, userID string, platformID int) (map[string]int, error) {
 	return a.cache.GetTokensWithoutError(ctx, userID, platformID)
@@ -59,11 +87,12 @@ func (a *authDatabase) SetTokenMapByUidPid(ctx context.Context, userID string, p
 func (a *authDatabase) BatchSetTokenMapByUidPid(ctx context.Context, tokens []string) error {
 	setMap := make(map[string]map[string]any)
 	for _, token := range tokens {
-		claims, err := tokenverify.GetClaimFromToken(token, authverify.Secret(a.accessSecret))
-		key := cachekey.GetTokenKey(claims.UserID, claims.PlatformID)
-		if err != nil {
+		claims, err := authverify.GetClaimIgnoreExpire(token, a.keySet.Keyfunc)
+		// A tenant can only kick the tokens it issued.
+		if err != nil || claims.TenantID != tenant.GetTenantID(ctx) {
 			continue
 		} else {
+			key := tenant.Key(ctx, cachekey.GetTokenKey(claims.UserID, claims.PlatformID))
 			if v, ok := setMap[key]; ok {
 				v[token] = constant.KickedToken
 			} else {
@@ -79,6 +108,28 @@ func (a *authDatabase) BatchSetTokenMapByUidPid(ctx context.Context, tokens []st
 	return nil
 }
 
+func (a *authDatabase) KickUserTokens(ctx context.Context, userID string) error {
+	tokens, err := a.cache.GetAllTokensWithoutError(ctx, userID)
+	if err != nil {
+		return err
+	}
+	setMap := make(map[string]map[string]any)
+	for platformID, m := range tokens {
+		if len(m) == 0 {
+			continue
+		}
+		kicked := make(map[string]any, len(m))
+		for token := range m {
+			kicked[token] = constant.KickedToken
+		}
+		setMap[tenant.Key(ctx, cachekey.GetTokenKey(userID, platformID))] = kicked
+	}
+	if len(setMap) == 0 {
+		return nil
+	}
+	return a.cache.BatchSetTokenMapByUidPid(ctx, setMap)
+}
+
 // Create Token.
 func (a *authDatabase) CreateToken(ctx context.Context, userID string, platformID int) (string, error) {
 	isAdmin := authverify.IsManagerUserID(userID, a.adminUserIDs)
@@ -87,37 +138,23 @@ func (a *authDatabase) CreateToken(ctx context.Context, userID string, platformI
 		if err != nil {
 			return "", err
 		}
-
-		deleteTokenKey, kickedTokenKey, err := a.checkToken(ctx, tokens, platformID)
-		if err != nil {
+		if err := a.applyMultiLogin(ctx, userID, platformID, tokens); err != nil {
 			return "", err
 		}
-		if len(deleteTokenKey) != 0 {
-			err = a.cache.DeleteTokenByUidPid(ctx, userID, platformID, deleteTokenKey)
-			if err != nil {
-				return "", err
-			}
-		}
-		if len(kickedTokenKey) != 0 {
-			for _, k := range kickedTokenKey {
-				err := a.cache.SetTokenFlagEx(ctx, userID, platformID, k, constant.KickedToken)
-				if err != nil {
-					return "", err
-				}
-				log.ZDebug(ctx, "kicked token in create token", "token", k)
-			}
-		}
 	}
 
-	claims := tokenverify.BuildClaims(userID, platformID, a.accessExpire)
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(a.accessSecret))
+	claims := authverify.BuildClaims(userID, platformID, a.accessTTL, tenant.GetTenantID(ctx))
+	tokenString, err := a.keySet.Sign(claims)
 	if err != nil {
-		return "", errs.WrapMsg(err, "token.SignedString")
+		return "", err
 	}
 
 	if !isAdmin {
-		if err = a.cache.SetTokenFlagEx(ctx, userID, platformID, tokenString, constant.NormalToken); err != nil {
+		session := &cache.TokenSession{
+			PlatformID: platformID,
+			CreateTime: time.Now().UnixMilli(),
+		}
+		if err := a.saveToken(ctx, userID, tokenString, session); err != nil {
 			return "", err
 		}
 	}
@@ -125,6 +162,100 @@ func (a *authDatabase) CreateToken(ctx context.Context, userID string, platformI
 	return tokenString, nil
 }
 
+func (a *authDatabase) RefreshToken(ctx context.Context, oldToken string) (string, error) {
+	claims, err := authverify.GetClaimIgnoreExpire(oldToken, a.keySet.Keyfunc)
+	if err != nil {
+		return "", err
+	}
+	if authverify.IsManagerUserID(claims.UserID, a.adminUserIDs) {
+		return "", errs.ErrNoPermission.WrapMsg("admin token can not be refreshed")
+	}
+	ctx = tenant.WithTenantID(ctx, claims.TenantID)
+	tokens, err := a.cache.GetAllTokensWithoutError(ctx, claims.UserID)
+	if err != nil {
+		return "", err
+	}
+	switch status, ok := tokens[claims.PlatformID][oldToken]; {
+	case !ok || !authverify.IssuedTokenAlive(oldToken, a.refreshTTL):
+		return "", servererrs.ErrTokenNotExist.Wrap()
+	case status == constant.KickedToken:
+		return "", servererrs.ErrTokenKicked.Wrap()
+	case status != constant.NormalToken:
+		return "", errs.Wrap(errs.ErrTokenUnknown)
+	}
+	// The new token takes the place of the old one, which must not count as another login.
+	delete(tokens[claims.PlatformID], oldToken)
+	if err := a.applyMultiLogin(ctx, claims.UserID, claims.PlatformID, tokens); err != nil {
+		return "", err
+	}
+
+	newClaims := authverify.BuildClaims(claims.UserID, claims.PlatformID, a.accessTTL, claims.TenantID)
+	tokenString, err := a.keySet.Sign(newClaims)
+	if err != nil {
+		return "", err
+	}
+	oldSessionID := cachekey.GetTokenSessionID(oldToken)
+	session, err := a.session.GetTokenSession(ctx, claims.UserID, oldSessionID)
+	if err != nil {
+		return "", err
+	}
+	if session == nil {
+		session = &cache.TokenSession{PlatformID: claims.PlatformID, CreateTime: time.Now().UnixMilli()}
+	}
+	if err := a.saveToken(ctx, claims.UserID, tokenString, session); err != nil {
+		return "", err
+	}
+	if err := a.cache.DeleteTokenByUidPid(ctx, claims.UserID, claims.PlatformID, []string{oldToken}); err != nil {
+		return "", err
+	}
+	if err := a.session.DeleteTokenSessions(ctx, claims.UserID, []string{oldSessionID}); err != nil {
+		return "", err
+	}
+	return tokenString, nil
+}
+
+// applyMultiLogin deletes the dead tokens of the user and kicks the ones the multi-login policy
+// no longer allows once another token of platformID is created.
+func (a *authDatabase) applyMultiLogin(ctx context.Context, userID string, platformID int, tokens map[int]map[string]int) error {
+	deleteTokenKey, kickedTokenKey, err := a.checkToken(ctx, tokens, platformID)
+	if err != nil {
+		return err
+	}
+	if len(deleteTokenKey) != 0 {
+		err = a.cache.DeleteTokenByUidPid(ctx, userID, platformID, deleteTokenKey)
+		if err != nil {
+			return err
+		}
+		err = a.session.DeleteTokenSessions(ctx, userID, datautil.Slice(deleteTokenKey, cachekey.GetTokenSessionID))
+		if err != nil {
+			return err
+		}
+	}
+	if len(kickedTokenKey) != 0 {
+		for _, k := range kickedTokenKey {
+			err := a.cache.SetTokenFlagEx(ctx, userID, platformID, k, constant.KickedToken)
+			if err != nil {
+				return err
+			}
+			log.ZDebug(ctx, "kicked token in create token", "token", k)
+		}
+	}
+	return nil
+}
+
+// saveToken stores a new token together with the session it belongs to.
+func (a *authDatabase) saveToken(ctx context.Context, userID string, token string, session *cache.TokenSession) error {
+	if err := a.cache.SetTokenFlagEx(ctx, userID, session.PlatformID, token, constant.NormalToken); err != nil {
+		return err
+	}
+	session.Token = token
+	sessionTTL := a.accessTTL
+	if a.refreshTTL > sessionTTL {
+		sessionTTL = a.refreshTTL
+	}
+	return a.session.AddTokenSession(ctx, userID, cachekey.GetTokenSessionID(token), session, sessionTTL)
+}
+
 func (a *authDatabase) checkToken(ctx context.Context, tokens map[int]map[string]int, platformID int) ([]string, []string, error) {
 	// todo: Move the logic for handling old data to another location.
 	var (
@@ -137,8 +268,7 @@ func (a *authDatabase) checkToken(ctx context.Context, tokens map[int]map[string
 
 	for plfID, tks := range tokens {
 		for k, v := range tks {
-			_, err := tokenverify.GetClaimFromToken(k, authverify.Secret(a.accessSecret))
-			if err != nil || v != constant.NormalToken {
+			if !authverify.IssuedTokenAlive(k, a.refreshTTL) || v != constant.NormalToken {
 				deleteToken = append(deleteToken, k)
 			} else {
 				if plfID != constant.AdminPlatformID {
@@ -150,14 +280,15 @@ func (a *authDatabase) checkToken(ctx context.Context, tokens map[int]map[string
 		}
 	}
 
-	switch a.multiLogin.Policy {
+	multiLogin := a.getMultiLogin(ctx)
+	switch multiLogin.Policy {
 	case constant.DefalutNotKick:
 		for plt, ts := range loginTokenMap {
 			l := len(ts)
 			if platformID == plt {
 				l++
 			}
-			limit := a.multiLogin.MaxNumOneEnd
+			limit := multiLogin.MaxNumOneEnd
 			if l > limit {
 				kickToken = append(kickToken, ts[:l-limit]...)
 			}
@@ -0,0 +1,88 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+const (
+	// maxSyncDrafts bounds the drafts returned with a full conversation sync.
+	maxSyncDrafts = 1000
+
+	maxDraftTextLength = 10000
+)
+
+type ConversationDraftDatabase interface {
+	// SetDraft stores the draft, or clears it when it is empty, unless a draft set or cleared after
+	// it is stored. It returns whether the draft was stored.
+	SetDraft(ctx context.Context, draft *model.ConversationDraft) (bool, error)
+	// ClearDraft clears the draft of the conversation and returns whether there was one.
+	ClearDraft(ctx context.Context, userID string, conversationID string) (bool, error)
+	GetDrafts(ctx context.Context, userID string, conversationIDs []string) ([]*model.ConversationDraft, error)
+	// GetAllDrafts returns the most recently updated drafts of the user.
+	GetAllDrafts(ctx context.Context, userID string) ([]*model.ConversationDraft, error)
+	FindDraftVersion(ctx context.Context, userID string, version uint, limit int) (*model.VersionLog, error)
+}
+
+func NewConversationDraftDatabase(db database.ConversationDraft, cache cache.ConversationDraftCache) ConversationDraftDatabase {
+	return &conversationDraftDatabase{db: db, cache: cache}
+}
+
+type conversationDraftDatabase struct {
+	db    database.ConversationDraft
+	cache cache.ConversationDraftCache
+}
+
+func (c *conversationDraftDatabase) SetDraft(ctx context.Context, draft *model.ConversationDraft) (bool, error) {
+	if draft.IsEmpty() {
+		return c.clearDraft(ctx, draft.OwnerUserID, draft.ConversationID, draft.UpdateTime)
+	}
+	if len(draft.Text) > maxDraftTextLength {
+		return false, errs.ErrArgs.WrapMsg("draft text too long", "max", maxDraftTextLength)
+	}
+	stored, err := c.db.Set(ctx, draft)
+	if err != nil || !stored {
+		return false, err
+	}
+	return true, c.cache.DelDraftConversationIDs(draft.OwnerUserID).ChainExecDel(ctx)
+}
+
+func (c *conversationDraftDatabase) ClearDraft(ctx context.Context, userID string, conversationID string) (bool, error) {
+	conversationIDs, err := c.cache.GetDraftConversationIDs(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	if !datautil.Contain(conversationID, conversationIDs...) {
+		return false, nil
+	}
+	return c.clearDraft(ctx, userID, conversationID, time.Now())
+}
+
+func (c *conversationDraftDatabase) clearDraft(ctx context.Context, userID string, conversationID string, clearTime time.Time) (bool, error) {
+	cleared, err := c.db.Clear(ctx, userID, conversationID, clearTime)
+	if err != nil || !cleared {
+		return false, err
+	}
+	return true, c.cache.DelDraftConversationIDs(userID).ChainExecDel(ctx)
+}
+
+func (c *conversationDraftDatabase) GetDrafts(ctx context.Context, userID string, conversationIDs []string) ([]*model.ConversationDraft, error) {
+	if len(conversationIDs) == 0 {
+		return nil, nil
+	}
+	return c.db.Find(ctx, userID, conversationIDs)
+}
+
+func (c *conversationDraftDatabase) GetAllDrafts(ctx context.Context, userID string) ([]*model.ConversationDraft, error) {
+	return c.db.FindAll(ctx, userID, maxSyncDrafts)
+}
+
+func (c *conversationDraftDatabase) FindDraftVersion(ctx context.Context, userID string, version uint, limit int) (*model.VersionLog, error) {
+	return c.db.FindVersion(ctx, userID, version, limit)
+}
@@ -0,0 +1,107 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+// memConversationDraftDB keeps the drafts like mongo, a cleared draft stays empty with the time it was cleared.
+type memConversationDraftDB struct {
+	database.ConversationDraft
+	drafts map[string]*model.ConversationDraft
+}
+
+func (m *memConversationDraftDB) Set(_ context.Context, draft *model.ConversationDraft) (bool, error) {
+	if stored, ok := m.drafts[draft.ConversationID]; ok && !stored.UpdateTime.Before(draft.UpdateTime) {
+		return false, nil
+	}
+	m.drafts[draft.ConversationID] = draft
+	return true, nil
+}
+
+func (m *memConversationDraftDB) Clear(_ context.Context, ownerUserID string, conversationID string, clearTime time.Time) (bool, error) {
+	stored, ok := m.drafts[conversationID]
+	if !ok || stored.IsEmpty() || !stored.UpdateTime.Before(clearTime) {
+		return false, nil
+	}
+	m.drafts[conversationID] = &model.ConversationDraft{OwnerUserID: ownerUserID, ConversationID: conversationID, UpdateTime: clearTime}
+	return true, nil
+}
+
+func (m *memConversationDraftDB) FindConversationIDs(context.Context, string) ([]string, error) {
+	var conversationIDs []string
+	for conversationID, draft := range m.drafts {
+		if !draft.IsEmpty() {
+			conversationIDs = append(conversationIDs, conversationID)
+		}
+	}
+	return conversationIDs, nil
+}
+
+// memConversationDraftCache loads the conversation ids from the database when they are not cached, like rockscache.
+type memConversationDraftCache struct {
+	cache.BatchDeleter
+	db     *memConversationDraftDB
+	cached map[string][]string
+	del    []string
+}
+
+func (m *memConversationDraftCache) GetDraftConversationIDs(ctx context.Context, userID string) ([]string, error) {
+	if conversationIDs, ok := m.cached[userID]; ok {
+		return conversationIDs, nil
+	}
+	conversationIDs, err := m.db.FindConversationIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	m.cached[userID] = conversationIDs
+	return conversationIDs, nil
+}
+
+func (m *memConversationDraftCache) CloneConversationDraftCache() cache.ConversationDraftCache {
+	return m
+}
+
+func (m *memConversationDraftCache) DelDraftConversationIDs(userIDs ...string) cache.ConversationDraftCache {
+	m.del = append(m.del, userIDs...)
+	return m
+}
+
+func (m *memConversationDraftCache) ChainExecDel(context.Context) error {
+	for _, userID := range m.del {
+		delete(m.cached, userID)
+	}
+	m.del = nil
+	return nil
+}
+
+func TestConversationDraftClear(t *testing.T) {
+	ctx := context.Background()
+	db := &memConversationDraftDB{drafts: make(map[string]*model.ConversationDraft)}
+	c := &memConversationDraftCache{db: db, cached: make(map[string][]string)}
+	drafts := NewConversationDraftDatabase(db, c)
+	typed := time.Now().Add(-time.Second)
+	if stored, err := drafts.SetDraft(ctx, &model.ConversationDraft{OwnerUserID: "u1", ConversationID: "si_u1_u2", Text: "a", UpdateTime: typed}); err != nil || !stored {
+		t.Fatal("draft not stored", stored, err)
+	}
+	// The cached conversation ids are lost, they are loaded again from the database.
+	c.cached = make(map[string][]string)
+	if cleared, err := drafts.ClearDraft(ctx, "u1", "si_u1_u2"); err != nil || !cleared {
+		t.Fatal("draft not cleared", cleared, err)
+	}
+	if cleared, err := drafts.ClearDraft(ctx, "u1", "si_u1_u2"); err != nil || cleared {
+		t.Fatal("draft cleared twice", cleared, err)
+	}
+	// A draft typed before the message was sent is saved late, it must not come back.
+	if stored, err := drafts.SetDraft(ctx, &model.ConversationDraft{OwnerUserID: "u1", ConversationID: "si_u1_u2", Text: "a", UpdateTime: typed}); err != nil || stored {
+		t.Fatal("stale draft stored", stored, err)
+	}
+	if conversationIDs, _ := c.GetDraftConversationIDs(ctx, "u1"); len(conversationIDs) != 0 {
+		t.Fatal("unexpected draft conversations", conversationIDs)
+	}
+}
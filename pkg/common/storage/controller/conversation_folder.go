@@ -0,0 +1,64 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ConversationFolderDatabase interface {
+	// CreateFolder assigns the folder a new FolderID and stores it, unless the owner would have
+	// more than limit folders.
+	CreateFolder(ctx context.Context, folder *model.ConversationFolder, limit int) error
+	UpdateFolder(ctx context.Context, ownerUserID string, folderID string, args map[string]any) error
+	AddFolderConversations(ctx context.Context, ownerUserID string, folderID string, conversationIDs []string) error
+	RemoveFolderConversations(ctx context.Context, ownerUserID string, folderID string, conversationIDs []string) error
+	DeleteFolders(ctx context.Context, ownerUserID string, folderIDs []string) error
+	FindFolders(ctx context.Context, ownerUserID string, folderIDs []string) ([]*model.ConversationFolder, error)
+	// FindAllFolders returns the folders of the user sorted by order.
+	FindAllFolders(ctx context.Context, ownerUserID string) ([]*model.ConversationFolder, error)
+	FindFolderVersion(ctx context.Context, ownerUserID string, version uint, limit int) (*model.VersionLog, error)
+}
+
+func NewConversationFolderDatabase(db database.ConversationFolder) ConversationFolderDatabase {
+	return &conversationFolderDatabase{db: db}
+}
+
+type conversationFolderDatabase struct {
+	db database.ConversationFolder
+}
+
+func (c *conversationFolderDatabase) CreateFolder(ctx context.Context, folder *model.ConversationFolder, limit int) error {
+	folder.FolderID = primitive.NewObjectID().Hex()
+	return c.db.Create(ctx, folder, limit)
+}
+
+func (c *conversationFolderDatabase) UpdateFolder(ctx context.Context, ownerUserID string, folderID string, args map[string]any) error {
+	return c.db.Update(ctx, ownerUserID, folderID, args)
+}
+
+func (c *conversationFolderDatabase) AddFolderConversations(ctx context.Context, ownerUserID string, folderID string, conversationIDs []string) error {
+	return c.db.AddConversations(ctx, ownerUserID, folderID, conversationIDs)
+}
+
+func (c *conversationFolderDatabase) RemoveFolderConversations(ctx context.Context, ownerUserID string, folderID string, conversationIDs []string) error {
+	return c.db.RemoveConversations(ctx, ownerUserID, folderID, conversationIDs)
+}
+
+func (c *conversationFolderDatabase) DeleteFolders(ctx context.Context, ownerUserID string, folderIDs []string) error {
+	return c.db.Delete(ctx, ownerUserID, folderIDs)
+}
+
+func (c *conversationFolderDatabase) FindFolders(ctx context.Context, ownerUserID string, folderIDs []string) ([]*model.ConversationFolder, error) {
+	return c.db.Find(ctx, ownerUserID, folderIDs)
+}
+
+func (c *conversationFolderDatabase) FindAllFolders(ctx context.Context, ownerUserID string) ([]*model.ConversationFolder, error) {
+	return c.db.FindAll(ctx, ownerUserID)
+}
+
+func (c *conversationFolderDatabase) FindFolderVersion(ctx context.Context, ownerUserID string, version uint, limit int) (*model.VersionLog, error) {
+	return c.db.FindVersion(ctx, ownerUserID, version, limit)
+}
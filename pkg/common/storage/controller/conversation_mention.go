@@ -0,0 +1,72 @@
+package controller
+
+import (
+	"context"
+	"slices"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+// maxMentionSeqs bounds the mentions kept for a user in a conversation, older ones are dropped first.
+const maxMentionSeqs = 1000
+
+type ConversationMentionDatabase interface {
+	// AddMention records that the message seq of the conversation mentions the users.
+	AddMention(ctx context.Context, conversationID string, userIDs []string, seq int64) error
+	// AddMentionAll records that the message seq of the conversation mentions all the members.
+	AddMentionAll(ctx context.Context, conversationID string, seq int64) error
+	// PruneMentions removes the mentions the user has read.
+	PruneMentions(ctx context.Context, userID string, conversationID string, hasReadSeq int64) error
+	// GetMentions returns the mentions of the user in the conversations, including those of all the members.
+	GetMentions(ctx context.Context, userID string, conversationIDs []string) ([]*model.ConversationMention, error)
+}
+
+func NewConversationMentionDatabase(db database.ConversationMention) ConversationMentionDatabase {
+	return &conversationMentionDatabase{db: db}
+}
+
+type conversationMentionDatabase struct {
+	db database.ConversationMention
+}
+
+func (c *conversationMentionDatabase) AddMention(ctx context.Context, conversationID string, userIDs []string, seq int64) error {
+	return c.db.AddSeq(ctx, conversationID, userIDs, seq, maxMentionSeqs)
+}
+
+func (c *conversationMentionDatabase) AddMentionAll(ctx context.Context, conversationID string, seq int64) error {
+	return c.db.AddSeq(ctx, conversationID, []string{model.MentionAllOwnerUserID}, seq, maxMentionSeqs)
+}
+
+func (c *conversationMentionDatabase) PruneMentions(ctx context.Context, userID string, conversationID string, hasReadSeq int64) error {
+	return c.db.Prune(ctx, userID, conversationID, hasReadSeq)
+}
+
+func (c *conversationMentionDatabase) GetMentions(ctx context.Context, userID string, conversationIDs []string) ([]*model.ConversationMention, error) {
+	if len(conversationIDs) == 0 {
+		return nil, nil
+	}
+	mentions, err := c.db.Find(ctx, userID, conversationIDs)
+	if err != nil {
+		return nil, err
+	}
+	merged := make(map[string]*model.ConversationMention)
+	res := make([]*model.ConversationMention, 0, len(mentions))
+	for _, mention := range mentions {
+		m, ok := merged[mention.ConversationID]
+		if !ok {
+			m = &model.ConversationMention{OwnerUserID: userID, ConversationID: mention.ConversationID}
+			merged[mention.ConversationID] = m
+			res = append(res, m)
+		}
+		m.Seqs = append(m.Seqs, mention.Seqs...)
+		if mention.UpdateTime.After(m.UpdateTime) {
+			m.UpdateTime = mention.UpdateTime
+		}
+	}
+	for _, m := range res {
+		slices.Sort(m.Seqs)
+		m.Seqs = slices.Compact(m.Seqs)
+	}
+	return res, nil
+}
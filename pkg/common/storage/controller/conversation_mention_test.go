@@ -0,0 +1,67 @@
+package controller
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type memConversationMentionDB struct {
+	database.ConversationMention
+	mentions []*model.ConversationMention
+}
+
+func (m *memConversationMentionDB) AddSeq(_ context.Context, conversationID string, userIDs []string, seq int64, _ int) error {
+	for _, userID := range userIDs {
+		m.mentions = append(m.mentions, &model.ConversationMention{OwnerUserID: userID, ConversationID: conversationID, Seqs: []int64{seq}})
+	}
+	return nil
+}
+
+func (m *memConversationMentionDB) Find(_ context.Context, ownerUserID string, conversationIDs []string) ([]*model.ConversationMention, error) {
+	var res []*model.ConversationMention
+	for _, mention := range m.mentions {
+		for _, conversationID := range conversationIDs {
+			if mention.ConversationID == conversationID && (mention.OwnerUserID == ownerUserID || mention.OwnerUserID == model.MentionAllOwnerUserID) {
+				res = append(res, mention)
+			}
+		}
+	}
+	return res, nil
+}
+
+func TestConversationMentionAll(t *testing.T) {
+	ctx := context.Background()
+	db := &memConversationMentionDB{}
+	mentions := NewConversationMentionDatabase(db)
+	if err := mentions.AddMention(ctx, "sg_g1", []string{"u1"}, 3); err != nil {
+		t.Fatal(err)
+	}
+	if err := mentions.AddMentionAll(ctx, "sg_g1", 5); err != nil {
+		t.Fatal(err)
+	}
+	if err := mentions.AddMentionAll(ctx, "sg_g2", 1); err != nil {
+		t.Fatal(err)
+	}
+	// The mention of all the members is stored once, not once per member.
+	if len(db.mentions) != 3 {
+		t.Fatal("unexpected stored mentions", len(db.mentions))
+	}
+	res, err := mentions.GetMentions(ctx, "u1", []string{"sg_g1", "sg_g2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 2 || res[0].OwnerUserID != "u1" || !reflect.DeepEqual(res[0].Seqs, []int64{3, 5}) || !reflect.DeepEqual(res[1].Seqs, []int64{1}) {
+		t.Fatal("unexpected mentions of u1", res)
+	}
+	res, err = mentions.GetMentions(ctx, "u2", []string{"sg_g1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 1 || res[0].OwnerUserID != "u2" || !reflect.DeepEqual(res[0].Seqs, []int64{5}) {
+		t.Fatal("unexpected mentions of u2", res)
+	}
+}
@@ -0,0 +1,46 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+type OfflinePushDeadLetterDatabase interface {
+	AddDeadLetter(ctx context.Context, letter *model.OfflinePushDeadLetter) error
+	SearchDeadLetters(ctx context.Context, filter *database.OfflinePushDeadLetterFilter, pagination pagination.Pagination) (int64, []*model.OfflinePushDeadLetter, error)
+	GetDeadLetters(ctx context.Context, ids []string) ([]*model.OfflinePushDeadLetter, error)
+	DeleteDeadLetters(ctx context.Context, ids []string) error
+}
+
+func NewOfflinePushDeadLetterDatabase(db database.OfflinePushDeadLetter) OfflinePushDeadLetterDatabase {
+	return &offlinePushDeadLetterDatabase{db: db}
+}
+
+type offlinePushDeadLetterDatabase struct {
+	db database.OfflinePushDeadLetter
+}
+
+func (o *offlinePushDeadLetterDatabase) AddDeadLetter(ctx context.Context, letter *model.OfflinePushDeadLetter) error {
+	return o.db.Create(ctx, letter)
+}
+
+func (o *offlinePushDeadLetterDatabase) SearchDeadLetters(ctx context.Context, filter *database.OfflinePushDeadLetterFilter, pagination pagination.Pagination) (int64, []*model.OfflinePushDeadLetter, error) {
+	return o.db.Search(ctx, filter, pagination)
+}
+
+func (o *offlinePushDeadLetterDatabase) GetDeadLetters(ctx context.Context, ids []string) ([]*model.OfflinePushDeadLetter, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return o.db.Find(ctx, ids)
+}
+
+func (o *offlinePushDeadLetterDatabase) DeleteDeadLetters(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return o.db.Delete(ctx, ids)
+}
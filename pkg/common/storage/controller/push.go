@@ -16,23 +16,46 @@ package controller
 
 import (
 	"context"
+	"strconv"
+	"time"
 
+	"github.com/IBM/sarama"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/protocol/push"
 	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/mq/kafka"
+	"google.golang.org/protobuf/proto"
 )
 
+// The headers of the retry topic messages, next to the context headers.
+const (
+	OfflinePushAttemptHeader = "attempt"
+	OfflinePushDueHeader     = "due"
+)
+
+// OfflinePushRetryTopic returns the retry topic of the delay, the retries of each delay have their own topic
+// so that the messages of a topic are due in the order they are consumed.
+func OfflinePushRetryTopic(topic string, delay time.Duration) string {
+	return topic + "." + strconv.FormatInt(int64(delay/time.Second), 10) + "s"
+}
+
 type PushDatabase interface {
+	GetFcmToken(ctx context.Context, userID string, platformID int) (string, error)
 	DelFcmToken(ctx context.Context, userID string, platformID int) error
 	MsgToOfflinePushMQ(ctx context.Context, key string, userIDs []string, msg2mq *sdkws.MsgData) error
+	// MsgToOfflinePushRetryMQ schedules the attempt of a failed offline push on the topic of the delay, it is consumed once due.
+	MsgToOfflinePushRetryMQ(ctx context.Context, key string, userIDs []string, msg2mq *sdkws.MsgData, attempt int, delay time.Duration) error
 }
 
 type pushDataBase struct {
-	cache                 cache.ThirdCache
-	producerToOfflinePush *kafka.Producer
+	cache                      cache.ThirdCache
+	producerToOfflinePush      *kafka.Producer
+	producerToOfflinePushRetry sarama.SyncProducer
+	offlinePushRetryTopic      string
 }
 
 func NewPushDatabase(cache cache.ThirdCache, kafkaConf *config.Kafka) PushDatabase {
@@ -44,18 +67,55 @@ func NewPushDatabase(cache cache.ThirdCache, kafkaConf *config.Kafka) PushDataba
 	if err != nil {
 		return nil
 	}
+	producerToOfflinePushRetry, err := kafka.NewProducer(conf, kafkaConf.Address)
+	if err != nil {
+		return nil
+	}
 	return &pushDataBase{
-		cache:                 cache,
-		producerToOfflinePush: producerToOfflinePush,
+		cache:                      cache,
+		producerToOfflinePush:      producerToOfflinePush,
+		producerToOfflinePushRetry: producerToOfflinePushRetry,
+		offlinePushRetryTopic:      kafkaConf.ToOfflinePushRetryTopic,
 	}
 }
 
+func (p *pushDataBase) GetFcmToken(ctx context.Context, userID string, platformID int) (string, error) {
+	return p.cache.GetFcmToken(ctx, userID, platformID)
+}
+
 func (p *pushDataBase) DelFcmToken(ctx context.Context, userID string, platformID int) error {
 	return p.cache.DelFcmToken(ctx, userID, platformID)
 }
 
 func (p *pushDataBase) MsgToOfflinePushMQ(ctx context.Context, key string, userIDs []string, msg2mq *sdkws.MsgData) error {
-	_, _, err := p.producerToOfflinePush.SendMessage(ctx, key, &push.PushMsgReq{MsgData: msg2mq, UserIDs: userIDs})
+	_, _, err := p.producerToOfflinePush.SendMessage(ctx, tenant.Key(ctx, key), &push.PushMsgReq{MsgData: msg2mq, UserIDs: userIDs})
 	log.ZInfo(ctx, "message is push to offlinePush topic", "key", key, "userIDs", userIDs, "msg", msg2mq.String())
 	return err
 }
+
+func (p *pushDataBase) MsgToOfflinePushRetryMQ(ctx context.Context, key string, userIDs []string, msg2mq *sdkws.MsgData, attempt int, delay time.Duration) error {
+	data, err := proto.Marshal(&push.PushMsgReq{MsgData: msg2mq, UserIDs: userIDs})
+	if err != nil {
+		return errs.WrapMsg(err, "kafka proto Marshal err")
+	}
+	header, err := kafka.GetMQHeaderWithContext(ctx)
+	if err != nil {
+		return err
+	}
+	due := time.Now().Add(delay)
+	header = append(header,
+		sarama.RecordHeader{Key: []byte(OfflinePushAttemptHeader), Value: []byte(strconv.Itoa(attempt))},
+		sarama.RecordHeader{Key: []byte(OfflinePushDueHeader), Value: []byte(strconv.FormatInt(due.UnixMilli(), 10))},
+	)
+	_, _, err = p.producerToOfflinePushRetry.SendMessage(&sarama.ProducerMessage{
+		Topic:   OfflinePushRetryTopic(p.offlinePushRetryTopic, delay),
+		Key:     sarama.StringEncoder(tenant.Key(ctx, key)),
+		Value:   sarama.ByteEncoder(data),
+		Headers: header,
+	})
+	if err != nil {
+		return errs.WrapMsg(err, "send offline push retry message failed", "attempt", attempt)
+	}
+	log.ZInfo(ctx, "message is push to offlinePushRetry topic", "key", key, "userIDs", userIDs, "attempt", attempt, "due", due)
+	return nil
+}
@@ -0,0 +1,75 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type PushDeviceDatabase interface {
+	// RegisterDevice stores the push token of the device, a token registered again moves to the new device.
+	RegisterDevice(ctx context.Context, device *model.PushDevice) error
+	// UpdateDevice updates the fields of a registered device, it fails when the device is not registered.
+	UpdateDevice(ctx context.Context, userID string, deviceID string, data map[string]any) error
+	UnregisterDevice(ctx context.Context, userID string, deviceID string) error
+	// UnregisterTokenDevices removes the devices of the user registered with the token, e.g. the device logging out.
+	UnregisterTokenDevices(ctx context.Context, userID string, token string) error
+	GetUserDevices(ctx context.Context, userID string) ([]*model.PushDevice, error)
+	// GetDevices returns the enabled devices of the users, the ones to push to.
+	GetDevices(ctx context.Context, userIDs []string) ([]*model.PushDevice, error)
+	// GetDisabledTokens returns the tokens of the provider whose notifications the users turned off,
+	// not to push to them through the tokens registered before the devices.
+	GetDisabledTokens(ctx context.Context, provider string, userIDs []string) ([]string, error)
+	// DeleteInvalidTokens removes the tokens the provider reported as no longer valid.
+	DeleteInvalidTokens(ctx context.Context, provider string, tokens []string) error
+}
+
+func NewPushDeviceDatabase(db database.PushDevice) PushDeviceDatabase {
+	return &pushDeviceDatabase{db: db}
+}
+
+type pushDeviceDatabase struct {
+	db database.PushDevice
+}
+
+func (p *pushDeviceDatabase) RegisterDevice(ctx context.Context, device *model.PushDevice) error {
+	return p.db.Register(ctx, device)
+}
+
+func (p *pushDeviceDatabase) UpdateDevice(ctx context.Context, userID string, deviceID string, data map[string]any) error {
+	return p.db.Update(ctx, userID, deviceID, data)
+}
+
+func (p *pushDeviceDatabase) UnregisterDevice(ctx context.Context, userID string, deviceID string) error {
+	return p.db.Unregister(ctx, userID, deviceID)
+}
+
+func (p *pushDeviceDatabase) UnregisterTokenDevices(ctx context.Context, userID string, token string) error {
+	return p.db.UnregisterToken(ctx, userID, token)
+}
+
+func (p *pushDeviceDatabase) GetUserDevices(ctx context.Context, userID string) ([]*model.PushDevice, error) {
+	return p.db.FindByUser(ctx, userID)
+}
+
+func (p *pushDeviceDatabase) GetDevices(ctx context.Context, userIDs []string) ([]*model.PushDevice, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	return p.db.FindEnabledByUsers(ctx, userIDs)
+}
+
+func (p *pushDeviceDatabase) GetDisabledTokens(ctx context.Context, provider string, userIDs []string) ([]string, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	return p.db.FindDisabledTokens(ctx, provider, userIDs)
+}
+
+func (p *pushDeviceDatabase) DeleteInvalidTokens(ctx context.Context, provider string, tokens []string) error {
+	if len(tokens) == 0 {
+		return nil
+	}
+	return p.db.DeleteTokens(ctx, provider, tokens)
+}
@@ -0,0 +1,86 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+type PushQuietHoursDatabase interface {
+	SetQuietHours(ctx context.Context, quietHours *model.PushQuietHours) error
+	// Snooze suppresses the offline pushes of the user until the time, a past time ends the snooze.
+	Snooze(ctx context.Context, userID string, until time.Time) error
+	// GetQuietHours returns the settings of the user, nil when it has none.
+	GetQuietHours(ctx context.Context, userID string) (*model.PushQuietHours, error)
+	FindQuietHours(ctx context.Context, userIDs []string) ([]*model.PushQuietHours, error)
+	DeleteQuietHours(ctx context.Context, userID string) error
+	// AddToDigests counts the suppressed pushes in the digests of the users, with one write.
+	AddToDigests(ctx context.Context, digests []*model.PushQuietDigest) error
+	FindDueDigests(ctx context.Context, now time.Time, limit int64) ([]*model.PushQuietDigest, error)
+	TakeDueDigest(ctx context.Context, userID string, now time.Time) (*model.PushQuietDigest, error)
+}
+
+func NewPushQuietHoursDatabase(quietHours database.PushQuietHours, digest database.PushQuietDigest, cache cache.PushQuietHoursCache) PushQuietHoursDatabase {
+	return &pushQuietHoursDatabase{quietHours: quietHours, digest: digest, cache: cache}
+}
+
+type pushQuietHoursDatabase struct {
+	quietHours database.PushQuietHours
+	digest     database.PushQuietDigest
+	cache      cache.PushQuietHoursCache
+}
+
+func (p *pushQuietHoursDatabase) SetQuietHours(ctx context.Context, quietHours *model.PushQuietHours) error {
+	if err := p.quietHours.Set(ctx, quietHours); err != nil {
+		return err
+	}
+	return p.cache.DelQuietHours(quietHours.UserID).ChainExecDel(ctx)
+}
+
+func (p *pushQuietHoursDatabase) Snooze(ctx context.Context, userID string, until time.Time) error {
+	if err := p.quietHours.Snooze(ctx, userID, until); err != nil {
+		return err
+	}
+	return p.cache.DelQuietHours(userID).ChainExecDel(ctx)
+}
+
+func (p *pushQuietHoursDatabase) GetQuietHours(ctx context.Context, userID string) (*model.PushQuietHours, error) {
+	quietHours, err := p.FindQuietHours(ctx, []string{userID})
+	if err != nil {
+		return nil, err
+	}
+	if len(quietHours) == 0 {
+		return nil, nil
+	}
+	return quietHours[0], nil
+}
+
+func (p *pushQuietHoursDatabase) FindQuietHours(ctx context.Context, userIDs []string) ([]*model.PushQuietHours, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	return p.cache.GetQuietHours(ctx, datautil.Distinct(userIDs))
+}
+
+func (p *pushQuietHoursDatabase) DeleteQuietHours(ctx context.Context, userID string) error {
+	if err := p.quietHours.Delete(ctx, userID); err != nil {
+		return err
+	}
+	return p.cache.DelQuietHours(userID).ChainExecDel(ctx)
+}
+
+func (p *pushQuietHoursDatabase) AddToDigests(ctx context.Context, digests []*model.PushQuietDigest) error {
+	return p.digest.Add(ctx, digests)
+}
+
+func (p *pushQuietHoursDatabase) FindDueDigests(ctx context.Context, now time.Time, limit int64) ([]*model.PushQuietDigest, error) {
+	return p.digest.FindDue(ctx, now, limit)
+}
+
+func (p *pushQuietHoursDatabase) TakeDueDigest(ctx context.Context, userID string, now time.Time) (*model.PushQuietDigest, error) {
+	return p.digest.TakeDue(ctx, userID, now)
+}
@@ -0,0 +1,41 @@
+package controller
+
+import (
+	"context"
+	"strings"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type PushTemplateDatabase interface {
+	SetTemplate(ctx context.Context, template *model.PushTemplate) error
+	DeleteTemplate(ctx context.Context, contentType int32, locale string) error
+	GetTemplates(ctx context.Context) ([]*model.PushTemplate, error)
+}
+
+func NewPushTemplateDatabase(db database.PushTemplate) PushTemplateDatabase {
+	return &pushTemplateDatabase{db: db}
+}
+
+type pushTemplateDatabase struct {
+	db database.PushTemplate
+}
+
+func (p *pushTemplateDatabase) SetTemplate(ctx context.Context, template *model.PushTemplate) error {
+	template.Locale = NormalizeLocale(template.Locale)
+	return p.db.Set(ctx, template)
+}
+
+func (p *pushTemplateDatabase) DeleteTemplate(ctx context.Context, contentType int32, locale string) error {
+	return p.db.Delete(ctx, contentType, NormalizeLocale(locale))
+}
+
+func (p *pushTemplateDatabase) GetTemplates(ctx context.Context) ([]*model.PushTemplate, error) {
+	return p.db.FindAll(ctx)
+}
+
+// NormalizeLocale returns the locale the templates are stored with, e.g. zh_CN becomes zh-cn.
+func NormalizeLocale(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+}
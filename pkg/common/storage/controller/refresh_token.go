@@ -0,0 +1,112 @@
+package controller
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/openimsdk/tools/errs"
+)
+
+type RefreshTokenDatabase interface {
+	// Create starts a new family for the access token and returns its first refresh token.
+	Create(ctx context.Context, userID string, platformID int, accessToken string) (string, error)
+	// Use consumes a refresh token. A refresh token presented a second time means it leaked,
+	// the whole family is revoked and returned together with ErrRefreshTokenReused.
+	Use(ctx context.Context, refreshToken string) (*cache.RefreshTokenFamily, error)
+	// Release gives back a refresh token consumed by Use whose access token could not be issued,
+	// so that the client can retry it. It fails when the refresh token was presented again meanwhile.
+	Release(ctx context.Context, refreshToken string) error
+	// Rotate binds the family to its new access token and returns the next refresh token.
+	Rotate(ctx context.Context, family *cache.RefreshTokenFamily, accessToken string) (string, error)
+	Revoke(ctx context.Context, familyID string) error
+}
+
+func NewRefreshTokenDatabase(cache cache.RefreshTokenCache, refreshTTL time.Duration) RefreshTokenDatabase {
+	return &refreshTokenDatabase{cache: cache, refreshTTL: refreshTTL}
+}
+
+type refreshTokenDatabase struct {
+	cache      cache.RefreshTokenCache
+	refreshTTL time.Duration
+}
+
+func (r *refreshTokenDatabase) Create(ctx context.Context, userID string, platformID int, accessToken string) (string, error) {
+	familyID, err := randomToken()
+	if err != nil {
+		return "", err
+	}
+	family := &cache.RefreshTokenFamily{
+		FamilyID:   familyID,
+		UserID:     userID,
+		PlatformID: platformID,
+		TenantID:   tenant.GetTenantID(ctx),
+	}
+	return r.Rotate(ctx, family, accessToken)
+}
+
+func (r *refreshTokenDatabase) Use(ctx context.Context, refreshToken string) (*cache.RefreshTokenFamily, error) {
+	familyID, used, err := r.cache.UseRefreshToken(ctx, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+	if familyID == "" {
+		return nil, servererrs.ErrRefreshTokenInvalid.WrapMsg("refresh token not found")
+	}
+	family, err := r.cache.GetRefreshTokenFamily(ctx, familyID)
+	if err != nil {
+		return nil, err
+	}
+	if family == nil {
+		return nil, servererrs.ErrRefreshTokenInvalid.WrapMsg("refresh token revoked")
+	}
+	if used > 1 {
+		if err := r.Revoke(ctx, familyID); err != nil {
+			return nil, err
+		}
+		return family, servererrs.ErrRefreshTokenReused.WrapMsg("refresh token reused", "userID", family.UserID, "platformID", family.PlatformID)
+	}
+	return family, nil
+}
+
+func (r *refreshTokenDatabase) Release(ctx context.Context, refreshToken string) error {
+	released, err := r.cache.UnuseRefreshToken(ctx, refreshToken)
+	if err != nil {
+		return err
+	}
+	if !released {
+		return servererrs.ErrRefreshTokenInvalid.WrapMsg("refresh token used again")
+	}
+	return nil
+}
+
+func (r *refreshTokenDatabase) Rotate(ctx context.Context, family *cache.RefreshTokenFamily, accessToken string) (string, error) {
+	refreshToken, err := randomToken()
+	if err != nil {
+		return "", err
+	}
+	family.AccessToken = accessToken
+	if err := r.cache.SetRefreshTokenFamily(ctx, family, r.refreshTTL); err != nil {
+		return "", err
+	}
+	if err := r.cache.AddRefreshToken(ctx, refreshToken, family.FamilyID, r.refreshTTL); err != nil {
+		return "", err
+	}
+	return refreshToken, nil
+}
+
+func (r *refreshTokenDatabase) Revoke(ctx context.Context, familyID string) error {
+	return r.cache.DeleteRefreshTokenFamily(ctx, familyID)
+}
+
+func randomToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", errs.Wrap(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,153 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+)
+
+type memRefreshTokenCache struct {
+	families map[string]cache.RefreshTokenFamily
+	tokens   map[string]string
+	used     map[string]int64
+}
+
+func newMemRefreshTokenCache() *memRefreshTokenCache {
+	return &memRefreshTokenCache{
+		families: make(map[string]cache.RefreshTokenFamily),
+		tokens:   make(map[string]string),
+		used:     make(map[string]int64),
+	}
+}
+
+func (m *memRefreshTokenCache) SetRefreshTokenFamily(ctx context.Context, family *cache.RefreshTokenFamily, expire time.Duration) error {
+	m.families[family.FamilyID] = *family
+	return nil
+}
+
+func (m *memRefreshTokenCache) GetRefreshTokenFamily(ctx context.Context, familyID string) (*cache.RefreshTokenFamily, error) {
+	family, ok := m.families[familyID]
+	if !ok {
+		return nil, nil
+	}
+	return &family, nil
+}
+
+func (m *memRefreshTokenCache) DeleteRefreshTokenFamily(ctx context.Context, familyID string) error {
+	delete(m.families, familyID)
+	return nil
+}
+
+func (m *memRefreshTokenCache) AddRefreshToken(ctx context.Context, refreshToken string, familyID string, expire time.Duration) error {
+	m.tokens[refreshToken] = familyID
+	return nil
+}
+
+func (m *memRefreshTokenCache) UseRefreshToken(ctx context.Context, refreshToken string) (string, int64, error) {
+	familyID, ok := m.tokens[refreshToken]
+	if !ok {
+		return "", 0, nil
+	}
+	m.used[refreshToken]++
+	return familyID, m.used[refreshToken], nil
+}
+
+func (m *memRefreshTokenCache) UnuseRefreshToken(ctx context.Context, refreshToken string) (bool, error) {
+	if m.used[refreshToken] != 1 {
+		return false, nil
+	}
+	m.used[refreshToken] = 0
+	return true, nil
+}
+
+func TestRefreshTokenRotation(t *testing.T) {
+	db := NewRefreshTokenDatabase(newMemRefreshTokenCache(), time.Hour)
+	ctx := tenant.WithTenantID(context.Background(), "a")
+	first, err := db.Create(ctx, "user1", 1, "access1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	family, err := db.Use(ctx, first)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if family.UserID != "user1" || family.PlatformID != 1 || family.AccessToken != "access1" || family.TenantID != "a" {
+		t.Fatal("unexpected family", family)
+	}
+	second, err := db.Rotate(ctx, family, "access2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second == first {
+		t.Fatal("refresh token not rotated")
+	}
+	family, err = db.Use(ctx, second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if family.AccessToken != "access2" {
+		t.Fatal("family not bound to the new access token", family.AccessToken)
+	}
+}
+
+func TestRefreshTokenReuse(t *testing.T) {
+	db := NewRefreshTokenDatabase(newMemRefreshTokenCache(), time.Hour)
+	ctx := context.Background()
+	first, err := db.Create(ctx, "user1", 1, "access1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	family, err := db.Use(ctx, first)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := db.Rotate(ctx, family, "access2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The leaked first token is presented again, the family is revoked and returned.
+	family, err = db.Use(ctx, first)
+	if !servererrs.ErrRefreshTokenReused.Is(err) {
+		t.Fatal("reuse not detected", err)
+	}
+	if family == nil || family.AccessToken != "access2" {
+		t.Fatal("reused family must be returned to revoke its session", family)
+	}
+	// The rotated token died with its family.
+	if _, err := db.Use(ctx, second); !servererrs.ErrRefreshTokenInvalid.Is(err) {
+		t.Fatal("token of a revoked family accepted", err)
+	}
+	if _, err := db.Use(ctx, "unknown"); !servererrs.ErrRefreshTokenInvalid.Is(err) {
+		t.Fatal("unknown token accepted", err)
+	}
+}
+
+func TestRefreshTokenRelease(t *testing.T) {
+	db := NewRefreshTokenDatabase(newMemRefreshTokenCache(), time.Hour)
+	ctx := context.Background()
+	first, err := db.Create(ctx, "user1", 1, "access1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Use(ctx, first); err != nil {
+		t.Fatal(err)
+	}
+	// The access token could not be issued, the retry is not a reuse.
+	if err := db.Release(ctx, first); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Use(ctx, first); err != nil {
+		t.Fatal("released refresh token rejected", err)
+	}
+	// A refresh token presented twice revoked its family, it is not released.
+	if _, err := db.Use(ctx, first); !servererrs.ErrRefreshTokenReused.Is(err) {
+		t.Fatal("reuse not detected", err)
+	}
+	if err := db.Release(ctx, first); !servererrs.ErrRefreshTokenInvalid.Is(err) {
+		t.Fatal("reused refresh token released", err)
+	}
+}
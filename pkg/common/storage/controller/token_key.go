@@ -0,0 +1,140 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/log"
+)
+
+type TokenKeyDatabase interface {
+	// Load returns the keys that may still verify unexpired tokens, newest first.
+	Load(ctx context.Context) ([]*authverify.Key, error)
+	// Rotate stores a new key that becomes the signing key of its algorithm.
+	Rotate(ctx context.Context, algorithm string) (*authverify.Key, error)
+	// EncryptStoredKeys seals the private keys stored before the key encryption key was configured.
+	EncryptStoredKeys(ctx context.Context) error
+}
+
+// NewTokenKeyDatabase stores the private keys sealed with kek, a nil kek stores them in clear.
+func NewTokenKeyDatabase(db database.TokenKey, accessExpire int64, kek []byte) TokenKeyDatabase {
+	return &tokenKeyDatabase{db: db, tokenTTL: time.Hour * 24 * time.Duration(accessExpire), kek: kek}
+}
+
+type tokenKeyDatabase struct {
+	db       database.TokenKey
+	tokenTTL time.Duration
+	kek      []byte
+}
+
+// retired reports the keys whose tokens have all expired: a key stops signing when a newer key of
+// its algorithm is created, so its last token expires one token lifetime after that.
+func (t *tokenKeyDatabase) retired(keys []*model.TokenKey, now time.Time) map[string]struct{} {
+	retired := make(map[string]struct{})
+	successor := make(map[string]time.Time)
+	for _, key := range keys {
+		if next, ok := successor[key.Algorithm]; ok && next.Add(t.tokenTTL).Before(now) {
+			retired[key.KID] = struct{}{}
+		}
+		successor[key.Algorithm] = key.CreateTime
+	}
+	return retired
+}
+
+func (t *tokenKeyDatabase) Load(ctx context.Context) ([]*authverify.Key, error) {
+	keys, err := t.db.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	retired := t.retired(keys, time.Now())
+	res := make([]*authverify.Key, 0, len(keys))
+	for _, key := range keys {
+		if _, ok := retired[key.KID]; ok {
+			continue
+		}
+		pemKey := key.PrivateKey
+		if key.Encrypted {
+			if pemKey, err = authverify.DecryptPrivateKey(t.kek, key.KID, key.PrivateKey); err != nil {
+				log.ZError(ctx, "decrypt token key failed", err, "kid", key.KID)
+				continue
+			}
+		}
+		privateKey, err := authverify.ParsePrivateKey(pemKey)
+		if err != nil {
+			log.ZError(ctx, "parse token key failed", err, "kid", key.KID)
+			continue
+		}
+		res = append(res, &authverify.Key{
+			KID:        key.KID,
+			Algorithm:  key.Algorithm,
+			PrivateKey: privateKey,
+			CreateTime: key.CreateTime,
+		})
+	}
+	return res, nil
+}
+
+func (t *tokenKeyDatabase) Rotate(ctx context.Context, algorithm string) (*authverify.Key, error) {
+	key, err := authverify.GenerateKey(algorithm)
+	if err != nil {
+		return nil, err
+	}
+	privateKey, err := authverify.MarshalPrivateKey(key.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	if t.kek != nil {
+		if privateKey, err = authverify.EncryptPrivateKey(t.kek, key.KID, privateKey); err != nil {
+			return nil, err
+		}
+	}
+	if err := t.db.Create(ctx, &model.TokenKey{
+		KID:        key.KID,
+		Algorithm:  key.Algorithm,
+		PrivateKey: privateKey,
+		Encrypted:  t.kek != nil,
+		CreateTime: key.CreateTime,
+	}); err != nil {
+		return nil, err
+	}
+	keys, err := t.db.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	retired := t.retired(keys, time.Now())
+	kids := make([]string, 0, len(retired))
+	for kid := range retired {
+		kids = append(kids, kid)
+	}
+	if err := t.db.Delete(ctx, kids); err != nil {
+		log.ZWarn(ctx, "delete retired token keys failed", err, "kids", kids)
+	}
+	return key, nil
+}
+
+func (t *tokenKeyDatabase) EncryptStoredKeys(ctx context.Context) error {
+	if t.kek == nil {
+		return nil
+	}
+	keys, err := t.db.FindAll(ctx)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if key.Encrypted {
+			continue
+		}
+		privateKey, err := authverify.EncryptPrivateKey(t.kek, key.KID, key.PrivateKey)
+		if err != nil {
+			return err
+		}
+		if err := t.db.SetPrivateKey(ctx, key.KID, privateKey, true); err != nil {
+			return err
+		}
+		log.ZInfo(ctx, "token key encrypted", "kid", key.KID)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/log"
+)
+
+type TokenSessionDatabase interface {
+	// GetSessions returns the sessions of the user whose token is still valid, keyed by session ID.
+	GetSessions(ctx context.Context, userID string) (map[string]*cache.TokenSession, error)
+	// RevokeSessions kicks the tokens of the given sessions and returns the sessions that were revoked.
+	RevokeSessions(ctx context.Context, userID string, sessionIDs []string) (map[string]*cache.TokenSession, error)
+}
+
+func NewTokenSessionDatabase(token cache.TokenModel, session cache.TokenSessionCache, refreshTTL time.Duration) TokenSessionDatabase {
+	return &tokenSessionDatabase{token: token, session: session, refreshTTL: refreshTTL}
+}
+
+type tokenSessionDatabase struct {
+	token      cache.TokenModel
+	session    cache.TokenSessionCache
+	refreshTTL time.Duration
+}
+
+func (t *tokenSessionDatabase) GetSessions(ctx context.Context, userID string) (map[string]*cache.TokenSession, error) {
+	sessions, err := t.session.GetTokenSessions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(sessions) == 0 {
+		return sessions, nil
+	}
+	tokens, err := t.token.GetAllTokensWithoutError(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	var stale []string
+	for sessionID, session := range sessions {
+		if tokens[session.PlatformID][session.Token] != constant.NormalToken || !authverify.IssuedTokenAlive(session.Token, t.refreshTTL) {
+			stale = append(stale, sessionID)
+		}
+	}
+	if len(stale) > 0 {
+		// Kicked and expired tokens are cleaned up lazily.
+		if err := t.session.DeleteTokenSessions(ctx, userID, stale); err != nil {
+			log.ZWarn(ctx, "delete stale token sessions failed", err, "userID", userID, "sessionIDs", stale)
+		}
+		for _, sessionID := range stale {
+			delete(sessions, sessionID)
+		}
+	}
+	return sessions, nil
+}
+
+func (t *tokenSessionDatabase) RevokeSessions(ctx context.Context, userID string, sessionIDs []string) (map[string]*cache.TokenSession, error) {
+	sessions, err := t.GetSessions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		revoked   = make(map[string]*cache.TokenSession)
+		platforms = make(map[int]map[string]int)
+	)
+	for _, sessionID := range sessionIDs {
+		session, ok := sessions[sessionID]
+		if !ok {
+			continue
+		}
+		revoked[sessionID] = session
+		if platforms[session.PlatformID] == nil {
+			platforms[session.PlatformID] = make(map[string]int)
+		}
+		platforms[session.PlatformID][session.Token] = constant.KickedToken
+	}
+	for platformID, m := range platforms {
+		if err := t.token.SetTokenMapByUidPid(ctx, userID, platformID, m); err != nil {
+			return nil, err
+		}
+	}
+	if len(revoked) == 0 {
+		return revoked, nil
+	}
+	ids := make([]string, 0, len(revoked))
+	for sessionID := range revoked {
+		ids = append(ids, sessionID)
+	}
+	if err := t.session.DeleteTokenSessions(ctx, userID, ids); err != nil {
+		return nil, err
+	}
+	return revoked, nil
+}
@@ -0,0 +1,44 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type UserFieldDatabase interface {
+	// SetFields creates or replaces the custom profile field definitions.
+	SetFields(ctx context.Context, fields []*model.UserField) error
+	DeleteFields(ctx context.Context, keys []string) error
+	// GetFields returns every custom profile field definition.
+	GetFields(ctx context.Context) ([]*model.UserField, error)
+}
+
+func NewUserFieldDatabase(db database.UserField, cache cache.UserFieldCache) UserFieldDatabase {
+	return &userFieldDatabase{db: db, cache: cache}
+}
+
+type userFieldDatabase struct {
+	db    database.UserField
+	cache cache.UserFieldCache
+}
+
+func (u *userFieldDatabase) SetFields(ctx context.Context, fields []*model.UserField) error {
+	if err := u.db.Set(ctx, fields); err != nil {
+		return err
+	}
+	return u.cache.DelUserFields().ChainExecDel(ctx)
+}
+
+func (u *userFieldDatabase) DeleteFields(ctx context.Context, keys []string) error {
+	if err := u.db.Delete(ctx, keys); err != nil {
+		return err
+	}
+	return u.cache.DelUserFields().ChainExecDel(ctx)
+}
+
+func (u *userFieldDatabase) GetFields(ctx context.Context) ([]*model.UserField, error) {
+	return u.cache.GetUserFields(ctx)
+}
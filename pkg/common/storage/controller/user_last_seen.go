@@ -0,0 +1,56 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+type UserLastSeenDatabase interface {
+	// GetLastSeen returns the last-seen times keyed by userID, users never seen are omitted.
+	GetLastSeen(ctx context.Context, userIDs []string) (map[string]int64, error)
+	SetLastSeenPrivacy(ctx context.Context, userID string, privacy string) error
+	// GetLastSeenPrivacy returns the last-seen privacy keyed by userID, defaulting to everyone.
+	GetLastSeenPrivacy(ctx context.Context, userIDs []string) (map[string]string, error)
+}
+
+func NewUserLastSeenDatabase(db database.UserPrivacy, cache cache.UserLastSeenCache) UserLastSeenDatabase {
+	return &userLastSeenDatabase{db: db, cache: cache}
+}
+
+type userLastSeenDatabase struct {
+	db    database.UserPrivacy
+	cache cache.UserLastSeenCache
+}
+
+func (u *userLastSeenDatabase) GetLastSeen(ctx context.Context, userIDs []string) (map[string]int64, error) {
+	return u.cache.GetLastSeen(ctx, datautil.Distinct(userIDs))
+}
+
+func (u *userLastSeenDatabase) SetLastSeenPrivacy(ctx context.Context, userID string, privacy string) error {
+	if err := u.db.Set(ctx, &model.UserPrivacy{UserID: userID, LastSeen: privacy}); err != nil {
+		return err
+	}
+	return u.cache.DelUsersPrivacy(userID).ChainExecDel(ctx)
+}
+
+func (u *userLastSeenDatabase) GetLastSeenPrivacy(ctx context.Context, userIDs []string) (map[string]string, error) {
+	userIDs = datautil.Distinct(userIDs)
+	privacies, err := u.cache.GetUsersPrivacy(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string]string, len(userIDs))
+	for _, userID := range userIDs {
+		res[userID] = model.LastSeenPrivacyEveryone
+	}
+	for _, privacy := range privacies {
+		if privacy.LastSeen != "" {
+			res[privacy.UserID] = privacy.LastSeen
+		}
+	}
+	return res, nil
+}
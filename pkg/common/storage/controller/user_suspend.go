@@ -0,0 +1,73 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+type UserSuspendDatabase interface {
+	// Suspend creates or replaces the suspension of a user.
+	Suspend(ctx context.Context, suspend *model.UserSuspend) error
+	Unsuspend(ctx context.Context, userIDs []string) error
+	// GetSuspended returns the active suspension of the user, or nil if the user is not suspended.
+	GetSuspended(ctx context.Context, userID string) (*model.UserSuspend, error)
+	// FindSuspended returns the active suspensions among userIDs, keyed by userID.
+	FindSuspended(ctx context.Context, userIDs []string) (map[string]*model.UserSuspend, error)
+	PageSuspended(ctx context.Context, pagination pagination.Pagination) (int64, []*model.UserSuspend, error)
+}
+
+func NewUserSuspendDatabase(db database.UserSuspend, cache cache.UserSuspendCache) UserSuspendDatabase {
+	return &userSuspendDatabase{db: db, cache: cache}
+}
+
+type userSuspendDatabase struct {
+	db    database.UserSuspend
+	cache cache.UserSuspendCache
+}
+
+func (u *userSuspendDatabase) Suspend(ctx context.Context, suspend *model.UserSuspend) error {
+	if err := u.db.Set(ctx, suspend); err != nil {
+		return err
+	}
+	return u.cache.DelUsersSuspend(suspend.UserID).ChainExecDel(ctx)
+}
+
+func (u *userSuspendDatabase) Unsuspend(ctx context.Context, userIDs []string) error {
+	if err := u.db.Delete(ctx, userIDs); err != nil {
+		return err
+	}
+	return u.cache.DelUsersSuspend(userIDs...).ChainExecDel(ctx)
+}
+
+func (u *userSuspendDatabase) GetSuspended(ctx context.Context, userID string) (*model.UserSuspend, error) {
+	m, err := u.FindSuspended(ctx, []string{userID})
+	if err != nil {
+		return nil, err
+	}
+	return m[userID], nil
+}
+
+func (u *userSuspendDatabase) FindSuspended(ctx context.Context, userIDs []string) (map[string]*model.UserSuspend, error) {
+	suspends, err := u.cache.GetUsersSuspend(ctx, datautil.Distinct(userIDs))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	res := make(map[string]*model.UserSuspend, len(suspends))
+	for _, suspend := range suspends {
+		if suspend.IsActive(now) {
+			res[suspend.UserID] = suspend
+		}
+	}
+	return res, nil
+}
+
+func (u *userSuspendDatabase) PageSuspended(ctx context.Context, pagination pagination.Pagination) (int64, []*model.UserSuspend, error) {
+	return u.db.Page(ctx, pagination)
+}
@@ -0,0 +1,16 @@
+package database
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+type ApiKey interface {
+	Create(ctx context.Context, key *model.ApiKey) error
+	Update(ctx context.Context, keyID string, data map[string]any) error
+	Delete(ctx context.Context, keyIDs []string) error
+	Find(ctx context.Context, keyIDs []string) ([]*model.ApiKey, error)
+	Page(ctx context.Context, pagination pagination.Pagination) (int64, []*model.ApiKey, error)
+}
@@ -0,0 +1,26 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+// AuditLogFilter selects audit logs, empty fields and zero times match everything.
+type AuditLogFilter struct {
+	OperatorUserID string
+	ApiKeyID       string
+	Action         string
+	Target         string
+	StartTime      time.Time
+	EndTime        time.Time
+}
+
+type AuditLog interface {
+	Create(ctx context.Context, logs []*model.AuditLog) error
+	Search(ctx context.Context, filter *AuditLogFilter, pagination pagination.Pagination) (int64, []*model.AuditLog, error)
+	// DeleteBefore removes the logs created before t and returns how many were removed.
+	DeleteBefore(ctx context.Context, t time.Time) (int64, error)
+}
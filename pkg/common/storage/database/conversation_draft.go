@@ -0,0 +1,21 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type ConversationDraft interface {
+	// Set stores the draft unless a draft set or cleared after it is stored, and returns whether it was stored.
+	Set(ctx context.Context, draft *model.ConversationDraft) (bool, error)
+	// Clear empties the draft set before clearTime, and returns whether there was one.
+	Clear(ctx context.Context, ownerUserID string, conversationID string, clearTime time.Time) (bool, error)
+	// Find returns the drafts of the conversations, leaving out the cleared ones.
+	Find(ctx context.Context, ownerUserID string, conversationIDs []string) ([]*model.ConversationDraft, error)
+	// FindAll returns the most recently updated drafts of the user, at most limit.
+	FindAll(ctx context.Context, ownerUserID string, limit int64) ([]*model.ConversationDraft, error)
+	FindConversationIDs(ctx context.Context, ownerUserID string) ([]string, error)
+	FindVersion(ctx context.Context, ownerUserID string, version uint, limit int) (*model.VersionLog, error)
+}
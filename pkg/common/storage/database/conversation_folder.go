@@ -0,0 +1,19 @@
+package database
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type ConversationFolder interface {
+	// Create stores the folder, it fails when the owner would have more than limit folders.
+	Create(ctx context.Context, folder *model.ConversationFolder, limit int) error
+	Update(ctx context.Context, ownerUserID string, folderID string, args map[string]any) error
+	AddConversations(ctx context.Context, ownerUserID string, folderID string, conversationIDs []string) error
+	RemoveConversations(ctx context.Context, ownerUserID string, folderID string, conversationIDs []string) error
+	Delete(ctx context.Context, ownerUserID string, folderIDs []string) error
+	Find(ctx context.Context, ownerUserID string, folderIDs []string) ([]*model.ConversationFolder, error)
+	FindAll(ctx context.Context, ownerUserID string) ([]*model.ConversationFolder, error)
+	FindVersion(ctx context.Context, ownerUserID string, version uint, limit int) (*model.VersionLog, error)
+}
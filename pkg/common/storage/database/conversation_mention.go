@@ -0,0 +1,16 @@
+package database
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type ConversationMention interface {
+	// AddSeq records that the message seq of the conversation mentions the users, keeping at most limit seqs per user.
+	AddSeq(ctx context.Context, conversationID string, userIDs []string, seq int64, limit int) error
+	// Prune removes the seqs up to hasReadSeq.
+	Prune(ctx context.Context, ownerUserID string, conversationID string, hasReadSeq int64) error
+	// Find returns the mentions of the user and of all the members in the conversations.
+	Find(ctx context.Context, ownerUserID string, conversationIDs []string) ([]*model.ConversationMention, error)
+}
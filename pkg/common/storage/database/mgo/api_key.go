@@ -0,0 +1,72 @@
+package mgo
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewApiKeyMongo(db *mongo.Database) (database.ApiKey, error) {
+	coll := db.Collection(database.ApiKeyName)
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "key_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &ApiKeyMgo{coll: coll}, nil
+}
+
+// ApiKeyMgo keeps the keys of all tenants in one collection, a key is found by its ID before its
+// tenant is known. The management of the keys only sees the keys of the tenant of the request.
+type ApiKeyMgo struct {
+	coll *mongo.Collection
+}
+
+func (a *ApiKeyMgo) tenantFilter(ctx context.Context, filter bson.M) bson.M {
+	if tenantID := tenant.GetTenantID(ctx); tenantID != "" {
+		filter["tenant_id"] = tenantID
+	} else {
+		// The keys created before tenants existed have no tenant_id.
+		filter["tenant_id"] = bson.M{"$in": bson.A{"", nil}}
+	}
+	return filter
+}
+
+func (a *ApiKeyMgo) Create(ctx context.Context, key *model.ApiKey) error {
+	key.TenantID = tenant.GetTenantID(ctx)
+	return mongoutil.InsertMany(ctx, a.coll, []*model.ApiKey{key})
+}
+
+func (a *ApiKeyMgo) Update(ctx context.Context, keyID string, data map[string]any) error {
+	if len(data) == 0 {
+		return nil
+	}
+	return mongoutil.UpdateOne(ctx, a.coll, a.tenantFilter(ctx, bson.M{"key_id": keyID}), bson.M{"$set": data}, true)
+}
+
+func (a *ApiKeyMgo) Delete(ctx context.Context, keyIDs []string) error {
+	if len(keyIDs) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, a.coll, a.tenantFilter(ctx, bson.M{"key_id": bson.M{"$in": keyIDs}}))
+}
+
+func (a *ApiKeyMgo) Find(ctx context.Context, keyIDs []string) ([]*model.ApiKey, error) {
+	return mongoutil.Find[*model.ApiKey](ctx, a.coll, bson.M{"key_id": bson.M{"$in": keyIDs}})
+}
+
+func (a *ApiKeyMgo) Page(ctx context.Context, pagination pagination.Pagination) (int64, []*model.ApiKey, error) {
+	return mongoutil.FindPage[*model.ApiKey](ctx, a.coll, a.tenantFilter(ctx, bson.M{}), pagination, options.Find().SetSort(bson.M{"create_time": -1}))
+}
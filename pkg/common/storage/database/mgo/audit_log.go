@@ -0,0 +1,93 @@
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewAuditLogMongo(db *mongo.Database) (database.AuditLog, error) {
+	coll, err := newTenantCollection(db, database.AuditLogName, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "create_time", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "operator_user_id", Value: 1},
+				{Key: "create_time", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "action", Value: 1},
+				{Key: "create_time", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "target", Value: 1},
+				{Key: "create_time", Value: -1},
+			},
+		},
+	}...)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &AuditLogMgo{coll: coll}, nil
+}
+
+type AuditLogMgo struct {
+	coll *tenantCollection
+}
+
+func (a *AuditLogMgo) Create(ctx context.Context, logs []*model.AuditLog) error {
+	if len(logs) == 0 {
+		return nil
+	}
+	return mongoutil.InsertMany(ctx, a.coll.get(ctx), logs)
+}
+
+func (a *AuditLogMgo) Search(ctx context.Context, filter *database.AuditLogFilter, pagination pagination.Pagination) (int64, []*model.AuditLog, error) {
+	query := bson.M{}
+	if filter.OperatorUserID != "" {
+		query["operator_user_id"] = filter.OperatorUserID
+	}
+	if filter.ApiKeyID != "" {
+		query["api_key_id"] = filter.ApiKeyID
+	}
+	if filter.Action != "" {
+		query["action"] = filter.Action
+	}
+	if filter.Target != "" {
+		query["target"] = filter.Target
+	}
+	createTime := bson.M{}
+	if !filter.StartTime.IsZero() {
+		createTime["$gte"] = filter.StartTime
+	}
+	if !filter.EndTime.IsZero() {
+		createTime["$lt"] = filter.EndTime
+	}
+	if len(createTime) > 0 {
+		query["create_time"] = createTime
+	}
+	return mongoutil.FindPage[*model.AuditLog](ctx, a.coll.get(ctx), query, pagination, options.Find().SetSort(bson.M{"create_time": -1}))
+}
+
+func (a *AuditLogMgo) DeleteBefore(ctx context.Context, t time.Time) (int64, error) {
+	res, err := mongoutil.DeleteManyResult(ctx, a.coll.get(ctx), bson.M{"create_time": bson.M{"$lt": t}})
+	if err != nil {
+		return 0, err
+	}
+	return res.DeletedCount, nil
+}
@@ -27,8 +27,7 @@ import (
 )
 
 func NewBlackMongo(db *mongo.Database) (database.Black, error) {
-	coll := db.Collection(database.BlackName)
-	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+	coll, err := newTenantCollection(db, database.BlackName, mongo.IndexModel{
 		Keys: bson.D{
 			{Key: "owner_user_id", Value: 1},
 			{Key: "block_user_id", Value: 1},
@@ -42,7 +41,7 @@ func NewBlackMongo(db *mongo.Database) (database.Black, error) {
 }
 
 type BlackMgo struct {
-	coll *mongo.Collection
+	coll *tenantCollection
 }
 
 func (b *BlackMgo) blackFilter(ownerUserID, blockUserID string) bson.M {
@@ -64,42 +63,42 @@ func (b *BlackMgo) blacksFilter(blacks []*model.Black) bson.M {
 }
 
 func (b *BlackMgo) Create(ctx context.Context, blacks []*model.Black) (err error) {
-	return mongoutil.InsertMany(ctx, b.coll, blacks)
+	return mongoutil.InsertMany(ctx, b.coll.get(ctx), blacks)
 }
 
 func (b *BlackMgo) Delete(ctx context.Context, blacks []*model.Black) (err error) {
 	if len(blacks) == 0 {
 		return nil
 	}
-	return mongoutil.DeleteMany(ctx, b.coll, b.blacksFilter(blacks))
+	return mongoutil.DeleteMany(ctx, b.coll.get(ctx), b.blacksFilter(blacks))
 }
 
 func (b *BlackMgo) UpdateByMap(ctx context.Context, ownerUserID, blockUserID string, args map[string]any) (err error) {
 	if len(args) == 0 {
 		return nil
 	}
-	return mongoutil.UpdateOne(ctx, b.coll, b.blackFilter(ownerUserID, blockUserID), bson.M{"$set": args}, false)
+	return mongoutil.UpdateOne(ctx, b.coll.get(ctx), b.blackFilter(ownerUserID, blockUserID), bson.M{"$set": args}, false)
 }
 
 func (b *BlackMgo) Find(ctx context.Context, blacks []*model.Black) (blackList []*model.Black, err error) {
-	return mongoutil.Find[*model.Black](ctx, b.coll, b.blacksFilter(blacks))
+	return mongoutil.Find[*model.Black](ctx, b.coll.get(ctx), b.blacksFilter(blacks))
 }
 
 func (b *BlackMgo) Take(ctx context.Context, ownerUserID, blockUserID string) (black *model.Black, err error) {
-	return mongoutil.FindOne[*model.Black](ctx, b.coll, b.blackFilter(ownerUserID, blockUserID))
+	return mongoutil.FindOne[*model.Black](ctx, b.coll.get(ctx), b.blackFilter(ownerUserID, blockUserID))
 }
 
 func (b *BlackMgo) FindOwnerBlacks(ctx context.Context, ownerUserID string, pagination pagination.Pagination) (total int64, blacks []*model.Black, err error) {
-	return mongoutil.FindPage[*model.Black](ctx, b.coll, bson.M{"owner_user_id": ownerUserID}, pagination)
+	return mongoutil.FindPage[*model.Black](ctx, b.coll.get(ctx), bson.M{"owner_user_id": ownerUserID}, pagination)
 }
 
 func (b *BlackMgo) FindOwnerBlackInfos(ctx context.Context, ownerUserID string, userIDs []string) (blacks []*model.Black, err error) {
 	if len(userIDs) == 0 {
-		return mongoutil.Find[*model.Black](ctx, b.coll, bson.M{"owner_user_id": ownerUserID})
+		return mongoutil.Find[*model.Black](ctx, b.coll.get(ctx), bson.M{"owner_user_id": ownerUserID})
 	}
-	return mongoutil.Find[*model.Black](ctx, b.coll, bson.M{"owner_user_id": ownerUserID, "block_user_id": bson.M{"$in": userIDs}})
+	return mongoutil.Find[*model.Black](ctx, b.coll.get(ctx), bson.M{"owner_user_id": ownerUserID, "block_user_id": bson.M{"$in": userIDs}})
 }
 
 func (b *BlackMgo) FindBlackUserIDs(ctx context.Context, ownerUserID string) (blackUserIDs []string, err error) {
-	return mongoutil.Find[string](ctx, b.coll, bson.M{"owner_user_id": ownerUserID}, options.Find().SetProjection(bson.M{"_id": 0, "block_user_id": 1}))
+	return mongoutil.Find[string](ctx, b.coll.get(ctx), bson.M{"owner_user_id": ownerUserID}, options.Find().SetProjection(bson.M{"_id": 0, "block_user_id": 1}))
 }
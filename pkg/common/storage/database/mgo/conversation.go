@@ -31,8 +31,7 @@ import (
 )
 
 func NewConversationMongo(db *mongo.Database) (*ConversationMgo, error) {
-	coll := db.Collection(database.ConversationName)
-	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+	coll, err := newTenantCollection(db, database.ConversationName, mongo.IndexModel{
 		Keys: bson.D{
 			{Key: "owner_user_id", Value: 1},
 			{Key: "conversation_id", Value: 1},
@@ -42,7 +41,7 @@ func NewConversationMongo(db *mongo.Database) (*ConversationMgo, error) {
 	if err != nil {
 		return nil, errs.Wrap(err)
 	}
-	version, err := NewVersionLog(db.Collection(database.ConversationVersionName))
+	version, err := NewVersionLog(db, database.ConversationVersionName)
 	if err != nil {
 		return nil, err
 	}
@@ -51,12 +50,12 @@ func NewConversationMongo(db *mongo.Database) (*ConversationMgo, error) {
 
 type ConversationMgo struct {
 	version database.VersionLog
-	coll    *mongo.Collection
+	coll    *tenantCollection
 }
 
 func (c *ConversationMgo) Create(ctx context.Context, conversations []*model.Conversation) (err error) {
 	return mongoutil.IncrVersion(func() error {
-		return mongoutil.InsertMany(ctx, c.coll, conversations)
+		return mongoutil.InsertMany(ctx, c.coll.get(ctx), conversations)
 	}, func() error {
 		userConversation := make(map[string][]string)
 		for _, conversation := range conversations {
@@ -81,7 +80,7 @@ func (c *ConversationMgo) UpdateByMap(ctx context.Context, userIDs []string, con
 	}
 	var rows int64
 	err := mongoutil.IncrVersion(func() error {
-		res, err := mongoutil.UpdateMany(ctx, c.coll, filter, bson.M{"$set": args})
+		res, err := mongoutil.UpdateMany(ctx, c.coll.get(ctx), filter, bson.M{"$set": args})
 		if err != nil {
 			return err
 		}
@@ -103,52 +102,52 @@ func (c *ConversationMgo) UpdateByMap(ctx context.Context, userIDs []string, con
 
 func (c *ConversationMgo) Update(ctx context.Context, conversation *model.Conversation) (err error) {
 	return mongoutil.IncrVersion(func() error {
-		return mongoutil.UpdateOne(ctx, c.coll, bson.M{"owner_user_id": conversation.OwnerUserID, "conversation_id": conversation.ConversationID}, bson.M{"$set": conversation}, true)
+		return mongoutil.UpdateOne(ctx, c.coll.get(ctx), bson.M{"owner_user_id": conversation.OwnerUserID, "conversation_id": conversation.ConversationID}, bson.M{"$set": conversation}, true)
 	}, func() error {
 		return c.version.IncrVersion(ctx, conversation.OwnerUserID, []string{conversation.ConversationID}, model.VersionStateUpdate)
 	})
 }
 
 func (c *ConversationMgo) Find(ctx context.Context, ownerUserID string, conversationIDs []string) (conversations []*model.Conversation, err error) {
-	return mongoutil.Find[*model.Conversation](ctx, c.coll, bson.M{"owner_user_id": ownerUserID, "conversation_id": bson.M{"$in": conversationIDs}})
+	return mongoutil.Find[*model.Conversation](ctx, c.coll.get(ctx), bson.M{"owner_user_id": ownerUserID, "conversation_id": bson.M{"$in": conversationIDs}})
 }
 
 func (c *ConversationMgo) FindUserID(ctx context.Context, userIDs []string, conversationIDs []string) ([]string, error) {
 	return mongoutil.Find[string](
 		ctx,
-		c.coll,
+		c.coll.get(ctx),
 		bson.M{"owner_user_id": bson.M{"$in": userIDs}, "conversation_id": bson.M{"$in": conversationIDs}},
 		options.Find().SetProjection(bson.M{"_id": 0, "owner_user_id": 1}),
 	)
 }
 func (c *ConversationMgo) FindUserIDAllConversationID(ctx context.Context, userID string) ([]string, error) {
-	return mongoutil.Find[string](ctx, c.coll, bson.M{"owner_user_id": userID}, options.Find().SetProjection(bson.M{"_id": 0, "conversation_id": 1}))
+	return mongoutil.Find[string](ctx, c.coll.get(ctx), bson.M{"owner_user_id": userID}, options.Find().SetProjection(bson.M{"_id": 0, "conversation_id": 1}))
 }
 
 func (c *ConversationMgo) FindUserIDAllNotNotifyConversationID(ctx context.Context, userID string) ([]string, error) {
-	return mongoutil.Find[string](ctx, c.coll, bson.M{
+	return mongoutil.Find[string](ctx, c.coll.get(ctx), bson.M{
 		"owner_user_id": userID,
 		"recv_msg_opt":  constant.ReceiveNotNotifyMessage,
 	}, options.Find().SetProjection(bson.M{"_id": 0, "conversation_id": 1}))
 }
 
 func (c *ConversationMgo) FindUserIDAllPinnedConversationID(ctx context.Context, userID string) ([]string, error) {
-	return mongoutil.Find[string](ctx, c.coll, bson.M{
+	return mongoutil.Find[string](ctx, c.coll.get(ctx), bson.M{
 		"owner_user_id": userID,
 		"is_pinned":     true,
 	}, options.Find().SetProjection(bson.M{"_id": 0, "conversation_id": 1}))
 }
 
 func (c *ConversationMgo) Take(ctx context.Context, userID, conversationID string) (conversation *model.Conversation, err error) {
-	return mongoutil.FindOne[*model.Conversation](ctx, c.coll, bson.M{"owner_user_id": userID, "conversation_id": conversationID})
+	return mongoutil.FindOne[*model.Conversation](ctx, c.coll.get(ctx), bson.M{"owner_user_id": userID, "conversation_id": conversationID})
 }
 
 func (c *ConversationMgo) FindConversationID(ctx context.Context, userID string, conversationIDs []string) (existConversationID []string, err error) {
-	return mongoutil.Find[string](ctx, c.coll, bson.M{"owner_user_id": userID, "conversation_id": bson.M{"$in": conversationIDs}}, options.Find().SetProjection(bson.M{"_id": 0, "conversation_id": 1}))
+	return mongoutil.Find[string](ctx, c.coll.get(ctx), bson.M{"owner_user_id": userID, "conversation_id": bson.M{"$in": conversationIDs}}, options.Find().SetProjection(bson.M{"_id": 0, "conversation_id": 1}))
 }
 
 func (c *ConversationMgo) FindUserIDAllConversations(ctx context.Context, userID string) (conversations []*model.Conversation, err error) {
-	return mongoutil.Find[*model.Conversation](ctx, c.coll, bson.M{"owner_user_id": userID})
+	return mongoutil.Find[*model.Conversation](ctx, c.coll.get(ctx), bson.M{"owner_user_id": userID})
 }
 
 func (c *ConversationMgo) FindRecvMsgUserIDs(ctx context.Context, conversationID string, recvOpts []int) ([]string, error) {
@@ -158,22 +157,22 @@ func (c *ConversationMgo) FindRecvMsgUserIDs(ctx context.Context, conversationID
 	} else {
 		filter = bson.M{"conversation_id": conversationID, "recv_msg_opt": bson.M{"$in": recvOpts}}
 	}
-	return mongoutil.Find[string](ctx, c.coll, filter, options.Find().SetProjection(bson.M{"_id": 0, "owner_user_id": 1}))
+	return mongoutil.Find[string](ctx, c.coll.get(ctx), filter, options.Find().SetProjection(bson.M{"_id": 0, "owner_user_id": 1}))
 }
 
 func (c *ConversationMgo) GetUserRecvMsgOpt(ctx context.Context, ownerUserID, conversationID string) (opt int, err error) {
-	return mongoutil.FindOne[int](ctx, c.coll, bson.M{"owner_user_id": ownerUserID, "conversation_id": conversationID}, options.FindOne().SetProjection(bson.M{"recv_msg_opt": 1}))
+	return mongoutil.FindOne[int](ctx, c.coll.get(ctx), bson.M{"owner_user_id": ownerUserID, "conversation_id": conversationID}, options.FindOne().SetProjection(bson.M{"recv_msg_opt": 1}))
 }
 
 func (c *ConversationMgo) GetAllConversationIDs(ctx context.Context) ([]string, error) {
-	return mongoutil.Aggregate[string](ctx, c.coll, []bson.M{
+	return mongoutil.Aggregate[string](ctx, c.coll.get(ctx), []bson.M{
 		{"$group": bson.M{"_id": "$conversation_id"}},
 		{"$project": bson.M{"_id": 0, "conversation_id": "$_id"}},
 	})
 }
 
 func (c *ConversationMgo) GetAllConversationIDsNumber(ctx context.Context) (int64, error) {
-	counts, err := mongoutil.Aggregate[int64](ctx, c.coll, []bson.M{
+	counts, err := mongoutil.Aggregate[int64](ctx, c.coll.get(ctx), []bson.M{
 		{"$group": bson.M{"_id": "$conversation_id"}},
 		{"$group": bson.M{"_id": nil, "count": bson.M{"$sum": 1}}},
 		{"$project": bson.M{"_id": 0}},
@@ -188,16 +187,16 @@ func (c *ConversationMgo) GetAllConversationIDsNumber(ctx context.Context) (int6
 }
 
 func (c *ConversationMgo) PageConversationIDs(ctx context.Context, pagination pagination.Pagination) (conversationIDs []string, err error) {
-	return mongoutil.FindPageOnly[string](ctx, c.coll, bson.M{}, pagination, options.Find().SetProjection(bson.M{"conversation_id": 1}))
+	return mongoutil.FindPageOnly[string](ctx, c.coll.get(ctx), bson.M{}, pagination, options.Find().SetProjection(bson.M{"conversation_id": 1}))
 }
 
 func (c *ConversationMgo) GetConversationsByConversationID(ctx context.Context, conversationIDs []string) ([]*model.Conversation, error) {
-	return mongoutil.Find[*model.Conversation](ctx, c.coll, bson.M{"conversation_id": bson.M{"$in": conversationIDs}})
+	return mongoutil.Find[*model.Conversation](ctx, c.coll.get(ctx), bson.M{"conversation_id": bson.M{"$in": conversationIDs}})
 }
 
 func (c *ConversationMgo) GetConversationIDsNeedDestruct(ctx context.Context) ([]*model.Conversation, error) {
 	// "is_msg_destruct = 1 && msg_destruct_time != 0 && (UNIX_TIMESTAMP(NOW()) > (msg_destruct_time + UNIX_TIMESTAMP(latest_msg_destruct_time)) || latest_msg_destruct_time is NULL)"
-	return mongoutil.Find[*model.Conversation](ctx, c.coll, bson.M{
+	return mongoutil.Find[*model.Conversation](ctx, c.coll.get(ctx), bson.M{
 		"is_msg_destruct":   1,
 		"msg_destruct_time": bson.M{"$ne": 0},
 		"$or": []bson.M{
@@ -219,7 +218,7 @@ func (c *ConversationMgo) GetConversationIDsNeedDestruct(ctx context.Context) ([
 func (c *ConversationMgo) GetConversationNotReceiveMessageUserIDs(ctx context.Context, conversationID string) ([]string, error) {
 	return mongoutil.Find[string](
 		ctx,
-		c.coll,
+		c.coll.get(ctx),
 		bson.M{"conversation_id": conversationID, "recv_msg_opt": bson.M{"$ne": constant.ReceiveMessage}},
 		options.Find().SetProjection(bson.M{"_id": 0, "owner_user_id": 1}),
 	)
@@ -258,5 +257,5 @@ func (c *ConversationMgo) FindRandConversation(ctx context.Context, ts int64, li
 			},
 		},
 	}
-	return mongoutil.Aggregate[*model.Conversation](ctx, c.coll, pipeline)
+	return mongoutil.Aggregate[*model.Conversation](ctx, c.coll.get(ctx), pipeline)
 }
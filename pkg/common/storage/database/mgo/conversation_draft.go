@@ -0,0 +1,92 @@
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewConversationDraftMongo(db *mongo.Database) (database.ConversationDraft, error) {
+	coll, err := newTenantCollection(db, database.ConversationDraftName, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "owner_user_id", Value: 1},
+				{Key: "conversation_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "owner_user_id", Value: 1},
+				{Key: "update_time", Value: -1},
+			},
+		},
+	}...)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	version, err := NewVersionLog(db, database.ConversationDraftVersionName)
+	if err != nil {
+		return nil, err
+	}
+	return &ConversationDraftMgo{coll: coll, version: version}, nil
+}
+
+// ConversationDraftMgo keeps the cleared drafts empty instead of deleting them, their update time
+// keeps a draft set before the clear from being stored after it.
+type ConversationDraftMgo struct {
+	coll    *tenantCollection
+	version database.VersionLog
+}
+
+// notEmptyDraft matches the drafts which are not cleared.
+var notEmptyDraft = bson.A{bson.M{"text": bson.M{"$ne": ""}}, bson.M{"reply_client_msg_id": bson.M{"$ne": ""}}}
+
+func (c *ConversationDraftMgo) Set(ctx context.Context, draft *model.ConversationDraft) (bool, error) {
+	filter := bson.M{"owner_user_id": draft.OwnerUserID, "conversation_id": draft.ConversationID, "update_time": bson.M{"$lt": draft.UpdateTime}}
+	// A newer draft fails the filter, the upsert then conflicts with it on the unique index.
+	if _, err := c.coll.get(ctx).UpdateOne(ctx, filter, bson.M{"$set": draft}, options.Update().SetUpsert(true)); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+		return false, errs.WrapMsg(err, "mongo update one")
+	}
+	return true, c.version.IncrVersion(ctx, draft.OwnerUserID, []string{draft.ConversationID}, model.VersionStateUpdate)
+}
+
+func (c *ConversationDraftMgo) Clear(ctx context.Context, ownerUserID string, conversationID string, clearTime time.Time) (bool, error) {
+	filter := bson.M{"owner_user_id": ownerUserID, "conversation_id": conversationID, "update_time": bson.M{"$lt": clearTime}, "$or": notEmptyDraft}
+	update := bson.M{"$set": bson.M{"text": "", "reply_client_msg_id": "", "update_time": clearTime}}
+	res, err := mongoutil.UpdateOneResult(ctx, c.coll.get(ctx), filter, update)
+	if err != nil {
+		return false, err
+	}
+	if res.ModifiedCount == 0 {
+		return false, nil
+	}
+	return true, c.version.IncrVersion(ctx, ownerUserID, []string{conversationID}, model.VersionStateDelete)
+}
+
+func (c *ConversationDraftMgo) Find(ctx context.Context, ownerUserID string, conversationIDs []string) ([]*model.ConversationDraft, error) {
+	return mongoutil.Find[*model.ConversationDraft](ctx, c.coll.get(ctx), bson.M{"owner_user_id": ownerUserID, "conversation_id": bson.M{"$in": conversationIDs}, "$or": notEmptyDraft})
+}
+
+func (c *ConversationDraftMgo) FindAll(ctx context.Context, ownerUserID string, limit int64) ([]*model.ConversationDraft, error) {
+	opts := options.Find().SetSort(bson.M{"update_time": -1}).SetLimit(limit)
+	return mongoutil.Find[*model.ConversationDraft](ctx, c.coll.get(ctx), bson.M{"owner_user_id": ownerUserID, "$or": notEmptyDraft}, opts)
+}
+
+func (c *ConversationDraftMgo) FindConversationIDs(ctx context.Context, ownerUserID string) ([]string, error) {
+	return mongoutil.Find[string](ctx, c.coll.get(ctx), bson.M{"owner_user_id": ownerUserID, "$or": notEmptyDraft}, options.Find().SetProjection(bson.M{"_id": 0, "conversation_id": 1}))
+}
+
+func (c *ConversationDraftMgo) FindVersion(ctx context.Context, ownerUserID string, version uint, limit int) (*model.VersionLog, error) {
+	return c.version.FindChangeLog(ctx, ownerUserID, version, limit)
+}
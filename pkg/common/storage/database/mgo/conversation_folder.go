@@ -0,0 +1,114 @@
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewConversationFolderMongo(db *mongo.Database) (database.ConversationFolder, error) {
+	coll, err := newTenantCollection(db, database.ConversationFolderName, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "owner_user_id", Value: 1},
+			{Key: "folder_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	version, err := NewVersionLog(db, database.ConversationFolderVersionName)
+	if err != nil {
+		return nil, err
+	}
+	return &ConversationFolderMgo{coll: coll, version: version}, nil
+}
+
+type ConversationFolderMgo struct {
+	coll    *tenantCollection
+	version database.VersionLog
+}
+
+// Create inserts the folder unless its owner then has more than limit folders. The folders are
+// counted after the insert, which is undone when over the limit, so concurrent creates can not
+// pass a check of the count made before either of them was inserted.
+func (c *ConversationFolderMgo) Create(ctx context.Context, folder *model.ConversationFolder, limit int) error {
+	return mongoutil.IncrVersion(func() error {
+		coll := c.coll.get(ctx)
+		if err := mongoutil.InsertMany(ctx, coll, []*model.ConversationFolder{folder}); err != nil {
+			return err
+		}
+		count, countErr := mongoutil.Count(ctx, coll, bson.M{"owner_user_id": folder.OwnerUserID})
+		if countErr == nil && count <= int64(limit) {
+			return nil
+		}
+		if err := mongoutil.DeleteOne(ctx, coll, bson.M{"owner_user_id": folder.OwnerUserID, "folder_id": folder.FolderID}); err != nil {
+			return err
+		}
+		if countErr != nil {
+			return countErr
+		}
+		return errs.ErrArgs.WrapMsg("too many conversation folders", "max", limit)
+	}, func() error {
+		return c.version.IncrVersion(ctx, folder.OwnerUserID, []string{folder.FolderID}, model.VersionStateInsert)
+	})
+}
+
+func (c *ConversationFolderMgo) Update(ctx context.Context, ownerUserID string, folderID string, args map[string]any) error {
+	if len(args) == 0 {
+		return nil
+	}
+	return c.update(ctx, ownerUserID, folderID, bson.M{"$set": args})
+}
+
+func (c *ConversationFolderMgo) AddConversations(ctx context.Context, ownerUserID string, folderID string, conversationIDs []string) error {
+	return c.update(ctx, ownerUserID, folderID, bson.M{
+		"$addToSet": bson.M{"conversation_ids": bson.M{"$each": conversationIDs}},
+		"$set":      bson.M{"update_time": time.Now()},
+	})
+}
+
+func (c *ConversationFolderMgo) RemoveConversations(ctx context.Context, ownerUserID string, folderID string, conversationIDs []string) error {
+	return c.update(ctx, ownerUserID, folderID, bson.M{
+		"$pull": bson.M{"conversation_ids": bson.M{"$in": conversationIDs}},
+		"$set":  bson.M{"update_time": time.Now()},
+	})
+}
+
+func (c *ConversationFolderMgo) update(ctx context.Context, ownerUserID string, folderID string, update bson.M) error {
+	return mongoutil.IncrVersion(func() error {
+		return mongoutil.UpdateOne(ctx, c.coll.get(ctx), bson.M{"owner_user_id": ownerUserID, "folder_id": folderID}, update, true)
+	}, func() error {
+		return c.version.IncrVersion(ctx, ownerUserID, []string{folderID}, model.VersionStateUpdate)
+	})
+}
+
+func (c *ConversationFolderMgo) Delete(ctx context.Context, ownerUserID string, folderIDs []string) error {
+	if len(folderIDs) == 0 {
+		return nil
+	}
+	return mongoutil.IncrVersion(func() error {
+		return mongoutil.DeleteMany(ctx, c.coll.get(ctx), bson.M{"owner_user_id": ownerUserID, "folder_id": bson.M{"$in": folderIDs}})
+	}, func() error {
+		return c.version.IncrVersion(ctx, ownerUserID, folderIDs, model.VersionStateDelete)
+	})
+}
+
+func (c *ConversationFolderMgo) Find(ctx context.Context, ownerUserID string, folderIDs []string) ([]*model.ConversationFolder, error) {
+	return mongoutil.Find[*model.ConversationFolder](ctx, c.coll.get(ctx), bson.M{"owner_user_id": ownerUserID, "folder_id": bson.M{"$in": folderIDs}})
+}
+
+func (c *ConversationFolderMgo) FindAll(ctx context.Context, ownerUserID string) ([]*model.ConversationFolder, error) {
+	return mongoutil.Find[*model.ConversationFolder](ctx, c.coll.get(ctx), bson.M{"owner_user_id": ownerUserID}, options.Find().SetSort(bson.M{"order": 1}))
+}
+
+func (c *ConversationFolderMgo) FindVersion(ctx context.Context, ownerUserID string, version uint, limit int) (*model.VersionLog, error) {
+	return c.version.FindChangeLog(ctx, ownerUserID, version, limit)
+}
@@ -0,0 +1,75 @@
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// mentionRetention is how long the mentions of a user, or of all the members, in a conversation are
+// kept after the last one was added. The mentions of all the members are never pruned by a read.
+const mentionRetention = 30 * 24 * time.Hour
+
+func NewConversationMentionMongo(db *mongo.Database) (database.ConversationMention, error) {
+	coll, err := newTenantCollection(db, database.ConversationMentionName, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "owner_user_id", Value: 1},
+				{Key: "conversation_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys:    bson.M{"update_time": 1},
+			Options: options.Index().SetExpireAfterSeconds(int32(mentionRetention / time.Second)),
+		},
+	}...)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &ConversationMentionMgo{coll: coll}, nil
+}
+
+type ConversationMentionMgo struct {
+	coll *tenantCollection
+}
+
+func (c *ConversationMentionMgo) AddSeq(ctx context.Context, conversationID string, userIDs []string, seq int64, limit int) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	update := bson.M{
+		"$push": bson.M{"seqs": bson.M{"$each": []int64{seq}, "$sort": 1, "$slice": -limit}},
+		"$set":  bson.M{"update_time": time.Now()},
+	}
+	models := make([]mongo.WriteModel, 0, len(userIDs))
+	for _, userID := range userIDs {
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"owner_user_id": userID, "conversation_id": conversationID}).
+			SetUpdate(update).
+			SetUpsert(true))
+	}
+	_, err := c.coll.get(ctx).BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	return errs.Wrap(err)
+}
+
+func (c *ConversationMentionMgo) Prune(ctx context.Context, ownerUserID string, conversationID string, hasReadSeq int64) error {
+	filter := bson.M{"owner_user_id": ownerUserID, "conversation_id": conversationID, "seqs": bson.M{"$lte": hasReadSeq}}
+	return mongoutil.UpdateOne(ctx, c.coll.get(ctx), filter, bson.M{"$pull": bson.M{"seqs": bson.M{"$lte": hasReadSeq}}}, false)
+}
+
+func (c *ConversationMentionMgo) Find(ctx context.Context, ownerUserID string, conversationIDs []string) ([]*model.ConversationMention, error) {
+	filter := bson.M{
+		"owner_user_id":   bson.M{"$in": []string{ownerUserID, model.MentionAllOwnerUserID}},
+		"conversation_id": bson.M{"$in": conversationIDs},
+		"seqs.0":          bson.M{"$exists": true},
+	}
+	return mongoutil.Find[*model.ConversationMention](ctx, c.coll.get(ctx), filter)
+}
@@ -21,8 +21,7 @@ import (
 )
 
 func NewMsgMongo(db *mongo.Database) (database.Msg, error) {
-	coll := db.Collection(new(model.MsgDocModel).TableName())
-	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+	coll, err := newTenantCollection(db, new(model.MsgDocModel).TableName(), mongo.IndexModel{
 		Keys: bson.D{
 			{Key: "doc_id", Value: 1},
 		},
@@ -35,12 +34,12 @@ func NewMsgMongo(db *mongo.Database) (database.Msg, error) {
 }
 
 type MsgMgo struct {
-	coll  *mongo.Collection
+	coll  *tenantCollection
 	model model.MsgDocModel
 }
 
 func (m *MsgMgo) Create(ctx context.Context, msg *model.MsgDocModel) error {
-	return mongoutil.InsertMany(ctx, m.coll, []*model.MsgDocModel{msg})
+	return mongoutil.InsertMany(ctx, m.coll.get(ctx), []*model.MsgDocModel{msg})
 }
 
 func (m *MsgMgo) UpdateMsg(ctx context.Context, docID string, index int64, key string, value any) (*mongo.UpdateResult, error) {
@@ -52,7 +51,7 @@ func (m *MsgMgo) UpdateMsg(ctx context.Context, docID string, index int64, key s
 	}
 	filter := bson.M{"doc_id": docID}
 	update := bson.M{"$set": bson.M{field: value}}
-	return mongoutil.UpdateOneResult(ctx, m.coll, filter, update)
+	return mongoutil.UpdateOneResult(ctx, m.coll.get(ctx), filter, update)
 }
 
 func (m *MsgMgo) PushUnique(ctx context.Context, docID string, index int64, key string, value any) (*mongo.UpdateResult, error) {
@@ -68,11 +67,11 @@ func (m *MsgMgo) PushUnique(ctx context.Context, docID string, index int64, key
 			field: bson.M{"$each": value},
 		},
 	}
-	return mongoutil.UpdateOneResult(ctx, m.coll, filter, update)
+	return mongoutil.UpdateOneResult(ctx, m.coll.get(ctx), filter, update)
 }
 
 func (m *MsgMgo) FindOneByDocID(ctx context.Context, docID string) (*model.MsgDocModel, error) {
-	return mongoutil.FindOne[*model.MsgDocModel](ctx, m.coll, bson.M{"doc_id": docID})
+	return mongoutil.FindOne[*model.MsgDocModel](ctx, m.coll.get(ctx), bson.M{"doc_id": docID})
 }
 
 func (m *MsgMgo) GetMsgBySeqIndexIn1Doc(ctx context.Context, userID, docID string, seqs []int64) ([]*model.MsgInfoModel, error) {
@@ -121,7 +120,7 @@ func (m *MsgMgo) getMsgBySeqIndexIn1Doc(ctx context.Context, userID, docID strin
 			}},
 		}}},
 	}
-	msgDocModel, err := mongoutil.Aggregate[*model.MsgDocModel](ctx, m.coll, pipeline)
+	msgDocModel, err := mongoutil.Aggregate[*model.MsgDocModel](ctx, m.coll.get(ctx), pipeline)
 	if err != nil {
 		return nil, err
 	}
@@ -205,7 +204,7 @@ func (m *MsgMgo) GetMsgDocModelByIndex(ctx context.Context, conversationID strin
 	}
 	opt := options.Find().SetSkip(index).SetSort(bson.M{"_id": sort}).SetLimit(1)
 	filter := bson.M{"doc_id": primitive.Regex{Pattern: fmt.Sprintf("^%s:", conversationID)}}
-	msgs, err := mongoutil.Find[*model.MsgDocModel](ctx, m.coll, filter, opt)
+	msgs, err := mongoutil.Find[*model.MsgDocModel](ctx, m.coll.get(ctx), filter, opt)
 	if err != nil {
 		return nil, err
 	}
@@ -224,7 +223,7 @@ func (m *MsgMgo) DeleteMsgsInOneDocByIndex(ctx context.Context, docID string, in
 			"msg": nil,
 		}
 	}
-	_, err := mongoutil.UpdateMany(ctx, m.coll, bson.M{"doc_id": docID}, update)
+	_, err := mongoutil.UpdateMany(ctx, m.coll.get(ctx), bson.M{"doc_id": docID}, update)
 	return err
 }
 
@@ -247,7 +246,7 @@ func (m *MsgMgo) MarkSingleChatMsgsAsRead(ctx context.Context, userID string, do
 			SetUpdate(update)
 		updates = append(updates, updateModel)
 	}
-	if _, err := m.coll.BulkWrite(ctx, updates); err != nil {
+	if _, err := m.coll.get(ctx).BulkWrite(ctx, updates); err != nil {
 		return errs.WrapMsg(err, fmt.Sprintf("docID is %s, indexes is %v", docID, indexes))
 	}
 	return nil
@@ -317,7 +316,7 @@ func (m *MsgMgo) searchMessageIndex(ctx context.Context, filter any, nextID prim
 		},
 		bson.M{"$sort": bson.M{"_id": 1}},
 	)
-	return mongoutil.Aggregate[searchMessageIndex](ctx, m.coll, pipeline)
+	return mongoutil.Aggregate[searchMessageIndex](ctx, m.coll.get(ctx), pipeline)
 }
 
 func (m *MsgMgo) searchMessage(ctx context.Context, req *msg.SearchMessageReq) (int64, []searchMessageIndex, error) {
@@ -411,7 +410,7 @@ func (m *MsgMgo) SearchMessage(ctx context.Context, req *msg.SearchMessageReq) (
 		msgs = make([]*model.MsgInfoModel, 0, n)
 	}
 	for _, val := range data {
-		res, err := mongoutil.FindOne[*model.MsgDocModel](ctx, m.coll, bson.M{"_id": val.ID})
+		res, err := mongoutil.FindOne[*model.MsgDocModel](ctx, m.coll.get(ctx), bson.M{"_id": val.ID})
 		if err != nil {
 			return 0, nil, err
 		}
@@ -652,7 +651,7 @@ func (m *MsgMgo) RangeUserSendCount(ctx context.Context, start time.Time, end ti
 			},
 		},
 	}
-	result, err := mongoutil.Aggregate[*Result](ctx, m.coll, pipeline, options.Aggregate().SetAllowDiskUse(true))
+	result, err := mongoutil.Aggregate[*Result](ctx, m.coll.get(ctx), pipeline, options.Aggregate().SetAllowDiskUse(true))
 	if err != nil {
 		return 0, 0, nil, nil, err
 	}
@@ -889,7 +888,7 @@ func (m *MsgMgo) RangeGroupSendCount(ctx context.Context, start time.Time, end t
 			},
 		},
 	}
-	result, err := mongoutil.Aggregate[*Result](ctx, m.coll, pipeline, options.Aggregate().SetAllowDiskUse(true))
+	result, err := mongoutil.Aggregate[*Result](ctx, m.coll.get(ctx), pipeline, options.Aggregate().SetAllowDiskUse(true))
 	if err != nil {
 		return 0, 0, nil, nil, err
 	}
@@ -911,7 +910,7 @@ func (m *MsgMgo) RangeGroupSendCount(ctx context.Context, start time.Time, end t
 }
 
 func (m *MsgMgo) GetRandBeforeMsg(ctx context.Context, ts int64, limit int) ([]*model.MsgDocModel, error) {
-	return mongoutil.Aggregate[*model.MsgDocModel](ctx, m.coll, []bson.M{
+	return mongoutil.Aggregate[*model.MsgDocModel](ctx, m.coll.get(ctx), []bson.M{
 		{
 			"$match": bson.M{
 				"msgs": bson.M{
@@ -942,7 +941,7 @@ func (m *MsgMgo) GetRandBeforeMsg(ctx context.Context, ts int64, limit int) ([]*
 }
 
 func (m *MsgMgo) DeleteDoc(ctx context.Context, docID string) error {
-	return mongoutil.DeleteOne(ctx, m.coll, bson.M{"doc_id": docID})
+	return mongoutil.DeleteOne(ctx, m.coll.get(ctx), bson.M{"doc_id": docID})
 }
 
 func (m *MsgMgo) GetLastMessageSeqByTime(ctx context.Context, conversationID string, time int64) (int64, error) {
@@ -978,7 +977,7 @@ func (m *MsgMgo) GetLastMessageSeqByTime(ctx context.Context, conversationID str
 			},
 		},
 	}
-	res, err := mongoutil.Aggregate[*model.MsgDocModel](ctx, m.coll, pipeline)
+	res, err := mongoutil.Aggregate[*model.MsgDocModel](ctx, m.coll.get(ctx), pipeline)
 	if err != nil {
 		return 0, err
 	}
@@ -1049,7 +1048,7 @@ func (m *MsgMgo) GetLastMessage(ctx context.Context, conversationID string) (*mo
 	type Result struct {
 		Msgs *model.MsgInfoModel `bson:"msgs"`
 	}
-	res, err := mongoutil.Aggregate[*Result](ctx, m.coll, pipeline)
+	res, err := mongoutil.Aggregate[*Result](ctx, m.coll.get(ctx), pipeline)
 	if err != nil {
 		return nil, err
 	}
@@ -1081,7 +1080,7 @@ func (m *MsgMgo) onlyFindDocIndex(ctx context.Context, docID string, indexes []i
 			}},
 		}}},
 	}
-	msgDocModel, err := mongoutil.Aggregate[*model.MsgDocModel](ctx, m.coll, pipeline)
+	msgDocModel, err := mongoutil.Aggregate[*model.MsgDocModel](ctx, m.coll.get(ctx), pipeline)
 	if err != nil {
 		return nil, err
 	}
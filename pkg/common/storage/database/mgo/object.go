@@ -29,35 +29,24 @@ import (
 )
 
 func NewS3Mongo(db *mongo.Database) (database.ObjectInfo, error) {
-	coll := db.Collection(database.ObjectName)
-
-	// Create index for name
-	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
-		Keys: bson.D{
-			{Key: "name", Value: 1},
+	coll, err := newTenantCollection(db, database.ObjectName, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "name", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
 		},
-		Options: options.Index().SetUnique(true),
-	})
-	if err != nil {
-		return nil, errs.Wrap(err)
-	}
-
-	// Create index for create_time
-	_, err = coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
-		Keys: bson.D{
-			{Key: "create_time", Value: 1},
+		{
+			Keys: bson.D{
+				{Key: "create_time", Value: 1},
+			},
 		},
-	})
-	if err != nil {
-		return nil, errs.Wrap(err)
-	}
-
-	// Create index for key
-	_, err = coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
-		Keys: bson.D{
-			{Key: "key", Value: 1},
+		{
+			Keys: bson.D{
+				{Key: "key", Value: 1},
+			},
 		},
-	})
+	}...)
 	if err != nil {
 		return nil, errs.Wrap(err)
 	}
@@ -66,7 +55,7 @@ func NewS3Mongo(db *mongo.Database) (database.ObjectInfo, error) {
 }
 
 type S3Mongo struct {
-	coll *mongo.Collection
+	coll *tenantCollection
 }
 
 func (o *S3Mongo) SetObject(ctx context.Context, obj *model.Object) error {
@@ -80,21 +69,21 @@ func (o *S3Mongo) SetObject(ctx context.Context, obj *model.Object) error {
 		"group":        obj.Group,
 		"create_time":  obj.CreateTime,
 	}
-	return mongoutil.UpdateOne(ctx, o.coll, filter, bson.M{"$set": update}, false, options.Update().SetUpsert(true))
+	return mongoutil.UpdateOne(ctx, o.coll.get(ctx), filter, bson.M{"$set": update}, false, options.Update().SetUpsert(true))
 }
 
 func (o *S3Mongo) Take(ctx context.Context, engine string, name string) (*model.Object, error) {
 	if engine == "" {
-		return mongoutil.FindOne[*model.Object](ctx, o.coll, bson.M{"name": name})
+		return mongoutil.FindOne[*model.Object](ctx, o.coll.get(ctx), bson.M{"name": name})
 	}
-	return mongoutil.FindOne[*model.Object](ctx, o.coll, bson.M{"name": name, "engine": engine})
+	return mongoutil.FindOne[*model.Object](ctx, o.coll.get(ctx), bson.M{"name": name, "engine": engine})
 }
 
 func (o *S3Mongo) Delete(ctx context.Context, engine string, name []string) error {
 	if len(name) == 0 {
 		return nil
 	}
-	return mongoutil.DeleteOne(ctx, o.coll, bson.M{"engine": engine, "name": bson.M{"$in": name}})
+	return mongoutil.DeleteOne(ctx, o.coll.get(ctx), bson.M{"engine": engine, "name": bson.M{"$in": name}})
 }
 
 func (o *S3Mongo) FindExpirationObject(ctx context.Context, engine string, expiration time.Time, needDelType []string, count int64) ([]*model.Object, error) {
@@ -102,7 +91,7 @@ func (o *S3Mongo) FindExpirationObject(ctx context.Context, engine string, expir
 	if count > 0 {
 		opt.SetLimit(count)
 	}
-	return mongoutil.Find[*model.Object](ctx, o.coll, bson.M{
+	return mongoutil.Find[*model.Object](ctx, o.coll.get(ctx), bson.M{
 		"engine":      engine,
 		"create_time": bson.M{"$lt": expiration},
 		"group":       bson.M{"$in": needDelType},
@@ -110,17 +99,17 @@ func (o *S3Mongo) FindExpirationObject(ctx context.Context, engine string, expir
 }
 
 func (o *S3Mongo) GetKeyCount(ctx context.Context, engine string, key string) (int64, error) {
-	return mongoutil.Count(ctx, o.coll, bson.M{"engine": engine, "key": key})
+	return mongoutil.Count(ctx, o.coll.get(ctx), bson.M{"engine": engine, "key": key})
 }
 
 func (o *S3Mongo) GetEngineCount(ctx context.Context, engine string) (int64, error) {
-	return mongoutil.Count(ctx, o.coll, bson.M{"engine": engine})
+	return mongoutil.Count(ctx, o.coll.get(ctx), bson.M{"engine": engine})
 }
 
 func (o *S3Mongo) GetEngineInfo(ctx context.Context, engine string, limit int, skip int) ([]*model.Object, error) {
-	return mongoutil.Find[*model.Object](ctx, o.coll, bson.M{"engine": engine}, options.Find().SetLimit(int64(limit)).SetSkip(int64(skip)))
+	return mongoutil.Find[*model.Object](ctx, o.coll.get(ctx), bson.M{"engine": engine}, options.Find().SetLimit(int64(limit)).SetSkip(int64(skip)))
 }
 
 func (o *S3Mongo) UpdateEngine(ctx context.Context, oldEngine, oldName string, newEngine string) error {
-	return mongoutil.UpdateOne(ctx, o.coll, bson.M{"engine": oldEngine, "name": oldName}, bson.M{"$set": bson.M{"engine": newEngine}}, false)
+	return mongoutil.UpdateOne(ctx, o.coll.get(ctx), bson.M{"engine": oldEngine, "name": oldName}, bson.M{"$set": bson.M{"engine": newEngine}}, false)
 }
@@ -0,0 +1,107 @@
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// NewOfflinePushDeadLetterMongo returns the dead letters, they are removed once kept for retention.
+func NewOfflinePushDeadLetterMongo(db *mongo.Database, retention time.Duration) (database.OfflinePushDeadLetter, error) {
+	coll, err := newTenantCollection(db, database.OfflinePushDeadLetterName, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "create_time", Value: 1},
+			},
+			Options: options.Index().SetExpireAfterSeconds(int32(retention / time.Second)),
+		},
+		{
+			Keys: bson.D{
+				{Key: "conversation_id", Value: 1},
+				{Key: "create_time", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "user_ids", Value: 1},
+				{Key: "create_time", Value: -1},
+			},
+		},
+	}...)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &OfflinePushDeadLetterMgo{coll: coll}, nil
+}
+
+type OfflinePushDeadLetterMgo struct {
+	coll *tenantCollection
+}
+
+func (o *OfflinePushDeadLetterMgo) Create(ctx context.Context, letter *model.OfflinePushDeadLetter) error {
+	return mongoutil.InsertMany(ctx, o.coll.get(ctx), []*model.OfflinePushDeadLetter{letter})
+}
+
+func (o *OfflinePushDeadLetterMgo) Search(ctx context.Context, filter *database.OfflinePushDeadLetterFilter, pagination pagination.Pagination) (int64, []*model.OfflinePushDeadLetter, error) {
+	query := bson.M{}
+	if filter.ConversationID != "" {
+		query["conversation_id"] = filter.ConversationID
+	}
+	if filter.UserID != "" {
+		query["user_ids"] = filter.UserID
+	}
+	if filter.Provider != "" {
+		query["providers"] = filter.Provider
+	}
+	if filter.ErrorClass != "" {
+		query["error_class"] = filter.ErrorClass
+	}
+	createTime := bson.M{}
+	if !filter.StartTime.IsZero() {
+		createTime["$gte"] = filter.StartTime
+	}
+	if !filter.EndTime.IsZero() {
+		createTime["$lt"] = filter.EndTime
+	}
+	if len(createTime) > 0 {
+		query["create_time"] = createTime
+	}
+	return mongoutil.FindPage[*model.OfflinePushDeadLetter](ctx, o.coll.get(ctx), query, pagination, options.Find().SetSort(bson.M{"create_time": -1}))
+}
+
+func (o *OfflinePushDeadLetterMgo) objectIDs(ids []string) ([]primitive.ObjectID, error) {
+	objectIDs := make([]primitive.ObjectID, 0, len(ids))
+	for _, id := range ids {
+		objectID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, errs.ErrArgs.WrapMsg("invalid dead letter id", "id", id)
+		}
+		objectIDs = append(objectIDs, objectID)
+	}
+	return objectIDs, nil
+}
+
+func (o *OfflinePushDeadLetterMgo) Find(ctx context.Context, ids []string) ([]*model.OfflinePushDeadLetter, error) {
+	objectIDs, err := o.objectIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+	return mongoutil.Find[*model.OfflinePushDeadLetter](ctx, o.coll.get(ctx), bson.M{"_id": bson.M{"$in": objectIDs}})
+}
+
+func (o *OfflinePushDeadLetterMgo) Delete(ctx context.Context, ids []string) error {
+	objectIDs, err := o.objectIDs(ids)
+	if err != nil {
+		return err
+	}
+	return mongoutil.DeleteMany(ctx, o.coll.get(ctx), bson.M{"_id": bson.M{"$in": objectIDs}})
+}
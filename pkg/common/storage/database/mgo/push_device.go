@@ -0,0 +1,104 @@
+package mgo
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewPushDeviceMongo(db *mongo.Database) (database.PushDevice, error) {
+	coll, err := newTenantCollection(db, database.PushDeviceName, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "device_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "provider", Value: 1},
+				{Key: "token", Value: 1},
+			},
+		},
+	}...)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &PushDeviceMgo{coll: coll}, nil
+}
+
+type PushDeviceMgo struct {
+	coll *tenantCollection
+}
+
+func (p *PushDeviceMgo) Register(ctx context.Context, device *model.PushDevice) error {
+	// A token moves with the app installation, e.g. when another user logs in on the device.
+	err := mongoutil.DeleteMany(ctx, p.coll.get(ctx), bson.M{
+		"provider": device.Provider,
+		"token":    device.Token,
+		"$or": bson.A{
+			bson.M{"user_id": bson.M{"$ne": device.UserID}},
+			bson.M{"device_id": bson.M{"$ne": device.DeviceID}},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	filter := bson.M{"user_id": device.UserID, "device_id": device.DeviceID}
+	update := bson.M{
+		"$set": bson.M{
+			"platform_id":    device.PlatformID,
+			"provider":       device.Provider,
+			"token":          device.Token,
+			"p256dh":         device.P256dh,
+			"auth":           device.Auth,
+			"app_version":    device.AppVersion,
+			"locale":         device.Locale,
+			"update_time":    device.UpdateTime,
+			"last_seen_time": device.LastSeenTime,
+		},
+		// Registering again on each login keeps the notifications of the device turned off.
+		"$setOnInsert": bson.M{"enabled": device.Enabled, "create_time": device.CreateTime},
+	}
+	return mongoutil.UpdateOne(ctx, p.coll.get(ctx), filter, update, false, options.Update().SetUpsert(true))
+}
+
+func (p *PushDeviceMgo) Update(ctx context.Context, userID string, deviceID string, data map[string]any) error {
+	if len(data) == 0 {
+		return nil
+	}
+	return mongoutil.UpdateOne(ctx, p.coll.get(ctx), bson.M{"user_id": userID, "device_id": deviceID}, bson.M{"$set": data}, true)
+}
+
+func (p *PushDeviceMgo) Unregister(ctx context.Context, userID string, deviceID string) error {
+	return mongoutil.DeleteOne(ctx, p.coll.get(ctx), bson.M{"user_id": userID, "device_id": deviceID})
+}
+
+func (p *PushDeviceMgo) UnregisterToken(ctx context.Context, userID string, token string) error {
+	return mongoutil.DeleteMany(ctx, p.coll.get(ctx), bson.M{"user_id": userID, "token": token})
+}
+
+func (p *PushDeviceMgo) FindByUser(ctx context.Context, userID string) ([]*model.PushDevice, error) {
+	return mongoutil.Find[*model.PushDevice](ctx, p.coll.get(ctx), bson.M{"user_id": userID}, options.Find().SetSort(bson.M{"create_time": 1}))
+}
+
+func (p *PushDeviceMgo) FindEnabledByUsers(ctx context.Context, userIDs []string) ([]*model.PushDevice, error) {
+	// The devices registered before the enabled flag existed have no such field, and are enabled.
+	return mongoutil.Find[*model.PushDevice](ctx, p.coll.get(ctx), bson.M{"user_id": bson.M{"$in": userIDs}, "enabled": bson.M{"$ne": false}})
+}
+
+func (p *PushDeviceMgo) FindDisabledTokens(ctx context.Context, provider string, userIDs []string) ([]string, error) {
+	filter := bson.M{"user_id": bson.M{"$in": userIDs}, "provider": provider, "enabled": false}
+	return mongoutil.Find[string](ctx, p.coll.get(ctx), filter, options.Find().SetProjection(bson.M{"_id": 0, "token": 1}))
+}
+
+func (p *PushDeviceMgo) DeleteTokens(ctx context.Context, provider string, tokens []string) error {
+	return mongoutil.DeleteMany(ctx, p.coll.get(ctx), bson.M{"provider": provider, "token": bson.M{"$in": tokens}})
+}
@@ -0,0 +1,122 @@
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewPushQuietHoursMongo(db *mongo.Database) (database.PushQuietHours, error) {
+	coll, err := newTenantCollection(db, database.PushQuietHoursName, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "user_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &PushQuietHoursMgo{coll: coll}, nil
+}
+
+type PushQuietHoursMgo struct {
+	coll *tenantCollection
+}
+
+func (p *PushQuietHoursMgo) Set(ctx context.Context, quietHours *model.PushQuietHours) error {
+	update := bson.M{
+		"$set": bson.M{
+			"time_zone":   quietHours.TimeZone,
+			"windows":     quietHours.Windows,
+			"overrides":   quietHours.Overrides,
+			"digest":      quietHours.Digest,
+			"update_time": quietHours.UpdateTime,
+		},
+	}
+	return mongoutil.UpdateOne(ctx, p.coll.get(ctx), bson.M{"user_id": quietHours.UserID}, update, false, options.Update().SetUpsert(true))
+}
+
+func (p *PushQuietHoursMgo) Snooze(ctx context.Context, userID string, until time.Time) error {
+	update := bson.M{"$set": bson.M{"snooze_until": until, "update_time": time.Now()}}
+	return mongoutil.UpdateOne(ctx, p.coll.get(ctx), bson.M{"user_id": userID}, update, false, options.Update().SetUpsert(true))
+}
+
+func (p *PushQuietHoursMgo) Find(ctx context.Context, userIDs []string) ([]*model.PushQuietHours, error) {
+	return mongoutil.Find[*model.PushQuietHours](ctx, p.coll.get(ctx), bson.M{"user_id": bson.M{"$in": userIDs}})
+}
+
+func (p *PushQuietHoursMgo) Delete(ctx context.Context, userID string) error {
+	return mongoutil.DeleteOne(ctx, p.coll.get(ctx), bson.M{"user_id": userID})
+}
+
+func NewPushQuietDigestMongo(db *mongo.Database) (database.PushQuietDigest, error) {
+	coll, err := newTenantCollection(db, database.PushQuietDigestName, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "send_time", Value: 1},
+			},
+		},
+	}...)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &PushQuietDigestMgo{coll: coll}, nil
+}
+
+type PushQuietDigestMgo struct {
+	coll *tenantCollection
+}
+
+func (p *PushQuietDigestMgo) Add(ctx context.Context, digests []*model.PushQuietDigest) error {
+	if len(digests) == 0 {
+		return nil
+	}
+	models := make([]mongo.WriteModel, 0, len(digests))
+	for _, digest := range digests {
+		conversationIDs := digest.ConversationIDs
+		if conversationIDs == nil {
+			conversationIDs = []string{}
+		}
+		update := bson.M{
+			"$inc":      bson.M{"count": digest.Count},
+			"$addToSet": bson.M{"conversation_ids": bson.M{"$each": conversationIDs}},
+			"$set":      bson.M{"send_time": digest.SendTime},
+		}
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"user_id": digest.UserID}).
+			SetUpdate(update).
+			SetUpsert(true))
+	}
+	_, err := p.coll.get(ctx).BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	return errs.Wrap(err)
+}
+
+func (p *PushQuietDigestMgo) FindDue(ctx context.Context, now time.Time, limit int64) ([]*model.PushQuietDigest, error) {
+	opts := options.Find().SetSort(bson.M{"send_time": 1}).SetLimit(limit)
+	return mongoutil.Find[*model.PushQuietDigest](ctx, p.coll.get(ctx), bson.M{"send_time": bson.M{"$lte": now}}, opts)
+}
+
+func (p *PushQuietDigestMgo) TakeDue(ctx context.Context, userID string, now time.Time) (*model.PushQuietDigest, error) {
+	var digest model.PushQuietDigest
+	err := p.coll.get(ctx).FindOneAndDelete(ctx, bson.M{"user_id": userID, "send_time": bson.M{"$lte": now}}).Decode(&digest)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &digest, nil
+}
@@ -0,0 +1,45 @@
+package mgo
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewPushTemplateMongo(db *mongo.Database) (database.PushTemplate, error) {
+	coll, err := newTenantCollection(db, database.PushTemplateName, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "content_type", Value: 1},
+			{Key: "locale", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &PushTemplateMgo{coll: coll}, nil
+}
+
+type PushTemplateMgo struct {
+	coll *tenantCollection
+}
+
+func (p *PushTemplateMgo) Set(ctx context.Context, template *model.PushTemplate) error {
+	filter := bson.M{"content_type": template.ContentType, "locale": template.Locale}
+	return mongoutil.UpdateOne(ctx, p.coll.get(ctx), filter, bson.M{"$set": template}, false, options.Update().SetUpsert(true))
+}
+
+func (p *PushTemplateMgo) Delete(ctx context.Context, contentType int32, locale string) error {
+	return mongoutil.DeleteOne(ctx, p.coll.get(ctx), bson.M{"content_type": contentType, "locale": locale})
+}
+
+func (p *PushTemplateMgo) FindAll(ctx context.Context) ([]*model.PushTemplate, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "content_type", Value: 1}, {Key: "locale", Value: 1}})
+	return mongoutil.Find[*model.PushTemplate](ctx, p.coll.get(ctx), bson.M{}, opts)
+}
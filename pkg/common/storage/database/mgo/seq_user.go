@@ -12,8 +12,7 @@ import (
 )
 
 func NewSeqUserMongo(db *mongo.Database) (database.SeqUser, error) {
-	coll := db.Collection(database.SeqUserName)
-	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+	coll, err := newTenantCollection(db, database.SeqUserName, mongo.IndexModel{
 		Keys: bson.D{
 			{Key: "user_id", Value: 1},
 			{Key: "conversation_id", Value: 1},
@@ -26,7 +25,7 @@ func NewSeqUserMongo(db *mongo.Database) (database.SeqUser, error) {
 }
 
 type seqUserMongo struct {
-	coll *mongo.Collection
+	coll *tenantCollection
 }
 
 func (s *seqUserMongo) setSeq(ctx context.Context, conversationID string, userID string, seq int64, field string) error {
@@ -49,7 +48,7 @@ func (s *seqUserMongo) setSeq(ctx context.Context, conversationID string, userID
 		"$setOnInsert": insert,
 	}
 	opt := options.Update().SetUpsert(true)
-	return mongoutil.UpdateOne(ctx, s.coll, filter, update, false, opt)
+	return mongoutil.UpdateOne(ctx, s.coll.get(ctx), filter, update, false, opt)
 }
 
 func (s *seqUserMongo) getSeq(ctx context.Context, conversationID string, userID string, failed string) (int64, error) {
@@ -58,7 +57,7 @@ func (s *seqUserMongo) getSeq(ctx context.Context, conversationID string, userID
 		"conversation_id": conversationID,
 	}
 	opt := options.FindOne().SetProjection(bson.M{"_id": 0, failed: 1})
-	seq, err := mongoutil.FindOne[int64](ctx, s.coll, filter, opt)
+	seq, err := mongoutil.FindOne[int64](ctx, s.coll.get(ctx), filter, opt)
 	if err == nil {
 		return seq, nil
 	} else if errors.Is(err, mongo.ErrNoDocuments) {
@@ -102,7 +101,7 @@ func (s *seqUserMongo) GetUserReadSeqs(ctx context.Context, userID string, conve
 	}
 	filter := bson.M{"user_id": userID, "conversation_id": bson.M{"$in": conversationID}}
 	opt := options.Find().SetProjection(bson.M{"_id": 0, "conversation_id": 1, "read_seq": 1})
-	seqs, err := mongoutil.Find[*model.SeqUser](ctx, s.coll, filter, opt)
+	seqs, err := mongoutil.Find[*model.SeqUser](ctx, s.coll.get(ctx), filter, opt)
 	if err != nil {
 		return nil, err
 	}
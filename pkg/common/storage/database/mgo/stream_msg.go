@@ -13,8 +13,7 @@ import (
 )
 
 func NewStreamMsgMongo(db *mongo.Database) (*StreamMsgMongo, error) {
-	coll := db.Collection(database.StreamMsgName)
-	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+	coll, err := newTenantCollection(db, database.StreamMsgName, mongo.IndexModel{
 		Keys: bson.D{
 			{Key: "client_msg_id", Value: 1},
 		},
@@ -27,14 +26,14 @@ func NewStreamMsgMongo(db *mongo.Database) (*StreamMsgMongo, error) {
 }
 
 type StreamMsgMongo struct {
-	coll *mongo.Collection
+	coll *tenantCollection
 }
 
 func (m *StreamMsgMongo) CreateStreamMsg(ctx context.Context, val *model.StreamMsg) error {
 	if val.Packets == nil {
 		val.Packets = []string{}
 	}
-	return mongoutil.InsertMany(ctx, m.coll, []*model.StreamMsg{val})
+	return mongoutil.InsertMany(ctx, m.coll.get(ctx), []*model.StreamMsg{val})
 }
 
 func (m *StreamMsgMongo) AppendStreamMsg(ctx context.Context, clientMsgID string, startIndex int, packets []string, end bool, deadlineTime time.Time) error {
@@ -52,9 +51,9 @@ func (m *StreamMsgMongo) AppendStreamMsg(ctx context.Context, clientMsgID string
 			},
 		}
 	}
-	return mongoutil.UpdateOne(ctx, m.coll, bson.M{"client_msg_id": clientMsgID, "end": false}, update, true)
+	return mongoutil.UpdateOne(ctx, m.coll.get(ctx), bson.M{"client_msg_id": clientMsgID, "end": false}, update, true)
 }
 
 func (m *StreamMsgMongo) GetStreamMsg(ctx context.Context, clientMsgID string) (*model.StreamMsg, error) {
-	return mongoutil.FindOne[*model.StreamMsg](ctx, m.coll, bson.M{"client_msg_id": clientMsgID})
+	return mongoutil.FindOne[*model.StreamMsg](ctx, m.coll.get(ctx), bson.M{"client_msg_id": clientMsgID})
 }
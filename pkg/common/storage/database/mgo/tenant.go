@@ -0,0 +1,90 @@
+package mgo
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	tenantIndexTimeout = time.Second * 10
+
+	// indexOptionsConflict is the code of the error creating an index that exists with other options.
+	indexOptionsConflict = 85
+	// indexNotFound is the code of the error modifying an index that does not exist.
+	indexNotFound = 27
+)
+
+// tenantCollection resolves the collection of the tenant of the request, so the documents of
+// different tenants never share a collection. The default tenant keeps the shared collection,
+// the indexes of a tenant collection are created the first time the tenant uses it.
+type tenantCollection struct {
+	db      *mongo.Database
+	name    string
+	indexes []mongo.IndexModel
+	colls   sync.Map // tenantID -> *mongo.Collection
+}
+
+func newTenantCollection(db *mongo.Database, name string, indexes ...mongo.IndexModel) (*tenantCollection, error) {
+	coll := db.Collection(name)
+	if len(indexes) > 0 {
+		if err := createIndexes(context.Background(), coll, indexes); err != nil {
+			return nil, errs.WrapMsg(err, "create index failed", "coll", name)
+		}
+	}
+	t := &tenantCollection{db: db, name: name, indexes: indexes}
+	t.colls.Store("", coll)
+	return t, nil
+}
+
+func (t *tenantCollection) get(ctx context.Context) *mongo.Collection {
+	tenantID := tenant.GetTenantID(ctx)
+	if coll, ok := t.colls.Load(tenantID); ok {
+		return coll.(*mongo.Collection)
+	}
+	coll := t.db.Collection(tenant.CollectionName(tenantID, t.name))
+	if len(t.indexes) > 0 {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), tenantIndexTimeout)
+		defer cancel()
+		if err := createIndexes(ctx, coll, t.indexes); err != nil {
+			// The indexes are created again on the next call.
+			log.ZError(ctx, "create tenant collection index failed", err, "coll", coll.Name())
+			return coll
+		}
+	}
+	t.colls.Store(tenantID, coll)
+	return coll
+}
+
+// createIndexes creates the indexes of the collection, the expiry of the TTL indexes created with another one is updated.
+func createIndexes(ctx context.Context, coll *mongo.Collection, indexes []mongo.IndexModel) error {
+	_, err := coll.Indexes().CreateMany(ctx, indexes)
+	var cmdErr mongo.CommandError
+	if !errors.As(err, &cmdErr) || cmdErr.Code != indexOptionsConflict {
+		return err
+	}
+	for _, index := range indexes {
+		if index.Options == nil || index.Options.ExpireAfterSeconds == nil {
+			continue
+		}
+		cmd := bson.D{
+			{Key: "collMod", Value: coll.Name()},
+			{Key: "index", Value: bson.D{
+				{Key: "keyPattern", Value: index.Keys},
+				{Key: "expireAfterSeconds", Value: *index.Options.ExpireAfterSeconds},
+			}},
+		}
+		if err := coll.Database().RunCommand(ctx, cmd).Err(); err != nil && !(errors.As(err, &cmdErr) && cmdErr.Code == indexNotFound) {
+			return err
+		}
+	}
+	_, err = coll.Indexes().CreateMany(ctx, indexes)
+	return err
+}
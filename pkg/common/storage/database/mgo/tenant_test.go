@@ -0,0 +1,39 @@
+package mgo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func TestTenantCollection(t *testing.T) {
+	// Connect does not dial, the collections are only resolved.
+	cli, err := mongo.Connect(context.Background(), options.Client().ApplyURI("mongodb://127.0.0.1:27017"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Disconnect(context.Background())
+	coll, err := newTenantCollection(cli.Database("openim_v3"), database.UserName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	ctxA := tenant.WithTenantID(ctx, "a")
+	ctxB := tenant.WithTenantID(ctx, "b")
+	for c, name := range map[context.Context]string{
+		ctx:  database.UserName,
+		ctxA: "tenant_a_" + database.UserName,
+		ctxB: "tenant_b_" + database.UserName,
+	} {
+		if got := coll.get(c).Name(); got != name {
+			t.Fatalf("tenant %q uses collection %q, want %q", tenant.GetTenantID(c), got, name)
+		}
+	}
+	if coll.get(ctxA) != coll.get(ctxA) {
+		t.Fatal("tenant collection is not reused")
+	}
+}
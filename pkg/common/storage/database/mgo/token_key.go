@@ -0,0 +1,50 @@
+package mgo
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewTokenKeyMongo(db *mongo.Database) (database.TokenKey, error) {
+	coll := db.Collection(database.TokenKeyName)
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "kid", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &TokenKeyMgo{coll: coll}, nil
+}
+
+type TokenKeyMgo struct {
+	coll *mongo.Collection
+}
+
+func (t *TokenKeyMgo) Create(ctx context.Context, key *model.TokenKey) error {
+	return mongoutil.InsertMany(ctx, t.coll, []*model.TokenKey{key})
+}
+
+func (t *TokenKeyMgo) FindAll(ctx context.Context) ([]*model.TokenKey, error) {
+	return mongoutil.Find[*model.TokenKey](ctx, t.coll, bson.M{}, options.Find().SetSort(bson.M{"create_time": -1}))
+}
+
+func (t *TokenKeyMgo) Delete(ctx context.Context, kids []string) error {
+	if len(kids) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, t.coll, bson.M{"kid": bson.M{"$in": kids}})
+}
+
+func (t *TokenKeyMgo) SetPrivateKey(ctx context.Context, kid string, privateKey string, encrypted bool) error {
+	return mongoutil.UpdateOne(ctx, t.coll, bson.M{"kid": kid}, bson.M{"$set": bson.M{"private_key": privateKey, "encrypted": encrypted}}, false)
+}
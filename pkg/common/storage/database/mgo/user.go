@@ -31,52 +31,64 @@ import (
 )
 
 func NewUserMongo(db *mongo.Database) (database.User, error) {
-	coll := db.Collection(database.UserName)
-	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
-		Keys: bson.D{
-			{Key: "user_id", Value: 1},
+	coll, err := newTenantCollection(db, database.UserName, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			// The custom profile fields are searched by any of their keys.
+			Keys: bson.D{
+				{Key: "fields.$**", Value: 1},
+			},
 		},
-		Options: options.Index().SetUnique(true),
-	})
+	}...)
 	if err != nil {
 		return nil, errs.Wrap(err)
 	}
-	return &UserMgo{coll: coll}, nil
+	commands, err := newTenantCollection(db, "userCommands")
+	if err != nil {
+		return nil, err
+	}
+	return &UserMgo{coll: coll, commands: commands}, nil
 }
 
 type UserMgo struct {
-	coll *mongo.Collection
+	coll     *tenantCollection
+	commands *tenantCollection
 }
 
 func (u *UserMgo) Create(ctx context.Context, users []*model.User) error {
-	return mongoutil.InsertMany(ctx, u.coll, users)
+	return mongoutil.InsertMany(ctx, u.coll.get(ctx), users)
 }
 
 func (u *UserMgo) UpdateByMap(ctx context.Context, userID string, args map[string]any) (err error) {
 	if len(args) == 0 {
 		return nil
 	}
-	return mongoutil.UpdateOne(ctx, u.coll, bson.M{"user_id": userID}, bson.M{"$set": args}, true)
+	return mongoutil.UpdateOne(ctx, u.coll.get(ctx), bson.M{"user_id": userID}, bson.M{"$set": args}, true)
 }
 
 func (u *UserMgo) Find(ctx context.Context, userIDs []string) (users []*model.User, err error) {
-	return mongoutil.Find[*model.User](ctx, u.coll, bson.M{"user_id": bson.M{"$in": userIDs}})
+	return mongoutil.Find[*model.User](ctx, u.coll.get(ctx), bson.M{"user_id": bson.M{"$in": userIDs}})
 }
 
 func (u *UserMgo) Take(ctx context.Context, userID string) (user *model.User, err error) {
-	return mongoutil.FindOne[*model.User](ctx, u.coll, bson.M{"user_id": userID})
+	return mongoutil.FindOne[*model.User](ctx, u.coll.get(ctx), bson.M{"user_id": userID})
 }
 
 func (u *UserMgo) TakeNotification(ctx context.Context, level int64) (user []*model.User, err error) {
-	return mongoutil.Find[*model.User](ctx, u.coll, bson.M{"app_manger_level": level})
+	return mongoutil.Find[*model.User](ctx, u.coll.get(ctx), bson.M{"app_manger_level": level})
 }
 
 func (u *UserMgo) TakeByNickname(ctx context.Context, nickname string) (user []*model.User, err error) {
-	return mongoutil.Find[*model.User](ctx, u.coll, bson.M{"nickname": nickname})
+	return mongoutil.Find[*model.User](ctx, u.coll.get(ctx), bson.M{"nickname": nickname})
 }
 
 func (u *UserMgo) Page(ctx context.Context, pagination pagination.Pagination) (count int64, users []*model.User, err error) {
-	return mongoutil.FindPage[*model.User](ctx, u.coll, bson.M{}, pagination)
+	return mongoutil.FindPage[*model.User](ctx, u.coll.get(ctx), bson.M{}, pagination)
 }
 
 func (u *UserMgo) PageFindUser(ctx context.Context, level1 int64, level2 int64, pagination pagination.Pagination) (count int64, users []*model.User, err error) {
@@ -87,7 +99,7 @@ func (u *UserMgo) PageFindUser(ctx context.Context, level1 int64, level2 int64,
 		},
 	}
 
-	return mongoutil.FindPage[*model.User](ctx, u.coll, query, pagination)
+	return mongoutil.FindPage[*model.User](ctx, u.coll.get(ctx), query, pagination)
 }
 
 func (u *UserMgo) PageFindUserWithKeyword(
@@ -96,6 +108,7 @@ func (u *UserMgo) PageFindUserWithKeyword(
 	level2 int64,
 	userID string,
 	nickName string,
+	fields map[string]any,
 	pagination pagination.Pagination,
 ) (count int64, users []*model.User, err error) {
 	// Initialize the base query with level conditions
@@ -121,31 +134,36 @@ func (u *UserMgo) PageFindUserWithKeyword(
 		query["$and"] = append(query["$and"].([]bson.M), bson.M{"$or": userConditions})
 	}
 
+	// Custom profile fields must all match
+	for key, value := range fields {
+		query["$and"] = append(query["$and"].([]bson.M), bson.M{"fields." + key: value})
+	}
+
 	// Perform the paginated search
-	return mongoutil.FindPage[*model.User](ctx, u.coll, query, pagination)
+	return mongoutil.FindPage[*model.User](ctx, u.coll.get(ctx), query, pagination)
 }
 
 func (u *UserMgo) GetAllUserID(ctx context.Context, pagination pagination.Pagination) (int64, []string, error) {
-	return mongoutil.FindPage[string](ctx, u.coll, bson.M{}, pagination, options.Find().SetProjection(bson.M{"_id": 0, "user_id": 1}))
+	return mongoutil.FindPage[string](ctx, u.coll.get(ctx), bson.M{}, pagination, options.Find().SetProjection(bson.M{"_id": 0, "user_id": 1}))
 }
 
 func (u *UserMgo) Exist(ctx context.Context, userID string) (exist bool, err error) {
-	return mongoutil.Exist(ctx, u.coll, bson.M{"user_id": userID})
+	return mongoutil.Exist(ctx, u.coll.get(ctx), bson.M{"user_id": userID})
 }
 
 func (u *UserMgo) GetUserGlobalRecvMsgOpt(ctx context.Context, userID string) (opt int, err error) {
-	return mongoutil.FindOne[int](ctx, u.coll, bson.M{"user_id": userID}, options.FindOne().SetProjection(bson.M{"_id": 0, "global_recv_msg_opt": 1}))
+	return mongoutil.FindOne[int](ctx, u.coll.get(ctx), bson.M{"user_id": userID}, options.FindOne().SetProjection(bson.M{"_id": 0, "global_recv_msg_opt": 1}))
 }
 
 func (u *UserMgo) CountTotal(ctx context.Context, before *time.Time) (count int64, err error) {
 	if before == nil {
-		return mongoutil.Count(ctx, u.coll, bson.M{})
+		return mongoutil.Count(ctx, u.coll.get(ctx), bson.M{})
 	}
-	return mongoutil.Count(ctx, u.coll, bson.M{"create_time": bson.M{"$lt": before}})
+	return mongoutil.Count(ctx, u.coll.get(ctx), bson.M{"create_time": bson.M{"$lt": before}})
 }
 
 func (u *UserMgo) AddUserCommand(ctx context.Context, userID string, Type int32, UUID string, value string, ex string) error {
-	collection := u.coll.Database().Collection("userCommands")
+	collection := u.commands.get(ctx)
 
 	// Create a new document instead of updating an existing one
 	doc := bson.M{
@@ -162,7 +180,7 @@ func (u *UserMgo) AddUserCommand(ctx context.Context, userID string, Type int32,
 }
 
 func (u *UserMgo) DeleteUserCommand(ctx context.Context, userID string, Type int32, UUID string) error {
-	collection := u.coll.Database().Collection("userCommands")
+	collection := u.commands.get(ctx)
 
 	filter := bson.M{"userID": userID, "type": Type, "uuid": UUID}
 
@@ -182,7 +200,7 @@ func (u *UserMgo) UpdateUserCommand(ctx context.Context, userID string, Type int
 		return nil
 	}
 
-	collection := u.coll.Database().Collection("userCommands")
+	collection := u.commands.get(ctx)
 
 	filter := bson.M{"userID": userID, "type": Type, "uuid": UUID}
 	update := bson.M{"$set": val}
@@ -201,7 +219,7 @@ func (u *UserMgo) UpdateUserCommand(ctx context.Context, userID string, Type int
 }
 
 func (u *UserMgo) GetUserCommand(ctx context.Context, userID string, Type int32) ([]*user.CommandInfoResp, error) {
-	collection := u.coll.Database().Collection("userCommands")
+	collection := u.commands.get(ctx)
 	filter := bson.M{"userID": userID, "type": Type}
 
 	cursor, err := collection.Find(ctx, filter)
@@ -244,7 +262,7 @@ func (u *UserMgo) GetUserCommand(ctx context.Context, userID string, Type int32)
 	return commands, nil
 }
 func (u *UserMgo) GetAllUserCommand(ctx context.Context, userID string) ([]*user.AllCommandInfoResp, error) {
-	collection := u.coll.Database().Collection("userCommands")
+	collection := u.commands.get(ctx)
 	filter := bson.M{"userID": userID}
 
 	cursor, err := collection.Find(ctx, filter)
@@ -313,7 +331,7 @@ func (u *UserMgo) CountRangeEverydayTotal(ctx context.Context, start time.Time,
 		Date  string `bson:"_id"`
 		Count int64  `bson:"count"`
 	}
-	items, err := mongoutil.Aggregate[Item](ctx, u.coll, pipeline)
+	items, err := mongoutil.Aggregate[Item](ctx, u.coll.get(ctx), pipeline)
 	if err != nil {
 		return nil, err
 	}
@@ -346,7 +364,7 @@ func (u *UserMgo) SortQuery(ctx context.Context, userIDName map[string]string, a
 	if len(attached) == 0 {
 		filter := bson.M{"user_id": bson.M{"$in": userIDs}}
 		opt := options.Find().SetSort(bson.M{"nickname": sortValue})
-		return mongoutil.Find[*model.User](ctx, u.coll, filter, opt)
+		return mongoutil.Find[*model.User](ctx, u.coll.get(ctx), filter, opt)
 	}
 	pipeline := []bson.M{
 		{
@@ -387,5 +405,5 @@ func (u *UserMgo) SortQuery(ctx context.Context, userIDName map[string]string, a
 			},
 		},
 	}
-	return mongoutil.Aggregate[*model.User](ctx, u.coll, pipeline)
+	return mongoutil.Aggregate[*model.User](ctx, u.coll.get(ctx), pipeline)
 }
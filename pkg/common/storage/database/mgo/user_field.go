@@ -0,0 +1,53 @@
+package mgo
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewUserFieldMongo(db *mongo.Database) (database.UserField, error) {
+	coll, err := newTenantCollection(db, database.UserFieldName, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "key", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &UserFieldMgo{coll: coll}, nil
+}
+
+type UserFieldMgo struct {
+	coll *tenantCollection
+}
+
+func (u *UserFieldMgo) Set(ctx context.Context, fields []*model.UserField) error {
+	if len(fields) == 0 {
+		return nil
+	}
+	models := make([]mongo.WriteModel, 0, len(fields))
+	for _, field := range fields {
+		models = append(models, mongo.NewReplaceOneModel().SetFilter(bson.M{"key": field.Key}).SetReplacement(field).SetUpsert(true))
+	}
+	_, err := u.coll.get(ctx).BulkWrite(ctx, models)
+	return errs.Wrap(err)
+}
+
+func (u *UserFieldMgo) Delete(ctx context.Context, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, u.coll.get(ctx), bson.M{"key": bson.M{"$in": keys}})
+}
+
+func (u *UserFieldMgo) FindAll(ctx context.Context) ([]*model.UserField, error) {
+	return mongoutil.Find[*model.UserField](ctx, u.coll.get(ctx), bson.M{}, options.Find().SetSort(bson.M{"create_time": 1}))
+}
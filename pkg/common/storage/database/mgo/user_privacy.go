@@ -0,0 +1,38 @@
+package mgo
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewUserPrivacyMongo(db *mongo.Database) (database.UserPrivacy, error) {
+	coll, err := newTenantCollection(db, database.UserPrivacyName, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "user_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &UserPrivacyMgo{coll: coll}, nil
+}
+
+type UserPrivacyMgo struct {
+	coll *tenantCollection
+}
+
+func (u *UserPrivacyMgo) Set(ctx context.Context, privacy *model.UserPrivacy) error {
+	return mongoutil.UpdateOne(ctx, u.coll.get(ctx), bson.M{"user_id": privacy.UserID}, bson.M{"$set": privacy}, false, options.Update().SetUpsert(true))
+}
+
+func (u *UserPrivacyMgo) Find(ctx context.Context, userIDs []string) ([]*model.UserPrivacy, error) {
+	return mongoutil.Find[*model.UserPrivacy](ctx, u.coll.get(ctx), bson.M{"user_id": bson.M{"$in": userIDs}})
+}
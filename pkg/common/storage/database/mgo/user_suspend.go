@@ -0,0 +1,60 @@
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/database"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func NewUserSuspendMongo(db *mongo.Database) (database.UserSuspend, error) {
+	coll, err := newTenantCollection(db, database.UserSuspendName, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			// Expired suspensions are removed by mongo itself.
+			Keys: bson.D{
+				{Key: "expire_time", Value: 1},
+			},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	}...)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &UserSuspendMgo{coll: coll}, nil
+}
+
+type UserSuspendMgo struct {
+	coll *tenantCollection
+}
+
+func (u *UserSuspendMgo) Set(ctx context.Context, suspend *model.UserSuspend) error {
+	return mongoutil.UpdateOne(ctx, u.coll.get(ctx), bson.M{"user_id": suspend.UserID}, bson.M{"$set": suspend}, false, options.Update().SetUpsert(true))
+}
+
+func (u *UserSuspendMgo) Delete(ctx context.Context, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, u.coll.get(ctx), bson.M{"user_id": bson.M{"$in": userIDs}})
+}
+
+func (u *UserSuspendMgo) Find(ctx context.Context, userIDs []string) ([]*model.UserSuspend, error) {
+	return mongoutil.Find[*model.UserSuspend](ctx, u.coll.get(ctx), bson.M{"user_id": bson.M{"$in": userIDs}, "expire_time": bson.M{"$gt": time.Now()}})
+}
+
+func (u *UserSuspendMgo) Page(ctx context.Context, pagination pagination.Pagination) (int64, []*model.UserSuspend, error) {
+	return mongoutil.FindPage[*model.UserSuspend](ctx, u.coll.get(ctx), bson.M{"expire_time": bson.M{"$gt": time.Now()}}, pagination, options.Find().SetSort(bson.M{"create_time": -1}))
+}
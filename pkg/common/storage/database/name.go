@@ -18,4 +18,21 @@ const (
 	SeqConversationName     = "seq"
 	SeqUserName             = "seq_user"
 	StreamMsgName           = "stream_msg"
+	UserSuspendName         = "user_suspend"
+	UserFieldName           = "user_field"
+	UserPrivacyName         = "user_privacy"
+	TokenKeyName            = "token_key"
+	ApiKeyName              = "api_key"
+	AuditLogName            = "audit_log"
+
+	ConversationFolderName        = "conversation_folder"
+	ConversationFolderVersionName = "conversation_folder_version"
+	ConversationDraftName         = "conversation_draft"
+	ConversationDraftVersionName  = "conversation_draft_version"
+	ConversationMentionName       = "conversation_mention"
+	PushDeviceName                = "push_device"
+	OfflinePushDeadLetterName     = "offline_push_dead_letter"
+	PushQuietHoursName            = "push_quiet_hours"
+	PushQuietDigestName           = "push_quiet_digest"
+	PushTemplateName              = "push_template"
 )
@@ -0,0 +1,26 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+// OfflinePushDeadLetterFilter selects dead letters, empty fields and zero times match everything.
+type OfflinePushDeadLetterFilter struct {
+	ConversationID string
+	UserID         string
+	Provider       string
+	ErrorClass     string
+	StartTime      time.Time
+	EndTime        time.Time
+}
+
+type OfflinePushDeadLetter interface {
+	Create(ctx context.Context, letter *model.OfflinePushDeadLetter) error
+	Search(ctx context.Context, filter *OfflinePushDeadLetterFilter, pagination pagination.Pagination) (int64, []*model.OfflinePushDeadLetter, error)
+	Find(ctx context.Context, ids []string) ([]*model.OfflinePushDeadLetter, error)
+	Delete(ctx context.Context, ids []string) error
+}
@@ -0,0 +1,21 @@
+package database
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type PushDevice interface {
+	// Register creates or replaces the device, and removes its token from any other device.
+	// The create time of a device registered again is kept.
+	Register(ctx context.Context, device *model.PushDevice) error
+	Update(ctx context.Context, userID string, deviceID string, data map[string]any) error
+	Unregister(ctx context.Context, userID string, deviceID string) error
+	UnregisterToken(ctx context.Context, userID string, token string) error
+	FindDisabledTokens(ctx context.Context, provider string, userIDs []string) ([]string, error)
+	FindByUser(ctx context.Context, userID string) ([]*model.PushDevice, error)
+	// FindEnabledByUsers returns the devices of the users that have not been disabled.
+	FindEnabledByUsers(ctx context.Context, userIDs []string) ([]*model.PushDevice, error)
+	DeleteTokens(ctx context.Context, provider string, tokens []string) error
+}
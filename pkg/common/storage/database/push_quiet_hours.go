@@ -0,0 +1,24 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type PushQuietHours interface {
+	// Set creates or replaces the settings of the user, except the snooze.
+	Set(ctx context.Context, quietHours *model.PushQuietHours) error
+	Snooze(ctx context.Context, userID string, until time.Time) error
+	Find(ctx context.Context, userIDs []string) ([]*model.PushQuietHours, error)
+	Delete(ctx context.Context, userID string) error
+}
+
+type PushQuietDigest interface {
+	// Add adds the counts and conversations to the digests of the users, a digest is sent at its last SendTime.
+	Add(ctx context.Context, digests []*model.PushQuietDigest) error
+	FindDue(ctx context.Context, now time.Time, limit int64) ([]*model.PushQuietDigest, error)
+	// TakeDue removes and returns the digest of the user if it is due, nil if it is not or another instance took it.
+	TakeDue(ctx context.Context, userID string, now time.Time) (*model.PushQuietDigest, error)
+}
@@ -0,0 +1,14 @@
+package database
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type PushTemplate interface {
+	// Set creates or replaces the template of the content type and locale.
+	Set(ctx context.Context, template *model.PushTemplate) error
+	Delete(ctx context.Context, contentType int32, locale string) error
+	FindAll(ctx context.Context) ([]*model.PushTemplate, error)
+}
@@ -0,0 +1,16 @@
+package database
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type TokenKey interface {
+	Create(ctx context.Context, key *model.TokenKey) error
+	// FindAll returns all keys, newest first.
+	FindAll(ctx context.Context) ([]*model.TokenKey, error)
+	Delete(ctx context.Context, kids []string) error
+	// SetPrivateKey replaces the stored private key of kid.
+	SetPrivateKey(ctx context.Context, kid string, privateKey string, encrypted bool) error
+}
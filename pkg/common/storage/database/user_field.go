@@ -0,0 +1,14 @@
+package database
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type UserField interface {
+	// Set creates or replaces the fields, matched by key.
+	Set(ctx context.Context, fields []*model.UserField) error
+	Delete(ctx context.Context, keys []string) error
+	FindAll(ctx context.Context) ([]*model.UserField, error)
+}
@@ -0,0 +1,13 @@
+package database
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+)
+
+type UserPrivacy interface {
+	// Set creates or replaces the privacy settings of the user.
+	Set(ctx context.Context, privacy *model.UserPrivacy) error
+	Find(ctx context.Context, userIDs []string) ([]*model.UserPrivacy, error)
+}
@@ -0,0 +1,17 @@
+package database
+
+import (
+	"context"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/model"
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+type UserSuspend interface {
+	// Set creates or replaces the suspension of the user.
+	Set(ctx context.Context, suspend *model.UserSuspend) error
+	Delete(ctx context.Context, userIDs []string) error
+	Find(ctx context.Context, userIDs []string) ([]*model.UserSuspend, error)
+	// Page returns the suspensions that have not expired yet.
+	Page(ctx context.Context, pagination pagination.Pagination) (int64, []*model.UserSuspend, error)
+}
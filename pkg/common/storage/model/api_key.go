@@ -0,0 +1,32 @@
+package model
+
+import (
+	"time"
+)
+
+// ApiKey lets a backend integration call a restricted set of APIs with admin rights.
+type ApiKey struct {
+	KeyID      string `bson:"key_id"`
+	Name       string `bson:"name"`
+	SecretHash string `bson:"secret_hash"`
+	// TenantID is the tenant the requests of the key run for, empty for the default tenant.
+	TenantID string `bson:"tenant_id"`
+	// PrevSecretHash keeps the secret replaced by the last rotation valid until PrevExpireTime.
+	PrevSecretHash string    `bson:"prev_secret_hash"`
+	PrevExpireTime time.Time `bson:"prev_expire_time"`
+	// Scopes are API paths, a path ending with "/" covers the whole route group, "*" covers everything.
+	Scopes []string `bson:"scopes"`
+	// AllowIPs are IPs or CIDRs the key may be used from, empty allows any address.
+	AllowIPs []string `bson:"allow_ips"`
+	// RateLimit is the number of requests allowed per minute, 0 means unlimited.
+	RateLimit      int64     `bson:"rate_limit"`
+	ExpireTime     time.Time `bson:"expire_time"`
+	OperatorUserID string    `bson:"operator_user_id"`
+	CreateTime     time.Time `bson:"create_time"`
+	UpdateTime     time.Time `bson:"update_time"`
+}
+
+// IsExpired reports whether the key can no longer be used at now, a zero ExpireTime never expires.
+func (a *ApiKey) IsExpired(now time.Time) bool {
+	return !a.ExpireTime.IsZero() && !a.ExpireTime.After(now)
+}
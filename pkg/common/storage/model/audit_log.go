@@ -0,0 +1,21 @@
+package model
+
+import (
+	"time"
+)
+
+// AuditLog is the durable record of a privileged operation.
+type AuditLog struct {
+	OperationID    string    `bson:"operation_id"`
+	OperatorUserID string    `bson:"operator_user_id"`
+	ApiKeyID       string    `bson:"api_key_id"`
+	IP             string    `bson:"ip"`
+	Action         string    `bson:"action"`
+	Target         string    `bson:"target"`
+	Request        string    `bson:"request"`
+	ErrCode        int       `bson:"err_code"`
+	ErrMsg         string    `bson:"err_msg"`
+	CreateTime     time.Time `bson:"create_time"`
+	// TenantID is the tenant whose collection the log is written to.
+	TenantID string `bson:"-"`
+}
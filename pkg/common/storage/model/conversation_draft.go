@@ -0,0 +1,21 @@
+package model
+
+import (
+	"time"
+)
+
+// ConversationDraft is the unsent input of a user in a conversation.
+type ConversationDraft struct {
+	OwnerUserID      string `bson:"owner_user_id"`
+	ConversationID   string `bson:"conversation_id"`
+	Text             string `bson:"text"`
+	ReplyClientMsgID string `bson:"reply_client_msg_id"`
+	// UpdateTime is when the user set the draft, or when it was cleared for a cleared draft which is
+	// kept empty. A draft only replaces one set or cleared before it.
+	UpdateTime time.Time `bson:"update_time"`
+}
+
+// IsEmpty reports whether the draft holds nothing and should be removed.
+func (d *ConversationDraft) IsEmpty() bool {
+	return d.Text == "" && d.ReplyClientMsgID == ""
+}
@@ -0,0 +1,25 @@
+package model
+
+import (
+	"time"
+)
+
+// Rules of the folders whose conversations are picked by the clients instead of listed.
+const (
+	ConversationFolderRuleGroups = "groups"
+	ConversationFolderRuleUnread = "unread"
+	ConversationFolderRuleMuted  = "muted"
+)
+
+// ConversationFolder groups conversations of a user, either the listed ConversationIDs or those matching Rule.
+type ConversationFolder struct {
+	OwnerUserID     string    `bson:"owner_user_id"`
+	FolderID        string    `bson:"folder_id"`
+	Name            string    `bson:"name"`
+	Order           int32     `bson:"order"`
+	Rule            string    `bson:"rule"`
+	ConversationIDs []string  `bson:"conversation_ids"`
+	Ex              string    `bson:"ex"`
+	CreateTime      time.Time `bson:"create_time"`
+	UpdateTime      time.Time `bson:"update_time"`
+}
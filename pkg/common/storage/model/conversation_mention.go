@@ -0,0 +1,28 @@
+package model
+
+import (
+	"time"
+)
+
+// MentionAllOwnerUserID owns the seqs of the messages mentioning all the members of a group conversation,
+// which are stored once for the conversation and added to the mentions of each member when read.
+const MentionAllOwnerUserID = ""
+
+// ConversationMention holds the seqs of the messages mentioning a user in a group conversation,
+// the seqs the user has read are pruned.
+type ConversationMention struct {
+	OwnerUserID    string    `bson:"owner_user_id"`
+	ConversationID string    `bson:"conversation_id"`
+	Seqs           []int64   `bson:"seqs"`
+	UpdateTime     time.Time `bson:"update_time"`
+}
+
+// UnreadSeqs returns the seqs after hasReadSeq, the pruning may lag behind the read seq.
+func (m *ConversationMention) UnreadSeqs(hasReadSeq int64) []int64 {
+	for i, seq := range m.Seqs {
+		if seq > hasReadSeq {
+			return m.Seqs[i:]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// OfflinePushDeadLetter is an offline push that failed all its attempts, kept until it is replayed.
+type OfflinePushDeadLetter struct {
+	ID             primitive.ObjectID `bson:"_id"`
+	ConversationID string             `bson:"conversation_id"`
+	ClientMsgID    string             `bson:"client_msg_id"`
+	UserIDs        []string           `bson:"user_ids"`
+	// Msg is the protobuf encoded sdkws.MsgData.
+	Msg       []byte   `bson:"msg"`
+	Attempts  int      `bson:"attempts"`
+	Providers []string `bson:"providers"`
+	// ErrorClass groups the errors, e.g. timeout, network or provider.
+	ErrorClass string    `bson:"error_class"`
+	Error      string    `bson:"error"`
+	CreateTime time.Time `bson:"create_time"`
+}
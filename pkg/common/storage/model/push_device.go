@@ -0,0 +1,44 @@
+package model
+
+import (
+	"time"
+)
+
+// Push providers a device token can belong to.
+const (
+	PushProviderFCM    = "fcm"
+	PushProviderAPNs   = "apns"
+	PushProviderHuawei = "huawei"
+	PushProviderHonor  = "honor"
+	PushProviderXiaomi = "xiaomi"
+	PushProviderOPPO   = "oppo"
+	PushProviderVivo   = "vivo"
+	// PushProviderWebPush devices are browser push subscriptions, their token is the subscription endpoint.
+	PushProviderWebPush = "webpush"
+)
+
+// VendorPushProviders are the push channels of the Android vendors.
+var VendorPushProviders = []string{PushProviderHuawei, PushProviderHonor, PushProviderXiaomi, PushProviderOPPO, PushProviderVivo}
+
+// TokenPushProviders are the providers whose devices register a plain token, web push subscriptions have their own api.
+var TokenPushProviders = append([]string{PushProviderFCM, PushProviderAPNs}, VendorPushProviders...)
+
+// PushDevice is a device of a user registered for offline push, with the token of its push provider.
+type PushDevice struct {
+	UserID     string `bson:"user_id"`
+	DeviceID   string `bson:"device_id"`
+	PlatformID int32  `bson:"platform_id"`
+	Provider   string `bson:"provider"`
+	Token      string `bson:"token"`
+	// P256dh and Auth are the keys of a web push subscription, encoded in base64url.
+	P256dh     string `bson:"p256dh,omitempty"`
+	Auth       string `bson:"auth,omitempty"`
+	AppVersion string `bson:"app_version"`
+	// Locale is the language of the device, e.g. en-US, used to localize its notifications.
+	Locale string `bson:"locale"`
+	// Enabled is false when the user turned off the notifications of the device, the device keeps its token.
+	Enabled      bool      `bson:"enabled"`
+	CreateTime   time.Time `bson:"create_time"`
+	UpdateTime   time.Time `bson:"update_time"`
+	LastSeenTime time.Time `bson:"last_seen_time"`
+}
@@ -0,0 +1,47 @@
+package model
+
+import (
+	"time"
+)
+
+// How a conversation override notifies the user during the quiet hours.
+const (
+	QuietNotifyMentions = "mentions"
+	QuietNotifyAlways   = "always"
+)
+
+// PushQuietHours are the do-not-disturb settings of a user, the offline pushes are suppressed while they are active.
+type PushQuietHours struct {
+	UserID string `bson:"user_id"`
+	// TimeZone is the IANA time zone of the windows, e.g. Asia/Shanghai, empty is UTC.
+	TimeZone    string              `bson:"time_zone"`
+	Windows     []PushQuietWindow   `bson:"windows"`
+	Overrides   []PushQuietOverride `bson:"overrides"`
+	SnoozeUntil time.Time           `bson:"snooze_until"`
+	// Digest collects the suppressed pushes into one push sent when the quiet hours end, instead of dropping them.
+	Digest     bool      `bson:"digest"`
+	UpdateTime time.Time `bson:"update_time"`
+}
+
+// PushQuietWindow is a weekly window, Start and End are minutes since midnight, a window not ending after it starts ends the next day.
+type PushQuietWindow struct {
+	// Weekdays are the days the window starts on, 0 is Sunday, empty is every day.
+	Weekdays []int `bson:"weekdays"`
+	Start    int   `bson:"start"`
+	End      int   `bson:"end"`
+}
+
+// PushQuietOverride lets the pushes of a conversation through the quiet hours, an empty ConversationID matches all conversations.
+type PushQuietOverride struct {
+	ConversationID string `bson:"conversation_id"`
+	Notify         string `bson:"notify"`
+}
+
+// PushQuietDigest counts the pushes suppressed while the quiet hours of the user are active.
+type PushQuietDigest struct {
+	UserID          string   `bson:"user_id"`
+	Count           int64    `bson:"count"`
+	ConversationIDs []string `bson:"conversation_ids"`
+	// SendTime is when the quiet hours end and the digest is pushed.
+	SendTime time.Time `bson:"send_time"`
+}
@@ -0,0 +1,24 @@
+package model
+
+import (
+	"time"
+)
+
+const (
+	// PushTemplateDefault is the content type of the templates used for the content types without their own template.
+	PushTemplateDefault = 0
+	// PushTemplateQuietDigest is the content type of the templates of the quiet hours digests, which never fall back
+	// to the default template. They can use the variables {count} and {conversationCount}.
+	PushTemplateQuietDigest = -1
+)
+
+// PushTemplate is the title and content of the offline pushes of a content type in a locale.
+// They can use the variables {senderNickname}, {groupName}, and {preview}.
+type PushTemplate struct {
+	ContentType int32 `bson:"content_type"`
+	// Locale is lower case with a dash, e.g. zh-cn or en.
+	Locale     string    `bson:"locale"`
+	Title      string    `bson:"title"`
+	Content    string    `bson:"content"`
+	UpdateTime time.Time `bson:"update_time"`
+}
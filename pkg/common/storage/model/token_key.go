@@ -0,0 +1,15 @@
+package model
+
+import (
+	"time"
+)
+
+// TokenKey is an asymmetric token signing key, the newest key of an algorithm signs new tokens.
+type TokenKey struct {
+	KID       string `bson:"kid"`
+	Algorithm string `bson:"algorithm"`
+	// PrivateKey is a PKCS #8 PEM block, sealed with the key encryption key when Encrypted is set.
+	PrivateKey string    `bson:"private_key"`
+	Encrypted  bool      `bson:"encrypted"`
+	CreateTime time.Time `bson:"create_time"`
+}
@@ -26,6 +26,8 @@ type User struct {
 	AppMangerLevel   int32     `bson:"app_manger_level"`
 	GlobalRecvMsgOpt int32     `bson:"global_recv_msg_opt"`
 	CreateTime       time.Time `bson:"create_time"`
+	// Fields holds the values of the custom profile fields, keyed by UserField.Key.
+	Fields map[string]any `bson:"fields,omitempty"`
 }
 
 func (u *User) GetNickname() string {
@@ -0,0 +1,33 @@
+package model
+
+import (
+	"time"
+)
+
+const (
+	UserFieldTypeString = "string"
+	UserFieldTypeInt    = "int"
+	UserFieldTypeBool   = "bool"
+	UserFieldTypeEnum   = "enum"
+)
+
+const (
+	UserFieldVisibilitySelf    = "self"
+	UserFieldVisibilityFriends = "friends"
+	UserFieldVisibilityPublic  = "public"
+)
+
+// UserField is an admin-defined custom profile field, the values are stored in User.Fields.
+type UserField struct {
+	Key        string    `bson:"key"`
+	Name       string    `bson:"name"`
+	Type       string    `bson:"type"`
+	MaxLength  int       `bson:"max_length"`
+	Pattern    string    `bson:"pattern"`
+	Min        *int64    `bson:"min"`
+	Max        *int64    `bson:"max"`
+	Options    []string  `bson:"options"`
+	Visibility string    `bson:"visibility"`
+	Searchable bool      `bson:"searchable"`
+	CreateTime time.Time `bson:"create_time"`
+}
@@ -0,0 +1,13 @@
+package model
+
+const (
+	LastSeenPrivacyEveryone = "everyone"
+	LastSeenPrivacyFriends  = "friends"
+	LastSeenPrivacyNobody   = "nobody"
+)
+
+// UserPrivacy holds the privacy settings of a user. Without a document everyone may see everything.
+type UserPrivacy struct {
+	UserID   string `bson:"user_id"`
+	LastSeen string `bson:"last_seen"`
+}
@@ -0,0 +1,20 @@
+package model
+
+import (
+	"time"
+)
+
+// UserSuspend is an admin-issued suspension that blocks a user until ExpireTime.
+type UserSuspend struct {
+	UserID         string    `bson:"user_id"`
+	Reason         string    `bson:"reason"`
+	HideProfile    bool      `bson:"hide_profile"`
+	OperatorUserID string    `bson:"operator_user_id"`
+	CreateTime     time.Time `bson:"create_time"`
+	ExpireTime     time.Time `bson:"expire_time"`
+}
+
+// IsActive reports whether the suspension is still in effect at now.
+func (u *UserSuspend) IsActive(now time.Time) bool {
+	return u.ExpireTime.After(now)
+}
@@ -0,0 +1,120 @@
+package tenant
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/tools/s3"
+)
+
+// ObjectPrefix starts the object keys of every tenant but the default tenant.
+const ObjectPrefix = "tenant/"
+
+// NewS3 scopes the object keys of impl by the tenant of ctx. The keys it takes and returns
+// are unscoped, so the object records keep the same keys for every tenant.
+func NewS3(impl s3.Interface) s3.Interface {
+	return &tenantS3{Interface: impl}
+}
+
+type tenantS3 struct {
+	s3.Interface
+}
+
+func objectPrefix(ctx context.Context) string {
+	if tenantID := GetTenantID(ctx); tenantID != "" {
+		return ObjectPrefix + tenantID + "/"
+	}
+	return ""
+}
+
+// ObjectKey scopes an object key by the tenant of ctx, the keys of the default tenant are returned unchanged.
+func ObjectKey(ctx context.Context, name string) string {
+	return objectPrefix(ctx) + name
+}
+
+// SplitObjectKey returns the tenant and the unscoped key of a key built by ObjectKey.
+func SplitObjectKey(key string) (tenantID string, unscoped string) {
+	rest, ok := strings.CutPrefix(key, ObjectPrefix)
+	if !ok {
+		return "", key
+	}
+	tenantID, unscoped, ok = strings.Cut(rest, "/")
+	if !ok || CheckTenantID(tenantID) != nil {
+		return "", key
+	}
+	return tenantID, unscoped
+}
+
+func unscopedObjectKey(ctx context.Context, key string) string {
+	return strings.TrimPrefix(key, objectPrefix(ctx))
+}
+
+func (t *tenantS3) InitiateMultipartUpload(ctx context.Context, name string) (*s3.InitiateMultipartUploadResult, error) {
+	res, err := t.Interface.InitiateMultipartUpload(ctx, ObjectKey(ctx, name))
+	if err != nil {
+		return nil, err
+	}
+	res.Key = unscopedObjectKey(ctx, res.Key)
+	return res, nil
+}
+
+func (t *tenantS3) CompleteMultipartUpload(ctx context.Context, uploadID string, name string, parts []s3.Part) (*s3.CompleteMultipartUploadResult, error) {
+	res, err := t.Interface.CompleteMultipartUpload(ctx, uploadID, ObjectKey(ctx, name), parts)
+	if err != nil {
+		return nil, err
+	}
+	res.Key = unscopedObjectKey(ctx, res.Key)
+	return res, nil
+}
+
+func (t *tenantS3) AuthSign(ctx context.Context, uploadID string, name string, expire time.Duration, partNumbers []int) (*s3.AuthSignResult, error) {
+	return t.Interface.AuthSign(ctx, uploadID, ObjectKey(ctx, name), expire, partNumbers)
+}
+
+func (t *tenantS3) PresignedPutObject(ctx context.Context, name string, expire time.Duration) (string, error) {
+	return t.Interface.PresignedPutObject(ctx, ObjectKey(ctx, name), expire)
+}
+
+func (t *tenantS3) DeleteObject(ctx context.Context, name string) error {
+	return t.Interface.DeleteObject(ctx, ObjectKey(ctx, name))
+}
+
+func (t *tenantS3) CopyObject(ctx context.Context, src string, dst string) (*s3.CopyObjectInfo, error) {
+	res, err := t.Interface.CopyObject(ctx, ObjectKey(ctx, src), ObjectKey(ctx, dst))
+	if err != nil {
+		return nil, err
+	}
+	res.Key = unscopedObjectKey(ctx, res.Key)
+	return res, nil
+}
+
+func (t *tenantS3) StatObject(ctx context.Context, name string) (*s3.ObjectInfo, error) {
+	res, err := t.Interface.StatObject(ctx, ObjectKey(ctx, name))
+	if err != nil {
+		return nil, err
+	}
+	res.Key = unscopedObjectKey(ctx, res.Key)
+	return res, nil
+}
+
+func (t *tenantS3) AbortMultipartUpload(ctx context.Context, uploadID string, name string) error {
+	return t.Interface.AbortMultipartUpload(ctx, uploadID, ObjectKey(ctx, name))
+}
+
+func (t *tenantS3) ListUploadedParts(ctx context.Context, uploadID string, name string, partNumberMarker int, maxParts int) (*s3.ListUploadedPartsResult, error) {
+	res, err := t.Interface.ListUploadedParts(ctx, uploadID, ObjectKey(ctx, name), partNumberMarker, maxParts)
+	if err != nil {
+		return nil, err
+	}
+	res.Key = unscopedObjectKey(ctx, res.Key)
+	return res, nil
+}
+
+func (t *tenantS3) AccessURL(ctx context.Context, name string, expire time.Duration, opt *s3.AccessURLOption) (string, error) {
+	return t.Interface.AccessURL(ctx, ObjectKey(ctx, name), expire, opt)
+}
+
+func (t *tenantS3) FormData(ctx context.Context, name string, size int64, contentType string, duration time.Duration) (*s3.FormData, error) {
+	return t.Interface.FormData(ctx, ObjectKey(ctx, name), size, contentType, duration)
+}
@@ -0,0 +1,58 @@
+package tenant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/tools/s3"
+)
+
+type fakeS3 struct {
+	s3.Interface
+	names []string
+}
+
+func (f *fakeS3) StatObject(_ context.Context, name string) (*s3.ObjectInfo, error) {
+	f.names = append(f.names, name)
+	return &s3.ObjectInfo{Key: name}, nil
+}
+
+func (f *fakeS3) CopyObject(_ context.Context, src string, dst string) (*s3.CopyObjectInfo, error) {
+	f.names = append(f.names, src, dst)
+	return &s3.CopyObjectInfo{Key: dst}, nil
+}
+
+func TestS3(t *testing.T) {
+	impl := &fakeS3{}
+	o := NewS3(impl)
+	ctx := WithTenantID(context.Background(), "a")
+	info, err := o.StatObject(ctx, "openim/data/hash/1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if impl.names[0] != "tenant/a/openim/data/hash/1" || info.Key != "openim/data/hash/1" {
+		t.Fatal("object key must be scoped in the storage only", impl.names, info.Key)
+	}
+	copyInfo, err := o.CopyObject(ctx, "temp/1", "openim/data/hash/1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if impl.names[1] != "tenant/a/temp/1" || impl.names[2] != "tenant/a/openim/data/hash/1" || copyInfo.Key != "openim/data/hash/1" {
+		t.Fatal("unexpected copy", impl.names, copyInfo.Key)
+	}
+	if _, err := o.StatObject(context.Background(), "openim/data/hash/1"); err != nil || impl.names[3] != "openim/data/hash/1" {
+		t.Fatal("default tenant key must not be scoped", impl.names)
+	}
+}
+
+func TestSplitObjectKey(t *testing.T) {
+	key := ObjectKey(WithTenantID(context.Background(), "a"), "openim/data/hash/1")
+	if tenantID, name := SplitObjectKey(key); tenantID != "a" || name != "openim/data/hash/1" {
+		t.Fatal("unexpected split", tenantID, name)
+	}
+	for _, key := range []string{"openim/data/hash/1", "tenant/a.b/1", "tenant/a"} {
+		if tenantID, name := SplitObjectKey(key); tenantID != "" || name != key {
+			t.Fatal("unexpected split", key, tenantID, name)
+		}
+	}
+}
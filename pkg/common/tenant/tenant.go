@@ -0,0 +1,103 @@
+// Package tenant carries the tenant of a request, so that one deployment can serve several apps.
+//
+// The tenant is stored as a rpc custom header, so it is forwarded by the grpc interceptors of
+// every service without further wiring. The tenant is only ever taken from a verified token,
+// an API key or the key of an object, and scopes the cache keys, Mongo collections, Kafka keys
+// and S3 object keys of the request.
+package tenant
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// TenantID is the context key, rpc metadata key and http header of the tenant.
+const TenantID = "tenantID"
+
+// KeyPrefix starts every key scoped by Key, the keys of the default tenant are not scoped.
+const KeyPrefix = "TENANT:"
+
+var tenantIDRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,32}$`)
+
+// CheckTenantID makes sure a tenant ID can be used in keys and collection names.
+func CheckTenantID(tenantID string) error {
+	if !tenantIDRegexp.MatchString(tenantID) {
+		return errs.ErrArgs.WrapMsg("tenant id must be 1-32 letters, digits, '_' or '-'", "tenantID", tenantID)
+	}
+	return nil
+}
+
+// WithTenantID returns a context carrying the tenant, an empty tenantID leaves ctx untouched.
+func WithTenantID(ctx context.Context, tenantID string) context.Context {
+	if tenantID == "" {
+		return ctx
+	}
+	keys, _ := ctx.Value(constant.RpcCustomHeader).([]string)
+	if !datautil.Contain(TenantID, keys...) {
+		keys = append(append(make([]string, 0, len(keys)+1), keys...), TenantID)
+		ctx = context.WithValue(ctx, constant.RpcCustomHeader, keys)
+	}
+	return context.WithValue(ctx, TenantID, []string{tenantID})
+}
+
+// GetTenantID returns the tenant of ctx, or an empty string for the default tenant.
+func GetTenantID(ctx context.Context) string {
+	if values, ok := ctx.Value(TenantID).([]string); ok && len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// Shared returns ctx without the tenant, for the data all tenants share such as the API keys.
+func Shared(ctx context.Context) context.Context {
+	if GetTenantID(ctx) == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, TenantID, []string{""})
+}
+
+// Key scopes a cache key or Kafka message key by the tenant of ctx.
+func Key(ctx context.Context, key string) string {
+	return KeyOf(GetTenantID(ctx), key)
+}
+
+// KeyOf scopes key by tenantID, the keys of the default tenant are returned unchanged.
+func KeyOf(tenantID string, key string) string {
+	if tenantID == "" {
+		return key
+	}
+	return KeyPrefix + tenantID + ":" + key
+}
+
+// SplitKey returns the tenant and the unscoped key of a key built by Key.
+func SplitKey(key string) (tenantID string, unscoped string) {
+	rest, ok := strings.CutPrefix(key, KeyPrefix)
+	if !ok {
+		return "", key
+	}
+	tenantID, unscoped, ok = strings.Cut(rest, ":")
+	if !ok {
+		return "", key
+	}
+	return tenantID, unscoped
+}
+
+// FromKey returns ctx carrying the tenant of a key built by Key, together with the unscoped key.
+// It restores the tenant of Kafka messages, whose headers only carry the operation infos.
+func FromKey(ctx context.Context, key string) (context.Context, string) {
+	tenantID, unscoped := SplitKey(key)
+	return WithTenantID(ctx, tenantID), unscoped
+}
+
+// CollectionName returns the Mongo collection of the tenant, the default tenant keeps the shared name.
+func CollectionName(tenantID string, name string) string {
+	if tenantID == "" {
+		return name
+	}
+	return "tenant_" + tenantID + "_" + name
+}
@@ -0,0 +1,71 @@
+package tenant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/protocol/constant"
+)
+
+func TestKey(t *testing.T) {
+	ctx := context.Background()
+	if key := Key(ctx, "TOKEN:user1"); key != "TOKEN:user1" {
+		t.Fatal("default tenant key must not be scoped", key)
+	}
+	a := Key(WithTenantID(ctx, "a"), "TOKEN:user1")
+	b := Key(WithTenantID(ctx, "b"), "TOKEN:user1")
+	if a == b {
+		t.Fatal("tenants share a key", a)
+	}
+	if tenantID, key := SplitKey(a); tenantID != "a" || key != "TOKEN:user1" {
+		t.Fatal("unexpected split", tenantID, key)
+	}
+	if tenantID, key := SplitKey("TOKEN:user1"); tenantID != "" || key != "TOKEN:user1" {
+		t.Fatal("unexpected split", tenantID, key)
+	}
+}
+
+func TestFromKey(t *testing.T) {
+	ctx, key := FromKey(context.Background(), KeyOf("a", "si_user1_user2"))
+	if GetTenantID(ctx) != "a" || key != "si_user1_user2" {
+		t.Fatal("unexpected tenant", GetTenantID(ctx), key)
+	}
+	if keys, _ := ctx.Value(constant.RpcCustomHeader).([]string); len(keys) != 1 || keys[0] != TenantID {
+		t.Fatal("tenant is not forwarded as rpc header", keys)
+	}
+	if ctx, _ := FromKey(context.Background(), "si_user1_user2"); GetTenantID(ctx) != "" {
+		t.Fatal("unscoped key must keep the default tenant")
+	}
+}
+
+func TestShared(t *testing.T) {
+	ctx := WithTenantID(context.Background(), "a")
+	if GetTenantID(Shared(ctx)) != "" {
+		t.Fatal("shared context must not carry the tenant")
+	}
+	if GetTenantID(ctx) != "a" {
+		t.Fatal("Shared must not modify ctx")
+	}
+}
+
+func TestCheckTenantID(t *testing.T) {
+	for _, tenantID := range []string{"a", "app_1", "APP-2"} {
+		if err := CheckTenantID(tenantID); err != nil {
+			t.Fatal(tenantID, err)
+		}
+	}
+	for _, tenantID := range []string{"", "a:b", "a.b", "a b", "012345678901234567890123456789012"} {
+		if err := CheckTenantID(tenantID); err == nil {
+			t.Fatal("invalid tenant id accepted", tenantID)
+		}
+	}
+}
+
+func TestCollectionName(t *testing.T) {
+	if name := CollectionName("", "user"); name != "user" {
+		t.Fatal(name)
+	}
+	if name := CollectionName("a", "user"); name != "tenant_a_user" {
+		t.Fatal(name)
+	}
+}
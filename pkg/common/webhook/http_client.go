@@ -20,6 +20,7 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/callbackstruct"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/servererrs"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/mcontext"
@@ -29,9 +30,10 @@ import (
 )
 
 type Client struct {
-	client *httputil.HTTPClient
-	url    string
-	queue  *memamq.MemoryQueue
+	client    *httputil.HTTPClient
+	url       string
+	tenantURL map[string]string
+	queue     *memamq.MemoryQueue
 }
 
 const (
@@ -39,7 +41,7 @@ const (
 	webhookBufferSize  = 100
 )
 
-func NewWebhookClient(url string, options ...*memamq.MemoryQueue) *Client {
+func NewWebhookClient(webhooks *config.Webhooks, options ...*memamq.MemoryQueue) *Client {
 	var queue *memamq.MemoryQueue
 	if len(options) > 0 && options[0] != nil {
 		queue = options[0]
@@ -49,11 +51,24 @@ func NewWebhookClient(url string, options ...*memamq.MemoryQueue) *Client {
 
 	http.DefaultTransport.(*http.Transport).MaxConnsPerHost = 100 // Enhance the default number of max connections per host
 
+	tenantURL := make(map[string]string, len(webhooks.Tenants))
+	for _, t := range webhooks.Tenants {
+		tenantURL[t.ID] = t.URL
+	}
+
 	return &Client{
-		client: httputil.NewHTTPClient(httputil.NewClientConfig()),
-		url:    url,
-		queue:  queue,
+		client:    httputil.NewHTTPClient(httputil.NewClientConfig()),
+		url:       webhooks.URL,
+		tenantURL: tenantURL,
+		queue:     queue,
+	}
+}
+
+func (c *Client) getURL(ctx context.Context) string {
+	if url, ok := c.tenantURL[tenant.GetTenantID(ctx)]; ok {
+		return url
 	}
+	return c.url
 }
 
 func (c *Client) SyncPost(ctx context.Context, command string, req callbackstruct.CallbackReq, resp callbackstruct.CallbackResp, before *config.BeforeConfig) error {
@@ -67,8 +82,8 @@ func (c *Client) AsyncPost(ctx context.Context, command string, req callbackstru
 }
 
 func (c *Client) post(ctx context.Context, command string, input interface{}, output callbackstruct.CallbackResp, timeout int) error {
+	fullURL := c.getURL(ctx) + "/" + command
 	ctx = mcontext.WithMustInfoCtx([]string{mcontext.GetOperationID(ctx), mcontext.GetOpUserID(ctx), mcontext.GetOpUserPlatform(ctx), mcontext.GetConnID(ctx)})
-	fullURL := c.url + "/" + command
 	log.ZInfo(ctx, "webhook", "url", fullURL, "input", input, "config", timeout)
 	operationID, _ := ctx.Value(constant.OperationID).(string)
 	b, err := c.client.Post(ctx, fullURL, map[string]string{constant.OperationID: operationID}, input, timeout)
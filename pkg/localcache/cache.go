@@ -19,8 +19,10 @@ import (
 	"hash/fnv"
 	"unsafe"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/open-im-server/v3/pkg/localcache/link"
 	"github.com/openimsdk/open-im-server/v3/pkg/localcache/lru"
+	"github.com/openimsdk/tools/utils/datautil"
 )
 
 type Cache[V any] interface {
@@ -101,10 +103,12 @@ func (c *cache[V]) Get(ctx context.Context, key string, fetch func(ctx context.C
 }
 
 func (c *cache[V]) GetLink(ctx context.Context, key string, fetch func(ctx context.Context) (V, error), link ...string) (V, error) {
+	// The local entries of different tenants must never be shared.
+	key = tenant.Key(ctx, key)
 	if c.local != nil {
 		return c.local.Get(key, func() (V, error) {
 			if len(link) > 0 {
-				c.link.Link(key, link...)
+				c.link.Link(key, datautil.Slice(link, func(k string) string { return tenant.Key(ctx, k) })...)
 			}
 			return fetch(ctx)
 		})
@@ -114,6 +118,7 @@ func (c *cache[V]) GetLink(ctx context.Context, key string, fetch func(ctx conte
 }
 
 func (c *cache[V]) Del(ctx context.Context, key ...string) {
+	key = datautil.Slice(key, func(k string) string { return tenant.Key(ctx, k) })
 	for _, fn := range c.opt.delFn {
 		fn(ctx, key...)
 	}
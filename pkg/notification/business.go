@@ -0,0 +1,40 @@
+package rpcclient
+
+import (
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/utils/idutil"
+	"github.com/openimsdk/tools/utils/jsonutil"
+	"github.com/openimsdk/tools/utils/timeutil"
+)
+
+// ConversationDraftChangedKey is the key of the business notification carrying a changed conversation draft,
+// a draft with an empty text and reply means it was cleared.
+const ConversationDraftChangedKey = "conversationDraftChanged"
+
+// NewSelfBusinessNotification returns an unreliable business notification sent by the user to itself,
+// telling the other online devices of the user that the data identified by key changed.
+func NewSelfBusinessNotification(userID string, key string, data any) *msg.SendMsgReq {
+	return &msg.SendMsgReq{
+		MsgData: &sdkws.MsgData{
+			SendID: userID,
+			RecvID: userID,
+			Content: []byte(jsonutil.StructToJsonString(&sdkws.NotificationElem{
+				Detail: jsonutil.StructToJsonString(&struct {
+					Key  string `json:"key"`
+					Data string `json:"data"`
+				}{Key: key, Data: jsonutil.StructToJsonString(data)}),
+			})),
+			MsgFrom:     constant.SysMsgType,
+			ContentType: constant.BusinessNotification,
+			SessionType: constant.SingleChatType,
+			CreateTime:  timeutil.GetCurrentTimestampByMill(),
+			ClientMsgID: idutil.GetMsgIDByMD5(userID),
+			Options: config.GetOptionsByNotification(config.NotificationConfig{
+				ReliabilityLevel: constant.UnreliableNotification,
+			}),
+		},
+	}
+}
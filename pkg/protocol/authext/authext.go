@@ -0,0 +1,125 @@
+package authext
+
+import (
+	"errors"
+)
+
+func (x *IssueUserTokenReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *RefreshTokenReq) Check() error {
+	if x.RefreshToken == "" {
+		return errors.New("refreshToken is empty")
+	}
+	return nil
+}
+
+func (x *GetSessionsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *RevokeSessionReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.SessionID == "" {
+		return errors.New("sessionID is empty")
+	}
+	return nil
+}
+
+func (x *RevokeOtherSessionsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *GetTenantAdminTokenReq) Check() error {
+	if x.Secret == "" {
+		return errors.New("secret is empty")
+	}
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *KickUserReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *RecordAuditLogReq) Check() error {
+	if x.Action == "" {
+		return errors.New("action is empty")
+	}
+	return nil
+}
+
+func (x *SearchAuditLogsReq) Check() error {
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	return nil
+}
+
+func (x *CreateApiKeyReq) Check() error {
+	if x.Name == "" {
+		return errors.New("name is empty")
+	}
+	if len(x.Scopes) == 0 {
+		return errors.New("scopes is empty")
+	}
+	return nil
+}
+
+func (x *UpdateApiKeyReq) Check() error {
+	if x.KeyID == "" {
+		return errors.New("keyID is empty")
+	}
+	if x.Scopes != nil && len(x.Scopes.Scopes) == 0 {
+		return errors.New("scopes is empty")
+	}
+	return nil
+}
+
+func (x *RotateApiKeyReq) Check() error {
+	if x.KeyID == "" {
+		return errors.New("keyID is empty")
+	}
+	if x.GraceSeconds != nil && x.GraceSeconds.Value < 0 {
+		return errors.New("graceSeconds must not be negative")
+	}
+	return nil
+}
+
+func (x *DeleteApiKeysReq) Check() error {
+	if len(x.KeyIDs) == 0 {
+		return errors.New("keyIDs is empty")
+	}
+	return nil
+}
+
+func (x *SearchApiKeysReq) Check() error {
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	return nil
+}
+
+func (x *AuthenticateApiKeyReq) Check() error {
+	if x.ApiKey == "" {
+		return errors.New("apiKey is empty")
+	}
+	return nil
+}
@@ -0,0 +1,753 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.0
+// source: authext/authext.proto
+
+package authext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AuthExt_IssueUserToken_FullMethodName      = "/openim.server.authext.authExt/IssueUserToken"
+	AuthExt_RefreshToken_FullMethodName        = "/openim.server.authext.authExt/RefreshToken"
+	AuthExt_GetSessions_FullMethodName         = "/openim.server.authext.authExt/GetSessions"
+	AuthExt_RevokeSession_FullMethodName       = "/openim.server.authext.authExt/RevokeSession"
+	AuthExt_RevokeOtherSessions_FullMethodName = "/openim.server.authext.authExt/RevokeOtherSessions"
+	AuthExt_GetTenantAdminToken_FullMethodName = "/openim.server.authext.authExt/GetTenantAdminToken"
+	AuthExt_KickUser_FullMethodName            = "/openim.server.authext.authExt/KickUser"
+	AuthExt_GetJWKS_FullMethodName             = "/openim.server.authext.authExt/GetJWKS"
+	AuthExt_RotateSigningKey_FullMethodName    = "/openim.server.authext.authExt/RotateSigningKey"
+	AuthExt_RecordAuditLog_FullMethodName      = "/openim.server.authext.authExt/RecordAuditLog"
+	AuthExt_SearchAuditLogs_FullMethodName     = "/openim.server.authext.authExt/SearchAuditLogs"
+	AuthExt_CreateApiKey_FullMethodName        = "/openim.server.authext.authExt/CreateApiKey"
+	AuthExt_UpdateApiKey_FullMethodName        = "/openim.server.authext.authExt/UpdateApiKey"
+	AuthExt_RotateApiKey_FullMethodName        = "/openim.server.authext.authExt/RotateApiKey"
+	AuthExt_DeleteApiKeys_FullMethodName       = "/openim.server.authext.authExt/DeleteApiKeys"
+	AuthExt_SearchApiKeys_FullMethodName       = "/openim.server.authext.authExt/SearchApiKeys"
+	AuthExt_AuthenticateApiKey_FullMethodName  = "/openim.server.authext.authExt/AuthenticateApiKey"
+)
+
+// AuthExtClient is the client API for AuthExt service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AuthExtClient interface {
+	// IssueUserToken issues the token of a user like GetUserToken, paired with a refresh token.
+	IssueUserToken(ctx context.Context, in *IssueUserTokenReq, opts ...grpc.CallOption) (*IssueUserTokenResp, error)
+	// RefreshToken consumes a refresh token and replaces the access token of its session,
+	// unless the session was kicked or logged out.
+	RefreshToken(ctx context.Context, in *RefreshTokenReq, opts ...grpc.CallOption) (*RefreshTokenResp, error)
+	GetSessions(ctx context.Context, in *GetSessionsReq, opts ...grpc.CallOption) (*GetSessionsResp, error)
+	// RevokeSession kicks the token of the session and closes its connections.
+	RevokeSession(ctx context.Context, in *RevokeSessionReq, opts ...grpc.CallOption) (*RevokeSessionResp, error)
+	RevokeOtherSessions(ctx context.Context, in *RevokeOtherSessionsReq, opts ...grpc.CallOption) (*RevokeOtherSessionsResp, error)
+	// GetTenantAdminToken issues the admin token of a tenant, checked against the secret like GetAdminToken.
+	GetTenantAdminToken(ctx context.Context, in *GetTenantAdminTokenReq, opts ...grpc.CallOption) (*GetTenantAdminTokenResp, error)
+	// KickUser logs the user out of every platform at once: the tokens are kicked and the gateways close the connections.
+	KickUser(ctx context.Context, in *KickUserReq, opts ...grpc.CallOption) (*KickUserResp, error)
+	// GetJWKS returns the public keys tokens may currently be verified with.
+	GetJWKS(ctx context.Context, in *GetJWKSReq, opts ...grpc.CallOption) (*GetJWKSResp, error)
+	// RotateSigningKey makes a new key the signing key, the previous keys keep verifying their tokens.
+	RotateSigningKey(ctx context.Context, in *RotateSigningKeyReq, opts ...grpc.CallOption) (*RotateSigningKeyResp, error)
+	// RecordAuditLog records an admin operation handled by the api itself, such as a config change.
+	// The operator and the operationID are the ones of the request.
+	RecordAuditLog(ctx context.Context, in *RecordAuditLogReq, opts ...grpc.CallOption) (*RecordAuditLogResp, error)
+	SearchAuditLogs(ctx context.Context, in *SearchAuditLogsReq, opts ...grpc.CallOption) (*SearchAuditLogsResp, error)
+	CreateApiKey(ctx context.Context, in *CreateApiKeyReq, opts ...grpc.CallOption) (*CreateApiKeyResp, error)
+	UpdateApiKey(ctx context.Context, in *UpdateApiKeyReq, opts ...grpc.CallOption) (*UpdateApiKeyResp, error)
+	// RotateApiKey replaces the secret of the key, the old secret keeps working for the grace period.
+	RotateApiKey(ctx context.Context, in *RotateApiKeyReq, opts ...grpc.CallOption) (*RotateApiKeyResp, error)
+	DeleteApiKeys(ctx context.Context, in *DeleteApiKeysReq, opts ...grpc.CallOption) (*DeleteApiKeysResp, error)
+	SearchApiKeys(ctx context.Context, in *SearchApiKeysReq, opts ...grpc.CallOption) (*SearchApiKeysResp, error)
+	// AuthenticateApiKey checks an API key against its ips, scopes and rate limit for a request of the api.
+	AuthenticateApiKey(ctx context.Context, in *AuthenticateApiKeyReq, opts ...grpc.CallOption) (*AuthenticateApiKeyResp, error)
+}
+
+type authExtClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuthExtClient(cc grpc.ClientConnInterface) AuthExtClient {
+	return &authExtClient{cc}
+}
+
+func (c *authExtClient) IssueUserToken(ctx context.Context, in *IssueUserTokenReq, opts ...grpc.CallOption) (*IssueUserTokenResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssueUserTokenResp)
+	err := c.cc.Invoke(ctx, AuthExt_IssueUserToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authExtClient) RefreshToken(ctx context.Context, in *RefreshTokenReq, opts ...grpc.CallOption) (*RefreshTokenResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshTokenResp)
+	err := c.cc.Invoke(ctx, AuthExt_RefreshToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authExtClient) GetSessions(ctx context.Context, in *GetSessionsReq, opts ...grpc.CallOption) (*GetSessionsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSessionsResp)
+	err := c.cc.Invoke(ctx, AuthExt_GetSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authExtClient) RevokeSession(ctx context.Context, in *RevokeSessionReq, opts ...grpc.CallOption) (*RevokeSessionResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeSessionResp)
+	err := c.cc.Invoke(ctx, AuthExt_RevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authExtClient) RevokeOtherSessions(ctx context.Context, in *RevokeOtherSessionsReq, opts ...grpc.CallOption) (*RevokeOtherSessionsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeOtherSessionsResp)
+	err := c.cc.Invoke(ctx, AuthExt_RevokeOtherSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authExtClient) GetTenantAdminToken(ctx context.Context, in *GetTenantAdminTokenReq, opts ...grpc.CallOption) (*GetTenantAdminTokenResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTenantAdminTokenResp)
+	err := c.cc.Invoke(ctx, AuthExt_GetTenantAdminToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authExtClient) KickUser(ctx context.Context, in *KickUserReq, opts ...grpc.CallOption) (*KickUserResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KickUserResp)
+	err := c.cc.Invoke(ctx, AuthExt_KickUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authExtClient) GetJWKS(ctx context.Context, in *GetJWKSReq, opts ...grpc.CallOption) (*GetJWKSResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetJWKSResp)
+	err := c.cc.Invoke(ctx, AuthExt_GetJWKS_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authExtClient) RotateSigningKey(ctx context.Context, in *RotateSigningKeyReq, opts ...grpc.CallOption) (*RotateSigningKeyResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateSigningKeyResp)
+	err := c.cc.Invoke(ctx, AuthExt_RotateSigningKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authExtClient) RecordAuditLog(ctx context.Context, in *RecordAuditLogReq, opts ...grpc.CallOption) (*RecordAuditLogResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordAuditLogResp)
+	err := c.cc.Invoke(ctx, AuthExt_RecordAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authExtClient) SearchAuditLogs(ctx context.Context, in *SearchAuditLogsReq, opts ...grpc.CallOption) (*SearchAuditLogsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchAuditLogsResp)
+	err := c.cc.Invoke(ctx, AuthExt_SearchAuditLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authExtClient) CreateApiKey(ctx context.Context, in *CreateApiKeyReq, opts ...grpc.CallOption) (*CreateApiKeyResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateApiKeyResp)
+	err := c.cc.Invoke(ctx, AuthExt_CreateApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authExtClient) UpdateApiKey(ctx context.Context, in *UpdateApiKeyReq, opts ...grpc.CallOption) (*UpdateApiKeyResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateApiKeyResp)
+	err := c.cc.Invoke(ctx, AuthExt_UpdateApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authExtClient) RotateApiKey(ctx context.Context, in *RotateApiKeyReq, opts ...grpc.CallOption) (*RotateApiKeyResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateApiKeyResp)
+	err := c.cc.Invoke(ctx, AuthExt_RotateApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authExtClient) DeleteApiKeys(ctx context.Context, in *DeleteApiKeysReq, opts ...grpc.CallOption) (*DeleteApiKeysResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteApiKeysResp)
+	err := c.cc.Invoke(ctx, AuthExt_DeleteApiKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authExtClient) SearchApiKeys(ctx context.Context, in *SearchApiKeysReq, opts ...grpc.CallOption) (*SearchApiKeysResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchApiKeysResp)
+	err := c.cc.Invoke(ctx, AuthExt_SearchApiKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authExtClient) AuthenticateApiKey(ctx context.Context, in *AuthenticateApiKeyReq, opts ...grpc.CallOption) (*AuthenticateApiKeyResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthenticateApiKeyResp)
+	err := c.cc.Invoke(ctx, AuthExt_AuthenticateApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuthExtServer is the server API for AuthExt service.
+// All implementations must embed UnimplementedAuthExtServer
+// for forward compatibility.
+type AuthExtServer interface {
+	// IssueUserToken issues the token of a user like GetUserToken, paired with a refresh token.
+	IssueUserToken(context.Context, *IssueUserTokenReq) (*IssueUserTokenResp, error)
+	// RefreshToken consumes a refresh token and replaces the access token of its session,
+	// unless the session was kicked or logged out.
+	RefreshToken(context.Context, *RefreshTokenReq) (*RefreshTokenResp, error)
+	GetSessions(context.Context, *GetSessionsReq) (*GetSessionsResp, error)
+	// RevokeSession kicks the token of the session and closes its connections.
+	RevokeSession(context.Context, *RevokeSessionReq) (*RevokeSessionResp, error)
+	RevokeOtherSessions(context.Context, *RevokeOtherSessionsReq) (*RevokeOtherSessionsResp, error)
+	// GetTenantAdminToken issues the admin token of a tenant, checked against the secret like GetAdminToken.
+	GetTenantAdminToken(context.Context, *GetTenantAdminTokenReq) (*GetTenantAdminTokenResp, error)
+	// KickUser logs the user out of every platform at once: the tokens are kicked and the gateways close the connections.
+	KickUser(context.Context, *KickUserReq) (*KickUserResp, error)
+	// GetJWKS returns the public keys tokens may currently be verified with.
+	GetJWKS(context.Context, *GetJWKSReq) (*GetJWKSResp, error)
+	// RotateSigningKey makes a new key the signing key, the previous keys keep verifying their tokens.
+	RotateSigningKey(context.Context, *RotateSigningKeyReq) (*RotateSigningKeyResp, error)
+	// RecordAuditLog records an admin operation handled by the api itself, such as a config change.
+	// The operator and the operationID are the ones of the request.
+	RecordAuditLog(context.Context, *RecordAuditLogReq) (*RecordAuditLogResp, error)
+	SearchAuditLogs(context.Context, *SearchAuditLogsReq) (*SearchAuditLogsResp, error)
+	CreateApiKey(context.Context, *CreateApiKeyReq) (*CreateApiKeyResp, error)
+	UpdateApiKey(context.Context, *UpdateApiKeyReq) (*UpdateApiKeyResp, error)
+	// RotateApiKey replaces the secret of the key, the old secret keeps working for the grace period.
+	RotateApiKey(context.Context, *RotateApiKeyReq) (*RotateApiKeyResp, error)
+	DeleteApiKeys(context.Context, *DeleteApiKeysReq) (*DeleteApiKeysResp, error)
+	SearchApiKeys(context.Context, *SearchApiKeysReq) (*SearchApiKeysResp, error)
+	// AuthenticateApiKey checks an API key against its ips, scopes and rate limit for a request of the api.
+	AuthenticateApiKey(context.Context, *AuthenticateApiKeyReq) (*AuthenticateApiKeyResp, error)
+	mustEmbedUnimplementedAuthExtServer()
+}
+
+// UnimplementedAuthExtServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAuthExtServer struct{}
+
+func (UnimplementedAuthExtServer) IssueUserToken(context.Context, *IssueUserTokenReq) (*IssueUserTokenResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueUserToken not implemented")
+}
+func (UnimplementedAuthExtServer) RefreshToken(context.Context, *RefreshTokenReq) (*RefreshTokenResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedAuthExtServer) GetSessions(context.Context, *GetSessionsReq) (*GetSessionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessions not implemented")
+}
+func (UnimplementedAuthExtServer) RevokeSession(context.Context, *RevokeSessionReq) (*RevokeSessionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedAuthExtServer) RevokeOtherSessions(context.Context, *RevokeOtherSessionsReq) (*RevokeOtherSessionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeOtherSessions not implemented")
+}
+func (UnimplementedAuthExtServer) GetTenantAdminToken(context.Context, *GetTenantAdminTokenReq) (*GetTenantAdminTokenResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenantAdminToken not implemented")
+}
+func (UnimplementedAuthExtServer) KickUser(context.Context, *KickUserReq) (*KickUserResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KickUser not implemented")
+}
+func (UnimplementedAuthExtServer) GetJWKS(context.Context, *GetJWKSReq) (*GetJWKSResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJWKS not implemented")
+}
+func (UnimplementedAuthExtServer) RotateSigningKey(context.Context, *RotateSigningKeyReq) (*RotateSigningKeyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateSigningKey not implemented")
+}
+func (UnimplementedAuthExtServer) RecordAuditLog(context.Context, *RecordAuditLogReq) (*RecordAuditLogResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordAuditLog not implemented")
+}
+func (UnimplementedAuthExtServer) SearchAuditLogs(context.Context, *SearchAuditLogsReq) (*SearchAuditLogsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchAuditLogs not implemented")
+}
+func (UnimplementedAuthExtServer) CreateApiKey(context.Context, *CreateApiKeyReq) (*CreateApiKeyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApiKey not implemented")
+}
+func (UnimplementedAuthExtServer) UpdateApiKey(context.Context, *UpdateApiKeyReq) (*UpdateApiKeyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateApiKey not implemented")
+}
+func (UnimplementedAuthExtServer) RotateApiKey(context.Context, *RotateApiKeyReq) (*RotateApiKeyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateApiKey not implemented")
+}
+func (UnimplementedAuthExtServer) DeleteApiKeys(context.Context, *DeleteApiKeysReq) (*DeleteApiKeysResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteApiKeys not implemented")
+}
+func (UnimplementedAuthExtServer) SearchApiKeys(context.Context, *SearchApiKeysReq) (*SearchApiKeysResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchApiKeys not implemented")
+}
+func (UnimplementedAuthExtServer) AuthenticateApiKey(context.Context, *AuthenticateApiKeyReq) (*AuthenticateApiKeyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthenticateApiKey not implemented")
+}
+func (UnimplementedAuthExtServer) mustEmbedUnimplementedAuthExtServer() {}
+func (UnimplementedAuthExtServer) testEmbeddedByValue()                 {}
+
+// UnsafeAuthExtServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuthExtServer will
+// result in compilation errors.
+type UnsafeAuthExtServer interface {
+	mustEmbedUnimplementedAuthExtServer()
+}
+
+func RegisterAuthExtServer(s grpc.ServiceRegistrar, srv AuthExtServer) {
+	// If the following call pancis, it indicates UnimplementedAuthExtServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AuthExt_ServiceDesc, srv)
+}
+
+func _AuthExt_IssueUserToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueUserTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthExtServer).IssueUserToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthExt_IssueUserToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthExtServer).IssueUserToken(ctx, req.(*IssueUserTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthExt_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthExtServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthExt_RefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthExtServer).RefreshToken(ctx, req.(*RefreshTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthExt_GetSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthExtServer).GetSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthExt_GetSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthExtServer).GetSessions(ctx, req.(*GetSessionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthExt_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthExtServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthExt_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthExtServer).RevokeSession(ctx, req.(*RevokeSessionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthExt_RevokeOtherSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeOtherSessionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthExtServer).RevokeOtherSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthExt_RevokeOtherSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthExtServer).RevokeOtherSessions(ctx, req.(*RevokeOtherSessionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthExt_GetTenantAdminToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenantAdminTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthExtServer).GetTenantAdminToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthExt_GetTenantAdminToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthExtServer).GetTenantAdminToken(ctx, req.(*GetTenantAdminTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthExt_KickUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KickUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthExtServer).KickUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthExt_KickUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthExtServer).KickUser(ctx, req.(*KickUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthExt_GetJWKS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJWKSReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthExtServer).GetJWKS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthExt_GetJWKS_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthExtServer).GetJWKS(ctx, req.(*GetJWKSReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthExt_RotateSigningKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateSigningKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthExtServer).RotateSigningKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthExt_RotateSigningKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthExtServer).RotateSigningKey(ctx, req.(*RotateSigningKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthExt_RecordAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordAuditLogReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthExtServer).RecordAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthExt_RecordAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthExtServer).RecordAuditLog(ctx, req.(*RecordAuditLogReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthExt_SearchAuditLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAuditLogsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthExtServer).SearchAuditLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthExt_SearchAuditLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthExtServer).SearchAuditLogs(ctx, req.(*SearchAuditLogsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthExt_CreateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthExtServer).CreateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthExt_CreateApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthExtServer).CreateApiKey(ctx, req.(*CreateApiKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthExt_UpdateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateApiKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthExtServer).UpdateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthExt_UpdateApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthExtServer).UpdateApiKey(ctx, req.(*UpdateApiKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthExt_RotateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateApiKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthExtServer).RotateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthExt_RotateApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthExtServer).RotateApiKey(ctx, req.(*RotateApiKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthExt_DeleteApiKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteApiKeysReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthExtServer).DeleteApiKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthExt_DeleteApiKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthExtServer).DeleteApiKeys(ctx, req.(*DeleteApiKeysReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthExt_SearchApiKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchApiKeysReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthExtServer).SearchApiKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthExt_SearchApiKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthExtServer).SearchApiKeys(ctx, req.(*SearchApiKeysReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthExt_AuthenticateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthenticateApiKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthExtServer).AuthenticateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthExt_AuthenticateApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthExtServer).AuthenticateApiKey(ctx, req.(*AuthenticateApiKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuthExt_ServiceDesc is the grpc.ServiceDesc for AuthExt service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuthExt_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openim.server.authext.authExt",
+	HandlerType: (*AuthExtServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "IssueUserToken",
+			Handler:    _AuthExt_IssueUserToken_Handler,
+		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _AuthExt_RefreshToken_Handler,
+		},
+		{
+			MethodName: "GetSessions",
+			Handler:    _AuthExt_GetSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _AuthExt_RevokeSession_Handler,
+		},
+		{
+			MethodName: "RevokeOtherSessions",
+			Handler:    _AuthExt_RevokeOtherSessions_Handler,
+		},
+		{
+			MethodName: "GetTenantAdminToken",
+			Handler:    _AuthExt_GetTenantAdminToken_Handler,
+		},
+		{
+			MethodName: "KickUser",
+			Handler:    _AuthExt_KickUser_Handler,
+		},
+		{
+			MethodName: "GetJWKS",
+			Handler:    _AuthExt_GetJWKS_Handler,
+		},
+		{
+			MethodName: "RotateSigningKey",
+			Handler:    _AuthExt_RotateSigningKey_Handler,
+		},
+		{
+			MethodName: "RecordAuditLog",
+			Handler:    _AuthExt_RecordAuditLog_Handler,
+		},
+		{
+			MethodName: "SearchAuditLogs",
+			Handler:    _AuthExt_SearchAuditLogs_Handler,
+		},
+		{
+			MethodName: "CreateApiKey",
+			Handler:    _AuthExt_CreateApiKey_Handler,
+		},
+		{
+			MethodName: "UpdateApiKey",
+			Handler:    _AuthExt_UpdateApiKey_Handler,
+		},
+		{
+			MethodName: "RotateApiKey",
+			Handler:    _AuthExt_RotateApiKey_Handler,
+		},
+		{
+			MethodName: "DeleteApiKeys",
+			Handler:    _AuthExt_DeleteApiKeys_Handler,
+		},
+		{
+			MethodName: "SearchApiKeys",
+			Handler:    _AuthExt_SearchApiKeys_Handler,
+		},
+		{
+			MethodName: "AuthenticateApiKey",
+			Handler:    _AuthExt_AuthenticateApiKey_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "authext/authext.proto",
+}
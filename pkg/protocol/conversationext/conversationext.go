@@ -0,0 +1,90 @@
+package conversationext
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+func (x *ConversationIDs) UnmarshalJSON(p []byte) error {
+	return json.Unmarshal(p, &x.ConversationIDs)
+}
+
+func (x *ConversationIDs) MarshalJSON() ([]byte, error) {
+	return json.Marshal(x.ConversationIDs)
+}
+
+func (x *CreateConversationFolderReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.Name == "" {
+		return errors.New("name is empty")
+	}
+	return nil
+}
+
+func (x *UpdateConversationFolderReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.FolderID == "" {
+		return errors.New("folderID is empty")
+	}
+	if x.Name != nil && x.Name.Value == "" {
+		return errors.New("name is empty")
+	}
+	return nil
+}
+
+func (x *AddFolderConversationsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.FolderID == "" {
+		return errors.New("folderID is empty")
+	}
+	if len(x.ConversationIDs) == 0 {
+		return errors.New("conversationIDs is empty")
+	}
+	return nil
+}
+
+func (x *RemoveFolderConversationsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.FolderID == "" {
+		return errors.New("folderID is empty")
+	}
+	if len(x.ConversationIDs) == 0 {
+		return errors.New("conversationIDs is empty")
+	}
+	return nil
+}
+
+func (x *DeleteConversationFoldersReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if len(x.FolderIDs) == 0 {
+		return errors.New("folderIDs is empty")
+	}
+	return nil
+}
+
+func (x *GetIncrementalConversationFoldersReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *GetFolderConversationIDsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.FolderID == "" {
+		return errors.New("folderID is empty")
+	}
+	return nil
+}
@@ -0,0 +1,351 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.0
+// source: conversationext/conversationext.proto
+
+package conversationext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ConversationExt_CreateConversationFolder_FullMethodName          = "/openim.server.conversationext.conversationExt/CreateConversationFolder"
+	ConversationExt_UpdateConversationFolder_FullMethodName          = "/openim.server.conversationext.conversationExt/UpdateConversationFolder"
+	ConversationExt_AddFolderConversations_FullMethodName            = "/openim.server.conversationext.conversationExt/AddFolderConversations"
+	ConversationExt_RemoveFolderConversations_FullMethodName         = "/openim.server.conversationext.conversationExt/RemoveFolderConversations"
+	ConversationExt_DeleteConversationFolders_FullMethodName         = "/openim.server.conversationext.conversationExt/DeleteConversationFolders"
+	ConversationExt_GetIncrementalConversationFolders_FullMethodName = "/openim.server.conversationext.conversationExt/GetIncrementalConversationFolders"
+	ConversationExt_GetFolderConversationIDs_FullMethodName          = "/openim.server.conversationext.conversationExt/GetFolderConversationIDs"
+)
+
+// ConversationExtClient is the client API for ConversationExt service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ConversationExtClient interface {
+	CreateConversationFolder(ctx context.Context, in *CreateConversationFolderReq, opts ...grpc.CallOption) (*CreateConversationFolderResp, error)
+	UpdateConversationFolder(ctx context.Context, in *UpdateConversationFolderReq, opts ...grpc.CallOption) (*UpdateConversationFolderResp, error)
+	AddFolderConversations(ctx context.Context, in *AddFolderConversationsReq, opts ...grpc.CallOption) (*AddFolderConversationsResp, error)
+	RemoveFolderConversations(ctx context.Context, in *RemoveFolderConversationsReq, opts ...grpc.CallOption) (*RemoveFolderConversationsResp, error)
+	DeleteConversationFolders(ctx context.Context, in *DeleteConversationFoldersReq, opts ...grpc.CallOption) (*DeleteConversationFoldersResp, error)
+	GetIncrementalConversationFolders(ctx context.Context, in *GetIncrementalConversationFoldersReq, opts ...grpc.CallOption) (*GetIncrementalConversationFoldersResp, error)
+	// GetFolderConversationIDs evaluates the rule of a rule folder, whose conversations change without a new folder version.
+	GetFolderConversationIDs(ctx context.Context, in *GetFolderConversationIDsReq, opts ...grpc.CallOption) (*GetFolderConversationIDsResp, error)
+}
+
+type conversationExtClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewConversationExtClient(cc grpc.ClientConnInterface) ConversationExtClient {
+	return &conversationExtClient{cc}
+}
+
+func (c *conversationExtClient) CreateConversationFolder(ctx context.Context, in *CreateConversationFolderReq, opts ...grpc.CallOption) (*CreateConversationFolderResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateConversationFolderResp)
+	err := c.cc.Invoke(ctx, ConversationExt_CreateConversationFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationExtClient) UpdateConversationFolder(ctx context.Context, in *UpdateConversationFolderReq, opts ...grpc.CallOption) (*UpdateConversationFolderResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateConversationFolderResp)
+	err := c.cc.Invoke(ctx, ConversationExt_UpdateConversationFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationExtClient) AddFolderConversations(ctx context.Context, in *AddFolderConversationsReq, opts ...grpc.CallOption) (*AddFolderConversationsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddFolderConversationsResp)
+	err := c.cc.Invoke(ctx, ConversationExt_AddFolderConversations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationExtClient) RemoveFolderConversations(ctx context.Context, in *RemoveFolderConversationsReq, opts ...grpc.CallOption) (*RemoveFolderConversationsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveFolderConversationsResp)
+	err := c.cc.Invoke(ctx, ConversationExt_RemoveFolderConversations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationExtClient) DeleteConversationFolders(ctx context.Context, in *DeleteConversationFoldersReq, opts ...grpc.CallOption) (*DeleteConversationFoldersResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteConversationFoldersResp)
+	err := c.cc.Invoke(ctx, ConversationExt_DeleteConversationFolders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationExtClient) GetIncrementalConversationFolders(ctx context.Context, in *GetIncrementalConversationFoldersReq, opts ...grpc.CallOption) (*GetIncrementalConversationFoldersResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetIncrementalConversationFoldersResp)
+	err := c.cc.Invoke(ctx, ConversationExt_GetIncrementalConversationFolders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationExtClient) GetFolderConversationIDs(ctx context.Context, in *GetFolderConversationIDsReq, opts ...grpc.CallOption) (*GetFolderConversationIDsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFolderConversationIDsResp)
+	err := c.cc.Invoke(ctx, ConversationExt_GetFolderConversationIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConversationExtServer is the server API for ConversationExt service.
+// All implementations must embed UnimplementedConversationExtServer
+// for forward compatibility.
+type ConversationExtServer interface {
+	CreateConversationFolder(context.Context, *CreateConversationFolderReq) (*CreateConversationFolderResp, error)
+	UpdateConversationFolder(context.Context, *UpdateConversationFolderReq) (*UpdateConversationFolderResp, error)
+	AddFolderConversations(context.Context, *AddFolderConversationsReq) (*AddFolderConversationsResp, error)
+	RemoveFolderConversations(context.Context, *RemoveFolderConversationsReq) (*RemoveFolderConversationsResp, error)
+	DeleteConversationFolders(context.Context, *DeleteConversationFoldersReq) (*DeleteConversationFoldersResp, error)
+	GetIncrementalConversationFolders(context.Context, *GetIncrementalConversationFoldersReq) (*GetIncrementalConversationFoldersResp, error)
+	// GetFolderConversationIDs evaluates the rule of a rule folder, whose conversations change without a new folder version.
+	GetFolderConversationIDs(context.Context, *GetFolderConversationIDsReq) (*GetFolderConversationIDsResp, error)
+	mustEmbedUnimplementedConversationExtServer()
+}
+
+// UnimplementedConversationExtServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedConversationExtServer struct{}
+
+func (UnimplementedConversationExtServer) CreateConversationFolder(context.Context, *CreateConversationFolderReq) (*CreateConversationFolderResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateConversationFolder not implemented")
+}
+func (UnimplementedConversationExtServer) UpdateConversationFolder(context.Context, *UpdateConversationFolderReq) (*UpdateConversationFolderResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateConversationFolder not implemented")
+}
+func (UnimplementedConversationExtServer) AddFolderConversations(context.Context, *AddFolderConversationsReq) (*AddFolderConversationsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFolderConversations not implemented")
+}
+func (UnimplementedConversationExtServer) RemoveFolderConversations(context.Context, *RemoveFolderConversationsReq) (*RemoveFolderConversationsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFolderConversations not implemented")
+}
+func (UnimplementedConversationExtServer) DeleteConversationFolders(context.Context, *DeleteConversationFoldersReq) (*DeleteConversationFoldersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConversationFolders not implemented")
+}
+func (UnimplementedConversationExtServer) GetIncrementalConversationFolders(context.Context, *GetIncrementalConversationFoldersReq) (*GetIncrementalConversationFoldersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIncrementalConversationFolders not implemented")
+}
+func (UnimplementedConversationExtServer) GetFolderConversationIDs(context.Context, *GetFolderConversationIDsReq) (*GetFolderConversationIDsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFolderConversationIDs not implemented")
+}
+func (UnimplementedConversationExtServer) mustEmbedUnimplementedConversationExtServer() {}
+func (UnimplementedConversationExtServer) testEmbeddedByValue()                         {}
+
+// UnsafeConversationExtServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ConversationExtServer will
+// result in compilation errors.
+type UnsafeConversationExtServer interface {
+	mustEmbedUnimplementedConversationExtServer()
+}
+
+func RegisterConversationExtServer(s grpc.ServiceRegistrar, srv ConversationExtServer) {
+	// If the following call pancis, it indicates UnimplementedConversationExtServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ConversationExt_ServiceDesc, srv)
+}
+
+func _ConversationExt_CreateConversationFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateConversationFolderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationExtServer).CreateConversationFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationExt_CreateConversationFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationExtServer).CreateConversationFolder(ctx, req.(*CreateConversationFolderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationExt_UpdateConversationFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateConversationFolderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationExtServer).UpdateConversationFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationExt_UpdateConversationFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationExtServer).UpdateConversationFolder(ctx, req.(*UpdateConversationFolderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationExt_AddFolderConversations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFolderConversationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationExtServer).AddFolderConversations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationExt_AddFolderConversations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationExtServer).AddFolderConversations(ctx, req.(*AddFolderConversationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationExt_RemoveFolderConversations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFolderConversationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationExtServer).RemoveFolderConversations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationExt_RemoveFolderConversations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationExtServer).RemoveFolderConversations(ctx, req.(*RemoveFolderConversationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationExt_DeleteConversationFolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteConversationFoldersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationExtServer).DeleteConversationFolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationExt_DeleteConversationFolders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationExtServer).DeleteConversationFolders(ctx, req.(*DeleteConversationFoldersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationExt_GetIncrementalConversationFolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIncrementalConversationFoldersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationExtServer).GetIncrementalConversationFolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationExt_GetIncrementalConversationFolders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationExtServer).GetIncrementalConversationFolders(ctx, req.(*GetIncrementalConversationFoldersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationExt_GetFolderConversationIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFolderConversationIDsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationExtServer).GetFolderConversationIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationExt_GetFolderConversationIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationExtServer).GetFolderConversationIDs(ctx, req.(*GetFolderConversationIDsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ConversationExt_ServiceDesc is the grpc.ServiceDesc for ConversationExt service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ConversationExt_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openim.server.conversationext.conversationExt",
+	HandlerType: (*ConversationExtServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateConversationFolder",
+			Handler:    _ConversationExt_CreateConversationFolder_Handler,
+		},
+		{
+			MethodName: "UpdateConversationFolder",
+			Handler:    _ConversationExt_UpdateConversationFolder_Handler,
+		},
+		{
+			MethodName: "AddFolderConversations",
+			Handler:    _ConversationExt_AddFolderConversations_Handler,
+		},
+		{
+			MethodName: "RemoveFolderConversations",
+			Handler:    _ConversationExt_RemoveFolderConversations_Handler,
+		},
+		{
+			MethodName: "DeleteConversationFolders",
+			Handler:    _ConversationExt_DeleteConversationFolders_Handler,
+		},
+		{
+			MethodName: "GetIncrementalConversationFolders",
+			Handler:    _ConversationExt_GetIncrementalConversationFolders_Handler,
+		},
+		{
+			MethodName: "GetFolderConversationIDs",
+			Handler:    _ConversationExt_GetFolderConversationIDs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "conversationext/conversationext.proto",
+}
@@ -0,0 +1,210 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        v5.29.0
+// source: gatewayext/gatewayext.proto
+
+package gatewayext
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SubUserOnlineStatusElem extends sdkws.SubUserOnlineStatusElem with the last-seen time,
+// the shared fields keep their numbers so a client decoding sdkws still reads them.
+type SubUserOnlineStatusElem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID            string  `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	OnlinePlatformIDs []int32 `protobuf:"varint,2,rep,packed,name=onlinePlatformIDs,proto3" json:"onlinePlatformIDs"`
+	// lastSeen is the unix millisecond time an offline user was last online,
+	// zero when it is unknown or hidden from the subscriber.
+	LastSeen int64 `protobuf:"varint,3,opt,name=lastSeen,proto3" json:"lastSeen"`
+}
+
+func (x *SubUserOnlineStatusElem) Reset() {
+	*x = SubUserOnlineStatusElem{}
+	mi := &file_gatewayext_gatewayext_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubUserOnlineStatusElem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubUserOnlineStatusElem) ProtoMessage() {}
+
+func (x *SubUserOnlineStatusElem) ProtoReflect() protoreflect.Message {
+	mi := &file_gatewayext_gatewayext_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubUserOnlineStatusElem.ProtoReflect.Descriptor instead.
+func (*SubUserOnlineStatusElem) Descriptor() ([]byte, []int) {
+	return file_gatewayext_gatewayext_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SubUserOnlineStatusElem) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SubUserOnlineStatusElem) GetOnlinePlatformIDs() []int32 {
+	if x != nil {
+		return x.OnlinePlatformIDs
+	}
+	return nil
+}
+
+func (x *SubUserOnlineStatusElem) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
+type SubUserOnlineStatusTips struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subscribers []*SubUserOnlineStatusElem `protobuf:"bytes,1,rep,name=subscribers,proto3" json:"subscribers"`
+}
+
+func (x *SubUserOnlineStatusTips) Reset() {
+	*x = SubUserOnlineStatusTips{}
+	mi := &file_gatewayext_gatewayext_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubUserOnlineStatusTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubUserOnlineStatusTips) ProtoMessage() {}
+
+func (x *SubUserOnlineStatusTips) ProtoReflect() protoreflect.Message {
+	mi := &file_gatewayext_gatewayext_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubUserOnlineStatusTips.ProtoReflect.Descriptor instead.
+func (*SubUserOnlineStatusTips) Descriptor() ([]byte, []int) {
+	return file_gatewayext_gatewayext_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SubUserOnlineStatusTips) GetSubscribers() []*SubUserOnlineStatusElem {
+	if x != nil {
+		return x.Subscribers
+	}
+	return nil
+}
+
+var File_gatewayext_gatewayext_proto protoreflect.FileDescriptor
+
+var file_gatewayext_gatewayext_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x65, 0x78, 0x74, 0x2f, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x18, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x65, 0x78, 0x74, 0x22, 0x7b, 0x0a, 0x17, 0x53, 0x75, 0x62, 0x55, 0x73,
+	0x65, 0x72, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6c,
+	0x65, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x11, 0x6f, 0x6e,
+	0x6c, 0x69, 0x6e, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x44, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x11, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x44, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74,
+	0x53, 0x65, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74,
+	0x53, 0x65, 0x65, 0x6e, 0x22, 0x6e, 0x0a, 0x17, 0x53, 0x75, 0x62, 0x55, 0x73, 0x65, 0x72, 0x4f,
+	0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x69, 0x70, 0x73, 0x12,
+	0x53, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x65, 0x78, 0x74, 0x2e,
+	0x53, 0x75, 0x62, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x45, 0x6c, 0x65, 0x6d, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x72, 0x73, 0x42, 0x40, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x73, 0x64, 0x6b, 0x2f, 0x6f, 0x70, 0x65,
+	0x6e, 0x2d, 0x69, 0x6d, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x33, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x65, 0x78, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_gatewayext_gatewayext_proto_rawDescOnce sync.Once
+	file_gatewayext_gatewayext_proto_rawDescData = file_gatewayext_gatewayext_proto_rawDesc
+)
+
+func file_gatewayext_gatewayext_proto_rawDescGZIP() []byte {
+	file_gatewayext_gatewayext_proto_rawDescOnce.Do(func() {
+		file_gatewayext_gatewayext_proto_rawDescData = protoimpl.X.CompressGZIP(file_gatewayext_gatewayext_proto_rawDescData)
+	})
+	return file_gatewayext_gatewayext_proto_rawDescData
+}
+
+var file_gatewayext_gatewayext_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_gatewayext_gatewayext_proto_goTypes = []any{
+	(*SubUserOnlineStatusElem)(nil), // 0: openim.server.gatewayext.SubUserOnlineStatusElem
+	(*SubUserOnlineStatusTips)(nil), // 1: openim.server.gatewayext.SubUserOnlineStatusTips
+}
+var file_gatewayext_gatewayext_proto_depIdxs = []int32{
+	0, // 0: openim.server.gatewayext.SubUserOnlineStatusTips.subscribers:type_name -> openim.server.gatewayext.SubUserOnlineStatusElem
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_gatewayext_gatewayext_proto_init() }
+func file_gatewayext_gatewayext_proto_init() {
+	if File_gatewayext_gatewayext_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_gatewayext_gatewayext_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_gatewayext_gatewayext_proto_goTypes,
+		DependencyIndexes: file_gatewayext_gatewayext_proto_depIdxs,
+		MessageInfos:      file_gatewayext_gatewayext_proto_msgTypes,
+	}.Build()
+	File_gatewayext_gatewayext_proto = out.File
+	file_gatewayext_gatewayext_proto_rawDesc = nil
+	file_gatewayext_gatewayext_proto_goTypes = nil
+	file_gatewayext_gatewayext_proto_depIdxs = nil
+}
@@ -0,0 +1,32 @@
+#!/usr/bin/env bash
+# Generates the services only this server has, the messages they share with the other services,
+# e.g. sdkws, are imported from github.com/openimsdk/protocol.
+
+set -e
+cd "$(dirname "$0")"
+
+PROTOCOL_DIR=$(go list -m -f '{{.Dir}}' github.com/openimsdk/protocol)
+MODULE=github.com/openimsdk/open-im-server/v3/pkg/protocol
+
+PROTO_NAMES=(
+    "userext"
+    "gatewayext"
+    "authext"
+    "conversationext"
+    "thirdext"
+    "msgext"
+    "pushext"
+)
+
+for name in "${PROTO_NAMES[@]}"; do
+  protoc -I . -I "${PROTOCOL_DIR}" \
+    --go_out=./${name} --go_opt=module=${MODULE}/${name} \
+    --go-grpc_out=./${name} --go-grpc_opt=module=${MODULE}/${name} \
+    ${name}/${name}.proto
+done
+
+if [ "$(uname -s)" == "Darwin" ]; then
+    find . -type f -name '*.pb.go' -exec sed -i '' 's/,omitempty"`/\"\`/g' {} +
+else
+    find . -type f -name '*.pb.go' -exec sed -i 's/,omitempty"`/\"\`/g' {} +
+fi
@@ -0,0 +1,935 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        v5.29.0
+// source: msgext/msgext.proto
+
+package msgext
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ConversationDraft is the unsent input of the user in a conversation, an empty text and reply mean no draft.
+type ConversationDraft struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID   string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	Text             string `protobuf:"bytes,2,opt,name=text,proto3" json:"text"`
+	ReplyClientMsgID string `protobuf:"bytes,3,opt,name=replyClientMsgID,proto3" json:"replyClientMsgID"`
+	UpdateTime       int64  `protobuf:"varint,4,opt,name=updateTime,proto3" json:"updateTime"`
+}
+
+func (x *ConversationDraft) Reset() {
+	*x = ConversationDraft{}
+	mi := &file_msgext_msgext_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConversationDraft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversationDraft) ProtoMessage() {}
+
+func (x *ConversationDraft) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversationDraft.ProtoReflect.Descriptor instead.
+func (*ConversationDraft) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ConversationDraft) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *ConversationDraft) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ConversationDraft) GetReplyClientMsgID() string {
+	if x != nil {
+		return x.ReplyClientMsgID
+	}
+	return ""
+}
+
+func (x *ConversationDraft) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+type SetConversationDraftReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID           string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	ConversationID   string `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"`
+	Text             string `protobuf:"bytes,3,opt,name=text,proto3" json:"text"`
+	ReplyClientMsgID string `protobuf:"bytes,4,opt,name=replyClientMsgID,proto3" json:"replyClientMsgID"`
+}
+
+func (x *SetConversationDraftReq) Reset() {
+	*x = SetConversationDraftReq{}
+	mi := &file_msgext_msgext_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetConversationDraftReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetConversationDraftReq) ProtoMessage() {}
+
+func (x *SetConversationDraftReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetConversationDraftReq.ProtoReflect.Descriptor instead.
+func (*SetConversationDraftReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetConversationDraftReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SetConversationDraftReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *SetConversationDraftReq) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SetConversationDraftReq) GetReplyClientMsgID() string {
+	if x != nil {
+		return x.ReplyClientMsgID
+	}
+	return ""
+}
+
+type SetConversationDraftResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetConversationDraftResp) Reset() {
+	*x = SetConversationDraftResp{}
+	mi := &file_msgext_msgext_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetConversationDraftResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetConversationDraftResp) ProtoMessage() {}
+
+func (x *SetConversationDraftResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetConversationDraftResp.ProtoReflect.Descriptor instead.
+func (*SetConversationDraftResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{2}
+}
+
+type GetConversationDraftsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID          string   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	ConversationIDs []string `protobuf:"bytes,2,rep,name=conversationIDs,proto3" json:"conversationIDs"`
+}
+
+func (x *GetConversationDraftsReq) Reset() {
+	*x = GetConversationDraftsReq{}
+	mi := &file_msgext_msgext_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationDraftsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationDraftsReq) ProtoMessage() {}
+
+func (x *GetConversationDraftsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationDraftsReq.ProtoReflect.Descriptor instead.
+func (*GetConversationDraftsReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetConversationDraftsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetConversationDraftsReq) GetConversationIDs() []string {
+	if x != nil {
+		return x.ConversationIDs
+	}
+	return nil
+}
+
+type GetConversationDraftsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Drafts []*ConversationDraft `protobuf:"bytes,1,rep,name=drafts,proto3" json:"drafts"`
+}
+
+func (x *GetConversationDraftsResp) Reset() {
+	*x = GetConversationDraftsResp{}
+	mi := &file_msgext_msgext_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationDraftsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationDraftsResp) ProtoMessage() {}
+
+func (x *GetConversationDraftsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationDraftsResp.ProtoReflect.Descriptor instead.
+func (*GetConversationDraftsResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetConversationDraftsResp) GetDrafts() []*ConversationDraft {
+	if x != nil {
+		return x.Drafts
+	}
+	return nil
+}
+
+type GetIncrementalConversationDraftsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	// versionID and version are the drafts version of the last sync.
+	VersionID string `protobuf:"bytes,2,opt,name=versionID,proto3" json:"versionID"`
+	Version   uint64 `protobuf:"varint,3,opt,name=version,proto3" json:"version"`
+}
+
+func (x *GetIncrementalConversationDraftsReq) Reset() {
+	*x = GetIncrementalConversationDraftsReq{}
+	mi := &file_msgext_msgext_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIncrementalConversationDraftsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIncrementalConversationDraftsReq) ProtoMessage() {}
+
+func (x *GetIncrementalConversationDraftsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIncrementalConversationDraftsReq.ProtoReflect.Descriptor instead.
+func (*GetIncrementalConversationDraftsReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetIncrementalConversationDraftsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetIncrementalConversationDraftsReq) GetVersionID() string {
+	if x != nil {
+		return x.VersionID
+	}
+	return ""
+}
+
+func (x *GetIncrementalConversationDraftsReq) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type GetIncrementalConversationDraftsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VersionID string `protobuf:"bytes,1,opt,name=versionID,proto3" json:"versionID"`
+	Version   uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version"`
+	// full means the versions could not be compared, insert then holds every draft and the local ones are replaced.
+	Full bool `protobuf:"varint,3,opt,name=full,proto3" json:"full"`
+	// delete holds the conversations whose draft was cleared.
+	Delete []string             `protobuf:"bytes,4,rep,name=delete,proto3" json:"delete"`
+	Insert []*ConversationDraft `protobuf:"bytes,5,rep,name=insert,proto3" json:"insert"`
+	Update []*ConversationDraft `protobuf:"bytes,6,rep,name=update,proto3" json:"update"`
+}
+
+func (x *GetIncrementalConversationDraftsResp) Reset() {
+	*x = GetIncrementalConversationDraftsResp{}
+	mi := &file_msgext_msgext_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIncrementalConversationDraftsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIncrementalConversationDraftsResp) ProtoMessage() {}
+
+func (x *GetIncrementalConversationDraftsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIncrementalConversationDraftsResp.ProtoReflect.Descriptor instead.
+func (*GetIncrementalConversationDraftsResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetIncrementalConversationDraftsResp) GetVersionID() string {
+	if x != nil {
+		return x.VersionID
+	}
+	return ""
+}
+
+func (x *GetIncrementalConversationDraftsResp) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetIncrementalConversationDraftsResp) GetFull() bool {
+	if x != nil {
+		return x.Full
+	}
+	return false
+}
+
+func (x *GetIncrementalConversationDraftsResp) GetDelete() []string {
+	if x != nil {
+		return x.Delete
+	}
+	return nil
+}
+
+func (x *GetIncrementalConversationDraftsResp) GetInsert() []*ConversationDraft {
+	if x != nil {
+		return x.Insert
+	}
+	return nil
+}
+
+func (x *GetIncrementalConversationDraftsResp) GetUpdate() []*ConversationDraft {
+	if x != nil {
+		return x.Update
+	}
+	return nil
+}
+
+// ConversationMention counts the unread messages mentioning the user in a group conversation.
+type ConversationMention struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	UnreadCount    int32  `protobuf:"varint,2,opt,name=unreadCount,proto3" json:"unreadCount"`
+	// firstSeq is the seq of the oldest unread message mentioning the user.
+	FirstSeq int64 `protobuf:"varint,3,opt,name=firstSeq,proto3" json:"firstSeq"`
+}
+
+func (x *ConversationMention) Reset() {
+	*x = ConversationMention{}
+	mi := &file_msgext_msgext_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConversationMention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversationMention) ProtoMessage() {}
+
+func (x *ConversationMention) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversationMention.ProtoReflect.Descriptor instead.
+func (*ConversationMention) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ConversationMention) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *ConversationMention) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *ConversationMention) GetFirstSeq() int64 {
+	if x != nil {
+		return x.FirstSeq
+	}
+	return 0
+}
+
+type GetUnreadMentionsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	// conversationIDs are all the group conversations of the user when empty.
+	ConversationIDs []string `protobuf:"bytes,2,rep,name=conversationIDs,proto3" json:"conversationIDs"`
+}
+
+func (x *GetUnreadMentionsReq) Reset() {
+	*x = GetUnreadMentionsReq{}
+	mi := &file_msgext_msgext_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnreadMentionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadMentionsReq) ProtoMessage() {}
+
+func (x *GetUnreadMentionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadMentionsReq.ProtoReflect.Descriptor instead.
+func (*GetUnreadMentionsReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetUnreadMentionsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetUnreadMentionsReq) GetConversationIDs() []string {
+	if x != nil {
+		return x.ConversationIDs
+	}
+	return nil
+}
+
+type GetUnreadMentionsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// mentions leaves out the conversations without unread mentions.
+	Mentions []*ConversationMention `protobuf:"bytes,1,rep,name=mentions,proto3" json:"mentions"`
+}
+
+func (x *GetUnreadMentionsResp) Reset() {
+	*x = GetUnreadMentionsResp{}
+	mi := &file_msgext_msgext_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnreadMentionsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadMentionsResp) ProtoMessage() {}
+
+func (x *GetUnreadMentionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadMentionsResp.ProtoReflect.Descriptor instead.
+func (*GetUnreadMentionsResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetUnreadMentionsResp) GetMentions() []*ConversationMention {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+type GetNextMentionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID         string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	ConversationID string `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"`
+	// seq is where the user is in the conversation, the next mention is after it.
+	Seq int64 `protobuf:"varint,3,opt,name=seq,proto3" json:"seq"`
+}
+
+func (x *GetNextMentionReq) Reset() {
+	*x = GetNextMentionReq{}
+	mi := &file_msgext_msgext_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNextMentionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNextMentionReq) ProtoMessage() {}
+
+func (x *GetNextMentionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNextMentionReq.ProtoReflect.Descriptor instead.
+func (*GetNextMentionReq) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetNextMentionReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetNextMentionReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *GetNextMentionReq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+type GetNextMentionResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// seq is the next unread message mentioning the user, 0 when there is none.
+	Seq         int64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq"`
+	UnreadCount int32 `protobuf:"varint,2,opt,name=unreadCount,proto3" json:"unreadCount"`
+}
+
+func (x *GetNextMentionResp) Reset() {
+	*x = GetNextMentionResp{}
+	mi := &file_msgext_msgext_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNextMentionResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNextMentionResp) ProtoMessage() {}
+
+func (x *GetNextMentionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msgext_msgext_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNextMentionResp.ProtoReflect.Descriptor instead.
+func (*GetNextMentionResp) Descriptor() ([]byte, []int) {
+	return file_msgext_msgext_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetNextMentionResp) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *GetNextMentionResp) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+var File_msgext_msgext_proto protoreflect.FileDescriptor
+
+var file_msgext_msgext_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2f, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x22, 0x9b, 0x01, 0x0a, 0x11,
+	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61, 0x66,
+	0x74, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x2a, 0x0a,
+	0x10, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x99, 0x01, 0x0a, 0x17, 0x53, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61,
+	0x66, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a,
+	0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x70,
+	0x6c, 0x79, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x4d, 0x73, 0x67, 0x49, 0x44, 0x22, 0x1a, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x5c, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x22,
+	0x5c, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3f, 0x0a, 0x06,
+	0x64, 0x72, 0x61, 0x66, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67,
+	0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x06, 0x64, 0x72, 0x61, 0x66, 0x74, 0x73, 0x22, 0x75, 0x0a,
+	0x23, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x43,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x8c, 0x02, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1c, 0x0a,
+	0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x12, 0x3f, 0x0a, 0x06, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x73, 0x65,
+	0x72, 0x74, 0x12, 0x3f, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x06, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x22, 0x7b, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x71,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x71,
+	0x22, 0x58, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x22, 0x5e, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x45, 0x0a, 0x08, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x08, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x65, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x4e, 0x65, 0x78, 0x74, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12,
+	0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65,
+	0x71, 0x22, 0x48, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x78, 0x74, 0x4d, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x72,
+	0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x32, 0xe8, 0x04, 0x0a, 0x06,
+	0x6d, 0x73, 0x67, 0x45, 0x78, 0x74, 0x12, 0x75, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x2d,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d,
+	0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x2e, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73,
+	0x67, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x78, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x72, 0x61, 0x66, 0x74, 0x73, 0x12, 0x2e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61,
+	0x66, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61,
+	0x66, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x99, 0x01, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x49,
+	0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73, 0x12, 0x39, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67,
+	0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72,
+	0x61, 0x66, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x3a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47,
+	0x65, 0x74, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x6c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64,
+	0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e,
+	0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55,
+	0x6e, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x63, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x78, 0x74, 0x4d, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65,
+	0x78, 0x74, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67,
+	0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x78, 0x74, 0x4d, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x73, 0x64, 0x6b, 0x2f, 0x6f,
+	0x70, 0x65, 0x6e, 0x2d, 0x69, 0x6d, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x33,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x6d, 0x73,
+	0x67, 0x65, 0x78, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_msgext_msgext_proto_rawDescOnce sync.Once
+	file_msgext_msgext_proto_rawDescData = file_msgext_msgext_proto_rawDesc
+)
+
+func file_msgext_msgext_proto_rawDescGZIP() []byte {
+	file_msgext_msgext_proto_rawDescOnce.Do(func() {
+		file_msgext_msgext_proto_rawDescData = protoimpl.X.CompressGZIP(file_msgext_msgext_proto_rawDescData)
+	})
+	return file_msgext_msgext_proto_rawDescData
+}
+
+var file_msgext_msgext_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_msgext_msgext_proto_goTypes = []any{
+	(*ConversationDraft)(nil),                    // 0: openim.server.msgext.ConversationDraft
+	(*SetConversationDraftReq)(nil),              // 1: openim.server.msgext.SetConversationDraftReq
+	(*SetConversationDraftResp)(nil),             // 2: openim.server.msgext.SetConversationDraftResp
+	(*GetConversationDraftsReq)(nil),             // 3: openim.server.msgext.GetConversationDraftsReq
+	(*GetConversationDraftsResp)(nil),            // 4: openim.server.msgext.GetConversationDraftsResp
+	(*GetIncrementalConversationDraftsReq)(nil),  // 5: openim.server.msgext.GetIncrementalConversationDraftsReq
+	(*GetIncrementalConversationDraftsResp)(nil), // 6: openim.server.msgext.GetIncrementalConversationDraftsResp
+	(*ConversationMention)(nil),                  // 7: openim.server.msgext.ConversationMention
+	(*GetUnreadMentionsReq)(nil),                 // 8: openim.server.msgext.GetUnreadMentionsReq
+	(*GetUnreadMentionsResp)(nil),                // 9: openim.server.msgext.GetUnreadMentionsResp
+	(*GetNextMentionReq)(nil),                    // 10: openim.server.msgext.GetNextMentionReq
+	(*GetNextMentionResp)(nil),                   // 11: openim.server.msgext.GetNextMentionResp
+}
+var file_msgext_msgext_proto_depIdxs = []int32{
+	0,  // 0: openim.server.msgext.GetConversationDraftsResp.drafts:type_name -> openim.server.msgext.ConversationDraft
+	0,  // 1: openim.server.msgext.GetIncrementalConversationDraftsResp.insert:type_name -> openim.server.msgext.ConversationDraft
+	0,  // 2: openim.server.msgext.GetIncrementalConversationDraftsResp.update:type_name -> openim.server.msgext.ConversationDraft
+	7,  // 3: openim.server.msgext.GetUnreadMentionsResp.mentions:type_name -> openim.server.msgext.ConversationMention
+	1,  // 4: openim.server.msgext.msgExt.SetConversationDraft:input_type -> openim.server.msgext.SetConversationDraftReq
+	3,  // 5: openim.server.msgext.msgExt.GetConversationDrafts:input_type -> openim.server.msgext.GetConversationDraftsReq
+	5,  // 6: openim.server.msgext.msgExt.GetIncrementalConversationDrafts:input_type -> openim.server.msgext.GetIncrementalConversationDraftsReq
+	8,  // 7: openim.server.msgext.msgExt.GetUnreadMentions:input_type -> openim.server.msgext.GetUnreadMentionsReq
+	10, // 8: openim.server.msgext.msgExt.GetNextMention:input_type -> openim.server.msgext.GetNextMentionReq
+	2,  // 9: openim.server.msgext.msgExt.SetConversationDraft:output_type -> openim.server.msgext.SetConversationDraftResp
+	4,  // 10: openim.server.msgext.msgExt.GetConversationDrafts:output_type -> openim.server.msgext.GetConversationDraftsResp
+	6,  // 11: openim.server.msgext.msgExt.GetIncrementalConversationDrafts:output_type -> openim.server.msgext.GetIncrementalConversationDraftsResp
+	9,  // 12: openim.server.msgext.msgExt.GetUnreadMentions:output_type -> openim.server.msgext.GetUnreadMentionsResp
+	11, // 13: openim.server.msgext.msgExt.GetNextMention:output_type -> openim.server.msgext.GetNextMentionResp
+	9,  // [9:14] is the sub-list for method output_type
+	4,  // [4:9] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_msgext_msgext_proto_init() }
+func file_msgext_msgext_proto_init() {
+	if File_msgext_msgext_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_msgext_msgext_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_msgext_msgext_proto_goTypes,
+		DependencyIndexes: file_msgext_msgext_proto_depIdxs,
+		MessageInfos:      file_msgext_msgext_proto_msgTypes,
+	}.Build()
+	File_msgext_msgext_proto = out.File
+	file_msgext_msgext_proto_rawDesc = nil
+	file_msgext_msgext_proto_goTypes = nil
+	file_msgext_msgext_proto_depIdxs = nil
+}
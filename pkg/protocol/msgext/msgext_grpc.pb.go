@@ -0,0 +1,279 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.0
+// source: msgext/msgext.proto
+
+package msgext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	MsgExt_SetConversationDraft_FullMethodName             = "/openim.server.msgext.msgExt/SetConversationDraft"
+	MsgExt_GetConversationDrafts_FullMethodName            = "/openim.server.msgext.msgExt/GetConversationDrafts"
+	MsgExt_GetIncrementalConversationDrafts_FullMethodName = "/openim.server.msgext.msgExt/GetIncrementalConversationDrafts"
+	MsgExt_GetUnreadMentions_FullMethodName                = "/openim.server.msgext.msgExt/GetUnreadMentions"
+	MsgExt_GetNextMention_FullMethodName                   = "/openim.server.msgext.msgExt/GetNextMention"
+)
+
+// MsgExtClient is the client API for MsgExt service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgExtClient interface {
+	// SetConversationDraft sets the draft through the api, clients usually set them over the websocket where they are debounced.
+	SetConversationDraft(ctx context.Context, in *SetConversationDraftReq, opts ...grpc.CallOption) (*SetConversationDraftResp, error)
+	GetConversationDrafts(ctx context.Context, in *GetConversationDraftsReq, opts ...grpc.CallOption) (*GetConversationDraftsResp, error)
+	// GetIncrementalConversationDrafts returns the drafts changed since the drafts version of the client.
+	GetIncrementalConversationDrafts(ctx context.Context, in *GetIncrementalConversationDraftsReq, opts ...grpc.CallOption) (*GetIncrementalConversationDraftsResp, error)
+	// GetUnreadMentions is not versioned, the client replaces its own mentions with them.
+	GetUnreadMentions(ctx context.Context, in *GetUnreadMentionsReq, opts ...grpc.CallOption) (*GetUnreadMentionsResp, error)
+	GetNextMention(ctx context.Context, in *GetNextMentionReq, opts ...grpc.CallOption) (*GetNextMentionResp, error)
+}
+
+type msgExtClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgExtClient(cc grpc.ClientConnInterface) MsgExtClient {
+	return &msgExtClient{cc}
+}
+
+func (c *msgExtClient) SetConversationDraft(ctx context.Context, in *SetConversationDraftReq, opts ...grpc.CallOption) (*SetConversationDraftResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetConversationDraftResp)
+	err := c.cc.Invoke(ctx, MsgExt_SetConversationDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetConversationDrafts(ctx context.Context, in *GetConversationDraftsReq, opts ...grpc.CallOption) (*GetConversationDraftsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConversationDraftsResp)
+	err := c.cc.Invoke(ctx, MsgExt_GetConversationDrafts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetIncrementalConversationDrafts(ctx context.Context, in *GetIncrementalConversationDraftsReq, opts ...grpc.CallOption) (*GetIncrementalConversationDraftsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetIncrementalConversationDraftsResp)
+	err := c.cc.Invoke(ctx, MsgExt_GetIncrementalConversationDrafts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetUnreadMentions(ctx context.Context, in *GetUnreadMentionsReq, opts ...grpc.CallOption) (*GetUnreadMentionsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUnreadMentionsResp)
+	err := c.cc.Invoke(ctx, MsgExt_GetUnreadMentions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgExtClient) GetNextMention(ctx context.Context, in *GetNextMentionReq, opts ...grpc.CallOption) (*GetNextMentionResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNextMentionResp)
+	err := c.cc.Invoke(ctx, MsgExt_GetNextMention_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgExtServer is the server API for MsgExt service.
+// All implementations must embed UnimplementedMsgExtServer
+// for forward compatibility.
+type MsgExtServer interface {
+	// SetConversationDraft sets the draft through the api, clients usually set them over the websocket where they are debounced.
+	SetConversationDraft(context.Context, *SetConversationDraftReq) (*SetConversationDraftResp, error)
+	GetConversationDrafts(context.Context, *GetConversationDraftsReq) (*GetConversationDraftsResp, error)
+	// GetIncrementalConversationDrafts returns the drafts changed since the drafts version of the client.
+	GetIncrementalConversationDrafts(context.Context, *GetIncrementalConversationDraftsReq) (*GetIncrementalConversationDraftsResp, error)
+	// GetUnreadMentions is not versioned, the client replaces its own mentions with them.
+	GetUnreadMentions(context.Context, *GetUnreadMentionsReq) (*GetUnreadMentionsResp, error)
+	GetNextMention(context.Context, *GetNextMentionReq) (*GetNextMentionResp, error)
+	mustEmbedUnimplementedMsgExtServer()
+}
+
+// UnimplementedMsgExtServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMsgExtServer struct{}
+
+func (UnimplementedMsgExtServer) SetConversationDraft(context.Context, *SetConversationDraftReq) (*SetConversationDraftResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConversationDraft not implemented")
+}
+func (UnimplementedMsgExtServer) GetConversationDrafts(context.Context, *GetConversationDraftsReq) (*GetConversationDraftsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConversationDrafts not implemented")
+}
+func (UnimplementedMsgExtServer) GetIncrementalConversationDrafts(context.Context, *GetIncrementalConversationDraftsReq) (*GetIncrementalConversationDraftsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIncrementalConversationDrafts not implemented")
+}
+func (UnimplementedMsgExtServer) GetUnreadMentions(context.Context, *GetUnreadMentionsReq) (*GetUnreadMentionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnreadMentions not implemented")
+}
+func (UnimplementedMsgExtServer) GetNextMention(context.Context, *GetNextMentionReq) (*GetNextMentionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNextMention not implemented")
+}
+func (UnimplementedMsgExtServer) mustEmbedUnimplementedMsgExtServer() {}
+func (UnimplementedMsgExtServer) testEmbeddedByValue()                {}
+
+// UnsafeMsgExtServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgExtServer will
+// result in compilation errors.
+type UnsafeMsgExtServer interface {
+	mustEmbedUnimplementedMsgExtServer()
+}
+
+func RegisterMsgExtServer(s grpc.ServiceRegistrar, srv MsgExtServer) {
+	// If the following call pancis, it indicates UnimplementedMsgExtServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MsgExt_ServiceDesc, srv)
+}
+
+func _MsgExt_SetConversationDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetConversationDraftReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).SetConversationDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_SetConversationDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).SetConversationDraft(ctx, req.(*SetConversationDraftReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetConversationDrafts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConversationDraftsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetConversationDrafts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_GetConversationDrafts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetConversationDrafts(ctx, req.(*GetConversationDraftsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetIncrementalConversationDrafts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIncrementalConversationDraftsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetIncrementalConversationDrafts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_GetIncrementalConversationDrafts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetIncrementalConversationDrafts(ctx, req.(*GetIncrementalConversationDraftsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetUnreadMentions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUnreadMentionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetUnreadMentions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_GetUnreadMentions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetUnreadMentions(ctx, req.(*GetUnreadMentionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgExt_GetNextMention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNextMentionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgExtServer).GetNextMention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgExt_GetNextMention_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgExtServer).GetNextMention(ctx, req.(*GetNextMentionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MsgExt_ServiceDesc is the grpc.ServiceDesc for MsgExt service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MsgExt_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openim.server.msgext.msgExt",
+	HandlerType: (*MsgExtServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetConversationDraft",
+			Handler:    _MsgExt_SetConversationDraft_Handler,
+		},
+		{
+			MethodName: "GetConversationDrafts",
+			Handler:    _MsgExt_GetConversationDrafts_Handler,
+		},
+		{
+			MethodName: "GetIncrementalConversationDrafts",
+			Handler:    _MsgExt_GetIncrementalConversationDrafts_Handler,
+		},
+		{
+			MethodName: "GetUnreadMentions",
+			Handler:    _MsgExt_GetUnreadMentions_Handler,
+		},
+		{
+			MethodName: "GetNextMention",
+			Handler:    _MsgExt_GetNextMention_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "msgext/msgext.proto",
+}
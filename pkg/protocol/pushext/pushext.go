@@ -0,0 +1,26 @@
+package pushext
+
+import (
+	"errors"
+)
+
+func (x *SearchOfflinePushDeadLettersReq) Check() error {
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	return nil
+}
+
+func (x *ReplayOfflinePushDeadLettersReq) Check() error {
+	if len(x.Ids) == 0 {
+		return errors.New("ids is empty")
+	}
+	return nil
+}
+
+func (x *DeleteOfflinePushDeadLettersReq) Check() error {
+	if len(x.Ids) == 0 {
+		return errors.New("ids is empty")
+	}
+	return nil
+}
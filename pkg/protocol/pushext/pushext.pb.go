@@ -0,0 +1,627 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        v5.29.0
+// source: pushext/pushext.proto
+
+package pushext
+
+import (
+	sdkws "github.com/openimsdk/protocol/sdkws"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// OfflinePushDeadLetterInfo is an offline push which failed all its attempts.
+type OfflinePushDeadLetterInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	ConversationID string         `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"`
+	ClientMsgID    string         `protobuf:"bytes,3,opt,name=clientMsgID,proto3" json:"clientMsgID"`
+	UserIDs        []string       `protobuf:"bytes,4,rep,name=userIDs,proto3" json:"userIDs"`
+	Msg            *sdkws.MsgData `protobuf:"bytes,5,opt,name=msg,proto3" json:"msg"`
+	Attempts       int32          `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts"`
+	Providers      []string       `protobuf:"bytes,7,rep,name=providers,proto3" json:"providers"`
+	ErrorClass     string         `protobuf:"bytes,8,opt,name=errorClass,proto3" json:"errorClass"`
+	Error          string         `protobuf:"bytes,9,opt,name=error,proto3" json:"error"`
+	CreateTime     int64          `protobuf:"varint,10,opt,name=createTime,proto3" json:"createTime"`
+}
+
+func (x *OfflinePushDeadLetterInfo) Reset() {
+	*x = OfflinePushDeadLetterInfo{}
+	mi := &file_pushext_pushext_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OfflinePushDeadLetterInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfflinePushDeadLetterInfo) ProtoMessage() {}
+
+func (x *OfflinePushDeadLetterInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pushext_pushext_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfflinePushDeadLetterInfo.ProtoReflect.Descriptor instead.
+func (*OfflinePushDeadLetterInfo) Descriptor() ([]byte, []int) {
+	return file_pushext_pushext_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OfflinePushDeadLetterInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OfflinePushDeadLetterInfo) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *OfflinePushDeadLetterInfo) GetClientMsgID() string {
+	if x != nil {
+		return x.ClientMsgID
+	}
+	return ""
+}
+
+func (x *OfflinePushDeadLetterInfo) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+func (x *OfflinePushDeadLetterInfo) GetMsg() *sdkws.MsgData {
+	if x != nil {
+		return x.Msg
+	}
+	return nil
+}
+
+func (x *OfflinePushDeadLetterInfo) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *OfflinePushDeadLetterInfo) GetProviders() []string {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+func (x *OfflinePushDeadLetterInfo) GetErrorClass() string {
+	if x != nil {
+		return x.ErrorClass
+	}
+	return ""
+}
+
+func (x *OfflinePushDeadLetterInfo) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *OfflinePushDeadLetterInfo) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type SearchOfflinePushDeadLettersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string                   `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	UserID         string                   `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	Provider       string                   `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider"`
+	ErrorClass     string                   `protobuf:"bytes,4,opt,name=errorClass,proto3" json:"errorClass"`
+	StartTime      int64                    `protobuf:"varint,5,opt,name=startTime,proto3" json:"startTime"`
+	EndTime        int64                    `protobuf:"varint,6,opt,name=endTime,proto3" json:"endTime"`
+	Pagination     *sdkws.RequestPagination `protobuf:"bytes,7,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *SearchOfflinePushDeadLettersReq) Reset() {
+	*x = SearchOfflinePushDeadLettersReq{}
+	mi := &file_pushext_pushext_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchOfflinePushDeadLettersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchOfflinePushDeadLettersReq) ProtoMessage() {}
+
+func (x *SearchOfflinePushDeadLettersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_pushext_pushext_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchOfflinePushDeadLettersReq.ProtoReflect.Descriptor instead.
+func (*SearchOfflinePushDeadLettersReq) Descriptor() ([]byte, []int) {
+	return file_pushext_pushext_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SearchOfflinePushDeadLettersReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *SearchOfflinePushDeadLettersReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SearchOfflinePushDeadLettersReq) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *SearchOfflinePushDeadLettersReq) GetErrorClass() string {
+	if x != nil {
+		return x.ErrorClass
+	}
+	return ""
+}
+
+func (x *SearchOfflinePushDeadLettersReq) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *SearchOfflinePushDeadLettersReq) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *SearchOfflinePushDeadLettersReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type SearchOfflinePushDeadLettersResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total       int64                        `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	DeadLetters []*OfflinePushDeadLetterInfo `protobuf:"bytes,2,rep,name=deadLetters,proto3" json:"deadLetters"`
+}
+
+func (x *SearchOfflinePushDeadLettersResp) Reset() {
+	*x = SearchOfflinePushDeadLettersResp{}
+	mi := &file_pushext_pushext_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchOfflinePushDeadLettersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchOfflinePushDeadLettersResp) ProtoMessage() {}
+
+func (x *SearchOfflinePushDeadLettersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_pushext_pushext_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchOfflinePushDeadLettersResp.ProtoReflect.Descriptor instead.
+func (*SearchOfflinePushDeadLettersResp) Descriptor() ([]byte, []int) {
+	return file_pushext_pushext_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SearchOfflinePushDeadLettersResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchOfflinePushDeadLettersResp) GetDeadLetters() []*OfflinePushDeadLetterInfo {
+	if x != nil {
+		return x.DeadLetters
+	}
+	return nil
+}
+
+type ReplayOfflinePushDeadLettersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids"`
+}
+
+func (x *ReplayOfflinePushDeadLettersReq) Reset() {
+	*x = ReplayOfflinePushDeadLettersReq{}
+	mi := &file_pushext_pushext_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayOfflinePushDeadLettersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayOfflinePushDeadLettersReq) ProtoMessage() {}
+
+func (x *ReplayOfflinePushDeadLettersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_pushext_pushext_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayOfflinePushDeadLettersReq.ProtoReflect.Descriptor instead.
+func (*ReplayOfflinePushDeadLettersReq) Descriptor() ([]byte, []int) {
+	return file_pushext_pushext_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReplayOfflinePushDeadLettersReq) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type ReplayOfflinePushDeadLettersResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// replayedIDs are the dead letters queued again and removed from the store.
+	ReplayedIDs []string `protobuf:"bytes,1,rep,name=replayedIDs,proto3" json:"replayedIDs"`
+}
+
+func (x *ReplayOfflinePushDeadLettersResp) Reset() {
+	*x = ReplayOfflinePushDeadLettersResp{}
+	mi := &file_pushext_pushext_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayOfflinePushDeadLettersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayOfflinePushDeadLettersResp) ProtoMessage() {}
+
+func (x *ReplayOfflinePushDeadLettersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_pushext_pushext_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayOfflinePushDeadLettersResp.ProtoReflect.Descriptor instead.
+func (*ReplayOfflinePushDeadLettersResp) Descriptor() ([]byte, []int) {
+	return file_pushext_pushext_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReplayOfflinePushDeadLettersResp) GetReplayedIDs() []string {
+	if x != nil {
+		return x.ReplayedIDs
+	}
+	return nil
+}
+
+type DeleteOfflinePushDeadLettersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids"`
+}
+
+func (x *DeleteOfflinePushDeadLettersReq) Reset() {
+	*x = DeleteOfflinePushDeadLettersReq{}
+	mi := &file_pushext_pushext_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOfflinePushDeadLettersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOfflinePushDeadLettersReq) ProtoMessage() {}
+
+func (x *DeleteOfflinePushDeadLettersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_pushext_pushext_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOfflinePushDeadLettersReq.ProtoReflect.Descriptor instead.
+func (*DeleteOfflinePushDeadLettersReq) Descriptor() ([]byte, []int) {
+	return file_pushext_pushext_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteOfflinePushDeadLettersReq) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type DeleteOfflinePushDeadLettersResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteOfflinePushDeadLettersResp) Reset() {
+	*x = DeleteOfflinePushDeadLettersResp{}
+	mi := &file_pushext_pushext_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOfflinePushDeadLettersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOfflinePushDeadLettersResp) ProtoMessage() {}
+
+func (x *DeleteOfflinePushDeadLettersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_pushext_pushext_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOfflinePushDeadLettersResp.ProtoReflect.Descriptor instead.
+func (*DeleteOfflinePushDeadLettersResp) Descriptor() ([]byte, []int) {
+	return file_pushext_pushext_proto_rawDescGZIP(), []int{6}
+}
+
+var File_pushext_pushext_proto protoreflect.FileDescriptor
+
+var file_pushext_pushext_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x70, 0x75, 0x73, 0x68, 0x65, 0x78, 0x74, 0x2f, 0x70, 0x75, 0x73, 0x68, 0x65, 0x78,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x65, 0x78, 0x74, 0x1a, 0x11,
+	0x73, 0x64, 0x6b, 0x77, 0x73, 0x2f, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xc8, 0x02, 0x0a, 0x19, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x75, 0x73,
+	0x68, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x73, 0x12, 0x27, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e,
+	0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x1a, 0x0a, 0x08,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x96, 0x02, 0x0a,
+	0x1f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x75,
+	0x73, 0x68, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8c, 0x01, 0x0a, 0x20, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x61, 0x64, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x52, 0x0a, 0x0b, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x65, 0x78, 0x74, 0x2e, 0x4f, 0x66,
+	0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x73, 0x22, 0x33, 0x0a, 0x1f, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x4f, 0x66,
+	0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x44, 0x0a, 0x20, 0x52, 0x65, 0x70,
+	0x6c, 0x61, 0x79, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65,
+	0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x20, 0x0a,
+	0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x49, 0x44, 0x73, 0x22,
+	0x33, 0x0a, 0x1f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65,
+	0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x03, 0x69, 0x64, 0x73, 0x22, 0x22, 0x0a, 0x20, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x66,
+	0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x32, 0xbf, 0x03, 0x0a, 0x07, 0x70, 0x75, 0x73,
+	0x68, 0x45, 0x78, 0x74, 0x12, 0x8f, 0x01, 0x0a, 0x1c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4f,
+	0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x36, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x75, 0x73, 0x68, 0x44,
+	0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x37, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x75,
+	0x73, 0x68, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4f, 0x66, 0x66, 0x6c,
+	0x69, 0x6e, 0x65, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x8f, 0x01, 0x0a, 0x1c, 0x52, 0x65, 0x70, 0x6c, 0x61,
+	0x79, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x61, 0x64,
+	0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x36, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x65, 0x78, 0x74, 0x2e,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x75, 0x73,
+	0x68, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x37, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x70, 0x75, 0x73, 0x68, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x4f, 0x66,
+	0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x8f, 0x01, 0x0a, 0x1c, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65,
+	0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x36, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x65, 0x78,
+	0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50,
+	0x75, 0x73, 0x68, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x37, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x65, 0x78, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x61, 0x64, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x42, 0x3d, 0x5a, 0x3b, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x73,
+	0x64, 0x6b, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x2d, 0x69, 0x6d, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x2f, 0x70, 0x75, 0x73, 0x68, 0x65, 0x78, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_pushext_pushext_proto_rawDescOnce sync.Once
+	file_pushext_pushext_proto_rawDescData = file_pushext_pushext_proto_rawDesc
+)
+
+func file_pushext_pushext_proto_rawDescGZIP() []byte {
+	file_pushext_pushext_proto_rawDescOnce.Do(func() {
+		file_pushext_pushext_proto_rawDescData = protoimpl.X.CompressGZIP(file_pushext_pushext_proto_rawDescData)
+	})
+	return file_pushext_pushext_proto_rawDescData
+}
+
+var file_pushext_pushext_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_pushext_pushext_proto_goTypes = []any{
+	(*OfflinePushDeadLetterInfo)(nil),        // 0: openim.server.pushext.OfflinePushDeadLetterInfo
+	(*SearchOfflinePushDeadLettersReq)(nil),  // 1: openim.server.pushext.SearchOfflinePushDeadLettersReq
+	(*SearchOfflinePushDeadLettersResp)(nil), // 2: openim.server.pushext.SearchOfflinePushDeadLettersResp
+	(*ReplayOfflinePushDeadLettersReq)(nil),  // 3: openim.server.pushext.ReplayOfflinePushDeadLettersReq
+	(*ReplayOfflinePushDeadLettersResp)(nil), // 4: openim.server.pushext.ReplayOfflinePushDeadLettersResp
+	(*DeleteOfflinePushDeadLettersReq)(nil),  // 5: openim.server.pushext.DeleteOfflinePushDeadLettersReq
+	(*DeleteOfflinePushDeadLettersResp)(nil), // 6: openim.server.pushext.DeleteOfflinePushDeadLettersResp
+	(*sdkws.MsgData)(nil),                    // 7: openim.sdkws.MsgData
+	(*sdkws.RequestPagination)(nil),          // 8: openim.sdkws.RequestPagination
+}
+var file_pushext_pushext_proto_depIdxs = []int32{
+	7, // 0: openim.server.pushext.OfflinePushDeadLetterInfo.msg:type_name -> openim.sdkws.MsgData
+	8, // 1: openim.server.pushext.SearchOfflinePushDeadLettersReq.pagination:type_name -> openim.sdkws.RequestPagination
+	0, // 2: openim.server.pushext.SearchOfflinePushDeadLettersResp.deadLetters:type_name -> openim.server.pushext.OfflinePushDeadLetterInfo
+	1, // 3: openim.server.pushext.pushExt.SearchOfflinePushDeadLetters:input_type -> openim.server.pushext.SearchOfflinePushDeadLettersReq
+	3, // 4: openim.server.pushext.pushExt.ReplayOfflinePushDeadLetters:input_type -> openim.server.pushext.ReplayOfflinePushDeadLettersReq
+	5, // 5: openim.server.pushext.pushExt.DeleteOfflinePushDeadLetters:input_type -> openim.server.pushext.DeleteOfflinePushDeadLettersReq
+	2, // 6: openim.server.pushext.pushExt.SearchOfflinePushDeadLetters:output_type -> openim.server.pushext.SearchOfflinePushDeadLettersResp
+	4, // 7: openim.server.pushext.pushExt.ReplayOfflinePushDeadLetters:output_type -> openim.server.pushext.ReplayOfflinePushDeadLettersResp
+	6, // 8: openim.server.pushext.pushExt.DeleteOfflinePushDeadLetters:output_type -> openim.server.pushext.DeleteOfflinePushDeadLettersResp
+	6, // [6:9] is the sub-list for method output_type
+	3, // [3:6] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_pushext_pushext_proto_init() }
+func file_pushext_pushext_proto_init() {
+	if File_pushext_pushext_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pushext_pushext_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pushext_pushext_proto_goTypes,
+		DependencyIndexes: file_pushext_pushext_proto_depIdxs,
+		MessageInfos:      file_pushext_pushext_proto_msgTypes,
+	}.Build()
+	File_pushext_pushext_proto = out.File
+	file_pushext_pushext_proto_rawDesc = nil
+	file_pushext_pushext_proto_goTypes = nil
+	file_pushext_pushext_proto_depIdxs = nil
+}
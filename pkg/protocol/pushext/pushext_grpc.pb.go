@@ -0,0 +1,199 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.0
+// source: pushext/pushext.proto
+
+package pushext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PushExt_SearchOfflinePushDeadLetters_FullMethodName = "/openim.server.pushext.pushExt/SearchOfflinePushDeadLetters"
+	PushExt_ReplayOfflinePushDeadLetters_FullMethodName = "/openim.server.pushext.pushExt/ReplayOfflinePushDeadLetters"
+	PushExt_DeleteOfflinePushDeadLetters_FullMethodName = "/openim.server.pushext.pushExt/DeleteOfflinePushDeadLetters"
+)
+
+// PushExtClient is the client API for PushExt service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PushExtClient interface {
+	SearchOfflinePushDeadLetters(ctx context.Context, in *SearchOfflinePushDeadLettersReq, opts ...grpc.CallOption) (*SearchOfflinePushDeadLettersResp, error)
+	// ReplayOfflinePushDeadLetters queues the dead letters on the offline push topic, they are removed once queued.
+	ReplayOfflinePushDeadLetters(ctx context.Context, in *ReplayOfflinePushDeadLettersReq, opts ...grpc.CallOption) (*ReplayOfflinePushDeadLettersResp, error)
+	DeleteOfflinePushDeadLetters(ctx context.Context, in *DeleteOfflinePushDeadLettersReq, opts ...grpc.CallOption) (*DeleteOfflinePushDeadLettersResp, error)
+}
+
+type pushExtClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPushExtClient(cc grpc.ClientConnInterface) PushExtClient {
+	return &pushExtClient{cc}
+}
+
+func (c *pushExtClient) SearchOfflinePushDeadLetters(ctx context.Context, in *SearchOfflinePushDeadLettersReq, opts ...grpc.CallOption) (*SearchOfflinePushDeadLettersResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchOfflinePushDeadLettersResp)
+	err := c.cc.Invoke(ctx, PushExt_SearchOfflinePushDeadLetters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushExtClient) ReplayOfflinePushDeadLetters(ctx context.Context, in *ReplayOfflinePushDeadLettersReq, opts ...grpc.CallOption) (*ReplayOfflinePushDeadLettersResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplayOfflinePushDeadLettersResp)
+	err := c.cc.Invoke(ctx, PushExt_ReplayOfflinePushDeadLetters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushExtClient) DeleteOfflinePushDeadLetters(ctx context.Context, in *DeleteOfflinePushDeadLettersReq, opts ...grpc.CallOption) (*DeleteOfflinePushDeadLettersResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteOfflinePushDeadLettersResp)
+	err := c.cc.Invoke(ctx, PushExt_DeleteOfflinePushDeadLetters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PushExtServer is the server API for PushExt service.
+// All implementations must embed UnimplementedPushExtServer
+// for forward compatibility.
+type PushExtServer interface {
+	SearchOfflinePushDeadLetters(context.Context, *SearchOfflinePushDeadLettersReq) (*SearchOfflinePushDeadLettersResp, error)
+	// ReplayOfflinePushDeadLetters queues the dead letters on the offline push topic, they are removed once queued.
+	ReplayOfflinePushDeadLetters(context.Context, *ReplayOfflinePushDeadLettersReq) (*ReplayOfflinePushDeadLettersResp, error)
+	DeleteOfflinePushDeadLetters(context.Context, *DeleteOfflinePushDeadLettersReq) (*DeleteOfflinePushDeadLettersResp, error)
+	mustEmbedUnimplementedPushExtServer()
+}
+
+// UnimplementedPushExtServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPushExtServer struct{}
+
+func (UnimplementedPushExtServer) SearchOfflinePushDeadLetters(context.Context, *SearchOfflinePushDeadLettersReq) (*SearchOfflinePushDeadLettersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchOfflinePushDeadLetters not implemented")
+}
+func (UnimplementedPushExtServer) ReplayOfflinePushDeadLetters(context.Context, *ReplayOfflinePushDeadLettersReq) (*ReplayOfflinePushDeadLettersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayOfflinePushDeadLetters not implemented")
+}
+func (UnimplementedPushExtServer) DeleteOfflinePushDeadLetters(context.Context, *DeleteOfflinePushDeadLettersReq) (*DeleteOfflinePushDeadLettersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOfflinePushDeadLetters not implemented")
+}
+func (UnimplementedPushExtServer) mustEmbedUnimplementedPushExtServer() {}
+func (UnimplementedPushExtServer) testEmbeddedByValue()                 {}
+
+// UnsafePushExtServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PushExtServer will
+// result in compilation errors.
+type UnsafePushExtServer interface {
+	mustEmbedUnimplementedPushExtServer()
+}
+
+func RegisterPushExtServer(s grpc.ServiceRegistrar, srv PushExtServer) {
+	// If the following call pancis, it indicates UnimplementedPushExtServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PushExt_ServiceDesc, srv)
+}
+
+func _PushExt_SearchOfflinePushDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchOfflinePushDeadLettersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushExtServer).SearchOfflinePushDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushExt_SearchOfflinePushDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushExtServer).SearchOfflinePushDeadLetters(ctx, req.(*SearchOfflinePushDeadLettersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushExt_ReplayOfflinePushDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayOfflinePushDeadLettersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushExtServer).ReplayOfflinePushDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushExt_ReplayOfflinePushDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushExtServer).ReplayOfflinePushDeadLetters(ctx, req.(*ReplayOfflinePushDeadLettersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushExt_DeleteOfflinePushDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOfflinePushDeadLettersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushExtServer).DeleteOfflinePushDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushExt_DeleteOfflinePushDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushExtServer).DeleteOfflinePushDeadLetters(ctx, req.(*DeleteOfflinePushDeadLettersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PushExt_ServiceDesc is the grpc.ServiceDesc for PushExt service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PushExt_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openim.server.pushext.pushExt",
+	HandlerType: (*PushExtServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SearchOfflinePushDeadLetters",
+			Handler:    _PushExt_SearchOfflinePushDeadLetters_Handler,
+		},
+		{
+			MethodName: "ReplayOfflinePushDeadLetters",
+			Handler:    _PushExt_ReplayOfflinePushDeadLetters_Handler,
+		},
+		{
+			MethodName: "DeleteOfflinePushDeadLetters",
+			Handler:    _PushExt_DeleteOfflinePushDeadLetters_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pushext/pushext.proto",
+}
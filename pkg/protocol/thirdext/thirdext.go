@@ -0,0 +1,107 @@
+package thirdext
+
+import (
+	"errors"
+)
+
+func (x *RegisterPushDeviceReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.DeviceID == "" {
+		return errors.New("deviceID is empty")
+	}
+	if x.Provider == "" {
+		return errors.New("provider is empty")
+	}
+	if x.Token == "" {
+		return errors.New("token is empty")
+	}
+	return nil
+}
+
+func (x *RegisterWebPushReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.DeviceID == "" {
+		return errors.New("deviceID is empty")
+	}
+	if x.Subscription == nil || x.Subscription.Endpoint == "" {
+		return errors.New("subscription endpoint is empty")
+	}
+	if x.Subscription.Keys == nil || x.Subscription.Keys.P256Dh == "" || x.Subscription.Keys.Auth == "" {
+		return errors.New("subscription keys are empty")
+	}
+	return nil
+}
+
+func (x *UpdatePushDeviceReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.DeviceID == "" {
+		return errors.New("deviceID is empty")
+	}
+	return nil
+}
+
+func (x *GetPushDevicesReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *UnregisterPushDeviceReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.DeviceID == "" {
+		return errors.New("deviceID is empty")
+	}
+	return nil
+}
+
+func (x *SetPushQuietHoursReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	for _, override := range x.Overrides {
+		if override.Notify == "" {
+			return errors.New("override notify is empty")
+		}
+	}
+	return nil
+}
+
+func (x *GetPushQuietHoursReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *SnoozePushReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *SetPushTemplateReq) Check() error {
+	if x.Locale == "" {
+		return errors.New("locale is empty")
+	}
+	if x.Title == "" {
+		return errors.New("title is empty")
+	}
+	return nil
+}
+
+func (x *DeletePushTemplateReq) Check() error {
+	if x.Locale == "" {
+		return errors.New("locale is empty")
+	}
+	return nil
+}
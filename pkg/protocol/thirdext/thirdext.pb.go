@@ -0,0 +1,1949 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        v5.29.0
+// source: thirdext/thirdext.proto
+
+package thirdext
+
+import (
+	wrapperspb "github.com/openimsdk/protocol/wrapperspb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PushDeviceInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeviceID     string `protobuf:"bytes,1,opt,name=deviceID,proto3" json:"deviceID"`
+	PlatformID   int32  `protobuf:"varint,2,opt,name=platformID,proto3" json:"platformID"`
+	Provider     string `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider"`
+	AppVersion   string `protobuf:"bytes,4,opt,name=appVersion,proto3" json:"appVersion"`
+	Locale       string `protobuf:"bytes,5,opt,name=locale,proto3" json:"locale"`
+	Enabled      bool   `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled"`
+	CreateTime   int64  `protobuf:"varint,7,opt,name=createTime,proto3" json:"createTime"`
+	LastSeenTime int64  `protobuf:"varint,8,opt,name=lastSeenTime,proto3" json:"lastSeenTime"`
+}
+
+func (x *PushDeviceInfo) Reset() {
+	*x = PushDeviceInfo{}
+	mi := &file_thirdext_thirdext_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushDeviceInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushDeviceInfo) ProtoMessage() {}
+
+func (x *PushDeviceInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_thirdext_thirdext_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushDeviceInfo.ProtoReflect.Descriptor instead.
+func (*PushDeviceInfo) Descriptor() ([]byte, []int) {
+	return file_thirdext_thirdext_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PushDeviceInfo) GetDeviceID() string {
+	if x != nil {
+		return x.DeviceID
+	}
+	return ""
+}
+
+func (x *PushDeviceInfo) GetPlatformID() int32 {
+	if x != nil {
+		return x.PlatformID
+	}
+	return 0
+}
+
+func (x *PushDeviceInfo) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *PushDeviceInfo) GetAppVersion() string {
+	if x != nil {
+		return x.AppVersion
+	}
+	return ""
+}
+
+func (x *PushDeviceInfo) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *PushDeviceInfo) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *PushDeviceInfo) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *PushDeviceInfo) GetLastSeenTime() int64 {
+	if x != nil {
+		return x.LastSeenTime
+	}
+	return 0
+}
+
+type RegisterPushDeviceReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	DeviceID   string `protobuf:"bytes,2,opt,name=deviceID,proto3" json:"deviceID"`
+	PlatformID int32  `protobuf:"varint,3,opt,name=platformID,proto3" json:"platformID"`
+	// provider is the push channel the token belongs to, fcm, apns, huawei, honor, xiaomi, oppo or vivo.
+	Provider   string `protobuf:"bytes,4,opt,name=provider,proto3" json:"provider"`
+	Token      string `protobuf:"bytes,5,opt,name=token,proto3" json:"token"`
+	AppVersion string `protobuf:"bytes,6,opt,name=appVersion,proto3" json:"appVersion"`
+	Locale     string `protobuf:"bytes,7,opt,name=locale,proto3" json:"locale"`
+}
+
+func (x *RegisterPushDeviceReq) Reset() {
+	*x = RegisterPushDeviceReq{}
+	mi := &file_thirdext_thirdext_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterPushDeviceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterPushDeviceReq) ProtoMessage() {}
+
+func (x *RegisterPushDeviceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_thirdext_thirdext_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterPushDeviceReq.ProtoReflect.Descriptor instead.
+func (*RegisterPushDeviceReq) Descriptor() ([]byte, []int) {
+	return file_thirdext_thirdext_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RegisterPushDeviceReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RegisterPushDeviceReq) GetDeviceID() string {
+	if x != nil {
+		return x.DeviceID
+	}
+	return ""
+}
+
+func (x *RegisterPushDeviceReq) GetPlatformID() int32 {
+	if x != nil {
+		return x.PlatformID
+	}
+	return 0
+}
+
+func (x *RegisterPushDeviceReq) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *RegisterPushDeviceReq) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RegisterPushDeviceReq) GetAppVersion() string {
+	if x != nil {
+		return x.AppVersion
+	}
+	return ""
+}
+
+func (x *RegisterPushDeviceReq) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type RegisterPushDeviceResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RegisterPushDeviceResp) Reset() {
+	*x = RegisterPushDeviceResp{}
+	mi := &file_thirdext_thirdext_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterPushDeviceResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterPushDeviceResp) ProtoMessage() {}
+
+func (x *RegisterPushDeviceResp) ProtoReflect() protoreflect.Message {
+	mi := &file_thirdext_thirdext_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterPushDeviceResp.ProtoReflect.Descriptor instead.
+func (*RegisterPushDeviceResp) Descriptor() ([]byte, []int) {
+	return file_thirdext_thirdext_proto_rawDescGZIP(), []int{2}
+}
+
+type WebPushKeys struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	P256Dh string `protobuf:"bytes,1,opt,name=p256dh,proto3" json:"p256dh"`
+	Auth   string `protobuf:"bytes,2,opt,name=auth,proto3" json:"auth"`
+}
+
+func (x *WebPushKeys) Reset() {
+	*x = WebPushKeys{}
+	mi := &file_thirdext_thirdext_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebPushKeys) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebPushKeys) ProtoMessage() {}
+
+func (x *WebPushKeys) ProtoReflect() protoreflect.Message {
+	mi := &file_thirdext_thirdext_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebPushKeys.ProtoReflect.Descriptor instead.
+func (*WebPushKeys) Descriptor() ([]byte, []int) {
+	return file_thirdext_thirdext_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WebPushKeys) GetP256Dh() string {
+	if x != nil {
+		return x.P256Dh
+	}
+	return ""
+}
+
+func (x *WebPushKeys) GetAuth() string {
+	if x != nil {
+		return x.Auth
+	}
+	return ""
+}
+
+// WebPushSubscription is the PushSubscription of the browser, as returned by its toJSON method.
+type WebPushSubscription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Endpoint string       `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint"`
+	Keys     *WebPushKeys `protobuf:"bytes,2,opt,name=keys,proto3" json:"keys"`
+}
+
+func (x *WebPushSubscription) Reset() {
+	*x = WebPushSubscription{}
+	mi := &file_thirdext_thirdext_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebPushSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebPushSubscription) ProtoMessage() {}
+
+func (x *WebPushSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_thirdext_thirdext_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebPushSubscription.ProtoReflect.Descriptor instead.
+func (*WebPushSubscription) Descriptor() ([]byte, []int) {
+	return file_thirdext_thirdext_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WebPushSubscription) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *WebPushSubscription) GetKeys() *WebPushKeys {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type RegisterWebPushReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID       string               `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	DeviceID     string               `protobuf:"bytes,2,opt,name=deviceID,proto3" json:"deviceID"`
+	PlatformID   int32                `protobuf:"varint,3,opt,name=platformID,proto3" json:"platformID"`
+	Subscription *WebPushSubscription `protobuf:"bytes,4,opt,name=subscription,proto3" json:"subscription"`
+	AppVersion   string               `protobuf:"bytes,5,opt,name=appVersion,proto3" json:"appVersion"`
+	Locale       string               `protobuf:"bytes,6,opt,name=locale,proto3" json:"locale"`
+}
+
+func (x *RegisterWebPushReq) Reset() {
+	*x = RegisterWebPushReq{}
+	mi := &file_thirdext_thirdext_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterWebPushReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterWebPushReq) ProtoMessage() {}
+
+func (x *RegisterWebPushReq) ProtoReflect() protoreflect.Message {
+	mi := &file_thirdext_thirdext_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterWebPushReq.ProtoReflect.Descriptor instead.
+func (*RegisterWebPushReq) Descriptor() ([]byte, []int) {
+	return file_thirdext_thirdext_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RegisterWebPushReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RegisterWebPushReq) GetDeviceID() string {
+	if x != nil {
+		return x.DeviceID
+	}
+	return ""
+}
+
+func (x *RegisterWebPushReq) GetPlatformID() int32 {
+	if x != nil {
+		return x.PlatformID
+	}
+	return 0
+}
+
+func (x *RegisterWebPushReq) GetSubscription() *WebPushSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+func (x *RegisterWebPushReq) GetAppVersion() string {
+	if x != nil {
+		return x.AppVersion
+	}
+	return ""
+}
+
+func (x *RegisterWebPushReq) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type RegisterWebPushResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RegisterWebPushResp) Reset() {
+	*x = RegisterWebPushResp{}
+	mi := &file_thirdext_thirdext_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterWebPushResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterWebPushResp) ProtoMessage() {}
+
+func (x *RegisterWebPushResp) ProtoReflect() protoreflect.Message {
+	mi := &file_thirdext_thirdext_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterWebPushResp.ProtoReflect.Descriptor instead.
+func (*RegisterWebPushResp) Descriptor() ([]byte, []int) {
+	return file_thirdext_thirdext_proto_rawDescGZIP(), []int{6}
+}
+
+type UpdatePushDeviceReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     string                  `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	DeviceID   string                  `protobuf:"bytes,2,opt,name=deviceID,proto3" json:"deviceID"`
+	AppVersion *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=appVersion,proto3" json:"appVersion"`
+	Locale     *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=locale,proto3" json:"locale"`
+	Enabled    *wrapperspb.BoolValue   `protobuf:"bytes,5,opt,name=enabled,proto3" json:"enabled"`
+}
+
+func (x *UpdatePushDeviceReq) Reset() {
+	*x = UpdatePushDeviceReq{}
+	mi := &file_thirdext_thirdext_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePushDeviceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePushDeviceReq) ProtoMessage() {}
+
+func (x *UpdatePushDeviceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_thirdext_thirdext_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePushDeviceReq.ProtoReflect.Descriptor instead.
+func (*UpdatePushDeviceReq) Descriptor() ([]byte, []int) {
+	return file_thirdext_thirdext_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdatePushDeviceReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *UpdatePushDeviceReq) GetDeviceID() string {
+	if x != nil {
+		return x.DeviceID
+	}
+	return ""
+}
+
+func (x *UpdatePushDeviceReq) GetAppVersion() *wrapperspb.StringValue {
+	if x != nil {
+		return x.AppVersion
+	}
+	return nil
+}
+
+func (x *UpdatePushDeviceReq) GetLocale() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Locale
+	}
+	return nil
+}
+
+func (x *UpdatePushDeviceReq) GetEnabled() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Enabled
+	}
+	return nil
+}
+
+type UpdatePushDeviceResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdatePushDeviceResp) Reset() {
+	*x = UpdatePushDeviceResp{}
+	mi := &file_thirdext_thirdext_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePushDeviceResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePushDeviceResp) ProtoMessage() {}
+
+func (x *UpdatePushDeviceResp) ProtoReflect() protoreflect.Message {
+	mi := &file_thirdext_thirdext_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePushDeviceResp.ProtoReflect.Descriptor instead.
+func (*UpdatePushDeviceResp) Descriptor() ([]byte, []int) {
+	return file_thirdext_thirdext_proto_rawDescGZIP(), []int{8}
+}
+
+type GetPushDevicesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *GetPushDevicesReq) Reset() {
+	*x = GetPushDevicesReq{}
+	mi := &file_thirdext_thirdext_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPushDevicesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPushDevicesReq) ProtoMessage() {}
+
+func (x *GetPushDevicesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_thirdext_thirdext_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPushDevicesReq.ProtoReflect.Descriptor instead.
+func (*GetPushDevicesReq) Descriptor() ([]byte, []int) {
+	return file_thirdext_thirdext_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetPushDevicesReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type GetPushDevicesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Devices []*PushDeviceInfo `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices"`
+}
+
+func (x *GetPushDevicesResp) Reset() {
+	*x = GetPushDevicesResp{}
+	mi := &file_thirdext_thirdext_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPushDevicesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPushDevicesResp) ProtoMessage() {}
+
+func (x *GetPushDevicesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_thirdext_thirdext_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPushDevicesResp.ProtoReflect.Descriptor instead.
+func (*GetPushDevicesResp) Descriptor() ([]byte, []int) {
+	return file_thirdext_thirdext_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetPushDevicesResp) GetDevices() []*PushDeviceInfo {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+type UnregisterPushDeviceReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID   string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	DeviceID string `protobuf:"bytes,2,opt,name=deviceID,proto3" json:"deviceID"`
+}
+
+func (x *UnregisterPushDeviceReq) Reset() {
+	*x = UnregisterPushDeviceReq{}
+	mi := &file_thirdext_thirdext_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnregisterPushDeviceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregisterPushDeviceReq) ProtoMessage() {}
+
+func (x *UnregisterPushDeviceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_thirdext_thirdext_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregisterPushDeviceReq.ProtoReflect.Descriptor instead.
+func (*UnregisterPushDeviceReq) Descriptor() ([]byte, []int) {
+	return file_thirdext_thirdext_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UnregisterPushDeviceReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *UnregisterPushDeviceReq) GetDeviceID() string {
+	if x != nil {
+		return x.DeviceID
+	}
+	return ""
+}
+
+type UnregisterPushDeviceResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnregisterPushDeviceResp) Reset() {
+	*x = UnregisterPushDeviceResp{}
+	mi := &file_thirdext_thirdext_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnregisterPushDeviceResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregisterPushDeviceResp) ProtoMessage() {}
+
+func (x *UnregisterPushDeviceResp) ProtoReflect() protoreflect.Message {
+	mi := &file_thirdext_thirdext_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregisterPushDeviceResp.ProtoReflect.Descriptor instead.
+func (*UnregisterPushDeviceResp) Descriptor() ([]byte, []int) {
+	return file_thirdext_thirdext_proto_rawDescGZIP(), []int{12}
+}
+
+// PushQuietWindow is a weekly window, start and end are minutes since midnight, a window not ending after it starts ends the next day.
+type PushQuietWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// weekdays are the days the window starts on, 0 is Sunday, empty is every day.
+	Weekdays []int32 `protobuf:"varint,1,rep,packed,name=weekdays,proto3" json:"weekdays"`
+	Start    int32   `protobuf:"varint,2,opt,name=start,proto3" json:"start"`
+	End      int32   `protobuf:"varint,3,opt,name=end,proto3" json:"end"`
+}
+
+func (x *PushQuietWindow) Reset() {
+	*x = PushQuietWindow{}
+	mi := &file_thirdext_thirdext_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushQuietWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushQuietWindow) ProtoMessage() {}
+
+func (x *PushQuietWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_thirdext_thirdext_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushQuietWindow.ProtoReflect.Descriptor instead.
+func (*PushQuietWindow) Descriptor() ([]byte, []int) {
+	return file_thirdext_thirdext_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PushQuietWindow) GetWeekdays() []int32 {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+func (x *PushQuietWindow) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *PushQuietWindow) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type PushQuietOverride struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// conversationID is the conversation the override applies to, empty applies to all conversations.
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	// notify is mentions, to be notified when mentioned, or always.
+	Notify string `protobuf:"bytes,2,opt,name=notify,proto3" json:"notify"`
+}
+
+func (x *PushQuietOverride) Reset() {
+	*x = PushQuietOverride{}
+	mi := &file_thirdext_thirdext_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushQuietOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushQuietOverride) ProtoMessage() {}
+
+func (x *PushQuietOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_thirdext_thirdext_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushQuietOverride.ProtoReflect.Descriptor instead.
+func (*PushQuietOverride) Descriptor() ([]byte, []int) {
+	return file_thirdext_thirdext_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PushQuietOverride) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *PushQuietOverride) GetNotify() string {
+	if x != nil {
+		return x.Notify
+	}
+	return ""
+}
+
+type SetPushQuietHoursReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID    string               `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	TimeZone  string               `protobuf:"bytes,2,opt,name=timeZone,proto3" json:"timeZone"`
+	Windows   []*PushQuietWindow   `protobuf:"bytes,3,rep,name=windows,proto3" json:"windows"`
+	Overrides []*PushQuietOverride `protobuf:"bytes,4,rep,name=overrides,proto3" json:"overrides"`
+	// digest pushes a summary of the suppressed pushes when the quiet hours end, instead of dropping them.
+	Digest bool `protobuf:"varint,5,opt,name=digest,proto3" json:"digest"`
+}
+
+func (x *SetPushQuietHoursReq) Reset() {
+	*x = SetPushQuietHoursReq{}
+	mi := &file_thirdext_thirdext_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPushQuietHoursReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPushQuietHoursReq) ProtoMessage() {}
+
+func (x *SetPushQuietHoursReq) ProtoReflect() protoreflect.Message {
+	mi := &file_thirdext_thirdext_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPushQuietHoursReq.ProtoReflect.Descriptor instead.
+func (*SetPushQuietHoursReq) Descriptor() ([]byte, []int) {
+	return file_thirdext_thirdext_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SetPushQuietHoursReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SetPushQuietHoursReq) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *SetPushQuietHoursReq) GetWindows() []*PushQuietWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+func (x *SetPushQuietHoursReq) GetOverrides() []*PushQuietOverride {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+func (x *SetPushQuietHoursReq) GetDigest() bool {
+	if x != nil {
+		return x.Digest
+	}
+	return false
+}
+
+type SetPushQuietHoursResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetPushQuietHoursResp) Reset() {
+	*x = SetPushQuietHoursResp{}
+	mi := &file_thirdext_thirdext_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPushQuietHoursResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPushQuietHoursResp) ProtoMessage() {}
+
+func (x *SetPushQuietHoursResp) ProtoReflect() protoreflect.Message {
+	mi := &file_thirdext_thirdext_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPushQuietHoursResp.ProtoReflect.Descriptor instead.
+func (*SetPushQuietHoursResp) Descriptor() ([]byte, []int) {
+	return file_thirdext_thirdext_proto_rawDescGZIP(), []int{16}
+}
+
+type GetPushQuietHoursReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *GetPushQuietHoursReq) Reset() {
+	*x = GetPushQuietHoursReq{}
+	mi := &file_thirdext_thirdext_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPushQuietHoursReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPushQuietHoursReq) ProtoMessage() {}
+
+func (x *GetPushQuietHoursReq) ProtoReflect() protoreflect.Message {
+	mi := &file_thirdext_thirdext_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPushQuietHoursReq.ProtoReflect.Descriptor instead.
+func (*GetPushQuietHoursReq) Descriptor() ([]byte, []int) {
+	return file_thirdext_thirdext_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetPushQuietHoursReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type GetPushQuietHoursResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TimeZone    string               `protobuf:"bytes,1,opt,name=timeZone,proto3" json:"timeZone"`
+	Windows     []*PushQuietWindow   `protobuf:"bytes,2,rep,name=windows,proto3" json:"windows"`
+	Overrides   []*PushQuietOverride `protobuf:"bytes,3,rep,name=overrides,proto3" json:"overrides"`
+	SnoozeUntil int64                `protobuf:"varint,4,opt,name=snoozeUntil,proto3" json:"snoozeUntil"`
+	Digest      bool                 `protobuf:"varint,5,opt,name=digest,proto3" json:"digest"`
+}
+
+func (x *GetPushQuietHoursResp) Reset() {
+	*x = GetPushQuietHoursResp{}
+	mi := &file_thirdext_thirdext_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPushQuietHoursResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPushQuietHoursResp) ProtoMessage() {}
+
+func (x *GetPushQuietHoursResp) ProtoReflect() protoreflect.Message {
+	mi := &file_thirdext_thirdext_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPushQuietHoursResp.ProtoReflect.Descriptor instead.
+func (*GetPushQuietHoursResp) Descriptor() ([]byte, []int) {
+	return file_thirdext_thirdext_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetPushQuietHoursResp) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *GetPushQuietHoursResp) GetWindows() []*PushQuietWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+func (x *GetPushQuietHoursResp) GetOverrides() []*PushQuietOverride {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+func (x *GetPushQuietHoursResp) GetSnoozeUntil() int64 {
+	if x != nil {
+		return x.SnoozeUntil
+	}
+	return 0
+}
+
+func (x *GetPushQuietHoursResp) GetDigest() bool {
+	if x != nil {
+		return x.Digest
+	}
+	return false
+}
+
+type SnoozePushReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	// snoozeUntil suppresses the offline pushes until the time in milliseconds, 0 ends the snooze.
+	SnoozeUntil int64 `protobuf:"varint,2,opt,name=snoozeUntil,proto3" json:"snoozeUntil"`
+}
+
+func (x *SnoozePushReq) Reset() {
+	*x = SnoozePushReq{}
+	mi := &file_thirdext_thirdext_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnoozePushReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnoozePushReq) ProtoMessage() {}
+
+func (x *SnoozePushReq) ProtoReflect() protoreflect.Message {
+	mi := &file_thirdext_thirdext_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnoozePushReq.ProtoReflect.Descriptor instead.
+func (*SnoozePushReq) Descriptor() ([]byte, []int) {
+	return file_thirdext_thirdext_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SnoozePushReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SnoozePushReq) GetSnoozeUntil() int64 {
+	if x != nil {
+		return x.SnoozeUntil
+	}
+	return 0
+}
+
+type SnoozePushResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SnoozePushResp) Reset() {
+	*x = SnoozePushResp{}
+	mi := &file_thirdext_thirdext_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnoozePushResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnoozePushResp) ProtoMessage() {}
+
+func (x *SnoozePushResp) ProtoReflect() protoreflect.Message {
+	mi := &file_thirdext_thirdext_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnoozePushResp.ProtoReflect.Descriptor instead.
+func (*SnoozePushResp) Descriptor() ([]byte, []int) {
+	return file_thirdext_thirdext_proto_rawDescGZIP(), []int{20}
+}
+
+type PushTemplateInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContentType int32  `protobuf:"varint,1,opt,name=contentType,proto3" json:"contentType"`
+	Locale      string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale"`
+	Title       string `protobuf:"bytes,3,opt,name=title,proto3" json:"title"`
+	Content     string `protobuf:"bytes,4,opt,name=content,proto3" json:"content"`
+	UpdateTime  int64  `protobuf:"varint,5,opt,name=updateTime,proto3" json:"updateTime"`
+}
+
+func (x *PushTemplateInfo) Reset() {
+	*x = PushTemplateInfo{}
+	mi := &file_thirdext_thirdext_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushTemplateInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushTemplateInfo) ProtoMessage() {}
+
+func (x *PushTemplateInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_thirdext_thirdext_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushTemplateInfo.ProtoReflect.Descriptor instead.
+func (*PushTemplateInfo) Descriptor() ([]byte, []int) {
+	return file_thirdext_thirdext_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PushTemplateInfo) GetContentType() int32 {
+	if x != nil {
+		return x.ContentType
+	}
+	return 0
+}
+
+func (x *PushTemplateInfo) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *PushTemplateInfo) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PushTemplateInfo) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *PushTemplateInfo) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+type SetPushTemplateReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// contentType is 0 for the template of all the content types, -1 for the quiet hours digests.
+	ContentType int32  `protobuf:"varint,1,opt,name=contentType,proto3" json:"contentType"`
+	Locale      string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale"`
+	Title       string `protobuf:"bytes,3,opt,name=title,proto3" json:"title"`
+	Content     string `protobuf:"bytes,4,opt,name=content,proto3" json:"content"`
+}
+
+func (x *SetPushTemplateReq) Reset() {
+	*x = SetPushTemplateReq{}
+	mi := &file_thirdext_thirdext_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPushTemplateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPushTemplateReq) ProtoMessage() {}
+
+func (x *SetPushTemplateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_thirdext_thirdext_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPushTemplateReq.ProtoReflect.Descriptor instead.
+func (*SetPushTemplateReq) Descriptor() ([]byte, []int) {
+	return file_thirdext_thirdext_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetPushTemplateReq) GetContentType() int32 {
+	if x != nil {
+		return x.ContentType
+	}
+	return 0
+}
+
+func (x *SetPushTemplateReq) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *SetPushTemplateReq) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SetPushTemplateReq) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type SetPushTemplateResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetPushTemplateResp) Reset() {
+	*x = SetPushTemplateResp{}
+	mi := &file_thirdext_thirdext_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPushTemplateResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPushTemplateResp) ProtoMessage() {}
+
+func (x *SetPushTemplateResp) ProtoReflect() protoreflect.Message {
+	mi := &file_thirdext_thirdext_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPushTemplateResp.ProtoReflect.Descriptor instead.
+func (*SetPushTemplateResp) Descriptor() ([]byte, []int) {
+	return file_thirdext_thirdext_proto_rawDescGZIP(), []int{23}
+}
+
+type GetPushTemplatesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetPushTemplatesReq) Reset() {
+	*x = GetPushTemplatesReq{}
+	mi := &file_thirdext_thirdext_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPushTemplatesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPushTemplatesReq) ProtoMessage() {}
+
+func (x *GetPushTemplatesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_thirdext_thirdext_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPushTemplatesReq.ProtoReflect.Descriptor instead.
+func (*GetPushTemplatesReq) Descriptor() ([]byte, []int) {
+	return file_thirdext_thirdext_proto_rawDescGZIP(), []int{24}
+}
+
+type GetPushTemplatesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Templates []*PushTemplateInfo `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates"`
+}
+
+func (x *GetPushTemplatesResp) Reset() {
+	*x = GetPushTemplatesResp{}
+	mi := &file_thirdext_thirdext_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPushTemplatesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPushTemplatesResp) ProtoMessage() {}
+
+func (x *GetPushTemplatesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_thirdext_thirdext_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPushTemplatesResp.ProtoReflect.Descriptor instead.
+func (*GetPushTemplatesResp) Descriptor() ([]byte, []int) {
+	return file_thirdext_thirdext_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetPushTemplatesResp) GetTemplates() []*PushTemplateInfo {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type DeletePushTemplateReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContentType int32  `protobuf:"varint,1,opt,name=contentType,proto3" json:"contentType"`
+	Locale      string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale"`
+}
+
+func (x *DeletePushTemplateReq) Reset() {
+	*x = DeletePushTemplateReq{}
+	mi := &file_thirdext_thirdext_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePushTemplateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePushTemplateReq) ProtoMessage() {}
+
+func (x *DeletePushTemplateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_thirdext_thirdext_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePushTemplateReq.ProtoReflect.Descriptor instead.
+func (*DeletePushTemplateReq) Descriptor() ([]byte, []int) {
+	return file_thirdext_thirdext_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeletePushTemplateReq) GetContentType() int32 {
+	if x != nil {
+		return x.ContentType
+	}
+	return 0
+}
+
+func (x *DeletePushTemplateReq) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type DeletePushTemplateResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeletePushTemplateResp) Reset() {
+	*x = DeletePushTemplateResp{}
+	mi := &file_thirdext_thirdext_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePushTemplateResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePushTemplateResp) ProtoMessage() {}
+
+func (x *DeletePushTemplateResp) ProtoReflect() protoreflect.Message {
+	mi := &file_thirdext_thirdext_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePushTemplateResp.ProtoReflect.Descriptor instead.
+func (*DeletePushTemplateResp) Descriptor() ([]byte, []int) {
+	return file_thirdext_thirdext_proto_rawDescGZIP(), []int{27}
+}
+
+var File_thirdext_thirdext_proto protoreflect.FileDescriptor
+
+var file_thirdext_thirdext_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x74, 0x68, 0x69, 0x72, 0x64, 0x65, 0x78, 0x74, 0x2f, 0x74, 0x68, 0x69, 0x72, 0x64,
+	0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x74, 0x68, 0x69, 0x72, 0x64, 0x65, 0x78,
+	0x74, 0x1a, 0x1b, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x70, 0x62, 0x2f, 0x77, 0x72,
+	0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfe,
+	0x01, 0x0a, 0x0e, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x12, 0x1e, 0x0a,
+	0x0a, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x44, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x70,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61,
+	0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6c,
+	0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0xd5, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x75, 0x73, 0x68,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x12, 0x1e, 0x0a,
+	0x0a, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x44, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x39, 0x0a, 0x0b, 0x57, 0x65, 0x62, 0x50, 0x75, 0x73, 0x68, 0x4b, 0x65, 0x79, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x32, 0x35, 0x36, 0x64, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x32, 0x35, 0x36, 0x64, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x22, 0x6a, 0x0a, 0x13,
+	0x57, 0x65, 0x62, 0x50, 0x75, 0x73, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12,
+	0x37, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x74, 0x68,
+	0x69, 0x72, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x57, 0x65, 0x62, 0x50, 0x75, 0x73, 0x68, 0x4b, 0x65,
+	0x79, 0x73, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0xf1, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x65, 0x62, 0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
+	0x6d, 0x49, 0x44, 0x12, 0x4f, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x74, 0x68, 0x69, 0x72, 0x64, 0x65,
+	0x78, 0x74, 0x2e, 0x57, 0x65, 0x62, 0x50, 0x75, 0x73, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x15, 0x0a, 0x13,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x65, 0x62, 0x50, 0x75, 0x73, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0xf3, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x75,
+	0x73, 0x68, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x12,
+	0x3c, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a,
+	0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x2b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x56,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x40, 0x0a, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x74, 0x68, 0x69, 0x72, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x50,
+	0x75, 0x73, 0x68, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x4d, 0x0a, 0x17, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x44, 0x22, 0x1a, 0x0a, 0x18, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x55, 0x0a, 0x0f, 0x50, 0x75, 0x73, 0x68, 0x51, 0x75, 0x69, 0x65, 0x74, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x08, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x53, 0x0a, 0x11, 0x50, 0x75, 0x73, 0x68,
+	0x51, 0x75, 0x69, 0x65, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x26, 0x0a,
+	0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x22, 0xee, 0x01,
+	0x0a, 0x14, 0x53, 0x65, 0x74, 0x50, 0x75, 0x73, 0x68, 0x51, 0x75, 0x69, 0x65, 0x74, 0x48, 0x6f,
+	0x75, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a,
+	0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x41, 0x0a, 0x07, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x74, 0x68, 0x69, 0x72,
+	0x64, 0x65, 0x78, 0x74, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x51, 0x75, 0x69, 0x65, 0x74, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x47, 0x0a,
+	0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x74, 0x68, 0x69, 0x72, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x51, 0x75,
+	0x69, 0x65, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x09, 0x6f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x22, 0x17,
+	0x0a, 0x15, 0x53, 0x65, 0x74, 0x50, 0x75, 0x73, 0x68, 0x51, 0x75, 0x69, 0x65, 0x74, 0x48, 0x6f,
+	0x75, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2e, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x75,
+	0x73, 0x68, 0x51, 0x75, 0x69, 0x65, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0xf9, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50,
+	0x75, 0x73, 0x68, 0x51, 0x75, 0x69, 0x65, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x41, 0x0a,
+	0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x74,
+	0x68, 0x69, 0x72, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x51, 0x75, 0x69, 0x65,
+	0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73,
+	0x12, 0x47, 0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x74, 0x68, 0x69, 0x72, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x50, 0x75, 0x73,
+	0x68, 0x51, 0x75, 0x69, 0x65, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x09,
+	0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x6e, 0x6f,
+	0x6f, 0x7a, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x73, 0x6e, 0x6f, 0x6f, 0x7a, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x64,
+	0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x69, 0x67,
+	0x65, 0x73, 0x74, 0x22, 0x49, 0x0a, 0x0d, 0x53, 0x6e, 0x6f, 0x6f, 0x7a, 0x65, 0x50, 0x75, 0x73,
+	0x68, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b,
+	0x73, 0x6e, 0x6f, 0x6f, 0x7a, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x73, 0x6e, 0x6f, 0x6f, 0x7a, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x10,
+	0x0a, 0x0e, 0x53, 0x6e, 0x6f, 0x6f, 0x7a, 0x65, 0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x9c, 0x01, 0x0a, 0x10, 0x50, 0x75, 0x73, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0x7e, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x50, 0x75, 0x73, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22,
+	0x15, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x50, 0x75, 0x73, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x75, 0x73,
+	0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x22, 0x5e, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x50, 0x75, 0x73, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x46, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x74, 0x68, 0x69, 0x72, 0x64, 0x65, 0x78,
+	0x74, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x22, 0x51, 0x0a,
+	0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x75, 0x73, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65,
+	0x22, 0x18, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x75, 0x73, 0x68, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x32, 0xcf, 0x09, 0x0a, 0x08, 0x74,
+	0x68, 0x69, 0x72, 0x64, 0x45, 0x78, 0x74, 0x12, 0x73, 0x0a, 0x12, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2d, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x74, 0x68,
+	0x69, 0x72, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50,
+	0x75, 0x73, 0x68, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x2e, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x74, 0x68, 0x69,
+	0x72, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x75,
+	0x73, 0x68, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6a, 0x0a, 0x0f,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x65, 0x62, 0x50, 0x75, 0x73, 0x68, 0x12,
+	0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x74, 0x68, 0x69, 0x72, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x57, 0x65, 0x62, 0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x74, 0x68, 0x69, 0x72,
+	0x64, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x65, 0x62,
+	0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6d, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2b, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x74, 0x68, 0x69,
+	0x72, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x75, 0x73, 0x68,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x2c, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x74, 0x68, 0x69, 0x72, 0x64, 0x65,
+	0x78, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x67, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x75,
+	0x73, 0x68, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x74, 0x68, 0x69, 0x72, 0x64, 0x65,
+	0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x74, 0x68, 0x69, 0x72, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x79, 0x0a, 0x14, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x75,
+	0x73, 0x68, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x74, 0x68, 0x69, 0x72, 0x64, 0x65, 0x78,
+	0x74, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x75, 0x73, 0x68,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x30, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x74, 0x68, 0x69, 0x72, 0x64, 0x65,
+	0x78, 0x74, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x75, 0x73,
+	0x68, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x70, 0x0a, 0x11, 0x53,
+	0x65, 0x74, 0x50, 0x75, 0x73, 0x68, 0x51, 0x75, 0x69, 0x65, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x73,
+	0x12, 0x2c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x74, 0x68, 0x69, 0x72, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x75, 0x73,
+	0x68, 0x51, 0x75, 0x69, 0x65, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2d,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x74,
+	0x68, 0x69, 0x72, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x75, 0x73, 0x68, 0x51,
+	0x75, 0x69, 0x65, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x70, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x50, 0x75, 0x73, 0x68, 0x51, 0x75, 0x69, 0x65, 0x74, 0x48, 0x6f, 0x75,
+	0x72, 0x73, 0x12, 0x2c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x74, 0x68, 0x69, 0x72, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x75, 0x73, 0x68, 0x51, 0x75, 0x69, 0x65, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x1a, 0x2d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x74, 0x68, 0x69, 0x72, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x73,
+	0x68, 0x51, 0x75, 0x69, 0x65, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x5b, 0x0a, 0x0a, 0x53, 0x6e, 0x6f, 0x6f, 0x7a, 0x65, 0x50, 0x75, 0x73, 0x68, 0x12, 0x25, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x74, 0x68,
+	0x69, 0x72, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x6e, 0x6f, 0x6f, 0x7a, 0x65, 0x50, 0x75, 0x73,
+	0x68, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x74, 0x68, 0x69, 0x72, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x6e,
+	0x6f, 0x6f, 0x7a, 0x65, 0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6a, 0x0a, 0x0f,
+	0x53, 0x65, 0x74, 0x50, 0x75, 0x73, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12,
+	0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x74, 0x68, 0x69, 0x72, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x75, 0x73, 0x68,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x74, 0x68, 0x69, 0x72,
+	0x64, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x75, 0x73, 0x68, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6d, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50,
+	0x75, 0x73, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x74, 0x68, 0x69,
+	0x72, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x73, 0x68, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2c, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x74, 0x68, 0x69, 0x72, 0x64, 0x65,
+	0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x73, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x73, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x50, 0x75, 0x73, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x74, 0x68,
+	0x69, 0x72, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x75, 0x73,
+	0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x2e, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x74, 0x68, 0x69,
+	0x72, 0x64, 0x65, 0x78, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x75, 0x73, 0x68,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x42, 0x3e, 0x5a, 0x3c,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x73, 0x64, 0x6b, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x2d, 0x69, 0x6d, 0x2d, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2f, 0x74, 0x68, 0x69, 0x72, 0x64, 0x65, 0x78, 0x74, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_thirdext_thirdext_proto_rawDescOnce sync.Once
+	file_thirdext_thirdext_proto_rawDescData = file_thirdext_thirdext_proto_rawDesc
+)
+
+func file_thirdext_thirdext_proto_rawDescGZIP() []byte {
+	file_thirdext_thirdext_proto_rawDescOnce.Do(func() {
+		file_thirdext_thirdext_proto_rawDescData = protoimpl.X.CompressGZIP(file_thirdext_thirdext_proto_rawDescData)
+	})
+	return file_thirdext_thirdext_proto_rawDescData
+}
+
+var file_thirdext_thirdext_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_thirdext_thirdext_proto_goTypes = []any{
+	(*PushDeviceInfo)(nil),           // 0: openim.server.thirdext.PushDeviceInfo
+	(*RegisterPushDeviceReq)(nil),    // 1: openim.server.thirdext.RegisterPushDeviceReq
+	(*RegisterPushDeviceResp)(nil),   // 2: openim.server.thirdext.RegisterPushDeviceResp
+	(*WebPushKeys)(nil),              // 3: openim.server.thirdext.WebPushKeys
+	(*WebPushSubscription)(nil),      // 4: openim.server.thirdext.WebPushSubscription
+	(*RegisterWebPushReq)(nil),       // 5: openim.server.thirdext.RegisterWebPushReq
+	(*RegisterWebPushResp)(nil),      // 6: openim.server.thirdext.RegisterWebPushResp
+	(*UpdatePushDeviceReq)(nil),      // 7: openim.server.thirdext.UpdatePushDeviceReq
+	(*UpdatePushDeviceResp)(nil),     // 8: openim.server.thirdext.UpdatePushDeviceResp
+	(*GetPushDevicesReq)(nil),        // 9: openim.server.thirdext.GetPushDevicesReq
+	(*GetPushDevicesResp)(nil),       // 10: openim.server.thirdext.GetPushDevicesResp
+	(*UnregisterPushDeviceReq)(nil),  // 11: openim.server.thirdext.UnregisterPushDeviceReq
+	(*UnregisterPushDeviceResp)(nil), // 12: openim.server.thirdext.UnregisterPushDeviceResp
+	(*PushQuietWindow)(nil),          // 13: openim.server.thirdext.PushQuietWindow
+	(*PushQuietOverride)(nil),        // 14: openim.server.thirdext.PushQuietOverride
+	(*SetPushQuietHoursReq)(nil),     // 15: openim.server.thirdext.SetPushQuietHoursReq
+	(*SetPushQuietHoursResp)(nil),    // 16: openim.server.thirdext.SetPushQuietHoursResp
+	(*GetPushQuietHoursReq)(nil),     // 17: openim.server.thirdext.GetPushQuietHoursReq
+	(*GetPushQuietHoursResp)(nil),    // 18: openim.server.thirdext.GetPushQuietHoursResp
+	(*SnoozePushReq)(nil),            // 19: openim.server.thirdext.SnoozePushReq
+	(*SnoozePushResp)(nil),           // 20: openim.server.thirdext.SnoozePushResp
+	(*PushTemplateInfo)(nil),         // 21: openim.server.thirdext.PushTemplateInfo
+	(*SetPushTemplateReq)(nil),       // 22: openim.server.thirdext.SetPushTemplateReq
+	(*SetPushTemplateResp)(nil),      // 23: openim.server.thirdext.SetPushTemplateResp
+	(*GetPushTemplatesReq)(nil),      // 24: openim.server.thirdext.GetPushTemplatesReq
+	(*GetPushTemplatesResp)(nil),     // 25: openim.server.thirdext.GetPushTemplatesResp
+	(*DeletePushTemplateReq)(nil),    // 26: openim.server.thirdext.DeletePushTemplateReq
+	(*DeletePushTemplateResp)(nil),   // 27: openim.server.thirdext.DeletePushTemplateResp
+	(*wrapperspb.StringValue)(nil),   // 28: openim.protobuf.StringValue
+	(*wrapperspb.BoolValue)(nil),     // 29: openim.protobuf.BoolValue
+}
+var file_thirdext_thirdext_proto_depIdxs = []int32{
+	3,  // 0: openim.server.thirdext.WebPushSubscription.keys:type_name -> openim.server.thirdext.WebPushKeys
+	4,  // 1: openim.server.thirdext.RegisterWebPushReq.subscription:type_name -> openim.server.thirdext.WebPushSubscription
+	28, // 2: openim.server.thirdext.UpdatePushDeviceReq.appVersion:type_name -> openim.protobuf.StringValue
+	28, // 3: openim.server.thirdext.UpdatePushDeviceReq.locale:type_name -> openim.protobuf.StringValue
+	29, // 4: openim.server.thirdext.UpdatePushDeviceReq.enabled:type_name -> openim.protobuf.BoolValue
+	0,  // 5: openim.server.thirdext.GetPushDevicesResp.devices:type_name -> openim.server.thirdext.PushDeviceInfo
+	13, // 6: openim.server.thirdext.SetPushQuietHoursReq.windows:type_name -> openim.server.thirdext.PushQuietWindow
+	14, // 7: openim.server.thirdext.SetPushQuietHoursReq.overrides:type_name -> openim.server.thirdext.PushQuietOverride
+	13, // 8: openim.server.thirdext.GetPushQuietHoursResp.windows:type_name -> openim.server.thirdext.PushQuietWindow
+	14, // 9: openim.server.thirdext.GetPushQuietHoursResp.overrides:type_name -> openim.server.thirdext.PushQuietOverride
+	21, // 10: openim.server.thirdext.GetPushTemplatesResp.templates:type_name -> openim.server.thirdext.PushTemplateInfo
+	1,  // 11: openim.server.thirdext.thirdExt.RegisterPushDevice:input_type -> openim.server.thirdext.RegisterPushDeviceReq
+	5,  // 12: openim.server.thirdext.thirdExt.RegisterWebPush:input_type -> openim.server.thirdext.RegisterWebPushReq
+	7,  // 13: openim.server.thirdext.thirdExt.UpdatePushDevice:input_type -> openim.server.thirdext.UpdatePushDeviceReq
+	9,  // 14: openim.server.thirdext.thirdExt.GetPushDevices:input_type -> openim.server.thirdext.GetPushDevicesReq
+	11, // 15: openim.server.thirdext.thirdExt.UnregisterPushDevice:input_type -> openim.server.thirdext.UnregisterPushDeviceReq
+	15, // 16: openim.server.thirdext.thirdExt.SetPushQuietHours:input_type -> openim.server.thirdext.SetPushQuietHoursReq
+	17, // 17: openim.server.thirdext.thirdExt.GetPushQuietHours:input_type -> openim.server.thirdext.GetPushQuietHoursReq
+	19, // 18: openim.server.thirdext.thirdExt.SnoozePush:input_type -> openim.server.thirdext.SnoozePushReq
+	22, // 19: openim.server.thirdext.thirdExt.SetPushTemplate:input_type -> openim.server.thirdext.SetPushTemplateReq
+	24, // 20: openim.server.thirdext.thirdExt.GetPushTemplates:input_type -> openim.server.thirdext.GetPushTemplatesReq
+	26, // 21: openim.server.thirdext.thirdExt.DeletePushTemplate:input_type -> openim.server.thirdext.DeletePushTemplateReq
+	2,  // 22: openim.server.thirdext.thirdExt.RegisterPushDevice:output_type -> openim.server.thirdext.RegisterPushDeviceResp
+	6,  // 23: openim.server.thirdext.thirdExt.RegisterWebPush:output_type -> openim.server.thirdext.RegisterWebPushResp
+	8,  // 24: openim.server.thirdext.thirdExt.UpdatePushDevice:output_type -> openim.server.thirdext.UpdatePushDeviceResp
+	10, // 25: openim.server.thirdext.thirdExt.GetPushDevices:output_type -> openim.server.thirdext.GetPushDevicesResp
+	12, // 26: openim.server.thirdext.thirdExt.UnregisterPushDevice:output_type -> openim.server.thirdext.UnregisterPushDeviceResp
+	16, // 27: openim.server.thirdext.thirdExt.SetPushQuietHours:output_type -> openim.server.thirdext.SetPushQuietHoursResp
+	18, // 28: openim.server.thirdext.thirdExt.GetPushQuietHours:output_type -> openim.server.thirdext.GetPushQuietHoursResp
+	20, // 29: openim.server.thirdext.thirdExt.SnoozePush:output_type -> openim.server.thirdext.SnoozePushResp
+	23, // 30: openim.server.thirdext.thirdExt.SetPushTemplate:output_type -> openim.server.thirdext.SetPushTemplateResp
+	25, // 31: openim.server.thirdext.thirdExt.GetPushTemplates:output_type -> openim.server.thirdext.GetPushTemplatesResp
+	27, // 32: openim.server.thirdext.thirdExt.DeletePushTemplate:output_type -> openim.server.thirdext.DeletePushTemplateResp
+	22, // [22:33] is the sub-list for method output_type
+	11, // [11:22] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_thirdext_thirdext_proto_init() }
+func file_thirdext_thirdext_proto_init() {
+	if File_thirdext_thirdext_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_thirdext_thirdext_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   28,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_thirdext_thirdext_proto_goTypes,
+		DependencyIndexes: file_thirdext_thirdext_proto_depIdxs,
+		MessageInfos:      file_thirdext_thirdext_proto_msgTypes,
+	}.Build()
+	File_thirdext_thirdext_proto = out.File
+	file_thirdext_thirdext_proto_rawDesc = nil
+	file_thirdext_thirdext_proto_goTypes = nil
+	file_thirdext_thirdext_proto_depIdxs = nil
+}
@@ -0,0 +1,509 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.0
+// source: thirdext/thirdext.proto
+
+package thirdext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ThirdExt_RegisterPushDevice_FullMethodName   = "/openim.server.thirdext.thirdExt/RegisterPushDevice"
+	ThirdExt_RegisterWebPush_FullMethodName      = "/openim.server.thirdext.thirdExt/RegisterWebPush"
+	ThirdExt_UpdatePushDevice_FullMethodName     = "/openim.server.thirdext.thirdExt/UpdatePushDevice"
+	ThirdExt_GetPushDevices_FullMethodName       = "/openim.server.thirdext.thirdExt/GetPushDevices"
+	ThirdExt_UnregisterPushDevice_FullMethodName = "/openim.server.thirdext.thirdExt/UnregisterPushDevice"
+	ThirdExt_SetPushQuietHours_FullMethodName    = "/openim.server.thirdext.thirdExt/SetPushQuietHours"
+	ThirdExt_GetPushQuietHours_FullMethodName    = "/openim.server.thirdext.thirdExt/GetPushQuietHours"
+	ThirdExt_SnoozePush_FullMethodName           = "/openim.server.thirdext.thirdExt/SnoozePush"
+	ThirdExt_SetPushTemplate_FullMethodName      = "/openim.server.thirdext.thirdExt/SetPushTemplate"
+	ThirdExt_GetPushTemplates_FullMethodName     = "/openim.server.thirdext.thirdExt/GetPushTemplates"
+	ThirdExt_DeletePushTemplate_FullMethodName   = "/openim.server.thirdext.thirdExt/DeletePushTemplate"
+)
+
+// ThirdExtClient is the client API for ThirdExt service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ThirdExtClient interface {
+	RegisterPushDevice(ctx context.Context, in *RegisterPushDeviceReq, opts ...grpc.CallOption) (*RegisterPushDeviceResp, error)
+	// RegisterWebPush registers the push subscription of a browser, the device pushed to by the webpush pusher.
+	RegisterWebPush(ctx context.Context, in *RegisterWebPushReq, opts ...grpc.CallOption) (*RegisterWebPushResp, error)
+	// UpdatePushDevice updates a registered device, a new token is registered again.
+	UpdatePushDevice(ctx context.Context, in *UpdatePushDeviceReq, opts ...grpc.CallOption) (*UpdatePushDeviceResp, error)
+	GetPushDevices(ctx context.Context, in *GetPushDevicesReq, opts ...grpc.CallOption) (*GetPushDevicesResp, error)
+	UnregisterPushDevice(ctx context.Context, in *UnregisterPushDeviceReq, opts ...grpc.CallOption) (*UnregisterPushDeviceResp, error)
+	// SetPushQuietHours sets the do-not-disturb schedule of the user, checked by the push service before each offline push.
+	SetPushQuietHours(ctx context.Context, in *SetPushQuietHoursReq, opts ...grpc.CallOption) (*SetPushQuietHoursResp, error)
+	GetPushQuietHours(ctx context.Context, in *GetPushQuietHoursReq, opts ...grpc.CallOption) (*GetPushQuietHoursResp, error)
+	SnoozePush(ctx context.Context, in *SnoozePushReq, opts ...grpc.CallOption) (*SnoozePushResp, error)
+	// SetPushTemplate sets the template of the offline pushes of a content type in a locale, used by the push service within a minute.
+	SetPushTemplate(ctx context.Context, in *SetPushTemplateReq, opts ...grpc.CallOption) (*SetPushTemplateResp, error)
+	GetPushTemplates(ctx context.Context, in *GetPushTemplatesReq, opts ...grpc.CallOption) (*GetPushTemplatesResp, error)
+	DeletePushTemplate(ctx context.Context, in *DeletePushTemplateReq, opts ...grpc.CallOption) (*DeletePushTemplateResp, error)
+}
+
+type thirdExtClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewThirdExtClient(cc grpc.ClientConnInterface) ThirdExtClient {
+	return &thirdExtClient{cc}
+}
+
+func (c *thirdExtClient) RegisterPushDevice(ctx context.Context, in *RegisterPushDeviceReq, opts ...grpc.CallOption) (*RegisterPushDeviceResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterPushDeviceResp)
+	err := c.cc.Invoke(ctx, ThirdExt_RegisterPushDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thirdExtClient) RegisterWebPush(ctx context.Context, in *RegisterWebPushReq, opts ...grpc.CallOption) (*RegisterWebPushResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterWebPushResp)
+	err := c.cc.Invoke(ctx, ThirdExt_RegisterWebPush_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thirdExtClient) UpdatePushDevice(ctx context.Context, in *UpdatePushDeviceReq, opts ...grpc.CallOption) (*UpdatePushDeviceResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePushDeviceResp)
+	err := c.cc.Invoke(ctx, ThirdExt_UpdatePushDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thirdExtClient) GetPushDevices(ctx context.Context, in *GetPushDevicesReq, opts ...grpc.CallOption) (*GetPushDevicesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPushDevicesResp)
+	err := c.cc.Invoke(ctx, ThirdExt_GetPushDevices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thirdExtClient) UnregisterPushDevice(ctx context.Context, in *UnregisterPushDeviceReq, opts ...grpc.CallOption) (*UnregisterPushDeviceResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnregisterPushDeviceResp)
+	err := c.cc.Invoke(ctx, ThirdExt_UnregisterPushDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thirdExtClient) SetPushQuietHours(ctx context.Context, in *SetPushQuietHoursReq, opts ...grpc.CallOption) (*SetPushQuietHoursResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPushQuietHoursResp)
+	err := c.cc.Invoke(ctx, ThirdExt_SetPushQuietHours_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thirdExtClient) GetPushQuietHours(ctx context.Context, in *GetPushQuietHoursReq, opts ...grpc.CallOption) (*GetPushQuietHoursResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPushQuietHoursResp)
+	err := c.cc.Invoke(ctx, ThirdExt_GetPushQuietHours_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thirdExtClient) SnoozePush(ctx context.Context, in *SnoozePushReq, opts ...grpc.CallOption) (*SnoozePushResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SnoozePushResp)
+	err := c.cc.Invoke(ctx, ThirdExt_SnoozePush_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thirdExtClient) SetPushTemplate(ctx context.Context, in *SetPushTemplateReq, opts ...grpc.CallOption) (*SetPushTemplateResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPushTemplateResp)
+	err := c.cc.Invoke(ctx, ThirdExt_SetPushTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thirdExtClient) GetPushTemplates(ctx context.Context, in *GetPushTemplatesReq, opts ...grpc.CallOption) (*GetPushTemplatesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPushTemplatesResp)
+	err := c.cc.Invoke(ctx, ThirdExt_GetPushTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thirdExtClient) DeletePushTemplate(ctx context.Context, in *DeletePushTemplateReq, opts ...grpc.CallOption) (*DeletePushTemplateResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePushTemplateResp)
+	err := c.cc.Invoke(ctx, ThirdExt_DeletePushTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ThirdExtServer is the server API for ThirdExt service.
+// All implementations must embed UnimplementedThirdExtServer
+// for forward compatibility.
+type ThirdExtServer interface {
+	RegisterPushDevice(context.Context, *RegisterPushDeviceReq) (*RegisterPushDeviceResp, error)
+	// RegisterWebPush registers the push subscription of a browser, the device pushed to by the webpush pusher.
+	RegisterWebPush(context.Context, *RegisterWebPushReq) (*RegisterWebPushResp, error)
+	// UpdatePushDevice updates a registered device, a new token is registered again.
+	UpdatePushDevice(context.Context, *UpdatePushDeviceReq) (*UpdatePushDeviceResp, error)
+	GetPushDevices(context.Context, *GetPushDevicesReq) (*GetPushDevicesResp, error)
+	UnregisterPushDevice(context.Context, *UnregisterPushDeviceReq) (*UnregisterPushDeviceResp, error)
+	// SetPushQuietHours sets the do-not-disturb schedule of the user, checked by the push service before each offline push.
+	SetPushQuietHours(context.Context, *SetPushQuietHoursReq) (*SetPushQuietHoursResp, error)
+	GetPushQuietHours(context.Context, *GetPushQuietHoursReq) (*GetPushQuietHoursResp, error)
+	SnoozePush(context.Context, *SnoozePushReq) (*SnoozePushResp, error)
+	// SetPushTemplate sets the template of the offline pushes of a content type in a locale, used by the push service within a minute.
+	SetPushTemplate(context.Context, *SetPushTemplateReq) (*SetPushTemplateResp, error)
+	GetPushTemplates(context.Context, *GetPushTemplatesReq) (*GetPushTemplatesResp, error)
+	DeletePushTemplate(context.Context, *DeletePushTemplateReq) (*DeletePushTemplateResp, error)
+	mustEmbedUnimplementedThirdExtServer()
+}
+
+// UnimplementedThirdExtServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedThirdExtServer struct{}
+
+func (UnimplementedThirdExtServer) RegisterPushDevice(context.Context, *RegisterPushDeviceReq) (*RegisterPushDeviceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterPushDevice not implemented")
+}
+func (UnimplementedThirdExtServer) RegisterWebPush(context.Context, *RegisterWebPushReq) (*RegisterWebPushResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterWebPush not implemented")
+}
+func (UnimplementedThirdExtServer) UpdatePushDevice(context.Context, *UpdatePushDeviceReq) (*UpdatePushDeviceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePushDevice not implemented")
+}
+func (UnimplementedThirdExtServer) GetPushDevices(context.Context, *GetPushDevicesReq) (*GetPushDevicesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPushDevices not implemented")
+}
+func (UnimplementedThirdExtServer) UnregisterPushDevice(context.Context, *UnregisterPushDeviceReq) (*UnregisterPushDeviceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterPushDevice not implemented")
+}
+func (UnimplementedThirdExtServer) SetPushQuietHours(context.Context, *SetPushQuietHoursReq) (*SetPushQuietHoursResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPushQuietHours not implemented")
+}
+func (UnimplementedThirdExtServer) GetPushQuietHours(context.Context, *GetPushQuietHoursReq) (*GetPushQuietHoursResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPushQuietHours not implemented")
+}
+func (UnimplementedThirdExtServer) SnoozePush(context.Context, *SnoozePushReq) (*SnoozePushResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SnoozePush not implemented")
+}
+func (UnimplementedThirdExtServer) SetPushTemplate(context.Context, *SetPushTemplateReq) (*SetPushTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPushTemplate not implemented")
+}
+func (UnimplementedThirdExtServer) GetPushTemplates(context.Context, *GetPushTemplatesReq) (*GetPushTemplatesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPushTemplates not implemented")
+}
+func (UnimplementedThirdExtServer) DeletePushTemplate(context.Context, *DeletePushTemplateReq) (*DeletePushTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePushTemplate not implemented")
+}
+func (UnimplementedThirdExtServer) mustEmbedUnimplementedThirdExtServer() {}
+func (UnimplementedThirdExtServer) testEmbeddedByValue()                  {}
+
+// UnsafeThirdExtServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ThirdExtServer will
+// result in compilation errors.
+type UnsafeThirdExtServer interface {
+	mustEmbedUnimplementedThirdExtServer()
+}
+
+func RegisterThirdExtServer(s grpc.ServiceRegistrar, srv ThirdExtServer) {
+	// If the following call pancis, it indicates UnimplementedThirdExtServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ThirdExt_ServiceDesc, srv)
+}
+
+func _ThirdExt_RegisterPushDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterPushDeviceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThirdExtServer).RegisterPushDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThirdExt_RegisterPushDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThirdExtServer).RegisterPushDevice(ctx, req.(*RegisterPushDeviceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThirdExt_RegisterWebPush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterWebPushReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThirdExtServer).RegisterWebPush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThirdExt_RegisterWebPush_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThirdExtServer).RegisterWebPush(ctx, req.(*RegisterWebPushReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThirdExt_UpdatePushDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePushDeviceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThirdExtServer).UpdatePushDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThirdExt_UpdatePushDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThirdExtServer).UpdatePushDevice(ctx, req.(*UpdatePushDeviceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThirdExt_GetPushDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPushDevicesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThirdExtServer).GetPushDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThirdExt_GetPushDevices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThirdExtServer).GetPushDevices(ctx, req.(*GetPushDevicesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThirdExt_UnregisterPushDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregisterPushDeviceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThirdExtServer).UnregisterPushDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThirdExt_UnregisterPushDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThirdExtServer).UnregisterPushDevice(ctx, req.(*UnregisterPushDeviceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThirdExt_SetPushQuietHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPushQuietHoursReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThirdExtServer).SetPushQuietHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThirdExt_SetPushQuietHours_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThirdExtServer).SetPushQuietHours(ctx, req.(*SetPushQuietHoursReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThirdExt_GetPushQuietHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPushQuietHoursReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThirdExtServer).GetPushQuietHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThirdExt_GetPushQuietHours_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThirdExtServer).GetPushQuietHours(ctx, req.(*GetPushQuietHoursReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThirdExt_SnoozePush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnoozePushReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThirdExtServer).SnoozePush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThirdExt_SnoozePush_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThirdExtServer).SnoozePush(ctx, req.(*SnoozePushReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThirdExt_SetPushTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPushTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThirdExtServer).SetPushTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThirdExt_SetPushTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThirdExtServer).SetPushTemplate(ctx, req.(*SetPushTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThirdExt_GetPushTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPushTemplatesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThirdExtServer).GetPushTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThirdExt_GetPushTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThirdExtServer).GetPushTemplates(ctx, req.(*GetPushTemplatesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThirdExt_DeletePushTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePushTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThirdExtServer).DeletePushTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThirdExt_DeletePushTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThirdExtServer).DeletePushTemplate(ctx, req.(*DeletePushTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ThirdExt_ServiceDesc is the grpc.ServiceDesc for ThirdExt service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ThirdExt_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openim.server.thirdext.thirdExt",
+	HandlerType: (*ThirdExtServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterPushDevice",
+			Handler:    _ThirdExt_RegisterPushDevice_Handler,
+		},
+		{
+			MethodName: "RegisterWebPush",
+			Handler:    _ThirdExt_RegisterWebPush_Handler,
+		},
+		{
+			MethodName: "UpdatePushDevice",
+			Handler:    _ThirdExt_UpdatePushDevice_Handler,
+		},
+		{
+			MethodName: "GetPushDevices",
+			Handler:    _ThirdExt_GetPushDevices_Handler,
+		},
+		{
+			MethodName: "UnregisterPushDevice",
+			Handler:    _ThirdExt_UnregisterPushDevice_Handler,
+		},
+		{
+			MethodName: "SetPushQuietHours",
+			Handler:    _ThirdExt_SetPushQuietHours_Handler,
+		},
+		{
+			MethodName: "GetPushQuietHours",
+			Handler:    _ThirdExt_GetPushQuietHours_Handler,
+		},
+		{
+			MethodName: "SnoozePush",
+			Handler:    _ThirdExt_SnoozePush_Handler,
+		},
+		{
+			MethodName: "SetPushTemplate",
+			Handler:    _ThirdExt_SetPushTemplate_Handler,
+		},
+		{
+			MethodName: "GetPushTemplates",
+			Handler:    _ThirdExt_GetPushTemplates_Handler,
+		},
+		{
+			MethodName: "DeletePushTemplate",
+			Handler:    _ThirdExt_DeletePushTemplate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "thirdext/thirdext.proto",
+}
@@ -0,0 +1,53 @@
+package userext
+
+import (
+	"errors"
+)
+
+func (x *SuspendUserReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.ExpireTime <= 0 {
+		return errors.New("expireTime is invalid")
+	}
+	return nil
+}
+
+func (x *UnsuspendUserReq) Check() error {
+	if len(x.UserIDs) == 0 {
+		return errors.New("userIDs is empty")
+	}
+	return nil
+}
+
+func (x *GetSuspendedUsersReq) Check() error {
+	if len(x.UserIDs) == 0 && x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	return nil
+}
+
+func (x *SetLastSeenPrivacyReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.Privacy == "" {
+		return errors.New("privacy is empty")
+	}
+	return nil
+}
+
+func (x *GetLastSeenPrivacyReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *GetUsersLastSeenReq) Check() error {
+	if len(x.UserIDs) == 0 {
+		return errors.New("userIDs is empty")
+	}
+	return nil
+}
@@ -0,0 +1,2011 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        v5.29.0
+// source: userext/userext.proto
+
+package userext
+
+import (
+	sdkws "github.com/openimsdk/protocol/sdkws"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type UserSuspendInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID         string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Reason         string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason"`
+	HideProfile    bool   `protobuf:"varint,3,opt,name=hideProfile,proto3" json:"hideProfile"`
+	OperatorUserID string `protobuf:"bytes,4,opt,name=operatorUserID,proto3" json:"operatorUserID"`
+	CreateTime     int64  `protobuf:"varint,5,opt,name=createTime,proto3" json:"createTime"`
+	ExpireTime     int64  `protobuf:"varint,6,opt,name=expireTime,proto3" json:"expireTime"`
+}
+
+func (x *UserSuspendInfo) Reset() {
+	*x = UserSuspendInfo{}
+	mi := &file_userext_userext_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSuspendInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSuspendInfo) ProtoMessage() {}
+
+func (x *UserSuspendInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSuspendInfo.ProtoReflect.Descriptor instead.
+func (*UserSuspendInfo) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UserSuspendInfo) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *UserSuspendInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *UserSuspendInfo) GetHideProfile() bool {
+	if x != nil {
+		return x.HideProfile
+	}
+	return false
+}
+
+func (x *UserSuspendInfo) GetOperatorUserID() string {
+	if x != nil {
+		return x.OperatorUserID
+	}
+	return ""
+}
+
+func (x *UserSuspendInfo) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *UserSuspendInfo) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+type SuspendUserReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	// expireTime is the unix millisecond timestamp at which the suspension is lifted.
+	ExpireTime int64  `protobuf:"varint,2,opt,name=expireTime,proto3" json:"expireTime"`
+	Reason     string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason"`
+	// hideProfile hides the user from GetUsersPublicInfo while suspended.
+	HideProfile bool `protobuf:"varint,4,opt,name=hideProfile,proto3" json:"hideProfile"`
+}
+
+func (x *SuspendUserReq) Reset() {
+	*x = SuspendUserReq{}
+	mi := &file_userext_userext_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendUserReq) ProtoMessage() {}
+
+func (x *SuspendUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendUserReq.ProtoReflect.Descriptor instead.
+func (*SuspendUserReq) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SuspendUserReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SuspendUserReq) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *SuspendUserReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SuspendUserReq) GetHideProfile() bool {
+	if x != nil {
+		return x.HideProfile
+	}
+	return false
+}
+
+type SuspendUserResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SuspendUserResp) Reset() {
+	*x = SuspendUserResp{}
+	mi := &file_userext_userext_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendUserResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendUserResp) ProtoMessage() {}
+
+func (x *SuspendUserResp) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendUserResp.ProtoReflect.Descriptor instead.
+func (*SuspendUserResp) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{2}
+}
+
+type UnsuspendUserReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *UnsuspendUserReq) Reset() {
+	*x = UnsuspendUserReq{}
+	mi := &file_userext_userext_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsuspendUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsuspendUserReq) ProtoMessage() {}
+
+func (x *UnsuspendUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsuspendUserReq.ProtoReflect.Descriptor instead.
+func (*UnsuspendUserReq) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UnsuspendUserReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type UnsuspendUserResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnsuspendUserResp) Reset() {
+	*x = UnsuspendUserResp{}
+	mi := &file_userext_userext_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsuspendUserResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsuspendUserResp) ProtoMessage() {}
+
+func (x *UnsuspendUserResp) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsuspendUserResp.ProtoReflect.Descriptor instead.
+func (*UnsuspendUserResp) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{4}
+}
+
+type GetSuspendedUsersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIDs    []string                 `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *GetSuspendedUsersReq) Reset() {
+	*x = GetSuspendedUsersReq{}
+	mi := &file_userext_userext_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSuspendedUsersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSuspendedUsersReq) ProtoMessage() {}
+
+func (x *GetSuspendedUsersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSuspendedUsersReq.ProtoReflect.Descriptor instead.
+func (*GetSuspendedUsersReq) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetSuspendedUsersReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+func (x *GetSuspendedUsersReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetSuspendedUsersResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total int64              `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Users []*UserSuspendInfo `protobuf:"bytes,2,rep,name=users,proto3" json:"users"`
+}
+
+func (x *GetSuspendedUsersResp) Reset() {
+	*x = GetSuspendedUsersResp{}
+	mi := &file_userext_userext_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSuspendedUsersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSuspendedUsersResp) ProtoMessage() {}
+
+func (x *GetSuspendedUsersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSuspendedUsersResp.ProtoReflect.Descriptor instead.
+func (*GetSuspendedUsersResp) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetSuspendedUsersResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetSuspendedUsersResp) GetUsers() []*UserSuspendInfo {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type GetHiddenUserIDsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *GetHiddenUserIDsReq) Reset() {
+	*x = GetHiddenUserIDsReq{}
+	mi := &file_userext_userext_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHiddenUserIDsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHiddenUserIDsReq) ProtoMessage() {}
+
+func (x *GetHiddenUserIDsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHiddenUserIDsReq.ProtoReflect.Descriptor instead.
+func (*GetHiddenUserIDsReq) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetHiddenUserIDsReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type GetHiddenUserIDsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// userIDs are the suspended users among the requested ones that hide their profile.
+	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *GetHiddenUserIDsResp) Reset() {
+	*x = GetHiddenUserIDsResp{}
+	mi := &file_userext_userext_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHiddenUserIDsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHiddenUserIDsResp) ProtoMessage() {}
+
+func (x *GetHiddenUserIDsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHiddenUserIDsResp.ProtoReflect.Descriptor instead.
+func (*GetHiddenUserIDsResp) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetHiddenUserIDsResp) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type UserFieldInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key  string `protobuf:"bytes,1,opt,name=key,proto3" json:"key"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	// type is one of string, int, bool and enum.
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type"`
+	// maxLength limits the length of string and enum values, zero means no limit.
+	MaxLength int32 `protobuf:"varint,4,opt,name=maxLength,proto3" json:"maxLength"`
+	// pattern is a regular expression that string values must match.
+	Pattern string `protobuf:"bytes,5,opt,name=pattern,proto3" json:"pattern"`
+	Min     *int64 `protobuf:"varint,6,opt,name=min,proto3,oneof" json:"min"`
+	Max     *int64 `protobuf:"varint,7,opt,name=max,proto3,oneof" json:"max"`
+	// options are the allowed values of an enum field.
+	Options []string `protobuf:"bytes,8,rep,name=options,proto3" json:"options"`
+	// visibility is one of self, friends and public.
+	Visibility string `protobuf:"bytes,9,opt,name=visibility,proto3" json:"visibility"`
+	Searchable bool   `protobuf:"varint,10,opt,name=searchable,proto3" json:"searchable"`
+	CreateTime int64  `protobuf:"varint,11,opt,name=createTime,proto3" json:"createTime"`
+}
+
+func (x *UserFieldInfo) Reset() {
+	*x = UserFieldInfo{}
+	mi := &file_userext_userext_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserFieldInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserFieldInfo) ProtoMessage() {}
+
+func (x *UserFieldInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserFieldInfo.ProtoReflect.Descriptor instead.
+func (*UserFieldInfo) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UserFieldInfo) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *UserFieldInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserFieldInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *UserFieldInfo) GetMaxLength() int32 {
+	if x != nil {
+		return x.MaxLength
+	}
+	return 0
+}
+
+func (x *UserFieldInfo) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *UserFieldInfo) GetMin() int64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *UserFieldInfo) GetMax() int64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+func (x *UserFieldInfo) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *UserFieldInfo) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *UserFieldInfo) GetSearchable() bool {
+	if x != nil {
+		return x.Searchable
+	}
+	return false
+}
+
+func (x *UserFieldInfo) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type SetUserFieldSchemaReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fields []*UserFieldInfo `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields"`
+}
+
+func (x *SetUserFieldSchemaReq) Reset() {
+	*x = SetUserFieldSchemaReq{}
+	mi := &file_userext_userext_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserFieldSchemaReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserFieldSchemaReq) ProtoMessage() {}
+
+func (x *SetUserFieldSchemaReq) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserFieldSchemaReq.ProtoReflect.Descriptor instead.
+func (*SetUserFieldSchemaReq) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SetUserFieldSchemaReq) GetFields() []*UserFieldInfo {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type SetUserFieldSchemaResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetUserFieldSchemaResp) Reset() {
+	*x = SetUserFieldSchemaResp{}
+	mi := &file_userext_userext_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserFieldSchemaResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserFieldSchemaResp) ProtoMessage() {}
+
+func (x *SetUserFieldSchemaResp) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserFieldSchemaResp.ProtoReflect.Descriptor instead.
+func (*SetUserFieldSchemaResp) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{11}
+}
+
+type DeleteUserFieldSchemaReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys"`
+}
+
+func (x *DeleteUserFieldSchemaReq) Reset() {
+	*x = DeleteUserFieldSchemaReq{}
+	mi := &file_userext_userext_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserFieldSchemaReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserFieldSchemaReq) ProtoMessage() {}
+
+func (x *DeleteUserFieldSchemaReq) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserFieldSchemaReq.ProtoReflect.Descriptor instead.
+func (*DeleteUserFieldSchemaReq) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteUserFieldSchemaReq) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type DeleteUserFieldSchemaResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteUserFieldSchemaResp) Reset() {
+	*x = DeleteUserFieldSchemaResp{}
+	mi := &file_userext_userext_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserFieldSchemaResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserFieldSchemaResp) ProtoMessage() {}
+
+func (x *DeleteUserFieldSchemaResp) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserFieldSchemaResp.ProtoReflect.Descriptor instead.
+func (*DeleteUserFieldSchemaResp) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{13}
+}
+
+type GetUserFieldSchemaReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetUserFieldSchemaReq) Reset() {
+	*x = GetUserFieldSchemaReq{}
+	mi := &file_userext_userext_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserFieldSchemaReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserFieldSchemaReq) ProtoMessage() {}
+
+func (x *GetUserFieldSchemaReq) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserFieldSchemaReq.ProtoReflect.Descriptor instead.
+func (*GetUserFieldSchemaReq) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{14}
+}
+
+type GetUserFieldSchemaResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fields []*UserFieldInfo `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields"`
+}
+
+func (x *GetUserFieldSchemaResp) Reset() {
+	*x = GetUserFieldSchemaResp{}
+	mi := &file_userext_userext_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserFieldSchemaResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserFieldSchemaResp) ProtoMessage() {}
+
+func (x *GetUserFieldSchemaResp) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserFieldSchemaResp.ProtoReflect.Descriptor instead.
+func (*GetUserFieldSchemaResp) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetUserFieldSchemaResp) GetFields() []*UserFieldInfo {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// The values of the custom fields are JSON encoded, as a field is a string, an integer or a boolean.
+type UserFields struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string            `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Fields map[string]string `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *UserFields) Reset() {
+	*x = UserFields{}
+	mi := &file_userext_userext_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserFields) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserFields) ProtoMessage() {}
+
+func (x *UserFields) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserFields.ProtoReflect.Descriptor instead.
+func (*UserFields) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UserFields) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *UserFields) GetFields() map[string]string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type SetUserFieldsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	// fields are merged into the existing values, a null value removes the field.
+	Fields map[string]string `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *SetUserFieldsReq) Reset() {
+	*x = SetUserFieldsReq{}
+	mi := &file_userext_userext_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserFieldsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserFieldsReq) ProtoMessage() {}
+
+func (x *SetUserFieldsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserFieldsReq.ProtoReflect.Descriptor instead.
+func (*SetUserFieldsReq) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetUserFieldsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SetUserFieldsReq) GetFields() map[string]string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type SetUserFieldsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetUserFieldsResp) Reset() {
+	*x = SetUserFieldsResp{}
+	mi := &file_userext_userext_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserFieldsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserFieldsResp) ProtoMessage() {}
+
+func (x *SetUserFieldsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserFieldsResp.ProtoReflect.Descriptor instead.
+func (*SetUserFieldsResp) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{18}
+}
+
+type SearchUsersByFieldsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID   string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Nickname string `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname"`
+	// fields must all match exactly, only searchable fields are allowed.
+	Fields     map[string]string        `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *SearchUsersByFieldsReq) Reset() {
+	*x = SearchUsersByFieldsReq{}
+	mi := &file_userext_userext_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersByFieldsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersByFieldsReq) ProtoMessage() {}
+
+func (x *SearchUsersByFieldsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersByFieldsReq.ProtoReflect.Descriptor instead.
+func (*SearchUsersByFieldsReq) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SearchUsersByFieldsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SearchUsersByFieldsReq) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *SearchUsersByFieldsReq) GetFields() map[string]string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *SearchUsersByFieldsReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type UserInfoWithFields struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserInfo *sdkws.UserInfo   `protobuf:"bytes,1,opt,name=userInfo,proto3" json:"userInfo"`
+	Fields   map[string]string `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *UserInfoWithFields) Reset() {
+	*x = UserInfoWithFields{}
+	mi := &file_userext_userext_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserInfoWithFields) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInfoWithFields) ProtoMessage() {}
+
+func (x *UserInfoWithFields) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInfoWithFields.ProtoReflect.Descriptor instead.
+func (*UserInfoWithFields) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UserInfoWithFields) GetUserInfo() *sdkws.UserInfo {
+	if x != nil {
+		return x.UserInfo
+	}
+	return nil
+}
+
+func (x *UserInfoWithFields) GetFields() map[string]string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type SearchUsersByFieldsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total int64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Users []*UserInfoWithFields `protobuf:"bytes,2,rep,name=users,proto3" json:"users"`
+}
+
+func (x *SearchUsersByFieldsResp) Reset() {
+	*x = SearchUsersByFieldsResp{}
+	mi := &file_userext_userext_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersByFieldsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersByFieldsResp) ProtoMessage() {}
+
+func (x *SearchUsersByFieldsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersByFieldsResp.ProtoReflect.Descriptor instead.
+func (*SearchUsersByFieldsResp) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SearchUsersByFieldsResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchUsersByFieldsResp) GetUsers() []*UserInfoWithFields {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type GetVisibleUserFieldsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *GetVisibleUserFieldsReq) Reset() {
+	*x = GetVisibleUserFieldsReq{}
+	mi := &file_userext_userext_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVisibleUserFieldsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVisibleUserFieldsReq) ProtoMessage() {}
+
+func (x *GetVisibleUserFieldsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVisibleUserFieldsReq.ProtoReflect.Descriptor instead.
+func (*GetVisibleUserFieldsReq) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetVisibleUserFieldsReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type GetVisibleUserFieldsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// users are the users with fields the caller is allowed to see.
+	Users []*UserFields `protobuf:"bytes,1,rep,name=users,proto3" json:"users"`
+}
+
+func (x *GetVisibleUserFieldsResp) Reset() {
+	*x = GetVisibleUserFieldsResp{}
+	mi := &file_userext_userext_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVisibleUserFieldsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVisibleUserFieldsResp) ProtoMessage() {}
+
+func (x *GetVisibleUserFieldsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVisibleUserFieldsResp.ProtoReflect.Descriptor instead.
+func (*GetVisibleUserFieldsResp) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetVisibleUserFieldsResp) GetUsers() []*UserFields {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type SetLastSeenPrivacyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	// privacy is one of everyone, friends or nobody.
+	Privacy string `protobuf:"bytes,2,opt,name=privacy,proto3" json:"privacy"`
+}
+
+func (x *SetLastSeenPrivacyReq) Reset() {
+	*x = SetLastSeenPrivacyReq{}
+	mi := &file_userext_userext_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLastSeenPrivacyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLastSeenPrivacyReq) ProtoMessage() {}
+
+func (x *SetLastSeenPrivacyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLastSeenPrivacyReq.ProtoReflect.Descriptor instead.
+func (*SetLastSeenPrivacyReq) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SetLastSeenPrivacyReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SetLastSeenPrivacyReq) GetPrivacy() string {
+	if x != nil {
+		return x.Privacy
+	}
+	return ""
+}
+
+type SetLastSeenPrivacyResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetLastSeenPrivacyResp) Reset() {
+	*x = SetLastSeenPrivacyResp{}
+	mi := &file_userext_userext_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLastSeenPrivacyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLastSeenPrivacyResp) ProtoMessage() {}
+
+func (x *SetLastSeenPrivacyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLastSeenPrivacyResp.ProtoReflect.Descriptor instead.
+func (*SetLastSeenPrivacyResp) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{25}
+}
+
+type GetLastSeenPrivacyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *GetLastSeenPrivacyReq) Reset() {
+	*x = GetLastSeenPrivacyReq{}
+	mi := &file_userext_userext_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLastSeenPrivacyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLastSeenPrivacyReq) ProtoMessage() {}
+
+func (x *GetLastSeenPrivacyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLastSeenPrivacyReq.ProtoReflect.Descriptor instead.
+func (*GetLastSeenPrivacyReq) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetLastSeenPrivacyReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type GetLastSeenPrivacyResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Privacy string `protobuf:"bytes,1,opt,name=privacy,proto3" json:"privacy"`
+}
+
+func (x *GetLastSeenPrivacyResp) Reset() {
+	*x = GetLastSeenPrivacyResp{}
+	mi := &file_userext_userext_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLastSeenPrivacyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLastSeenPrivacyResp) ProtoMessage() {}
+
+func (x *GetLastSeenPrivacyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLastSeenPrivacyResp.ProtoReflect.Descriptor instead.
+func (*GetLastSeenPrivacyResp) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetLastSeenPrivacyResp) GetPrivacy() string {
+	if x != nil {
+		return x.Privacy
+	}
+	return ""
+}
+
+type GetUsersLastSeenReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *GetUsersLastSeenReq) Reset() {
+	*x = GetUsersLastSeenReq{}
+	mi := &file_userext_userext_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsersLastSeenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersLastSeenReq) ProtoMessage() {}
+
+func (x *GetUsersLastSeenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersLastSeenReq.ProtoReflect.Descriptor instead.
+func (*GetUsersLastSeenReq) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetUsersLastSeenReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type UserLastSeen struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID       string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	LastSeenTime int64  `protobuf:"varint,2,opt,name=lastSeenTime,proto3" json:"lastSeenTime"`
+}
+
+func (x *UserLastSeen) Reset() {
+	*x = UserLastSeen{}
+	mi := &file_userext_userext_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserLastSeen) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserLastSeen) ProtoMessage() {}
+
+func (x *UserLastSeen) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserLastSeen.ProtoReflect.Descriptor instead.
+func (*UserLastSeen) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UserLastSeen) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *UserLastSeen) GetLastSeenTime() int64 {
+	if x != nil {
+		return x.LastSeenTime
+	}
+	return 0
+}
+
+type GetUsersLastSeenResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// users contains only the users whose last-seen time the caller may see.
+	Users []*UserLastSeen `protobuf:"bytes,1,rep,name=users,proto3" json:"users"`
+}
+
+func (x *GetUsersLastSeenResp) Reset() {
+	*x = GetUsersLastSeenResp{}
+	mi := &file_userext_userext_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsersLastSeenResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersLastSeenResp) ProtoMessage() {}
+
+func (x *GetUsersLastSeenResp) ProtoReflect() protoreflect.Message {
+	mi := &file_userext_userext_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersLastSeenResp.ProtoReflect.Descriptor instead.
+func (*GetUsersLastSeenResp) Descriptor() ([]byte, []int) {
+	return file_userext_userext_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetUsersLastSeenResp) GetUsers() []*UserLastSeen {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+var File_userext_userext_proto protoreflect.FileDescriptor
+
+var file_userext_userext_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x1a, 0x11,
+	0x73, 0x64, 0x6b, 0x77, 0x73, 0x2f, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xcb, 0x01, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e,
+	0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x68, 0x69, 0x64, 0x65, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x69, 0x64, 0x65,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0x82, 0x01, 0x0a, 0x0e, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x68, 0x69, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x69, 0x64, 0x65, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x22, 0x11, 0x0a, 0x0f, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2c, 0x0a, 0x10, 0x55, 0x6e, 0x73, 0x75, 0x73,
+	0x70, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x55, 0x6e, 0x73, 0x75, 0x73, 0x70, 0x65,
+	0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x71, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x3f, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6b, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x3c, 0x0a, 0x05,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x65, 0x78, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x2f, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x30, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0xb9, 0x02,
+	0x0a, 0x0d, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x61, 0x78,
+	0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x61,
+	0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65,
+	0x72, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x12, 0x15, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00,
+	0x52, 0x03, 0x6d, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x88, 0x01, 0x01, 0x12,
+	0x18, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x73,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76,
+	0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d, 0x69,
+	0x6e, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d, 0x61, 0x78, 0x22, 0x55, 0x0a, 0x15, 0x53, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52,
+	0x65, 0x71, 0x12, 0x3c, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x22, 0x18, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2e, 0x0a, 0x18, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x1b, 0x0a, 0x19, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71,
+	0x22, 0x56, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3c, 0x0a, 0x06, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x65,
+	0x78, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0xa6, 0x01, 0x0a, 0x0a, 0x55, 0x73, 0x65,
+	0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x45, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0xb2, 0x01, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x4b,
+	0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x13, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x9b, 0x02, 0x0a, 0x16,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x42, 0x79, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a,
+	0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x51, 0x0a, 0x06, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x65,
+	0x78, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x42, 0x79,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x3f, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x39,
+	0x0a, 0x0b, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd2, 0x01, 0x0a, 0x12, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x57, 0x69, 0x74, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x12, 0x32, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77,
+	0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x4d, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x57, 0x69, 0x74, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x2e,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x70,
+	0x0a, 0x17, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x42, 0x79, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x3f, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x57,
+	0x69, 0x74, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x22, 0x33, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x53, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x56, 0x69, 0x73, 0x69,
+	0x62, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x37, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x49, 0x0a, 0x15, 0x53, 0x65,
+	0x74, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79,
+	0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x63, 0x79, 0x22, 0x18, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74,
+	0x53, 0x65, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x2f, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x50, 0x72,
+	0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x22, 0x32, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x50,
+	0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x63, 0x79, 0x22, 0x2f, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x4c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x4a, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x61, 0x73,
+	0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x22, 0x0a,
+	0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0x51, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x4c, 0x61, 0x73,
+	0x74, 0x53, 0x65, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x39, 0x0a, 0x05, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x52, 0x05, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x32, 0xb0, 0x0b, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x45, 0x78, 0x74,
+	0x12, 0x5c, 0x0a, 0x0b, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2e, 0x53,
+	0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x62,
+	0x0a, 0x0d, 0x55, 0x6e, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e,
+	0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74,
+	0x2e, 0x55, 0x6e, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x6e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64,
+	0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x2c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x6b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2e, 0x47,
+	0x65, 0x74, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x69,
+	0x64, 0x64, 0x65, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x71, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x2c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x7a, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x2f, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x65, 0x78, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x1a, 0x30, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x65, 0x78, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x12, 0x71,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x12, 0x2c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52,
+	0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x62, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x12, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x74, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x42, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x2d, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x42, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2e, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x65, 0x78, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x42,
+	0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x77, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x12, 0x2e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x56,
+	0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x2f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x56,
+	0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x71, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x53,
+	0x65, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x12, 0x2c, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x65,
+	0x78, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x50, 0x72,
+	0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x65, 0x78, 0x74,
+	0x2e, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x76,
+	0x61, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x71, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c, 0x61,
+	0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x12, 0x2c, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65,
+	0x6e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x50,
+	0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6b, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x2a,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x4c,
+	0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x65,
+	0x78, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x53,
+	0x65, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x42, 0x3d, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x73, 0x64, 0x6b, 0x2f,
+	0x6f, 0x70, 0x65, 0x6e, 0x2d, 0x69, 0x6d, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76,
+	0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x75,
+	0x73, 0x65, 0x72, 0x65, 0x78, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_userext_userext_proto_rawDescOnce sync.Once
+	file_userext_userext_proto_rawDescData = file_userext_userext_proto_rawDesc
+)
+
+func file_userext_userext_proto_rawDescGZIP() []byte {
+	file_userext_userext_proto_rawDescOnce.Do(func() {
+		file_userext_userext_proto_rawDescData = protoimpl.X.CompressGZIP(file_userext_userext_proto_rawDescData)
+	})
+	return file_userext_userext_proto_rawDescData
+}
+
+var file_userext_userext_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
+var file_userext_userext_proto_goTypes = []any{
+	(*UserSuspendInfo)(nil),           // 0: openim.server.userext.UserSuspendInfo
+	(*SuspendUserReq)(nil),            // 1: openim.server.userext.SuspendUserReq
+	(*SuspendUserResp)(nil),           // 2: openim.server.userext.SuspendUserResp
+	(*UnsuspendUserReq)(nil),          // 3: openim.server.userext.UnsuspendUserReq
+	(*UnsuspendUserResp)(nil),         // 4: openim.server.userext.UnsuspendUserResp
+	(*GetSuspendedUsersReq)(nil),      // 5: openim.server.userext.GetSuspendedUsersReq
+	(*GetSuspendedUsersResp)(nil),     // 6: openim.server.userext.GetSuspendedUsersResp
+	(*GetHiddenUserIDsReq)(nil),       // 7: openim.server.userext.GetHiddenUserIDsReq
+	(*GetHiddenUserIDsResp)(nil),      // 8: openim.server.userext.GetHiddenUserIDsResp
+	(*UserFieldInfo)(nil),             // 9: openim.server.userext.UserFieldInfo
+	(*SetUserFieldSchemaReq)(nil),     // 10: openim.server.userext.SetUserFieldSchemaReq
+	(*SetUserFieldSchemaResp)(nil),    // 11: openim.server.userext.SetUserFieldSchemaResp
+	(*DeleteUserFieldSchemaReq)(nil),  // 12: openim.server.userext.DeleteUserFieldSchemaReq
+	(*DeleteUserFieldSchemaResp)(nil), // 13: openim.server.userext.DeleteUserFieldSchemaResp
+	(*GetUserFieldSchemaReq)(nil),     // 14: openim.server.userext.GetUserFieldSchemaReq
+	(*GetUserFieldSchemaResp)(nil),    // 15: openim.server.userext.GetUserFieldSchemaResp
+	(*UserFields)(nil),                // 16: openim.server.userext.UserFields
+	(*SetUserFieldsReq)(nil),          // 17: openim.server.userext.SetUserFieldsReq
+	(*SetUserFieldsResp)(nil),         // 18: openim.server.userext.SetUserFieldsResp
+	(*SearchUsersByFieldsReq)(nil),    // 19: openim.server.userext.SearchUsersByFieldsReq
+	(*UserInfoWithFields)(nil),        // 20: openim.server.userext.UserInfoWithFields
+	(*SearchUsersByFieldsResp)(nil),   // 21: openim.server.userext.SearchUsersByFieldsResp
+	(*GetVisibleUserFieldsReq)(nil),   // 22: openim.server.userext.GetVisibleUserFieldsReq
+	(*GetVisibleUserFieldsResp)(nil),  // 23: openim.server.userext.GetVisibleUserFieldsResp
+	(*SetLastSeenPrivacyReq)(nil),     // 24: openim.server.userext.SetLastSeenPrivacyReq
+	(*SetLastSeenPrivacyResp)(nil),    // 25: openim.server.userext.SetLastSeenPrivacyResp
+	(*GetLastSeenPrivacyReq)(nil),     // 26: openim.server.userext.GetLastSeenPrivacyReq
+	(*GetLastSeenPrivacyResp)(nil),    // 27: openim.server.userext.GetLastSeenPrivacyResp
+	(*GetUsersLastSeenReq)(nil),       // 28: openim.server.userext.GetUsersLastSeenReq
+	(*UserLastSeen)(nil),              // 29: openim.server.userext.UserLastSeen
+	(*GetUsersLastSeenResp)(nil),      // 30: openim.server.userext.GetUsersLastSeenResp
+	nil,                               // 31: openim.server.userext.UserFields.FieldsEntry
+	nil,                               // 32: openim.server.userext.SetUserFieldsReq.FieldsEntry
+	nil,                               // 33: openim.server.userext.SearchUsersByFieldsReq.FieldsEntry
+	nil,                               // 34: openim.server.userext.UserInfoWithFields.FieldsEntry
+	(*sdkws.RequestPagination)(nil),   // 35: openim.sdkws.RequestPagination
+	(*sdkws.UserInfo)(nil),            // 36: openim.sdkws.UserInfo
+}
+var file_userext_userext_proto_depIdxs = []int32{
+	35, // 0: openim.server.userext.GetSuspendedUsersReq.pagination:type_name -> openim.sdkws.RequestPagination
+	0,  // 1: openim.server.userext.GetSuspendedUsersResp.users:type_name -> openim.server.userext.UserSuspendInfo
+	9,  // 2: openim.server.userext.SetUserFieldSchemaReq.fields:type_name -> openim.server.userext.UserFieldInfo
+	9,  // 3: openim.server.userext.GetUserFieldSchemaResp.fields:type_name -> openim.server.userext.UserFieldInfo
+	31, // 4: openim.server.userext.UserFields.fields:type_name -> openim.server.userext.UserFields.FieldsEntry
+	32, // 5: openim.server.userext.SetUserFieldsReq.fields:type_name -> openim.server.userext.SetUserFieldsReq.FieldsEntry
+	33, // 6: openim.server.userext.SearchUsersByFieldsReq.fields:type_name -> openim.server.userext.SearchUsersByFieldsReq.FieldsEntry
+	35, // 7: openim.server.userext.SearchUsersByFieldsReq.pagination:type_name -> openim.sdkws.RequestPagination
+	36, // 8: openim.server.userext.UserInfoWithFields.userInfo:type_name -> openim.sdkws.UserInfo
+	34, // 9: openim.server.userext.UserInfoWithFields.fields:type_name -> openim.server.userext.UserInfoWithFields.FieldsEntry
+	20, // 10: openim.server.userext.SearchUsersByFieldsResp.users:type_name -> openim.server.userext.UserInfoWithFields
+	16, // 11: openim.server.userext.GetVisibleUserFieldsResp.users:type_name -> openim.server.userext.UserFields
+	29, // 12: openim.server.userext.GetUsersLastSeenResp.users:type_name -> openim.server.userext.UserLastSeen
+	1,  // 13: openim.server.userext.userExt.SuspendUser:input_type -> openim.server.userext.SuspendUserReq
+	3,  // 14: openim.server.userext.userExt.UnsuspendUser:input_type -> openim.server.userext.UnsuspendUserReq
+	5,  // 15: openim.server.userext.userExt.GetSuspendedUsers:input_type -> openim.server.userext.GetSuspendedUsersReq
+	7,  // 16: openim.server.userext.userExt.GetHiddenUserIDs:input_type -> openim.server.userext.GetHiddenUserIDsReq
+	10, // 17: openim.server.userext.userExt.SetUserFieldSchema:input_type -> openim.server.userext.SetUserFieldSchemaReq
+	12, // 18: openim.server.userext.userExt.DeleteUserFieldSchema:input_type -> openim.server.userext.DeleteUserFieldSchemaReq
+	14, // 19: openim.server.userext.userExt.GetUserFieldSchema:input_type -> openim.server.userext.GetUserFieldSchemaReq
+	17, // 20: openim.server.userext.userExt.SetUserFields:input_type -> openim.server.userext.SetUserFieldsReq
+	19, // 21: openim.server.userext.userExt.SearchUsersByFields:input_type -> openim.server.userext.SearchUsersByFieldsReq
+	22, // 22: openim.server.userext.userExt.GetVisibleUserFields:input_type -> openim.server.userext.GetVisibleUserFieldsReq
+	24, // 23: openim.server.userext.userExt.SetLastSeenPrivacy:input_type -> openim.server.userext.SetLastSeenPrivacyReq
+	26, // 24: openim.server.userext.userExt.GetLastSeenPrivacy:input_type -> openim.server.userext.GetLastSeenPrivacyReq
+	28, // 25: openim.server.userext.userExt.GetUsersLastSeen:input_type -> openim.server.userext.GetUsersLastSeenReq
+	2,  // 26: openim.server.userext.userExt.SuspendUser:output_type -> openim.server.userext.SuspendUserResp
+	4,  // 27: openim.server.userext.userExt.UnsuspendUser:output_type -> openim.server.userext.UnsuspendUserResp
+	6,  // 28: openim.server.userext.userExt.GetSuspendedUsers:output_type -> openim.server.userext.GetSuspendedUsersResp
+	8,  // 29: openim.server.userext.userExt.GetHiddenUserIDs:output_type -> openim.server.userext.GetHiddenUserIDsResp
+	11, // 30: openim.server.userext.userExt.SetUserFieldSchema:output_type -> openim.server.userext.SetUserFieldSchemaResp
+	13, // 31: openim.server.userext.userExt.DeleteUserFieldSchema:output_type -> openim.server.userext.DeleteUserFieldSchemaResp
+	15, // 32: openim.server.userext.userExt.GetUserFieldSchema:output_type -> openim.server.userext.GetUserFieldSchemaResp
+	18, // 33: openim.server.userext.userExt.SetUserFields:output_type -> openim.server.userext.SetUserFieldsResp
+	21, // 34: openim.server.userext.userExt.SearchUsersByFields:output_type -> openim.server.userext.SearchUsersByFieldsResp
+	23, // 35: openim.server.userext.userExt.GetVisibleUserFields:output_type -> openim.server.userext.GetVisibleUserFieldsResp
+	25, // 36: openim.server.userext.userExt.SetLastSeenPrivacy:output_type -> openim.server.userext.SetLastSeenPrivacyResp
+	27, // 37: openim.server.userext.userExt.GetLastSeenPrivacy:output_type -> openim.server.userext.GetLastSeenPrivacyResp
+	30, // 38: openim.server.userext.userExt.GetUsersLastSeen:output_type -> openim.server.userext.GetUsersLastSeenResp
+	26, // [26:39] is the sub-list for method output_type
+	13, // [13:26] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_userext_userext_proto_init() }
+func file_userext_userext_proto_init() {
+	if File_userext_userext_proto != nil {
+		return
+	}
+	file_userext_userext_proto_msgTypes[9].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_userext_userext_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   35,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_userext_userext_proto_goTypes,
+		DependencyIndexes: file_userext_userext_proto_depIdxs,
+		MessageInfos:      file_userext_userext_proto_msgTypes,
+	}.Build()
+	File_userext_userext_proto = out.File
+	file_userext_userext_proto_rawDesc = nil
+	file_userext_userext_proto_goTypes = nil
+	file_userext_userext_proto_depIdxs = nil
+}
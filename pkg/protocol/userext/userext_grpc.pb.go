@@ -0,0 +1,579 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.0
+// source: userext/userext.proto
+
+package userext
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	UserExt_SuspendUser_FullMethodName           = "/openim.server.userext.userExt/SuspendUser"
+	UserExt_UnsuspendUser_FullMethodName         = "/openim.server.userext.userExt/UnsuspendUser"
+	UserExt_GetSuspendedUsers_FullMethodName     = "/openim.server.userext.userExt/GetSuspendedUsers"
+	UserExt_GetHiddenUserIDs_FullMethodName      = "/openim.server.userext.userExt/GetHiddenUserIDs"
+	UserExt_SetUserFieldSchema_FullMethodName    = "/openim.server.userext.userExt/SetUserFieldSchema"
+	UserExt_DeleteUserFieldSchema_FullMethodName = "/openim.server.userext.userExt/DeleteUserFieldSchema"
+	UserExt_GetUserFieldSchema_FullMethodName    = "/openim.server.userext.userExt/GetUserFieldSchema"
+	UserExt_SetUserFields_FullMethodName         = "/openim.server.userext.userExt/SetUserFields"
+	UserExt_SearchUsersByFields_FullMethodName   = "/openim.server.userext.userExt/SearchUsersByFields"
+	UserExt_GetVisibleUserFields_FullMethodName  = "/openim.server.userext.userExt/GetVisibleUserFields"
+	UserExt_SetLastSeenPrivacy_FullMethodName    = "/openim.server.userext.userExt/SetLastSeenPrivacy"
+	UserExt_GetLastSeenPrivacy_FullMethodName    = "/openim.server.userext.userExt/GetLastSeenPrivacy"
+	UserExt_GetUsersLastSeen_FullMethodName      = "/openim.server.userext.userExt/GetUsersLastSeen"
+)
+
+// UserExtClient is the client API for UserExt service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UserExtClient interface {
+	SuspendUser(ctx context.Context, in *SuspendUserReq, opts ...grpc.CallOption) (*SuspendUserResp, error)
+	UnsuspendUser(ctx context.Context, in *UnsuspendUserReq, opts ...grpc.CallOption) (*UnsuspendUserResp, error)
+	GetSuspendedUsers(ctx context.Context, in *GetSuspendedUsersReq, opts ...grpc.CallOption) (*GetSuspendedUsersResp, error)
+	GetHiddenUserIDs(ctx context.Context, in *GetHiddenUserIDsReq, opts ...grpc.CallOption) (*GetHiddenUserIDsResp, error)
+	SetUserFieldSchema(ctx context.Context, in *SetUserFieldSchemaReq, opts ...grpc.CallOption) (*SetUserFieldSchemaResp, error)
+	DeleteUserFieldSchema(ctx context.Context, in *DeleteUserFieldSchemaReq, opts ...grpc.CallOption) (*DeleteUserFieldSchemaResp, error)
+	GetUserFieldSchema(ctx context.Context, in *GetUserFieldSchemaReq, opts ...grpc.CallOption) (*GetUserFieldSchemaResp, error)
+	SetUserFields(ctx context.Context, in *SetUserFieldsReq, opts ...grpc.CallOption) (*SetUserFieldsResp, error)
+	SearchUsersByFields(ctx context.Context, in *SearchUsersByFieldsReq, opts ...grpc.CallOption) (*SearchUsersByFieldsResp, error)
+	GetVisibleUserFields(ctx context.Context, in *GetVisibleUserFieldsReq, opts ...grpc.CallOption) (*GetVisibleUserFieldsResp, error)
+	SetLastSeenPrivacy(ctx context.Context, in *SetLastSeenPrivacyReq, opts ...grpc.CallOption) (*SetLastSeenPrivacyResp, error)
+	GetLastSeenPrivacy(ctx context.Context, in *GetLastSeenPrivacyReq, opts ...grpc.CallOption) (*GetLastSeenPrivacyResp, error)
+	// GetUsersLastSeen hides the last-seen time of the users whose privacy does not let the caller see it.
+	GetUsersLastSeen(ctx context.Context, in *GetUsersLastSeenReq, opts ...grpc.CallOption) (*GetUsersLastSeenResp, error)
+}
+
+type userExtClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserExtClient(cc grpc.ClientConnInterface) UserExtClient {
+	return &userExtClient{cc}
+}
+
+func (c *userExtClient) SuspendUser(ctx context.Context, in *SuspendUserReq, opts ...grpc.CallOption) (*SuspendUserResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuspendUserResp)
+	err := c.cc.Invoke(ctx, UserExt_SuspendUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExtClient) UnsuspendUser(ctx context.Context, in *UnsuspendUserReq, opts ...grpc.CallOption) (*UnsuspendUserResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnsuspendUserResp)
+	err := c.cc.Invoke(ctx, UserExt_UnsuspendUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExtClient) GetSuspendedUsers(ctx context.Context, in *GetSuspendedUsersReq, opts ...grpc.CallOption) (*GetSuspendedUsersResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSuspendedUsersResp)
+	err := c.cc.Invoke(ctx, UserExt_GetSuspendedUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExtClient) GetHiddenUserIDs(ctx context.Context, in *GetHiddenUserIDsReq, opts ...grpc.CallOption) (*GetHiddenUserIDsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHiddenUserIDsResp)
+	err := c.cc.Invoke(ctx, UserExt_GetHiddenUserIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExtClient) SetUserFieldSchema(ctx context.Context, in *SetUserFieldSchemaReq, opts ...grpc.CallOption) (*SetUserFieldSchemaResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetUserFieldSchemaResp)
+	err := c.cc.Invoke(ctx, UserExt_SetUserFieldSchema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExtClient) DeleteUserFieldSchema(ctx context.Context, in *DeleteUserFieldSchemaReq, opts ...grpc.CallOption) (*DeleteUserFieldSchemaResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteUserFieldSchemaResp)
+	err := c.cc.Invoke(ctx, UserExt_DeleteUserFieldSchema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExtClient) GetUserFieldSchema(ctx context.Context, in *GetUserFieldSchemaReq, opts ...grpc.CallOption) (*GetUserFieldSchemaResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserFieldSchemaResp)
+	err := c.cc.Invoke(ctx, UserExt_GetUserFieldSchema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExtClient) SetUserFields(ctx context.Context, in *SetUserFieldsReq, opts ...grpc.CallOption) (*SetUserFieldsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetUserFieldsResp)
+	err := c.cc.Invoke(ctx, UserExt_SetUserFields_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExtClient) SearchUsersByFields(ctx context.Context, in *SearchUsersByFieldsReq, opts ...grpc.CallOption) (*SearchUsersByFieldsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchUsersByFieldsResp)
+	err := c.cc.Invoke(ctx, UserExt_SearchUsersByFields_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExtClient) GetVisibleUserFields(ctx context.Context, in *GetVisibleUserFieldsReq, opts ...grpc.CallOption) (*GetVisibleUserFieldsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVisibleUserFieldsResp)
+	err := c.cc.Invoke(ctx, UserExt_GetVisibleUserFields_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExtClient) SetLastSeenPrivacy(ctx context.Context, in *SetLastSeenPrivacyReq, opts ...grpc.CallOption) (*SetLastSeenPrivacyResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetLastSeenPrivacyResp)
+	err := c.cc.Invoke(ctx, UserExt_SetLastSeenPrivacy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExtClient) GetLastSeenPrivacy(ctx context.Context, in *GetLastSeenPrivacyReq, opts ...grpc.CallOption) (*GetLastSeenPrivacyResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLastSeenPrivacyResp)
+	err := c.cc.Invoke(ctx, UserExt_GetLastSeenPrivacy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExtClient) GetUsersLastSeen(ctx context.Context, in *GetUsersLastSeenReq, opts ...grpc.CallOption) (*GetUsersLastSeenResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsersLastSeenResp)
+	err := c.cc.Invoke(ctx, UserExt_GetUsersLastSeen_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserExtServer is the server API for UserExt service.
+// All implementations must embed UnimplementedUserExtServer
+// for forward compatibility.
+type UserExtServer interface {
+	SuspendUser(context.Context, *SuspendUserReq) (*SuspendUserResp, error)
+	UnsuspendUser(context.Context, *UnsuspendUserReq) (*UnsuspendUserResp, error)
+	GetSuspendedUsers(context.Context, *GetSuspendedUsersReq) (*GetSuspendedUsersResp, error)
+	GetHiddenUserIDs(context.Context, *GetHiddenUserIDsReq) (*GetHiddenUserIDsResp, error)
+	SetUserFieldSchema(context.Context, *SetUserFieldSchemaReq) (*SetUserFieldSchemaResp, error)
+	DeleteUserFieldSchema(context.Context, *DeleteUserFieldSchemaReq) (*DeleteUserFieldSchemaResp, error)
+	GetUserFieldSchema(context.Context, *GetUserFieldSchemaReq) (*GetUserFieldSchemaResp, error)
+	SetUserFields(context.Context, *SetUserFieldsReq) (*SetUserFieldsResp, error)
+	SearchUsersByFields(context.Context, *SearchUsersByFieldsReq) (*SearchUsersByFieldsResp, error)
+	GetVisibleUserFields(context.Context, *GetVisibleUserFieldsReq) (*GetVisibleUserFieldsResp, error)
+	SetLastSeenPrivacy(context.Context, *SetLastSeenPrivacyReq) (*SetLastSeenPrivacyResp, error)
+	GetLastSeenPrivacy(context.Context, *GetLastSeenPrivacyReq) (*GetLastSeenPrivacyResp, error)
+	// GetUsersLastSeen hides the last-seen time of the users whose privacy does not let the caller see it.
+	GetUsersLastSeen(context.Context, *GetUsersLastSeenReq) (*GetUsersLastSeenResp, error)
+	mustEmbedUnimplementedUserExtServer()
+}
+
+// UnimplementedUserExtServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedUserExtServer struct{}
+
+func (UnimplementedUserExtServer) SuspendUser(context.Context, *SuspendUserReq) (*SuspendUserResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendUser not implemented")
+}
+func (UnimplementedUserExtServer) UnsuspendUser(context.Context, *UnsuspendUserReq) (*UnsuspendUserResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsuspendUser not implemented")
+}
+func (UnimplementedUserExtServer) GetSuspendedUsers(context.Context, *GetSuspendedUsersReq) (*GetSuspendedUsersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSuspendedUsers not implemented")
+}
+func (UnimplementedUserExtServer) GetHiddenUserIDs(context.Context, *GetHiddenUserIDsReq) (*GetHiddenUserIDsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHiddenUserIDs not implemented")
+}
+func (UnimplementedUserExtServer) SetUserFieldSchema(context.Context, *SetUserFieldSchemaReq) (*SetUserFieldSchemaResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserFieldSchema not implemented")
+}
+func (UnimplementedUserExtServer) DeleteUserFieldSchema(context.Context, *DeleteUserFieldSchemaReq) (*DeleteUserFieldSchemaResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserFieldSchema not implemented")
+}
+func (UnimplementedUserExtServer) GetUserFieldSchema(context.Context, *GetUserFieldSchemaReq) (*GetUserFieldSchemaResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserFieldSchema not implemented")
+}
+func (UnimplementedUserExtServer) SetUserFields(context.Context, *SetUserFieldsReq) (*SetUserFieldsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserFields not implemented")
+}
+func (UnimplementedUserExtServer) SearchUsersByFields(context.Context, *SearchUsersByFieldsReq) (*SearchUsersByFieldsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchUsersByFields not implemented")
+}
+func (UnimplementedUserExtServer) GetVisibleUserFields(context.Context, *GetVisibleUserFieldsReq) (*GetVisibleUserFieldsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVisibleUserFields not implemented")
+}
+func (UnimplementedUserExtServer) SetLastSeenPrivacy(context.Context, *SetLastSeenPrivacyReq) (*SetLastSeenPrivacyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLastSeenPrivacy not implemented")
+}
+func (UnimplementedUserExtServer) GetLastSeenPrivacy(context.Context, *GetLastSeenPrivacyReq) (*GetLastSeenPrivacyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLastSeenPrivacy not implemented")
+}
+func (UnimplementedUserExtServer) GetUsersLastSeen(context.Context, *GetUsersLastSeenReq) (*GetUsersLastSeenResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsersLastSeen not implemented")
+}
+func (UnimplementedUserExtServer) mustEmbedUnimplementedUserExtServer() {}
+func (UnimplementedUserExtServer) testEmbeddedByValue()                 {}
+
+// UnsafeUserExtServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserExtServer will
+// result in compilation errors.
+type UnsafeUserExtServer interface {
+	mustEmbedUnimplementedUserExtServer()
+}
+
+func RegisterUserExtServer(s grpc.ServiceRegistrar, srv UserExtServer) {
+	// If the following call pancis, it indicates UnimplementedUserExtServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&UserExt_ServiceDesc, srv)
+}
+
+func _UserExt_SuspendUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServer).SuspendUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserExt_SuspendUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServer).SuspendUser(ctx, req.(*SuspendUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExt_UnsuspendUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsuspendUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServer).UnsuspendUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserExt_UnsuspendUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServer).UnsuspendUser(ctx, req.(*UnsuspendUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExt_GetSuspendedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSuspendedUsersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServer).GetSuspendedUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserExt_GetSuspendedUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServer).GetSuspendedUsers(ctx, req.(*GetSuspendedUsersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExt_GetHiddenUserIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHiddenUserIDsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServer).GetHiddenUserIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserExt_GetHiddenUserIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServer).GetHiddenUserIDs(ctx, req.(*GetHiddenUserIDsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExt_SetUserFieldSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserFieldSchemaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServer).SetUserFieldSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserExt_SetUserFieldSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServer).SetUserFieldSchema(ctx, req.(*SetUserFieldSchemaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExt_DeleteUserFieldSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserFieldSchemaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServer).DeleteUserFieldSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserExt_DeleteUserFieldSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServer).DeleteUserFieldSchema(ctx, req.(*DeleteUserFieldSchemaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExt_GetUserFieldSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserFieldSchemaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServer).GetUserFieldSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserExt_GetUserFieldSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServer).GetUserFieldSchema(ctx, req.(*GetUserFieldSchemaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExt_SetUserFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserFieldsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServer).SetUserFields(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserExt_SetUserFields_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServer).SetUserFields(ctx, req.(*SetUserFieldsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExt_SearchUsersByFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUsersByFieldsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServer).SearchUsersByFields(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserExt_SearchUsersByFields_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServer).SearchUsersByFields(ctx, req.(*SearchUsersByFieldsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExt_GetVisibleUserFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVisibleUserFieldsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServer).GetVisibleUserFields(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserExt_GetVisibleUserFields_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServer).GetVisibleUserFields(ctx, req.(*GetVisibleUserFieldsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExt_SetLastSeenPrivacy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLastSeenPrivacyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServer).SetLastSeenPrivacy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserExt_SetLastSeenPrivacy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServer).SetLastSeenPrivacy(ctx, req.(*SetLastSeenPrivacyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExt_GetLastSeenPrivacy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLastSeenPrivacyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServer).GetLastSeenPrivacy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserExt_GetLastSeenPrivacy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServer).GetLastSeenPrivacy(ctx, req.(*GetLastSeenPrivacyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExt_GetUsersLastSeen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersLastSeenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServer).GetUsersLastSeen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserExt_GetUsersLastSeen_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServer).GetUsersLastSeen(ctx, req.(*GetUsersLastSeenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserExt_ServiceDesc is the grpc.ServiceDesc for UserExt service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserExt_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openim.server.userext.userExt",
+	HandlerType: (*UserExtServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SuspendUser",
+			Handler:    _UserExt_SuspendUser_Handler,
+		},
+		{
+			MethodName: "UnsuspendUser",
+			Handler:    _UserExt_UnsuspendUser_Handler,
+		},
+		{
+			MethodName: "GetSuspendedUsers",
+			Handler:    _UserExt_GetSuspendedUsers_Handler,
+		},
+		{
+			MethodName: "GetHiddenUserIDs",
+			Handler:    _UserExt_GetHiddenUserIDs_Handler,
+		},
+		{
+			MethodName: "SetUserFieldSchema",
+			Handler:    _UserExt_SetUserFieldSchema_Handler,
+		},
+		{
+			MethodName: "DeleteUserFieldSchema",
+			Handler:    _UserExt_DeleteUserFieldSchema_Handler,
+		},
+		{
+			MethodName: "GetUserFieldSchema",
+			Handler:    _UserExt_GetUserFieldSchema_Handler,
+		},
+		{
+			MethodName: "SetUserFields",
+			Handler:    _UserExt_SetUserFields_Handler,
+		},
+		{
+			MethodName: "SearchUsersByFields",
+			Handler:    _UserExt_SearchUsersByFields_Handler,
+		},
+		{
+			MethodName: "GetVisibleUserFields",
+			Handler:    _UserExt_GetVisibleUserFields_Handler,
+		},
+		{
+			MethodName: "SetLastSeenPrivacy",
+			Handler:    _UserExt_SetLastSeenPrivacy_Handler,
+		},
+		{
+			MethodName: "GetLastSeenPrivacy",
+			Handler:    _UserExt_GetLastSeenPrivacy_Handler,
+		},
+		{
+			MethodName: "GetUsersLastSeen",
+			Handler:    _UserExt_GetUsersLastSeen_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "userext/userext.proto",
+}
@@ -13,21 +13,26 @@ import (
 	"time"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/storage/cache/cachekey"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 	"github.com/openimsdk/open-im-server/v3/pkg/localcache"
 	"github.com/openimsdk/open-im-server/v3/pkg/localcache/lru"
 	"github.com/openimsdk/open-im-server/v3/pkg/util/useronline"
 	"github.com/openimsdk/tools/db/cacheutil"
 	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
 	"github.com/redis/go-redis/v9"
 )
 
-func NewOnlineCache(client *rpcli.UserClient, group *GroupLocalCache, rdb redis.UniversalClient, fullUserCache bool, fn func(ctx context.Context, userID string, platformIDs []int32)) (*OnlineCache, error) {
+// NewOnlineCache caches the online status of the users of the default tenant and of tenants. The
+// entries are keyed by the user IDs scoped by tenant.Key, fn receives ctx carrying the tenant.
+func NewOnlineCache(client *rpcli.UserClient, group *GroupLocalCache, rdb redis.UniversalClient, fullUserCache bool, tenants []string, fn func(ctx context.Context, userID string, platformIDs []int32)) (*OnlineCache, error) {
 	l := &sync.Mutex{}
 	x := &OnlineCache{
 		client:        client,
 		group:         group,
 		fullUserCache: fullUserCache,
+		tenants:       tenants,
 		Lock:          l,
 		Cond:          sync.NewCond(l),
 	}
@@ -71,6 +76,7 @@ type OnlineCache struct {
 	// fullUserCache if enabled, caches the online status of all users using mapCache;
 	// otherwise, only a portion of users' online statuses (regardless of whether they are online) will be cached using lruCache.
 	fullUserCache bool
+	tenants       []string
 
 	lruCache lru.LRU[string, []int32]
 	mapCache *cacheutil.Cache[string, []int32]
@@ -109,24 +115,27 @@ func (o *OnlineCache) initUsersOnlineStatus(ctx context.Context) (err error) {
 		return err
 	}
 
-	cursor := uint64(0)
-	for resp == nil || resp.NextCursor != 0 {
-		if err = retryOperation(func() error {
-			resp, err = o.client.GetAllOnlineUsers(ctx, cursor)
-			if err != nil {
-				return err
-			}
+	for _, tenantID := range append([]string{""}, o.tenants...) {
+		ctx := tenant.WithTenantID(ctx, tenantID)
+		cursor := uint64(0)
+		for resp = nil; resp == nil || resp.NextCursor != 0; {
+			if err = retryOperation(func() error {
+				resp, err = o.client.GetAllOnlineUsers(ctx, cursor)
+				if err != nil {
+					return err
+				}
 
-			for _, u := range resp.StatusList {
-				if u.Status == constant.Online {
-					o.setUserOnline(u.UserID, u.PlatformIDs)
+				for _, u := range resp.StatusList {
+					if u.Status == constant.Online {
+						o.setUserOnline(tenant.KeyOf(tenantID, u.UserID), u.PlatformIDs)
+					}
+					totalSet.Add(1)
 				}
-				totalSet.Add(1)
+				cursor = resp.NextCursor
+				return nil
+			}, "getAllOnlineUsers"); err != nil {
+				return err
 			}
-			cursor = resp.NextCursor
-			return nil
-		}, "getAllOnlineUsers"); err != nil {
-			return err
 		}
 	}
 
@@ -161,6 +170,7 @@ func (o *OnlineCache) doSubscribe(ctx context.Context, rdb redis.UniversalClient
 			storageCache := o.setHasUserOnline(userID, platformIDs)
 			log.ZDebug(ctx, "OnlineCache setHasUserOnline", "userID", userID, "platformIDs", platformIDs, "payload", message.Payload, "storageCache", storageCache)
 			if fn != nil {
+				ctx, userID := tenant.FromKey(ctx, userID)
 				fn(ctx, userID, platformIDs)
 			}
 		}
@@ -185,7 +195,7 @@ func (o *OnlineCache) doSubscribe(ctx context.Context, rdb redis.UniversalClient
 }
 
 func (o *OnlineCache) getUserOnlinePlatform(ctx context.Context, userID string) ([]int32, error) {
-	platformIDs, err := o.lruCache.Get(userID, func() ([]int32, error) {
+	platformIDs, err := o.lruCache.Get(tenant.Key(ctx, userID), func() ([]int32, error) {
 		return o.client.GetUserOnlinePlatform(ctx, userID)
 	})
 	if err != nil {
@@ -218,15 +228,20 @@ func (o *OnlineCache) getUserOnlinePlatformBatch(ctx context.Context, userIDs []
 	if len(userIDs) == 0 {
 		return nil, nil
 	}
-	platformIDsMap, err := o.lruCache.GetBatch(userIDs, func(missingUsers []string) (map[string][]int32, error) {
+	keys := datautil.Slice(userIDs, func(userID string) string { return tenant.Key(ctx, userID) })
+	platformIDsMap, err := o.lruCache.GetBatch(keys, func(missingKeys []string) (map[string][]int32, error) {
 		platformIDsMap := make(map[string][]int32)
+		missingUsers := datautil.Slice(missingKeys, func(key string) string {
+			_, userID := tenant.SplitKey(key)
+			return userID
+		})
 		usersStatus, err := o.client.GetUsersOnlinePlatform(ctx, missingUsers)
 		if err != nil {
 			return nil, err
 		}
 
 		for _, u := range usersStatus {
-			platformIDsMap[u.UserID] = u.PlatformIDs
+			platformIDsMap[tenant.Key(ctx, u.UserID)] = u.PlatformIDs
 		}
 
 		return platformIDsMap, nil
@@ -235,7 +250,12 @@ func (o *OnlineCache) getUserOnlinePlatformBatch(ctx context.Context, userIDs []
 		log.ZError(ctx, "OnlineCache GetUserOnlinePlatform", err, "userID", userIDs)
 		return nil, err
 	}
-	return platformIDsMap, nil
+	res := make(map[string][]int32, len(platformIDsMap))
+	for key, platformIDs := range platformIDsMap {
+		_, userID := tenant.SplitKey(key)
+		res[userID] = platformIDs
+	}
+	return res, nil
 }
 
 func (o *OnlineCache) GetUsersOnline(ctx context.Context, userIDs []string) ([]string, []string, error) {
@@ -249,7 +269,7 @@ func (o *OnlineCache) GetUsersOnline(ctx context.Context, userIDs []string) ([]s
 	switch o.fullUserCache {
 	case true:
 		for _, userID := range userIDs {
-			if _, ok := o.mapCache.Load(userID); ok {
+			if _, ok := o.mapCache.Load(tenant.Key(ctx, userID)); ok {
 				onlineUserIDs = append(onlineUserIDs, userID)
 			} else {
 				offlineUserIDs = append(offlineUserIDs, userID)
@@ -28,3 +28,7 @@ func (x *AuthClient) InvalidateToken(ctx context.Context, req *auth.InvalidateTo
 func (x *AuthClient) ParseToken(ctx context.Context, token string) (*auth.ParseTokenResp, error) {
 	return x.AuthClient.ParseToken(ctx, &auth.ParseTokenReq{Token: token})
 }
+
+func (x *AuthClient) ForceLogout(ctx context.Context, userID string, platformID int32) error {
+	return ignoreResp(x.AuthClient.ForceLogout(ctx, &auth.ForceLogoutReq{UserID: userID, PlatformID: platformID}))
+}
@@ -21,3 +21,9 @@ func (x *RelationClient) GetFriendsInfo(ctx context.Context, ownerUserID string,
 	req := &relation.GetFriendInfoReq{OwnerUserID: ownerUserID, FriendUserIDs: friendUserIDs}
 	return extractField(ctx, x.FriendClient.GetFriendInfo, req, (*relation.GetFriendInfoResp).GetFriendInfos)
 }
+
+// IsFriend reports whether friendUserID is in the friend list of ownerUserID.
+func (x *RelationClient) IsFriend(ctx context.Context, ownerUserID string, friendUserID string) (bool, error) {
+	req := &relation.IsFriendReq{UserID1: ownerUserID, UserID2: friendUserID}
+	return extractField(ctx, x.FriendClient.IsFriend, req, (*relation.IsFriendResp).GetInUser1Friends)
+}
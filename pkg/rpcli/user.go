@@ -93,3 +93,10 @@ func (x *UserClient) GetAllUserIDs(ctx context.Context, pageNumber, showNumber i
 	req := &user.GetAllUserIDReq{Pagination: &sdkws.RequestPagination{PageNumber: pageNumber, ShowNumber: showNumber}}
 	return extractField(ctx, x.UserClient.GetAllUserID, req, (*user.GetAllUserIDResp).GetUserIDs)
 }
+
+func (x *UserClient) RegisterUsers(ctx context.Context, users []*sdkws.UserInfo) error {
+	if len(users) == 0 {
+		return nil
+	}
+	return ignoreResp(x.UserClient.UserRegister(ctx, &user.UserRegisterReq{Users: users}))
+}
@@ -4,18 +4,31 @@ import (
 	"errors"
 	"strconv"
 	"strings"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/tenant"
 )
 
+// ParseUserOnlineStatus parses "platformID:...:userID", the userID of a tenant user is scoped by
+// tenant.Key and contains ":" itself.
 func ParseUserOnlineStatus(payload string) (string, []int32, error) {
-	arr := strings.Split(payload, ":")
-	if len(arr) == 0 {
-		return "", nil, errors.New("invalid data")
+	var (
+		arr    []string
+		userID string
+	)
+	if i := strings.Index(payload, tenant.KeyPrefix); i >= 0 {
+		userID = payload[i:]
+		if i > 0 {
+			arr = strings.Split(strings.TrimSuffix(payload[:i], ":"), ":")
+		}
+	} else {
+		arr = strings.Split(payload, ":")
+		userID = arr[len(arr)-1]
+		arr = arr[:len(arr)-1]
 	}
-	userID := arr[len(arr)-1]
-	if userID == "" {
+	if _, unscoped := tenant.SplitKey(userID); unscoped == "" {
 		return "", nil, errors.New("userID is empty")
 	}
-	platformIDs := make([]int32, len(arr)-1)
+	platformIDs := make([]int32, len(arr))
 	for i := range platformIDs {
 		platformID, err := strconv.Atoi(arr[i])
 		if err != nil {
@@ -0,0 +1,77 @@
+// Package webpushutil checks the endpoints of the browser push subscriptions, which the clients
+// choose and the server posts to.
+package webpushutil
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+// pushServiceHosts are the push services of the browsers, a host matches itself and its subdomains.
+var pushServiceHosts = []string{
+	"fcm.googleapis.com",                // Chrome, Edge on Android
+	"updates.push.services.mozilla.com", // Firefox
+	"web.push.apple.com",                // Safari
+	"notify.windows.com",                // Edge on Windows
+}
+
+// CheckEndpoint reports an error unless the endpoint is an https url of a known push service
+// whose addresses are all public.
+func CheckEndpoint(ctx context.Context, endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Scheme != "https" || u.User != nil || (u.Port() != "" && u.Port() != "443") {
+		return errs.ErrArgs.WrapMsg("subscription endpoint must be an https url", "endpoint", endpoint)
+	}
+	host := strings.ToLower(u.Hostname())
+	if !isPushServiceHost(host) {
+		return errs.ErrArgs.WrapMsg("subscription endpoint is not a known push service", "host", host)
+	}
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return errs.ErrArgs.WrapMsg("subscription endpoint can not be resolved", "host", host)
+	}
+	for _, addr := range addrs {
+		if !isPublicIP(addr.IP) {
+			return errs.ErrArgs.WrapMsg("subscription endpoint resolves to a private address", "host", host)
+		}
+	}
+	return nil
+}
+
+// Dialer connects to public addresses only, so an endpoint resolving to an internal address
+// after it was registered is still not reached.
+func Dialer(timeout time.Duration) *net.Dialer {
+	return &net.Dialer{
+		Timeout: timeout,
+		Control: func(_ string, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !isPublicIP(ip) {
+				return errs.New("web push to a private address", "address", address).Wrap()
+			}
+			return nil
+		},
+	}
+}
+
+func isPushServiceHost(host string) bool {
+	for _, h := range pushServiceHosts {
+		if host == h || strings.HasSuffix(host, "."+h) {
+			return true
+		}
+	}
+	return false
+}
+
+func isPublicIP(ip net.IP) bool {
+	return !(ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified())
+}
@@ -0,0 +1,64 @@
+package webpushutil
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCheckEndpointRejects(t *testing.T) {
+	for _, endpoint := range []string{
+		"http://fcm.googleapis.com/fcm/send/a",
+		"https://fcm.googleapis.com:8443/fcm/send/a",
+		"https://user@fcm.googleapis.com/fcm/send/a",
+		"https://fcm.googleapis.com.example.com/fcm/send/a",
+		"https://127.0.0.1/fcm/send/a",
+		"https://localhost/fcm/send/a",
+		"https://metadata.google.internal/computeMetadata/v1/",
+	} {
+		if err := CheckEndpoint(context.Background(), endpoint); err == nil {
+			t.Errorf("expected %s to be rejected", endpoint)
+		}
+	}
+	for _, host := range []string{"fcm.googleapis.com", "wns2-by3p.notify.windows.com", "web.push.apple.com"} {
+		if !isPushServiceHost(host) {
+			t.Errorf("expected %s to be a push service", host)
+		}
+	}
+}
+
+func TestIsPublicIP(t *testing.T) {
+	for ip, public := range map[string]bool{
+		"142.250.74.42":   true,
+		"2a00:1450::200a": true,
+		"127.0.0.1":       false,
+		"10.0.0.8":        false,
+		"172.16.3.4":      false,
+		"192.168.1.1":     false,
+		"169.254.169.254": false,
+		"::1":             false,
+		"fe80::1":         false,
+		"fd00::1":         false,
+		"::ffff:10.0.0.1": false,
+		"0.0.0.0":         false,
+	} {
+		if isPublicIP(net.ParseIP(ip)) != public {
+			t.Errorf("expected %s public %v", ip, public)
+		}
+	}
+}
+
+func TestDialerRefusesPrivateAddresses(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = Dialer(time.Second).DialContext
+	client := &http.Client{Transport: transport}
+	if resp, err := client.Get(srv.URL); err == nil {
+		resp.Body.Close()
+		t.Fatal("expected the loopback address to be refused")
+	}
+}